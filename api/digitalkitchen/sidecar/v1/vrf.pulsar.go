@@ -1019,25 +1019,1371 @@ func (x *fastReflection_QueryRandomnessResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var (
+	md_WatchRandomnessRequest             protoreflect.MessageDescriptor
+	fd_WatchRandomnessRequest_start_round protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_sidecar_v1_vrf_proto_init()
+	md_WatchRandomnessRequest = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("WatchRandomnessRequest")
+	fd_WatchRandomnessRequest_start_round = md_WatchRandomnessRequest.Fields().ByName("start_round")
+}
+
+var _ protoreflect.Message = (*fastReflection_WatchRandomnessRequest)(nil)
+
+type fastReflection_WatchRandomnessRequest WatchRandomnessRequest
+
+func (x *WatchRandomnessRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WatchRandomnessRequest)(x)
+}
+
+func (x *WatchRandomnessRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WatchRandomnessRequest_messageType fastReflection_WatchRandomnessRequest_messageType
+var _ protoreflect.MessageType = fastReflection_WatchRandomnessRequest_messageType{}
+
+type fastReflection_WatchRandomnessRequest_messageType struct{}
+
+func (x fastReflection_WatchRandomnessRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WatchRandomnessRequest)(nil)
+}
+func (x fastReflection_WatchRandomnessRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_WatchRandomnessRequest)
+}
+func (x fastReflection_WatchRandomnessRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WatchRandomnessRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WatchRandomnessRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_WatchRandomnessRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WatchRandomnessRequest) Type() protoreflect.MessageType {
+	return _fastReflection_WatchRandomnessRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WatchRandomnessRequest) New() protoreflect.Message {
+	return new(fastReflection_WatchRandomnessRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WatchRandomnessRequest) Interface() protoreflect.ProtoMessage {
+	return (*WatchRandomnessRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WatchRandomnessRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartRound)
+		if !f(fd_WatchRandomnessRequest_start_round, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WatchRandomnessRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		return x.StartRound != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WatchRandomnessRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		x.StartRound = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WatchRandomnessRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		value := x.StartRound
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WatchRandomnessRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		x.StartRound = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WatchRandomnessRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		panic(fmt.Errorf("field start_round of message digitalkitchen.sidecar.v1.WatchRandomnessRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WatchRandomnessRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.WatchRandomnessRequest.start_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.WatchRandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.WatchRandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WatchRandomnessRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.WatchRandomnessRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WatchRandomnessRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WatchRandomnessRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WatchRandomnessRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WatchRandomnessRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WatchRandomnessRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartRound))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WatchRandomnessRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StartRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartRound))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WatchRandomnessRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WatchRandomnessRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WatchRandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartRound", wireType)
+				}
+				x.StartRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_QueryInfoRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_digitalkitchen_sidecar_v1_vrf_proto_init()
-	md_QueryInfoRequest = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryInfoRequest")
+	md_QueryInfoRequest = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryInfoRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInfoRequest)(nil)
+
+type fastReflection_QueryInfoRequest QueryInfoRequest
+
+func (x *QueryInfoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInfoRequest)(x)
+}
+
+func (x *QueryInfoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInfoRequest_messageType fastReflection_QueryInfoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInfoRequest_messageType{}
+
+type fastReflection_QueryInfoRequest_messageType struct{}
+
+func (x fastReflection_QueryInfoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInfoRequest)(nil)
+}
+func (x fastReflection_QueryInfoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInfoRequest)
+}
+func (x fastReflection_QueryInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInfoRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInfoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInfoRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInfoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInfoRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInfoRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInfoRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInfoRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInfoRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryInfoRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInfoRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInfoRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInfoRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInfoRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInfoRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInfoResponse                  protoreflect.MessageDescriptor
+	fd_QueryInfoResponse_chain_hash       protoreflect.FieldDescriptor
+	fd_QueryInfoResponse_public_key       protoreflect.FieldDescriptor
+	fd_QueryInfoResponse_period_seconds   protoreflect.FieldDescriptor
+	fd_QueryInfoResponse_genesis_unix_sec protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_sidecar_v1_vrf_proto_init()
+	md_QueryInfoResponse = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryInfoResponse")
+	fd_QueryInfoResponse_chain_hash = md_QueryInfoResponse.Fields().ByName("chain_hash")
+	fd_QueryInfoResponse_public_key = md_QueryInfoResponse.Fields().ByName("public_key")
+	fd_QueryInfoResponse_period_seconds = md_QueryInfoResponse.Fields().ByName("period_seconds")
+	fd_QueryInfoResponse_genesis_unix_sec = md_QueryInfoResponse.Fields().ByName("genesis_unix_sec")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInfoResponse)(nil)
+
+type fastReflection_QueryInfoResponse QueryInfoResponse
+
+func (x *QueryInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInfoResponse)(x)
+}
+
+func (x *QueryInfoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInfoResponse_messageType fastReflection_QueryInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInfoResponse_messageType{}
+
+type fastReflection_QueryInfoResponse_messageType struct{}
+
+func (x fastReflection_QueryInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInfoResponse)(nil)
+}
+func (x fastReflection_QueryInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInfoResponse)
+}
+func (x fastReflection_QueryInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInfoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInfoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInfoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInfoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInfoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ChainHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ChainHash)
+		if !f(fd_QueryInfoResponse_chain_hash, value) {
+			return
+		}
+	}
+	if len(x.PublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PublicKey)
+		if !f(fd_QueryInfoResponse_public_key, value) {
+			return
+		}
+	}
+	if x.PeriodSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PeriodSeconds)
+		if !f(fd_QueryInfoResponse_period_seconds, value) {
+			return
+		}
+	}
+	if x.GenesisUnixSec != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GenesisUnixSec)
+		if !f(fd_QueryInfoResponse_genesis_unix_sec, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		return len(x.ChainHash) != 0
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		return len(x.PublicKey) != 0
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		return x.PeriodSeconds != uint64(0)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		return x.GenesisUnixSec != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		x.ChainHash = nil
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		x.PublicKey = nil
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		x.PeriodSeconds = uint64(0)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		x.GenesisUnixSec = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		value := x.ChainHash
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		value := x.PeriodSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		value := x.GenesisUnixSec
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		x.ChainHash = value.Bytes()
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		x.PublicKey = value.Bytes()
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		x.PeriodSeconds = value.Uint()
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		x.GenesisUnixSec = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		panic(fmt.Errorf("field chain_hash of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		panic(fmt.Errorf("field public_key of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		panic(fmt.Errorf("field period_seconds of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		panic(fmt.Errorf("field genesis_unix_sec of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryInfoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInfoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInfoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInfoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PeriodSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.PeriodSeconds))
+		}
+		if x.GenesisUnixSec != 0 {
+			n += 1 + runtime.Sov(uint64(x.GenesisUnixSec))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInfoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GenesisUnixSec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenesisUnixSec))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PeriodSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainHash) > 0 {
+			i -= len(x.ChainHash)
+			copy(dAtA[i:], x.ChainHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInfoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ChainHash == nil {
+					x.ChainHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PublicKey == nil {
+					x.PublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+				}
+				x.PeriodSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PeriodSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
+				}
+				x.GenesisUnixSec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GenesisUnixSec |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPartialSignatureRequest       protoreflect.MessageDescriptor
+	fd_QueryPartialSignatureRequest_round protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_sidecar_v1_vrf_proto_init()
+	md_QueryPartialSignatureRequest = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryPartialSignatureRequest")
+	fd_QueryPartialSignatureRequest_round = md_QueryPartialSignatureRequest.Fields().ByName("round")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryInfoRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPartialSignatureRequest)(nil)
 
-type fastReflection_QueryInfoRequest QueryInfoRequest
+type fastReflection_QueryPartialSignatureRequest QueryPartialSignatureRequest
 
-func (x *QueryInfoRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryInfoRequest)(x)
+func (x *QueryPartialSignatureRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPartialSignatureRequest)(x)
 }
 
-func (x *QueryInfoRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[2]
+func (x *QueryPartialSignatureRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,43 +2394,43 @@ func (x *QueryInfoRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryInfoRequest_messageType fastReflection_QueryInfoRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryInfoRequest_messageType{}
+var _fastReflection_QueryPartialSignatureRequest_messageType fastReflection_QueryPartialSignatureRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPartialSignatureRequest_messageType{}
 
-type fastReflection_QueryInfoRequest_messageType struct{}
+type fastReflection_QueryPartialSignatureRequest_messageType struct{}
 
-func (x fastReflection_QueryInfoRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryInfoRequest)(nil)
+func (x fastReflection_QueryPartialSignatureRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPartialSignatureRequest)(nil)
 }
-func (x fastReflection_QueryInfoRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryInfoRequest)
+func (x fastReflection_QueryPartialSignatureRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPartialSignatureRequest)
 }
-func (x fastReflection_QueryInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInfoRequest
+func (x fastReflection_QueryPartialSignatureRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPartialSignatureRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryInfoRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInfoRequest
+func (x *fastReflection_QueryPartialSignatureRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPartialSignatureRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryInfoRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryInfoRequest_messageType
+func (x *fastReflection_QueryPartialSignatureRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPartialSignatureRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryInfoRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryInfoRequest)
+func (x *fastReflection_QueryPartialSignatureRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPartialSignatureRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryInfoRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryInfoRequest)(x)
+func (x *fastReflection_QueryPartialSignatureRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPartialSignatureRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1092,7 +2438,13 @@ func (x *fastReflection_QueryInfoRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPartialSignatureRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Round != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Round)
+		if !f(fd_QueryPartialSignatureRequest_round, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1106,13 +2458,15 @@ func (x *fastReflection_QueryInfoRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPartialSignatureRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		return x.Round != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1122,13 +2476,15 @@ func (x *fastReflection_QueryInfoRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPartialSignatureRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		x.Round = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1138,13 +2494,16 @@ func (x *fastReflection_QueryInfoRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		value := x.Round
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1158,13 +2517,15 @@ func (x *fastReflection_QueryInfoRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPartialSignatureRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		x.Round = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1178,36 +2539,40 @@ func (x *fastReflection_QueryInfoRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		panic(fmt.Errorf("field round of message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureRequest.round":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPartialSignatureRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryInfoRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryPartialSignatureRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1215,7 +2580,7 @@ func (x *fastReflection_QueryInfoRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryInfoRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPartialSignatureRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1226,7 +2591,7 @@ func (x *fastReflection_QueryInfoRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPartialSignatureRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1238,7 +2603,7 @@ func (x *fastReflection_QueryInfoRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryInfoRequest) IsValid() bool {
+func (x *fastReflection_QueryPartialSignatureRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1248,9 +2613,9 @@ func (x *fastReflection_QueryInfoRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPartialSignatureRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryInfoRequest)
+		x := input.Message.Interface().(*QueryPartialSignatureRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1262,6 +2627,9 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1272,7 +2640,7 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInfoRequest)
+		x := input.Message.Interface().(*QueryPartialSignatureRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1291,6 +2659,11 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1302,7 +2675,7 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInfoRequest)
+		x := input.Message.Interface().(*QueryPartialSignatureRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1334,12 +2707,31 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPartialSignatureRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPartialSignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1376,32 +2768,28 @@ func (x *fastReflection_QueryInfoRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryInfoResponse                  protoreflect.MessageDescriptor
-	fd_QueryInfoResponse_chain_hash       protoreflect.FieldDescriptor
-	fd_QueryInfoResponse_public_key       protoreflect.FieldDescriptor
-	fd_QueryInfoResponse_period_seconds   protoreflect.FieldDescriptor
-	fd_QueryInfoResponse_genesis_unix_sec protoreflect.FieldDescriptor
+	md_QueryPartialSignatureResponse                   protoreflect.MessageDescriptor
+	fd_QueryPartialSignatureResponse_drand_round       protoreflect.FieldDescriptor
+	fd_QueryPartialSignatureResponse_partial_signature protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_digitalkitchen_sidecar_v1_vrf_proto_init()
-	md_QueryInfoResponse = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryInfoResponse")
-	fd_QueryInfoResponse_chain_hash = md_QueryInfoResponse.Fields().ByName("chain_hash")
-	fd_QueryInfoResponse_public_key = md_QueryInfoResponse.Fields().ByName("public_key")
-	fd_QueryInfoResponse_period_seconds = md_QueryInfoResponse.Fields().ByName("period_seconds")
-	fd_QueryInfoResponse_genesis_unix_sec = md_QueryInfoResponse.Fields().ByName("genesis_unix_sec")
+	md_QueryPartialSignatureResponse = File_digitalkitchen_sidecar_v1_vrf_proto.Messages().ByName("QueryPartialSignatureResponse")
+	fd_QueryPartialSignatureResponse_drand_round = md_QueryPartialSignatureResponse.Fields().ByName("drand_round")
+	fd_QueryPartialSignatureResponse_partial_signature = md_QueryPartialSignatureResponse.Fields().ByName("partial_signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryInfoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPartialSignatureResponse)(nil)
 
-type fastReflection_QueryInfoResponse QueryInfoResponse
+type fastReflection_QueryPartialSignatureResponse QueryPartialSignatureResponse
 
-func (x *QueryInfoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryInfoResponse)(x)
+func (x *QueryPartialSignatureResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPartialSignatureResponse)(x)
 }
 
-func (x *QueryInfoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[3]
+func (x *QueryPartialSignatureResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,43 +2800,43 @@ func (x *QueryInfoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryInfoResponse_messageType fastReflection_QueryInfoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryInfoResponse_messageType{}
+var _fastReflection_QueryPartialSignatureResponse_messageType fastReflection_QueryPartialSignatureResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPartialSignatureResponse_messageType{}
 
-type fastReflection_QueryInfoResponse_messageType struct{}
+type fastReflection_QueryPartialSignatureResponse_messageType struct{}
 
-func (x fastReflection_QueryInfoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryInfoResponse)(nil)
+func (x fastReflection_QueryPartialSignatureResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPartialSignatureResponse)(nil)
 }
-func (x fastReflection_QueryInfoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryInfoResponse)
+func (x fastReflection_QueryPartialSignatureResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPartialSignatureResponse)
 }
-func (x fastReflection_QueryInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInfoResponse
+func (x fastReflection_QueryPartialSignatureResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPartialSignatureResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryInfoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInfoResponse
+func (x *fastReflection_QueryPartialSignatureResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPartialSignatureResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryInfoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryInfoResponse_messageType
+func (x *fastReflection_QueryPartialSignatureResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPartialSignatureResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryInfoResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryInfoResponse)
+func (x *fastReflection_QueryPartialSignatureResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPartialSignatureResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryInfoResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryInfoResponse)(x)
+func (x *fastReflection_QueryPartialSignatureResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPartialSignatureResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1456,28 +2844,16 @@ func (x *fastReflection_QueryInfoResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ChainHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.ChainHash)
-		if !f(fd_QueryInfoResponse_chain_hash, value) {
-			return
-		}
-	}
-	if len(x.PublicKey) != 0 {
-		value := protoreflect.ValueOfBytes(x.PublicKey)
-		if !f(fd_QueryInfoResponse_public_key, value) {
-			return
-		}
-	}
-	if x.PeriodSeconds != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PeriodSeconds)
-		if !f(fd_QueryInfoResponse_period_seconds, value) {
+func (x *fastReflection_QueryPartialSignatureResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_QueryPartialSignatureResponse_drand_round, value) {
 			return
 		}
 	}
-	if x.GenesisUnixSec != int64(0) {
-		value := protoreflect.ValueOfInt64(x.GenesisUnixSec)
-		if !f(fd_QueryInfoResponse_genesis_unix_sec, value) {
+	if len(x.PartialSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.PartialSignature)
+		if !f(fd_QueryPartialSignatureResponse_partial_signature, value) {
 			return
 		}
 	}
@@ -1494,21 +2870,17 @@ func (x *fastReflection_QueryInfoResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPartialSignatureResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		return len(x.ChainHash) != 0
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		return len(x.PublicKey) != 0
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
-		return x.PeriodSeconds != uint64(0)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		return x.GenesisUnixSec != int64(0)
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		return len(x.PartialSignature) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1518,21 +2890,17 @@ func (x *fastReflection_QueryInfoResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPartialSignatureResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		x.ChainHash = nil
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		x.PublicKey = nil
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
-		x.PeriodSeconds = uint64(0)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		x.GenesisUnixSec = int64(0)
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		x.PartialSignature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1542,25 +2910,19 @@ func (x *fastReflection_QueryInfoResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		value := x.ChainHash
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		value := x.PublicKey
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
-		value := x.PeriodSeconds
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
+		value := x.DrandRound
 		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		value := x.GenesisUnixSec
-		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		value := x.PartialSignature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1572,23 +2934,19 @@ func (x *fastReflection_QueryInfoResponse) Get(descriptor protoreflect.FieldDesc
 // When setting a composite type, it is unspecified whether the stored value
 // aliases the source's memory in any way. If the composite value is an
 // empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		x.ChainHash = value.Bytes()
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		x.PublicKey = value.Bytes()
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
-		x.PeriodSeconds = value.Uint()
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		x.GenesisUnixSec = value.Int()
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPartialSignatureResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		x.PartialSignature = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1602,52 +2960,44 @@ func (x *fastReflection_QueryInfoResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		panic(fmt.Errorf("field chain_hash of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		panic(fmt.Errorf("field public_key of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
-		panic(fmt.Errorf("field period_seconds of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		panic(fmt.Errorf("field genesis_unix_sec of message digitalkitchen.sidecar.v1.QueryInfoResponse is not mutable"))
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse is not mutable"))
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		panic(fmt.Errorf("field partial_signature of message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPartialSignatureResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.chain_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.public_key":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.period_seconds":
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.drand_round":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.sidecar.v1.QueryInfoResponse.genesis_unix_sec":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.sidecar.v1.QueryPartialSignatureResponse.partial_signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryInfoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryInfoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.sidecar.v1.QueryPartialSignatureResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPartialSignatureResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryInfoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.sidecar.v1.QueryPartialSignatureResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1655,7 +3005,7 @@ func (x *fastReflection_QueryInfoResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryInfoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPartialSignatureResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1666,7 +3016,7 @@ func (x *fastReflection_QueryInfoResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPartialSignatureResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1678,7 +3028,7 @@ func (x *fastReflection_QueryInfoResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryInfoResponse) IsValid() bool {
+func (x *fastReflection_QueryPartialSignatureResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1688,9 +3038,9 @@ func (x *fastReflection_QueryInfoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPartialSignatureResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryInfoResponse)
+		x := input.Message.Interface().(*QueryPartialSignatureResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1702,20 +3052,13 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
 		}
-		l = len(x.PublicKey)
+		l = len(x.PartialSignature)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.PeriodSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.PeriodSeconds))
-		}
-		if x.GenesisUnixSec != 0 {
-			n += 1 + runtime.Sov(uint64(x.GenesisUnixSec))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1726,7 +3069,7 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInfoResponse)
+		x := input.Message.Interface().(*QueryPartialSignatureResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1745,29 +3088,17 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GenesisUnixSec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenesisUnixSec))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.PeriodSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodSeconds))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+		if len(x.PartialSignature) > 0 {
+			i -= len(x.PartialSignature)
+			copy(dAtA[i:], x.PartialSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartialSignature)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ChainHash) > 0 {
-			i -= len(x.ChainHash)
-			copy(dAtA[i:], x.ChainHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1780,7 +3111,7 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInfoResponse)
+		x := input.Message.Interface().(*QueryPartialSignatureResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1812,17 +3143,17 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPartialSignatureResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPartialSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
 				}
-				var byteLen int
+				x.DrandRound = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1832,29 +3163,14 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.DrandRound |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.ChainHash == nil {
-					x.ChainHash = []byte{}
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialSignature", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1881,49 +3197,11 @@ func (x *fastReflection_QueryInfoResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-				if x.PublicKey == nil {
-					x.PublicKey = []byte{}
+				x.PartialSignature = append(x.PartialSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.PartialSignature == nil {
+					x.PartialSignature = []byte{}
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
-				}
-				x.PeriodSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PeriodSeconds |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
-				}
-				x.GenesisUnixSec = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GenesisUnixSec |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2074,6 +3352,45 @@ func (x *QueryRandomnessResponse) GetPreviousSignature() []byte {
 	return nil
 }
 
+// WatchRandomnessRequest defines the request type for the WatchRandomness
+// method. A start_round of zero starts the stream at the latest beacon; a
+// client resuming after a disconnect passes the round after the last one it
+// received.
+type WatchRandomnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartRound uint64 `protobuf:"varint,1,opt,name=start_round,json=startRound,proto3" json:"start_round,omitempty"`
+}
+
+func (x *WatchRandomnessRequest) Reset() {
+	*x = WatchRandomnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRandomnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRandomnessRequest) ProtoMessage() {}
+
+// Deprecated: Use WatchRandomnessRequest.ProtoReflect.Descriptor instead.
+func (*WatchRandomnessRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchRandomnessRequest) GetStartRound() uint64 {
+	if x != nil {
+		return x.StartRound
+	}
+	return 0
+}
+
 // QueryInfoRequest defines the request type for the Info method.
 type QueryInfoRequest struct {
 	state         protoimpl.MessageState
@@ -2084,7 +3401,7 @@ type QueryInfoRequest struct {
 func (x *QueryInfoRequest) Reset() {
 	*x = QueryInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[2]
+		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2098,7 +3415,7 @@ func (*QueryInfoRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryInfoRequest.ProtoReflect.Descriptor instead.
 func (*QueryInfoRequest) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{2}
+	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{3}
 }
 
 // QueryInfoResponse carries static drand chain information.
@@ -2120,7 +3437,7 @@ type QueryInfoResponse struct {
 func (x *QueryInfoResponse) Reset() {
 	*x = QueryInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[3]
+		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2134,7 +3451,7 @@ func (*QueryInfoResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryInfoResponse.ProtoReflect.Descriptor instead.
 func (*QueryInfoResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{3}
+	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QueryInfoResponse) GetChainHash() []byte {
@@ -2165,6 +3482,90 @@ func (x *QueryInfoResponse) GetGenesisUnixSec() int64 {
 	return 0
 }
 
+// QueryPartialSignatureRequest defines the request type for the
+// PartialSignature method.
+type QueryPartialSignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *QueryPartialSignatureRequest) Reset() {
+	*x = QueryPartialSignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPartialSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPartialSignatureRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPartialSignatureRequest.ProtoReflect.Descriptor instead.
+func (*QueryPartialSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryPartialSignatureRequest) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+// QueryPartialSignatureResponse carries a partial beacon signature.
+type QueryPartialSignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// drand_round is the signed drand round number.
+	DrandRound uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// partial_signature is the threshold-BLS signature share, prefixed with the
+	// big-endian uint16 share index.
+	PartialSignature []byte `protobuf:"bytes,2,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (x *QueryPartialSignatureResponse) Reset() {
+	*x = QueryPartialSignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPartialSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPartialSignatureResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPartialSignatureResponse.ProtoReflect.Descriptor instead.
+func (*QueryPartialSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryPartialSignatureResponse) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *QueryPartialSignatureResponse) GetPartialSignature() []byte {
+	if x != nil {
+		return x.PartialSignature
+	}
+	return nil
+}
+
 var File_digitalkitchen_sidecar_v1_vrf_proto protoreflect.FileDescriptor
 
 var file_digitalkitchen_sidecar_v1_vrf_proto_rawDesc = []byte{
@@ -2187,19 +3588,33 @@ var file_digitalkitchen_sidecar_v1_vrf_proto_rawDesc = []byte{
 	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65,
 	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x01, 0x0a,
-	0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65,
-	0x63, 0x32, 0x90, 0x02, 0x0a, 0x03, 0x56, 0x72, 0x66, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x52, 0x61,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x39, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x22, 0x34, 0x0a, 0x1c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x32, 0xb7, 0x04, 0x0a, 0x03, 0x56, 0x72, 0x66, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x52, 0x61,
 	0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x31, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
 	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
@@ -2216,22 +3631,40 @@ var file_digitalkitchen_sidecar_v1_vrf_proto_rawDesc = []byte{
 	0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f,
-	0x69, 0x6e, 0x66, 0x6f, 0x42, 0xe5, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x56, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x73,
-	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x53, 0x58, 0xaa, 0x02,
-	0x19, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
-	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x44, 0x69, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x53, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a,
-	0x3a, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0xa8, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x37, 0x2e, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x7a, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0xe5, 0x01, 0x0a, 0x1d,
+	0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x56,
+	0x72, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x44, 0x53, 0x58, 0xaa, 0x02, 0x19, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x19, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x5c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25,
+	0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x53,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2246,20 +3679,27 @@ func file_digitalkitchen_sidecar_v1_vrf_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_sidecar_v1_vrf_proto_rawDescData
 }
 
-var file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_digitalkitchen_sidecar_v1_vrf_proto_goTypes = []interface{}{
-	(*QueryRandomnessRequest)(nil),  // 0: digitalkitchen.sidecar.v1.QueryRandomnessRequest
-	(*QueryRandomnessResponse)(nil), // 1: digitalkitchen.sidecar.v1.QueryRandomnessResponse
-	(*QueryInfoRequest)(nil),        // 2: digitalkitchen.sidecar.v1.QueryInfoRequest
-	(*QueryInfoResponse)(nil),       // 3: digitalkitchen.sidecar.v1.QueryInfoResponse
+	(*QueryRandomnessRequest)(nil),        // 0: digitalkitchen.sidecar.v1.QueryRandomnessRequest
+	(*QueryRandomnessResponse)(nil),       // 1: digitalkitchen.sidecar.v1.QueryRandomnessResponse
+	(*WatchRandomnessRequest)(nil),        // 2: digitalkitchen.sidecar.v1.WatchRandomnessRequest
+	(*QueryInfoRequest)(nil),              // 3: digitalkitchen.sidecar.v1.QueryInfoRequest
+	(*QueryInfoResponse)(nil),             // 4: digitalkitchen.sidecar.v1.QueryInfoResponse
+	(*QueryPartialSignatureRequest)(nil),  // 5: digitalkitchen.sidecar.v1.QueryPartialSignatureRequest
+	(*QueryPartialSignatureResponse)(nil), // 6: digitalkitchen.sidecar.v1.QueryPartialSignatureResponse
 }
 var file_digitalkitchen_sidecar_v1_vrf_proto_depIdxs = []int32{
 	0, // 0: digitalkitchen.sidecar.v1.Vrf.Randomness:input_type -> digitalkitchen.sidecar.v1.QueryRandomnessRequest
-	2, // 1: digitalkitchen.sidecar.v1.Vrf.Info:input_type -> digitalkitchen.sidecar.v1.QueryInfoRequest
-	1, // 2: digitalkitchen.sidecar.v1.Vrf.Randomness:output_type -> digitalkitchen.sidecar.v1.QueryRandomnessResponse
-	3, // 3: digitalkitchen.sidecar.v1.Vrf.Info:output_type -> digitalkitchen.sidecar.v1.QueryInfoResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	3, // 1: digitalkitchen.sidecar.v1.Vrf.Info:input_type -> digitalkitchen.sidecar.v1.QueryInfoRequest
+	5, // 2: digitalkitchen.sidecar.v1.Vrf.PartialSignature:input_type -> digitalkitchen.sidecar.v1.QueryPartialSignatureRequest
+	2, // 3: digitalkitchen.sidecar.v1.Vrf.WatchRandomness:input_type -> digitalkitchen.sidecar.v1.WatchRandomnessRequest
+	1, // 4: digitalkitchen.sidecar.v1.Vrf.Randomness:output_type -> digitalkitchen.sidecar.v1.QueryRandomnessResponse
+	4, // 5: digitalkitchen.sidecar.v1.Vrf.Info:output_type -> digitalkitchen.sidecar.v1.QueryInfoResponse
+	6, // 6: digitalkitchen.sidecar.v1.Vrf.PartialSignature:output_type -> digitalkitchen.sidecar.v1.QueryPartialSignatureResponse
+	1, // 7: digitalkitchen.sidecar.v1.Vrf.WatchRandomness:output_type -> digitalkitchen.sidecar.v1.QueryRandomnessResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -2296,7 +3736,7 @@ func file_digitalkitchen_sidecar_v1_vrf_proto_init() {
 			}
 		}
 		file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInfoRequest); i {
+			switch v := v.(*WatchRandomnessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2308,6 +3748,18 @@ func file_digitalkitchen_sidecar_v1_vrf_proto_init() {
 			}
 		}
 		file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryInfoResponse); i {
 			case 0:
 				return &v.state
@@ -2319,6 +3771,30 @@ func file_digitalkitchen_sidecar_v1_vrf_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPartialSignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_sidecar_v1_vrf_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPartialSignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2326,7 +3802,7 @@ func file_digitalkitchen_sidecar_v1_vrf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_sidecar_v1_vrf_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
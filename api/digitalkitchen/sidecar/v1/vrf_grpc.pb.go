@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Vrf_Randomness_FullMethodName = "/digitalkitchen.sidecar.v1.Vrf/Randomness"
-	Vrf_Info_FullMethodName       = "/digitalkitchen.sidecar.v1.Vrf/Info"
+	Vrf_Randomness_FullMethodName       = "/digitalkitchen.sidecar.v1.Vrf/Randomness"
+	Vrf_Info_FullMethodName             = "/digitalkitchen.sidecar.v1.Vrf/Info"
+	Vrf_PartialSignature_FullMethodName = "/digitalkitchen.sidecar.v1.Vrf/PartialSignature"
+	Vrf_WatchRandomness_FullMethodName  = "/digitalkitchen.sidecar.v1.Vrf/WatchRandomness"
 )
 
 // VrfClient is the client API for Vrf service.
@@ -35,6 +37,12 @@ type VrfClient interface {
 	// Info returns static drand chain information used for verification and
 	// debugging.
 	Info(ctx context.Context, in *QueryInfoRequest, opts ...grpc.CallOption) (*QueryInfoResponse, error)
+	// PartialSignature signs a round with the local drand node's key share. It
+	// is used when the chain recovers beacons from partial signatures.
+	PartialSignature(ctx context.Context, in *QueryPartialSignatureRequest, opts ...grpc.CallOption) (*QueryPartialSignatureResponse, error)
+	// WatchRandomness streams every verified beacon from start_round onwards,
+	// in round order, as soon as the sidecar sees it.
+	WatchRandomness(ctx context.Context, in *WatchRandomnessRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryRandomnessResponse], error)
 }
 
 type vrfClient struct {
@@ -65,6 +73,35 @@ func (c *vrfClient) Info(ctx context.Context, in *QueryInfoRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *vrfClient) PartialSignature(ctx context.Context, in *QueryPartialSignatureRequest, opts ...grpc.CallOption) (*QueryPartialSignatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPartialSignatureResponse)
+	err := c.cc.Invoke(ctx, Vrf_PartialSignature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vrfClient) WatchRandomness(ctx context.Context, in *WatchRandomnessRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryRandomnessResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Vrf_ServiceDesc.Streams[0], Vrf_WatchRandomness_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRandomnessRequest, QueryRandomnessResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Vrf_WatchRandomnessClient = grpc.ServerStreamingClient[QueryRandomnessResponse]
+
 // VrfServer is the server API for Vrf service.
 // All implementations must embed UnimplementedVrfServer
 // for forward compatibility.
@@ -77,6 +114,12 @@ type VrfServer interface {
 	// Info returns static drand chain information used for verification and
 	// debugging.
 	Info(context.Context, *QueryInfoRequest) (*QueryInfoResponse, error)
+	// PartialSignature signs a round with the local drand node's key share. It
+	// is used when the chain recovers beacons from partial signatures.
+	PartialSignature(context.Context, *QueryPartialSignatureRequest) (*QueryPartialSignatureResponse, error)
+	// WatchRandomness streams every verified beacon from start_round onwards,
+	// in round order, as soon as the sidecar sees it.
+	WatchRandomness(*WatchRandomnessRequest, grpc.ServerStreamingServer[QueryRandomnessResponse]) error
 	mustEmbedUnimplementedVrfServer()
 }
 
@@ -93,6 +136,12 @@ func (UnimplementedVrfServer) Randomness(context.Context, *QueryRandomnessReques
 func (UnimplementedVrfServer) Info(context.Context, *QueryInfoRequest) (*QueryInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Info not implemented")
 }
+func (UnimplementedVrfServer) PartialSignature(context.Context, *QueryPartialSignatureRequest) (*QueryPartialSignatureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PartialSignature not implemented")
+}
+func (UnimplementedVrfServer) WatchRandomness(*WatchRandomnessRequest, grpc.ServerStreamingServer[QueryRandomnessResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchRandomness not implemented")
+}
 func (UnimplementedVrfServer) mustEmbedUnimplementedVrfServer() {}
 func (UnimplementedVrfServer) testEmbeddedByValue()             {}
 
@@ -150,6 +199,35 @@ func _Vrf_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vrf_PartialSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartialSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VrfServer).PartialSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vrf_PartialSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VrfServer).PartialSignature(ctx, req.(*QueryPartialSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vrf_WatchRandomness_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRandomnessRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VrfServer).WatchRandomness(m, &grpc.GenericServerStream[WatchRandomnessRequest, QueryRandomnessResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Vrf_WatchRandomnessServer = grpc.ServerStreamingServer[QueryRandomnessResponse]
+
 // Vrf_ServiceDesc is the grpc.ServiceDesc for Vrf service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -165,7 +243,17 @@ var Vrf_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _Vrf_Info_Handler,
 		},
+		{
+			MethodName: "PartialSignature",
+			Handler:    _Vrf_PartialSignature_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRandomness",
+			Handler:       _Vrf_WatchRandomness_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "digitalkitchen/sidecar/v1/vrf.proto",
 }
@@ -4,6 +4,7 @@ package abciv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -12,6 +13,108 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_VrfVoteExtension_7_list)(nil)
+
+type _VrfVoteExtension_7_list struct {
+	list *[]*VrfVoteExtensionBeacon
+}
+
+func (x *_VrfVoteExtension_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfVoteExtension_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfVoteExtensionBeacon)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfVoteExtension_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfVoteExtensionBeacon)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfVoteExtension_7_list) AppendMutable() protoreflect.Value {
+	v := new(VrfVoteExtensionBeacon)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfVoteExtension_7_list) NewElement() protoreflect.Value {
+	v := new(VrfVoteExtensionBeacon)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VrfVoteExtension_8_list)(nil)
+
+type _VrfVoteExtension_8_list struct {
+	list *[]*VrfVoteExtensionPartial
+}
+
+func (x *_VrfVoteExtension_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfVoteExtension_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfVoteExtensionPartial)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfVoteExtension_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfVoteExtensionPartial)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfVoteExtension_8_list) AppendMutable() protoreflect.Value {
+	v := new(VrfVoteExtensionPartial)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfVoteExtension_8_list) NewElement() protoreflect.Value {
+	v := new(VrfVoteExtensionPartial)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfVoteExtension_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_VrfVoteExtension                    protoreflect.MessageDescriptor
 	fd_VrfVoteExtension_drand_round        protoreflect.FieldDescriptor
@@ -19,6 +122,10 @@ var (
 	fd_VrfVoteExtension_signature          protoreflect.FieldDescriptor
 	fd_VrfVoteExtension_previous_signature protoreflect.FieldDescriptor
 	fd_VrfVoteExtension_chain_hash         protoreflect.FieldDescriptor
+	fd_VrfVoteExtension_version            protoreflect.FieldDescriptor
+	fd_VrfVoteExtension_beacons            protoreflect.FieldDescriptor
+	fd_VrfVoteExtension_partials           protoreflect.FieldDescriptor
+	fd_VrfVoteExtension_ecvrf_proof        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +136,10 @@ func init() {
 	fd_VrfVoteExtension_signature = md_VrfVoteExtension.Fields().ByName("signature")
 	fd_VrfVoteExtension_previous_signature = md_VrfVoteExtension.Fields().ByName("previous_signature")
 	fd_VrfVoteExtension_chain_hash = md_VrfVoteExtension.Fields().ByName("chain_hash")
+	fd_VrfVoteExtension_version = md_VrfVoteExtension.Fields().ByName("version")
+	fd_VrfVoteExtension_beacons = md_VrfVoteExtension.Fields().ByName("beacons")
+	fd_VrfVoteExtension_partials = md_VrfVoteExtension.Fields().ByName("partials")
+	fd_VrfVoteExtension_ecvrf_proof = md_VrfVoteExtension.Fields().ByName("ecvrf_proof")
 }
 
 var _ protoreflect.Message = (*fastReflection_VrfVoteExtension)(nil)
@@ -126,6 +237,30 @@ func (x *fastReflection_VrfVoteExtension) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_VrfVoteExtension_version, value) {
+			return
+		}
+	}
+	if len(x.Beacons) != 0 {
+		value := protoreflect.ValueOfList(&_VrfVoteExtension_7_list{list: &x.Beacons})
+		if !f(fd_VrfVoteExtension_beacons, value) {
+			return
+		}
+	}
+	if len(x.Partials) != 0 {
+		value := protoreflect.ValueOfList(&_VrfVoteExtension_8_list{list: &x.Partials})
+		if !f(fd_VrfVoteExtension_partials, value) {
+			return
+		}
+	}
+	if len(x.EcvrfProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.EcvrfProof)
+		if !f(fd_VrfVoteExtension_ecvrf_proof, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -151,6 +286,14 @@ func (x *fastReflection_VrfVoteExtension) Has(fd protoreflect.FieldDescriptor) b
 		return len(x.PreviousSignature) != 0
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		return len(x.ChainHash) != 0
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		return x.Version != uint32(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		return len(x.Beacons) != 0
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		return len(x.Partials) != 0
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		return len(x.EcvrfProof) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -177,6 +320,14 @@ func (x *fastReflection_VrfVoteExtension) Clear(fd protoreflect.FieldDescriptor)
 		x.PreviousSignature = nil
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		x.ChainHash = nil
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		x.Version = uint32(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		x.Beacons = nil
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		x.Partials = nil
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		x.EcvrfProof = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -208,6 +359,24 @@ func (x *fastReflection_VrfVoteExtension) Get(descriptor protoreflect.FieldDescr
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		value := x.ChainHash
 		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		if len(x.Beacons) == 0 {
+			return protoreflect.ValueOfList(&_VrfVoteExtension_7_list{})
+		}
+		listValue := &_VrfVoteExtension_7_list{list: &x.Beacons}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		if len(x.Partials) == 0 {
+			return protoreflect.ValueOfList(&_VrfVoteExtension_8_list{})
+		}
+		listValue := &_VrfVoteExtension_8_list{list: &x.Partials}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		value := x.EcvrfProof
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -238,6 +407,18 @@ func (x *fastReflection_VrfVoteExtension) Set(fd protoreflect.FieldDescriptor, v
 		x.PreviousSignature = value.Bytes()
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		x.ChainHash = value.Bytes()
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		x.Version = uint32(value.Uint())
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		lv := value.List()
+		clv := lv.(*_VrfVoteExtension_7_list)
+		x.Beacons = *clv.list
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		lv := value.List()
+		clv := lv.(*_VrfVoteExtension_8_list)
+		x.Partials = *clv.list
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		x.EcvrfProof = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -258,6 +439,18 @@ func (x *fastReflection_VrfVoteExtension) Set(fd protoreflect.FieldDescriptor, v
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_VrfVoteExtension) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		if x.Beacons == nil {
+			x.Beacons = []*VrfVoteExtensionBeacon{}
+		}
+		value := &_VrfVoteExtension_7_list{list: &x.Beacons}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		if x.Partials == nil {
+			x.Partials = []*VrfVoteExtensionPartial{}
+		}
+		value := &_VrfVoteExtension_8_list{list: &x.Partials}
+		return protoreflect.ValueOfList(value)
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.drand_round":
 		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.abci.v1.VrfVoteExtension is not mutable"))
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.randomness":
@@ -268,6 +461,10 @@ func (x *fastReflection_VrfVoteExtension) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field previous_signature of message digitalkitchen.vrf.abci.v1.VrfVoteExtension is not mutable"))
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		panic(fmt.Errorf("field chain_hash of message digitalkitchen.vrf.abci.v1.VrfVoteExtension is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		panic(fmt.Errorf("field version of message digitalkitchen.vrf.abci.v1.VrfVoteExtension is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		panic(fmt.Errorf("field ecvrf_proof of message digitalkitchen.vrf.abci.v1.VrfVoteExtension is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -291,6 +488,16 @@ func (x *fastReflection_VrfVoteExtension) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfBytes(nil)
 	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.chain_hash":
 		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons":
+		list := []*VrfVoteExtensionBeacon{}
+		return protoreflect.ValueOfList(&_VrfVoteExtension_7_list{list: &list})
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials":
+		list := []*VrfVoteExtensionPartial{}
+		return protoreflect.ValueOfList(&_VrfVoteExtension_8_list{list: &list})
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtension.ecvrf_proof":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtension"))
@@ -379,6 +586,25 @@ func (x *fastReflection_VrfVoteExtension) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.Beacons) > 0 {
+			for _, e := range x.Beacons {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Partials) > 0 {
+			for _, e := range x.Partials {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EcvrfProof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -408,6 +634,50 @@ func (x *fastReflection_VrfVoteExtension) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EcvrfProof) > 0 {
+			i -= len(x.EcvrfProof)
+			copy(dAtA[i:], x.EcvrfProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcvrfProof)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Partials) > 0 {
+			for iNdEx := len(x.Partials) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Partials[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Beacons) > 0 {
+			for iNdEx := len(x.Beacons) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Beacons[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.ChainHash) > 0 {
 			i -= len(x.ChainHash)
 			copy(dAtA[i:], x.ChainHash)
@@ -645,6 +915,127 @@ func (x *fastReflection_VrfVoteExtension) ProtoMethods() *protoiface.Methods {
 					x.ChainHash = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beacons", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beacons = append(x.Beacons, &VrfVoteExtensionBeacon{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beacons[len(x.Beacons)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Partials", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Partials = append(x.Partials, &VrfVoteExtensionPartial{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Partials[len(x.Partials)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfProof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcvrfProof = append(x.EcvrfProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.EcvrfProof == nil {
+					x.EcvrfProof = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -680,123 +1071,1379 @@ func (x *fastReflection_VrfVoteExtension) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: digitalkitchen/vrf/abci/v1/vote_extension.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_VrfVoteExtensionBeacon                    protoreflect.MessageDescriptor
+	fd_VrfVoteExtensionBeacon_drand_round        protoreflect.FieldDescriptor
+	fd_VrfVoteExtensionBeacon_randomness         protoreflect.FieldDescriptor
+	fd_VrfVoteExtensionBeacon_signature          protoreflect.FieldDescriptor
+	fd_VrfVoteExtensionBeacon_previous_signature protoreflect.FieldDescriptor
 )
 
-// VrfVoteExtension carries a single drand beacon for a specific round.
-type VrfVoteExtension struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_digitalkitchen_vrf_abci_v1_vote_extension_proto_init()
+	md_VrfVoteExtensionBeacon = File_digitalkitchen_vrf_abci_v1_vote_extension_proto.Messages().ByName("VrfVoteExtensionBeacon")
+	fd_VrfVoteExtensionBeacon_drand_round = md_VrfVoteExtensionBeacon.Fields().ByName("drand_round")
+	fd_VrfVoteExtensionBeacon_randomness = md_VrfVoteExtensionBeacon.Fields().ByName("randomness")
+	fd_VrfVoteExtensionBeacon_signature = md_VrfVoteExtensionBeacon.Fields().ByName("signature")
+	fd_VrfVoteExtensionBeacon_previous_signature = md_VrfVoteExtensionBeacon.Fields().ByName("previous_signature")
+}
 
-	DrandRound        uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
-	Randomness        []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
-	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
-	ChainHash         []byte `protobuf:"bytes,5,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+var _ protoreflect.Message = (*fastReflection_VrfVoteExtensionBeacon)(nil)
+
+type fastReflection_VrfVoteExtensionBeacon VrfVoteExtensionBeacon
+
+func (x *VrfVoteExtensionBeacon) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfVoteExtensionBeacon)(x)
 }
 
-func (x *VrfVoteExtension) Reset() {
-	*x = VrfVoteExtension{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[0]
+func (x *VrfVoteExtensionBeacon) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *VrfVoteExtension) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_VrfVoteExtensionBeacon_messageType fastReflection_VrfVoteExtensionBeacon_messageType
+var _ protoreflect.MessageType = fastReflection_VrfVoteExtensionBeacon_messageType{}
 
-func (*VrfVoteExtension) ProtoMessage() {}
+type fastReflection_VrfVoteExtensionBeacon_messageType struct{}
 
-// Deprecated: Use VrfVoteExtension.ProtoReflect.Descriptor instead.
-func (*VrfVoteExtension) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_VrfVoteExtensionBeacon_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfVoteExtensionBeacon)(nil)
 }
-
-func (x *VrfVoteExtension) GetDrandRound() uint64 {
-	if x != nil {
-		return x.DrandRound
-	}
-	return 0
+func (x fastReflection_VrfVoteExtensionBeacon_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfVoteExtensionBeacon)
+}
+func (x fastReflection_VrfVoteExtensionBeacon_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfVoteExtensionBeacon
 }
 
-func (x *VrfVoteExtension) GetRandomness() []byte {
-	if x != nil {
-		return x.Randomness
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfVoteExtensionBeacon) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfVoteExtensionBeacon
 }
 
-func (x *VrfVoteExtension) GetSignature() []byte {
-	if x != nil {
-		return x.Signature
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfVoteExtensionBeacon) Type() protoreflect.MessageType {
+	return _fastReflection_VrfVoteExtensionBeacon_messageType
 }
 
-func (x *VrfVoteExtension) GetPreviousSignature() []byte {
-	if x != nil {
-		return x.PreviousSignature
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfVoteExtensionBeacon) New() protoreflect.Message {
+	return new(fastReflection_VrfVoteExtensionBeacon)
 }
 
-func (x *VrfVoteExtension) GetChainHash() []byte {
-	if x != nil {
-		return x.ChainHash
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfVoteExtensionBeacon) Interface() protoreflect.ProtoMessage {
+	return (*VrfVoteExtensionBeacon)(x)
 }
 
-var File_digitalkitchen_vrf_abci_v1_vote_extension_proto protoreflect.FileDescriptor
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfVoteExtensionBeacon) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_VrfVoteExtensionBeacon_drand_round, value) {
+			return
+		}
+	}
+	if len(x.Randomness) != 0 {
+		value := protoreflect.ValueOfBytes(x.Randomness)
+		if !f(fd_VrfVoteExtensionBeacon_randomness, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_VrfVoteExtensionBeacon_signature, value) {
+			return
+		}
+	}
+	if len(x.PreviousSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.PreviousSignature)
+		if !f(fd_VrfVoteExtensionBeacon_previous_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfVoteExtensionBeacon) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		return len(x.Randomness) != 0
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		return len(x.Signature) != 0
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		return len(x.PreviousSignature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionBeacon) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		x.Randomness = nil
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		x.Signature = nil
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		x.PreviousSignature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfVoteExtensionBeacon) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		value := x.Randomness
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		value := x.PreviousSignature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionBeacon) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		x.Randomness = value.Bytes()
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		x.Signature = value.Bytes()
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		x.PreviousSignature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionBeacon) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		panic(fmt.Errorf("field randomness of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		panic(fmt.Errorf("field signature of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		panic(fmt.Errorf("field previous_signature of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfVoteExtensionBeacon) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.randomness":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon.previous_signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfVoteExtensionBeacon) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfVoteExtensionBeacon) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionBeacon) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfVoteExtensionBeacon) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfVoteExtensionBeacon) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfVoteExtensionBeacon)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		l = len(x.Randomness)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfVoteExtensionBeacon)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PreviousSignature) > 0 {
+			i -= len(x.PreviousSignature)
+			copy(dAtA[i:], x.PreviousSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousSignature)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Randomness) > 0 {
+			i -= len(x.Randomness)
+			copy(dAtA[i:], x.Randomness)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Randomness)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfVoteExtensionBeacon)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfVoteExtensionBeacon: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfVoteExtensionBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Randomness = append(x.Randomness[:0], dAtA[iNdEx:postIndex]...)
+				if x.Randomness == nil {
+					x.Randomness = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousSignature = append(x.PreviousSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.PreviousSignature == nil {
+					x.PreviousSignature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VrfVoteExtensionPartial                   protoreflect.MessageDescriptor
+	fd_VrfVoteExtensionPartial_drand_round       protoreflect.FieldDescriptor
+	fd_VrfVoteExtensionPartial_partial_signature protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_abci_v1_vote_extension_proto_init()
+	md_VrfVoteExtensionPartial = File_digitalkitchen_vrf_abci_v1_vote_extension_proto.Messages().ByName("VrfVoteExtensionPartial")
+	fd_VrfVoteExtensionPartial_drand_round = md_VrfVoteExtensionPartial.Fields().ByName("drand_round")
+	fd_VrfVoteExtensionPartial_partial_signature = md_VrfVoteExtensionPartial.Fields().ByName("partial_signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfVoteExtensionPartial)(nil)
+
+type fastReflection_VrfVoteExtensionPartial VrfVoteExtensionPartial
+
+func (x *VrfVoteExtensionPartial) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfVoteExtensionPartial)(x)
+}
+
+func (x *VrfVoteExtensionPartial) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfVoteExtensionPartial_messageType fastReflection_VrfVoteExtensionPartial_messageType
+var _ protoreflect.MessageType = fastReflection_VrfVoteExtensionPartial_messageType{}
+
+type fastReflection_VrfVoteExtensionPartial_messageType struct{}
+
+func (x fastReflection_VrfVoteExtensionPartial_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfVoteExtensionPartial)(nil)
+}
+func (x fastReflection_VrfVoteExtensionPartial_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfVoteExtensionPartial)
+}
+func (x fastReflection_VrfVoteExtensionPartial_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfVoteExtensionPartial
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfVoteExtensionPartial) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfVoteExtensionPartial
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfVoteExtensionPartial) Type() protoreflect.MessageType {
+	return _fastReflection_VrfVoteExtensionPartial_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfVoteExtensionPartial) New() protoreflect.Message {
+	return new(fastReflection_VrfVoteExtensionPartial)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfVoteExtensionPartial) Interface() protoreflect.ProtoMessage {
+	return (*VrfVoteExtensionPartial)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfVoteExtensionPartial) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_VrfVoteExtensionPartial_drand_round, value) {
+			return
+		}
+	}
+	if len(x.PartialSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.PartialSignature)
+		if !f(fd_VrfVoteExtensionPartial_partial_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfVoteExtensionPartial) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		return len(x.PartialSignature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionPartial) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		x.PartialSignature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfVoteExtensionPartial) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		value := x.PartialSignature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionPartial) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		x.PartialSignature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionPartial) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial is not mutable"))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		panic(fmt.Errorf("field partial_signature of message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfVoteExtensionPartial) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial.partial_signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfVoteExtensionPartial) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfVoteExtensionPartial) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfVoteExtensionPartial) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfVoteExtensionPartial) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfVoteExtensionPartial) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfVoteExtensionPartial)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		l = len(x.PartialSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfVoteExtensionPartial)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PartialSignature) > 0 {
+			i -= len(x.PartialSignature)
+			copy(dAtA[i:], x.PartialSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PartialSignature)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfVoteExtensionPartial)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfVoteExtensionPartial: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfVoteExtensionPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PartialSignature = append(x.PartialSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.PartialSignature == nil {
+					x.PartialSignature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: digitalkitchen/vrf/abci/v1/vote_extension.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VrfVoteExtension carries drand beacons for the rounds a validator considers
+// eligible at a given height.
+//
+// Version 0 extensions carry a single beacon in the top-level fields. Version 1
+// extensions carry up to round_tolerance+1 beacons in `beacons`, ordered from the
+// highest round down, and leave the top-level beacon fields empty. Version 2
+// extensions carry partial signatures in `partials` with the same ordering and
+// are used when VrfParams.partial_threshold is set.
+type VrfVoteExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrandRound        uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	Randomness        []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
+	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+	ChainHash         []byte `protobuf:"bytes,5,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// version tags the layout of the extension.
+	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// beacons contains the beacons carried by a version 1 extension.
+	Beacons []*VrfVoteExtensionBeacon `protobuf:"bytes,7,rep,name=beacons,proto3" json:"beacons,omitempty"`
+	// partials contains the partial signatures carried by a version 2 extension.
+	Partials []*VrfVoteExtensionPartial `protobuf:"bytes,8,rep,name=partials,proto3" json:"partials,omitempty"`
+	// ecvrf_proof is the validator's ECVRF-EDWARDS25519-SHA512-TAI proof over
+	// the fallback input of the height, set when VrfParams.ecvrf_fallback is
+	// enabled. It may be the only content of the extension when drand is down.
+	EcvrfProof []byte `protobuf:"bytes,9,opt,name=ecvrf_proof,json=ecvrfProof,proto3" json:"ecvrf_proof,omitempty"`
+}
+
+func (x *VrfVoteExtension) Reset() {
+	*x = VrfVoteExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfVoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfVoteExtension) ProtoMessage() {}
+
+// Deprecated: Use VrfVoteExtension.ProtoReflect.Descriptor instead.
+func (*VrfVoteExtension) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VrfVoteExtension) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *VrfVoteExtension) GetRandomness() []byte {
+	if x != nil {
+		return x.Randomness
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetPreviousSignature() []byte {
+	if x != nil {
+		return x.PreviousSignature
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VrfVoteExtension) GetBeacons() []*VrfVoteExtensionBeacon {
+	if x != nil {
+		return x.Beacons
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetPartials() []*VrfVoteExtensionPartial {
+	if x != nil {
+		return x.Partials
+	}
+	return nil
+}
+
+func (x *VrfVoteExtension) GetEcvrfProof() []byte {
+	if x != nil {
+		return x.EcvrfProof
+	}
+	return nil
+}
+
+// VrfVoteExtensionBeacon is a single drand beacon carried in a vote extension.
+type VrfVoteExtensionBeacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrandRound        uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	Randomness        []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
+	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+}
+
+func (x *VrfVoteExtensionBeacon) Reset() {
+	*x = VrfVoteExtensionBeacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfVoteExtensionBeacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfVoteExtensionBeacon) ProtoMessage() {}
+
+// Deprecated: Use VrfVoteExtensionBeacon.ProtoReflect.Descriptor instead.
+func (*VrfVoteExtensionBeacon) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VrfVoteExtensionBeacon) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *VrfVoteExtensionBeacon) GetRandomness() []byte {
+	if x != nil {
+		return x.Randomness
+	}
+	return nil
+}
+
+func (x *VrfVoteExtensionBeacon) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *VrfVoteExtensionBeacon) GetPreviousSignature() []byte {
+	if x != nil {
+		return x.PreviousSignature
+	}
+	return nil
+}
+
+// VrfVoteExtensionPartial is a validator's threshold-BLS signature share for a
+// drand round.
+type VrfVoteExtensionPartial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrandRound uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// partial_signature is prefixed with the big-endian uint16 share index.
+	PartialSignature []byte `protobuf:"bytes,2,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (x *VrfVoteExtensionPartial) Reset() {
+	*x = VrfVoteExtensionPartial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfVoteExtensionPartial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfVoteExtensionPartial) ProtoMessage() {}
+
+// Deprecated: Use VrfVoteExtensionPartial.ProtoReflect.Descriptor instead.
+func (*VrfVoteExtensionPartial) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VrfVoteExtensionPartial) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *VrfVoteExtensionPartial) GetPartialSignature() []byte {
+	if x != nil {
+		return x.PartialSignature
+	}
+	return nil
+}
+
+var File_digitalkitchen_vrf_abci_v1_vote_extension_proto protoreflect.FileDescriptor
 
 var file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDesc = []byte{
 	0x0a, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
 	0x2f, 0x76, 0x72, 0x66, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74,
 	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x1a, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x22, 0xbf, 0x01,
-	0x0a, 0x10, 0x56, 0x72, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e,
-	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x42,
-	0xf3, 0x01, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x52, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72,
+	0x66, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
 	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
-	0x76, 0x31, 0x42, 0x12, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x61, 0x62,
-	0x63, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44,
-	0x56, 0x41, 0xaa, 0x02, 0x1a, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x2e, 0x56, 0x72, 0x66, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x1a, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x5c, 0x56, 0x72, 0x66, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x44,
-	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72,
-	0x66, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x72, 0x66, 0x3a, 0x3a, 0x41, 0x62, 0x63,
-	0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x63,
+	0x76, 0x72, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x65, 0x63, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xa6, 0x01, 0x0a, 0x16,
+	0x56, 0x72, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x67, 0x0a, 0x17, 0x56, 0x72, 0x66, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0xf3, 0x01,
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
+	0x42, 0x12, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x61, 0x62, 0x63, 0x69,
+	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x56, 0x41,
+	0xaa, 0x02, 0x1a, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x56, 0x72, 0x66, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a,
+	0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56,
+	0x72, 0x66, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x44, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c,
+	0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x1d, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x72, 0x66, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -811,16 +2458,20 @@ func file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDescData
 }
 
-var file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_digitalkitchen_vrf_abci_v1_vote_extension_proto_goTypes = []interface{}{
-	(*VrfVoteExtension)(nil), // 0: digitalkitchen.vrf.abci.v1.VrfVoteExtension
+	(*VrfVoteExtension)(nil),        // 0: digitalkitchen.vrf.abci.v1.VrfVoteExtension
+	(*VrfVoteExtensionBeacon)(nil),  // 1: digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon
+	(*VrfVoteExtensionPartial)(nil), // 2: digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial
 }
 var file_digitalkitchen_vrf_abci_v1_vote_extension_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: digitalkitchen.vrf.abci.v1.VrfVoteExtension.beacons:type_name -> digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon
+	2, // 1: digitalkitchen.vrf.abci.v1.VrfVoteExtension.partials:type_name -> digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_digitalkitchen_vrf_abci_v1_vote_extension_proto_init() }
@@ -841,6 +2492,30 @@ func file_digitalkitchen_vrf_abci_v1_vote_extension_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfVoteExtensionBeacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_abci_v1_vote_extension_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfVoteExtensionPartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -848,7 +2523,7 @@ func file_digitalkitchen_vrf_abci_v1_vote_extension_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_vrf_abci_v1_vote_extension_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
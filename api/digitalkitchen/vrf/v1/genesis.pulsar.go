@@ -3,7 +3,9 @@ package vrfv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -116,12 +118,277 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*RandomnessRequest
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RandomnessRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RandomnessRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(RandomnessRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(RandomnessRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*TimelockedMessage
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TimelockedMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(TimelockedMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(TimelockedMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*EmergencyTxRecord
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmergencyTxRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmergencyTxRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(EmergencyTxRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(EmergencyTxRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*EcvrfKey
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EcvrfKey)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EcvrfKey)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(EcvrfKey)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(EcvrfKey)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState               protoreflect.MessageDescriptor
-	fd_GenesisState_params        protoreflect.FieldDescriptor
-	fd_GenesisState_latest_beacon protoreflect.FieldDescriptor
-	fd_GenesisState_committee     protoreflect.FieldDescriptor
-	fd_GenesisState_identities    protoreflect.FieldDescriptor
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_params              protoreflect.FieldDescriptor
+	fd_GenesisState_latest_beacon       protoreflect.FieldDescriptor
+	fd_GenesisState_committee           protoreflect.FieldDescriptor
+	fd_GenesisState_identities          protoreflect.FieldDescriptor
+	fd_GenesisState_pending_params      protoreflect.FieldDescriptor
+	fd_GenesisState_requests            protoreflect.FieldDescriptor
+	fd_GenesisState_next_request_id     protoreflect.FieldDescriptor
+	fd_GenesisState_request_escrow      protoreflect.FieldDescriptor
+	fd_GenesisState_timelocked          protoreflect.FieldDescriptor
+	fd_GenesisState_next_timelock_id    protoreflect.FieldDescriptor
+	fd_GenesisState_emergency_txs       protoreflect.FieldDescriptor
+	fd_GenesisState_ecvrf_fallback_seed protoreflect.FieldDescriptor
+	fd_GenesisState_last_drand_round    protoreflect.FieldDescriptor
+	fd_GenesisState_ecvrf_keys          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +398,16 @@ func init() {
 	fd_GenesisState_latest_beacon = md_GenesisState.Fields().ByName("latest_beacon")
 	fd_GenesisState_committee = md_GenesisState.Fields().ByName("committee")
 	fd_GenesisState_identities = md_GenesisState.Fields().ByName("identities")
+	fd_GenesisState_pending_params = md_GenesisState.Fields().ByName("pending_params")
+	fd_GenesisState_requests = md_GenesisState.Fields().ByName("requests")
+	fd_GenesisState_next_request_id = md_GenesisState.Fields().ByName("next_request_id")
+	fd_GenesisState_request_escrow = md_GenesisState.Fields().ByName("request_escrow")
+	fd_GenesisState_timelocked = md_GenesisState.Fields().ByName("timelocked")
+	fd_GenesisState_next_timelock_id = md_GenesisState.Fields().ByName("next_timelock_id")
+	fd_GenesisState_emergency_txs = md_GenesisState.Fields().ByName("emergency_txs")
+	fd_GenesisState_ecvrf_fallback_seed = md_GenesisState.Fields().ByName("ecvrf_fallback_seed")
+	fd_GenesisState_last_drand_round = md_GenesisState.Fields().ByName("last_drand_round")
+	fd_GenesisState_ecvrf_keys = md_GenesisState.Fields().ByName("ecvrf_keys")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -222,6 +499,66 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.PendingParams != nil {
+		value := protoreflect.ValueOfMessage(x.PendingParams.ProtoReflect())
+		if !f(fd_GenesisState_pending_params, value) {
+			return
+		}
+	}
+	if len(x.Requests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Requests})
+		if !f(fd_GenesisState_requests, value) {
+			return
+		}
+	}
+	if x.NextRequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextRequestId)
+		if !f(fd_GenesisState_next_request_id, value) {
+			return
+		}
+	}
+	if len(x.RequestEscrow) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.RequestEscrow})
+		if !f(fd_GenesisState_request_escrow, value) {
+			return
+		}
+	}
+	if len(x.Timelocked) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Timelocked})
+		if !f(fd_GenesisState_timelocked, value) {
+			return
+		}
+	}
+	if x.NextTimelockId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextTimelockId)
+		if !f(fd_GenesisState_next_timelock_id, value) {
+			return
+		}
+	}
+	if len(x.EmergencyTxs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.EmergencyTxs})
+		if !f(fd_GenesisState_emergency_txs, value) {
+			return
+		}
+	}
+	if x.EcvrfFallbackSeed != nil {
+		value := protoreflect.ValueOfMessage(x.EcvrfFallbackSeed.ProtoReflect())
+		if !f(fd_GenesisState_ecvrf_fallback_seed, value) {
+			return
+		}
+	}
+	if x.LastDrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastDrandRound)
+		if !f(fd_GenesisState_last_drand_round, value) {
+			return
+		}
+	}
+	if len(x.EcvrfKeys) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.EcvrfKeys})
+		if !f(fd_GenesisState_ecvrf_keys, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -245,6 +582,26 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Committee) != 0
 	case "digitalkitchen.vrf.v1.GenesisState.identities":
 		return len(x.Identities) != 0
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		return x.PendingParams != nil
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		return len(x.Requests) != 0
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		return x.NextRequestId != uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		return len(x.RequestEscrow) != 0
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		return len(x.Timelocked) != 0
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		return x.NextTimelockId != uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		return len(x.EmergencyTxs) != 0
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		return x.EcvrfFallbackSeed != nil
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		return x.LastDrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		return len(x.EcvrfKeys) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -269,6 +626,26 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Committee = nil
 	case "digitalkitchen.vrf.v1.GenesisState.identities":
 		x.Identities = nil
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		x.PendingParams = nil
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		x.Requests = nil
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		x.NextRequestId = uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		x.RequestEscrow = nil
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		x.Timelocked = nil
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		x.NextTimelockId = uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		x.EmergencyTxs = nil
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		x.EcvrfFallbackSeed = nil
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		x.LastDrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		x.EcvrfKeys = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -303,6 +680,51 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_4_list{list: &x.Identities}
 		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		value := x.PendingParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		if len(x.Requests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Requests}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		value := x.NextRequestId
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		if len(x.RequestEscrow) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.RequestEscrow}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		if len(x.Timelocked) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Timelocked}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		value := x.NextTimelockId
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		if len(x.EmergencyTxs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.EmergencyTxs}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		value := x.EcvrfFallbackSeed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		value := x.LastDrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		if len(x.EcvrfKeys) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.EcvrfKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -335,6 +757,36 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_4_list)
 		x.Identities = *clv.list
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		x.PendingParams = value.Message().Interface().(*VrfPendingParams)
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Requests = *clv.list
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		x.NextRequestId = value.Uint()
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.RequestEscrow = *clv.list
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Timelocked = *clv.list
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		x.NextTimelockId = value.Uint()
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.EmergencyTxs = *clv.list
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		x.EcvrfFallbackSeed = value.Message().Interface().(*EcvrfFallbackSeed)
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		x.LastDrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.EcvrfKeys = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -377,6 +829,52 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.Identities}
 		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		if x.PendingParams == nil {
+			x.PendingParams = new(VrfPendingParams)
+		}
+		return protoreflect.ValueOfMessage(x.PendingParams.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		if x.Requests == nil {
+			x.Requests = []*RandomnessRequest{}
+		}
+		value := &_GenesisState_6_list{list: &x.Requests}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		if x.RequestEscrow == nil {
+			x.RequestEscrow = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_8_list{list: &x.RequestEscrow}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		if x.Timelocked == nil {
+			x.Timelocked = []*TimelockedMessage{}
+		}
+		value := &_GenesisState_9_list{list: &x.Timelocked}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		if x.EmergencyTxs == nil {
+			x.EmergencyTxs = []*EmergencyTxRecord{}
+		}
+		value := &_GenesisState_11_list{list: &x.EmergencyTxs}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		if x.EcvrfFallbackSeed == nil {
+			x.EcvrfFallbackSeed = new(EcvrfFallbackSeed)
+		}
+		return protoreflect.ValueOfMessage(x.EcvrfFallbackSeed.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		if x.EcvrfKeys == nil {
+			x.EcvrfKeys = []*EcvrfKey{}
+		}
+		value := &_GenesisState_14_list{list: &x.EcvrfKeys}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		panic(fmt.Errorf("field next_request_id of message digitalkitchen.vrf.v1.GenesisState is not mutable"))
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		panic(fmt.Errorf("field next_timelock_id of message digitalkitchen.vrf.v1.GenesisState is not mutable"))
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		panic(fmt.Errorf("field last_drand_round of message digitalkitchen.vrf.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -402,6 +900,33 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "digitalkitchen.vrf.v1.GenesisState.identities":
 		list := []*VrfIdentity{}
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "digitalkitchen.vrf.v1.GenesisState.pending_params":
+		m := new(VrfPendingParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.requests":
+		list := []*RandomnessRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "digitalkitchen.vrf.v1.GenesisState.next_request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.GenesisState.request_escrow":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "digitalkitchen.vrf.v1.GenesisState.timelocked":
+		list := []*TimelockedMessage{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "digitalkitchen.vrf.v1.GenesisState.next_timelock_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.GenesisState.emergency_txs":
+		list := []*EmergencyTxRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed":
+		m := new(EcvrfFallbackSeed)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "digitalkitchen.vrf.v1.GenesisState.last_drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.GenesisState.ecvrf_keys":
+		list := []*EcvrfKey{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.GenesisState"))
@@ -491,12 +1016,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.PendingParams != nil {
+			l = options.Size(x.PendingParams)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if len(x.Requests) > 0 {
+			for _, e := range x.Requests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextRequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextRequestId))
+		}
+		if len(x.RequestEscrow) > 0 {
+			for _, e := range x.RequestEscrow {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Timelocked) > 0 {
+			for _, e := range x.Timelocked {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextTimelockId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextTimelockId))
+		}
+		if len(x.EmergencyTxs) > 0 {
+			for _, e := range x.EmergencyTxs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EcvrfFallbackSeed != nil {
+			l = options.Size(x.EcvrfFallbackSeed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastDrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastDrandRound))
+		}
+		if len(x.EcvrfKeys) > 0 {
+			for _, e := range x.EcvrfKeys {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
 	}
 
@@ -520,6 +1092,129 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EcvrfKeys) > 0 {
+			for iNdEx := len(x.EcvrfKeys) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EcvrfKeys[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.LastDrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastDrandRound))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.EcvrfFallbackSeed != nil {
+			encoded, err := options.Marshal(x.EcvrfFallbackSeed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.EmergencyTxs) > 0 {
+			for iNdEx := len(x.EmergencyTxs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EmergencyTxs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.NextTimelockId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextTimelockId))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Timelocked) > 0 {
+			for iNdEx := len(x.Timelocked) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Timelocked[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.RequestEscrow) > 0 {
+			for iNdEx := len(x.RequestEscrow) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RequestEscrow[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.NextRequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRequestId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Requests) > 0 {
+			for iNdEx := len(x.Requests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Requests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.PendingParams != nil {
+			encoded, err := options.Marshal(x.PendingParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Identities) > 0 {
 			for iNdEx := len(x.Identities) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Identities[iNdEx])
@@ -769,123 +1464,3598 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingParams == nil {
+					x.PendingParams = &VrfPendingParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingParams); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Requests = append(x.Requests, &RandomnessRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Requests[len(x.Requests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_VrfParams                       protoreflect.MessageDescriptor
-	fd_VrfParams_chain_hash            protoreflect.FieldDescriptor
-	fd_VrfParams_public_key            protoreflect.FieldDescriptor
-	fd_VrfParams_period_seconds        protoreflect.FieldDescriptor
-	fd_VrfParams_genesis_unix_sec      protoreflect.FieldDescriptor
-	fd_VrfParams_safety_margin_seconds protoreflect.FieldDescriptor
-	fd_VrfParams_enabled               protoreflect.FieldDescriptor
-	fd_VrfParams_reshare_epoch         protoreflect.FieldDescriptor
-	fd_VrfParams_slashing_grace_blocks protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_digitalkitchen_vrf_v1_genesis_proto_init()
-	md_VrfParams = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("VrfParams")
-	fd_VrfParams_chain_hash = md_VrfParams.Fields().ByName("chain_hash")
-	fd_VrfParams_public_key = md_VrfParams.Fields().ByName("public_key")
-	fd_VrfParams_period_seconds = md_VrfParams.Fields().ByName("period_seconds")
-	fd_VrfParams_genesis_unix_sec = md_VrfParams.Fields().ByName("genesis_unix_sec")
-	fd_VrfParams_safety_margin_seconds = md_VrfParams.Fields().ByName("safety_margin_seconds")
-	fd_VrfParams_enabled = md_VrfParams.Fields().ByName("enabled")
-	fd_VrfParams_reshare_epoch = md_VrfParams.Fields().ByName("reshare_epoch")
-	fd_VrfParams_slashing_grace_blocks = md_VrfParams.Fields().ByName("slashing_grace_blocks")
-}
-
-var _ protoreflect.Message = (*fastReflection_VrfParams)(nil)
-
-type fastReflection_VrfParams VrfParams
-
-func (x *VrfParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VrfParams)(x)
-}
-
-func (x *VrfParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_VrfParams_messageType fastReflection_VrfParams_messageType
-var _ protoreflect.MessageType = fastReflection_VrfParams_messageType{}
-
-type fastReflection_VrfParams_messageType struct{}
-
-func (x fastReflection_VrfParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VrfParams)(nil)
-}
-func (x fastReflection_VrfParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_VrfParams)
-}
-func (x fastReflection_VrfParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VrfParams
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_VrfParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_VrfParams
-}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRequestId", wireType)
+				}
+				x.NextRequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestEscrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestEscrow = append(x.RequestEscrow, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RequestEscrow[len(x.RequestEscrow)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timelocked", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Timelocked = append(x.Timelocked, &TimelockedMessage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timelocked[len(x.Timelocked)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextTimelockId", wireType)
+				}
+				x.NextTimelockId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextTimelockId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyTxs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmergencyTxs = append(x.EmergencyTxs, &EmergencyTxRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmergencyTxs[len(x.EmergencyTxs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfFallbackSeed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EcvrfFallbackSeed == nil {
+					x.EcvrfFallbackSeed = &EcvrfFallbackSeed{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EcvrfFallbackSeed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDrandRound", wireType)
+				}
+				x.LastDrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastDrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfKeys", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcvrfKeys = append(x.EcvrfKeys, &EcvrfKey{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EcvrfKeys[len(x.EcvrfKeys)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EcvrfFallbackSeed      protoreflect.MessageDescriptor
+	fd_EcvrfFallbackSeed_seed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	md_EcvrfFallbackSeed = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("EcvrfFallbackSeed")
+	fd_EcvrfFallbackSeed_seed = md_EcvrfFallbackSeed.Fields().ByName("seed")
+}
+
+var _ protoreflect.Message = (*fastReflection_EcvrfFallbackSeed)(nil)
+
+type fastReflection_EcvrfFallbackSeed EcvrfFallbackSeed
+
+func (x *EcvrfFallbackSeed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EcvrfFallbackSeed)(x)
+}
+
+func (x *EcvrfFallbackSeed) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EcvrfFallbackSeed_messageType fastReflection_EcvrfFallbackSeed_messageType
+var _ protoreflect.MessageType = fastReflection_EcvrfFallbackSeed_messageType{}
+
+type fastReflection_EcvrfFallbackSeed_messageType struct{}
+
+func (x fastReflection_EcvrfFallbackSeed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EcvrfFallbackSeed)(nil)
+}
+func (x fastReflection_EcvrfFallbackSeed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EcvrfFallbackSeed)
+}
+func (x fastReflection_EcvrfFallbackSeed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EcvrfFallbackSeed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EcvrfFallbackSeed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EcvrfFallbackSeed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EcvrfFallbackSeed) Type() protoreflect.MessageType {
+	return _fastReflection_EcvrfFallbackSeed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EcvrfFallbackSeed) New() protoreflect.Message {
+	return new(fastReflection_EcvrfFallbackSeed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EcvrfFallbackSeed) Interface() protoreflect.ProtoMessage {
+	return (*EcvrfFallbackSeed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EcvrfFallbackSeed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Seed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seed)
+		if !f(fd_EcvrfFallbackSeed_seed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EcvrfFallbackSeed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		return len(x.Seed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfFallbackSeed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		x.Seed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EcvrfFallbackSeed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		value := x.Seed
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfFallbackSeed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		x.Seed = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfFallbackSeed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		panic(fmt.Errorf("field seed of message digitalkitchen.vrf.v1.EcvrfFallbackSeed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EcvrfFallbackSeed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfFallbackSeed.seed":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfFallbackSeed"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfFallbackSeed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EcvrfFallbackSeed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.EcvrfFallbackSeed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EcvrfFallbackSeed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfFallbackSeed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EcvrfFallbackSeed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EcvrfFallbackSeed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EcvrfFallbackSeed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Seed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EcvrfFallbackSeed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Seed) > 0 {
+			i -= len(x.Seed)
+			copy(dAtA[i:], x.Seed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EcvrfFallbackSeed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EcvrfFallbackSeed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EcvrfFallbackSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seed = append(x.Seed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seed == nil {
+					x.Seed = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EcvrfKey                   protoreflect.MessageDescriptor
+	fd_EcvrfKey_validator_address protoreflect.FieldDescriptor
+	fd_EcvrfKey_public_key        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	md_EcvrfKey = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("EcvrfKey")
+	fd_EcvrfKey_validator_address = md_EcvrfKey.Fields().ByName("validator_address")
+	fd_EcvrfKey_public_key = md_EcvrfKey.Fields().ByName("public_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_EcvrfKey)(nil)
+
+type fastReflection_EcvrfKey EcvrfKey
+
+func (x *EcvrfKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EcvrfKey)(x)
+}
+
+func (x *EcvrfKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EcvrfKey_messageType fastReflection_EcvrfKey_messageType
+var _ protoreflect.MessageType = fastReflection_EcvrfKey_messageType{}
+
+type fastReflection_EcvrfKey_messageType struct{}
+
+func (x fastReflection_EcvrfKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EcvrfKey)(nil)
+}
+func (x fastReflection_EcvrfKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_EcvrfKey)
+}
+func (x fastReflection_EcvrfKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EcvrfKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EcvrfKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_EcvrfKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EcvrfKey) Type() protoreflect.MessageType {
+	return _fastReflection_EcvrfKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EcvrfKey) New() protoreflect.Message {
+	return new(fastReflection_EcvrfKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EcvrfKey) Interface() protoreflect.ProtoMessage {
+	return (*EcvrfKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EcvrfKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_EcvrfKey_validator_address, value) {
+			return
+		}
+	}
+	if len(x.PublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PublicKey)
+		if !f(fd_EcvrfKey_public_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EcvrfKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		return x.ValidatorAddress != ""
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		return len(x.PublicKey) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		x.ValidatorAddress = ""
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		x.PublicKey = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EcvrfKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		x.PublicKey = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		panic(fmt.Errorf("field validator_address of message digitalkitchen.vrf.v1.EcvrfKey is not mutable"))
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		panic(fmt.Errorf("field public_key of message digitalkitchen.vrf.v1.EcvrfKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EcvrfKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EcvrfKey.validator_address":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.EcvrfKey.public_key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EcvrfKey"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EcvrfKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EcvrfKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.EcvrfKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EcvrfKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EcvrfKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EcvrfKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EcvrfKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EcvrfKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EcvrfKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EcvrfKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EcvrfKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EcvrfKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PublicKey == nil {
+					x.PublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmergencyTxRecord                protoreflect.MessageDescriptor
+	fd_EmergencyTxRecord_tx_hash        protoreflect.FieldDescriptor
+	fd_EmergencyTxRecord_timeout_height protoreflect.FieldDescriptor
+	fd_EmergencyTxRecord_signer         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	md_EmergencyTxRecord = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("EmergencyTxRecord")
+	fd_EmergencyTxRecord_tx_hash = md_EmergencyTxRecord.Fields().ByName("tx_hash")
+	fd_EmergencyTxRecord_timeout_height = md_EmergencyTxRecord.Fields().ByName("timeout_height")
+	fd_EmergencyTxRecord_signer = md_EmergencyTxRecord.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmergencyTxRecord)(nil)
+
+type fastReflection_EmergencyTxRecord EmergencyTxRecord
+
+func (x *EmergencyTxRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmergencyTxRecord)(x)
+}
+
+func (x *EmergencyTxRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmergencyTxRecord_messageType fastReflection_EmergencyTxRecord_messageType
+var _ protoreflect.MessageType = fastReflection_EmergencyTxRecord_messageType{}
+
+type fastReflection_EmergencyTxRecord_messageType struct{}
+
+func (x fastReflection_EmergencyTxRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmergencyTxRecord)(nil)
+}
+func (x fastReflection_EmergencyTxRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmergencyTxRecord)
+}
+func (x fastReflection_EmergencyTxRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmergencyTxRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmergencyTxRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmergencyTxRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmergencyTxRecord) Type() protoreflect.MessageType {
+	return _fastReflection_EmergencyTxRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmergencyTxRecord) New() protoreflect.Message {
+	return new(fastReflection_EmergencyTxRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmergencyTxRecord) Interface() protoreflect.ProtoMessage {
+	return (*EmergencyTxRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmergencyTxRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxHash)
+		if !f(fd_EmergencyTxRecord_tx_hash, value) {
+			return
+		}
+	}
+	if x.TimeoutHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutHeight)
+		if !f(fd_EmergencyTxRecord_timeout_height, value) {
+			return
+		}
+	}
+	if len(x.Signer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signer)
+		if !f(fd_EmergencyTxRecord_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmergencyTxRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		return len(x.TxHash) != 0
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		return x.TimeoutHeight != uint64(0)
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		return len(x.Signer) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyTxRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		x.TxHash = nil
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		x.TimeoutHeight = uint64(0)
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		x.Signer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmergencyTxRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		value := x.TimeoutHeight
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		value := x.Signer
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyTxRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		x.TxHash = value.Bytes()
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		x.TimeoutHeight = value.Uint()
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		x.Signer = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyTxRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message digitalkitchen.vrf.v1.EmergencyTxRecord is not mutable"))
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		panic(fmt.Errorf("field timeout_height of message digitalkitchen.vrf.v1.EmergencyTxRecord is not mutable"))
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		panic(fmt.Errorf("field signer of message digitalkitchen.vrf.v1.EmergencyTxRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmergencyTxRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.tx_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.timeout_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.EmergencyTxRecord.signer":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.EmergencyTxRecord"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.EmergencyTxRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmergencyTxRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.EmergencyTxRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmergencyTxRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmergencyTxRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmergencyTxRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmergencyTxRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmergencyTxRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutHeight))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmergencyTxRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TimeoutHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmergencyTxRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmergencyTxRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmergencyTxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = append(x.TxHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxHash == nil {
+					x.TxHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				}
+				x.TimeoutHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = append(x.Signer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signer == nil {
+					x.Signer = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VrfParams_11_list)(nil)
+
+type _VrfParams_11_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VrfParams_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParams_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfParams_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParams_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParams_11_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParams_11_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VrfParams_13_list)(nil)
+
+type _VrfParams_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VrfParams_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParams_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfParams_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParams_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParams_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParams_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VrfParams_14_list)(nil)
+
+type _VrfParams_14_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VrfParams_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParams_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfParams_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParams_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParams_14_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParams_14_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParams_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VrfParams                                 protoreflect.MessageDescriptor
+	fd_VrfParams_chain_hash                      protoreflect.FieldDescriptor
+	fd_VrfParams_public_key                      protoreflect.FieldDescriptor
+	fd_VrfParams_period_seconds                  protoreflect.FieldDescriptor
+	fd_VrfParams_genesis_unix_sec                protoreflect.FieldDescriptor
+	fd_VrfParams_safety_margin_seconds           protoreflect.FieldDescriptor
+	fd_VrfParams_enabled                         protoreflect.FieldDescriptor
+	fd_VrfParams_reshare_epoch                   protoreflect.FieldDescriptor
+	fd_VrfParams_slashing_grace_blocks           protoreflect.FieldDescriptor
+	fd_VrfParams_round_tolerance                 protoreflect.FieldDescriptor
+	fd_VrfParams_participation_retention_blocks  protoreflect.FieldDescriptor
+	fd_VrfParams_reward_per_block                protoreflect.FieldDescriptor
+	fd_VrfParams_reward_fee_share_bps            protoreflect.FieldDescriptor
+	fd_VrfParams_request_base_fee                protoreflect.FieldDescriptor
+	fd_VrfParams_request_fee_per_word            protoreflect.FieldDescriptor
+	fd_VrfParams_scheme_id                       protoreflect.FieldDescriptor
+	fd_VrfParams_partial_threshold               protoreflect.FieldDescriptor
+	fd_VrfParams_ecvrf_fallback                  protoreflect.FieldDescriptor
+	fd_VrfParams_genesis_seed                    protoreflect.FieldDescriptor
+	fd_VrfParams_beacon_id                       protoreflect.FieldDescriptor
+	fd_VrfParams_bootstrap_enable_delay_blocks   protoreflect.FieldDescriptor
+	fd_VrfParams_invariant_check_interval_blocks protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	md_VrfParams = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("VrfParams")
+	fd_VrfParams_chain_hash = md_VrfParams.Fields().ByName("chain_hash")
+	fd_VrfParams_public_key = md_VrfParams.Fields().ByName("public_key")
+	fd_VrfParams_period_seconds = md_VrfParams.Fields().ByName("period_seconds")
+	fd_VrfParams_genesis_unix_sec = md_VrfParams.Fields().ByName("genesis_unix_sec")
+	fd_VrfParams_safety_margin_seconds = md_VrfParams.Fields().ByName("safety_margin_seconds")
+	fd_VrfParams_enabled = md_VrfParams.Fields().ByName("enabled")
+	fd_VrfParams_reshare_epoch = md_VrfParams.Fields().ByName("reshare_epoch")
+	fd_VrfParams_slashing_grace_blocks = md_VrfParams.Fields().ByName("slashing_grace_blocks")
+	fd_VrfParams_round_tolerance = md_VrfParams.Fields().ByName("round_tolerance")
+	fd_VrfParams_participation_retention_blocks = md_VrfParams.Fields().ByName("participation_retention_blocks")
+	fd_VrfParams_reward_per_block = md_VrfParams.Fields().ByName("reward_per_block")
+	fd_VrfParams_reward_fee_share_bps = md_VrfParams.Fields().ByName("reward_fee_share_bps")
+	fd_VrfParams_request_base_fee = md_VrfParams.Fields().ByName("request_base_fee")
+	fd_VrfParams_request_fee_per_word = md_VrfParams.Fields().ByName("request_fee_per_word")
+	fd_VrfParams_scheme_id = md_VrfParams.Fields().ByName("scheme_id")
+	fd_VrfParams_partial_threshold = md_VrfParams.Fields().ByName("partial_threshold")
+	fd_VrfParams_ecvrf_fallback = md_VrfParams.Fields().ByName("ecvrf_fallback")
+	fd_VrfParams_genesis_seed = md_VrfParams.Fields().ByName("genesis_seed")
+	fd_VrfParams_beacon_id = md_VrfParams.Fields().ByName("beacon_id")
+	fd_VrfParams_bootstrap_enable_delay_blocks = md_VrfParams.Fields().ByName("bootstrap_enable_delay_blocks")
+	fd_VrfParams_invariant_check_interval_blocks = md_VrfParams.Fields().ByName("invariant_check_interval_blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfParams)(nil)
+
+type fastReflection_VrfParams VrfParams
+
+func (x *VrfParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfParams)(x)
+}
+
+func (x *VrfParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfParams_messageType fastReflection_VrfParams_messageType
+var _ protoreflect.MessageType = fastReflection_VrfParams_messageType{}
+
+type fastReflection_VrfParams_messageType struct{}
+
+func (x fastReflection_VrfParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfParams)(nil)
+}
+func (x fastReflection_VrfParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfParams)
+}
+func (x fastReflection_VrfParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfParams) Type() protoreflect.MessageType {
+	return _fastReflection_VrfParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfParams) New() protoreflect.Message {
+	return new(fastReflection_VrfParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfParams) Interface() protoreflect.ProtoMessage {
+	return (*VrfParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ChainHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ChainHash)
+		if !f(fd_VrfParams_chain_hash, value) {
+			return
+		}
+	}
+	if len(x.PublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PublicKey)
+		if !f(fd_VrfParams_public_key, value) {
+			return
+		}
+	}
+	if x.PeriodSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PeriodSeconds)
+		if !f(fd_VrfParams_period_seconds, value) {
+			return
+		}
+	}
+	if x.GenesisUnixSec != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GenesisUnixSec)
+		if !f(fd_VrfParams_genesis_unix_sec, value) {
+			return
+		}
+	}
+	if x.SafetyMarginSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SafetyMarginSeconds)
+		if !f(fd_VrfParams_safety_margin_seconds, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_VrfParams_enabled, value) {
+			return
+		}
+	}
+	if x.ReshareEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReshareEpoch)
+		if !f(fd_VrfParams_reshare_epoch, value) {
+			return
+		}
+	}
+	if x.SlashingGraceBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashingGraceBlocks)
+		if !f(fd_VrfParams_slashing_grace_blocks, value) {
+			return
+		}
+	}
+	if x.RoundTolerance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundTolerance)
+		if !f(fd_VrfParams_round_tolerance, value) {
+			return
+		}
+	}
+	if x.ParticipationRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParticipationRetentionBlocks)
+		if !f(fd_VrfParams_participation_retention_blocks, value) {
+			return
+		}
+	}
+	if len(x.RewardPerBlock) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParams_11_list{list: &x.RewardPerBlock})
+		if !f(fd_VrfParams_reward_per_block, value) {
+			return
+		}
+	}
+	if x.RewardFeeShareBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RewardFeeShareBps)
+		if !f(fd_VrfParams_reward_fee_share_bps, value) {
+			return
+		}
+	}
+	if len(x.RequestBaseFee) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParams_13_list{list: &x.RequestBaseFee})
+		if !f(fd_VrfParams_request_base_fee, value) {
+			return
+		}
+	}
+	if len(x.RequestFeePerWord) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParams_14_list{list: &x.RequestFeePerWord})
+		if !f(fd_VrfParams_request_fee_per_word, value) {
+			return
+		}
+	}
+	if x.SchemeId != "" {
+		value := protoreflect.ValueOfString(x.SchemeId)
+		if !f(fd_VrfParams_scheme_id, value) {
+			return
+		}
+	}
+	if x.PartialThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PartialThreshold)
+		if !f(fd_VrfParams_partial_threshold, value) {
+			return
+		}
+	}
+	if x.EcvrfFallback != false {
+		value := protoreflect.ValueOfBool(x.EcvrfFallback)
+		if !f(fd_VrfParams_ecvrf_fallback, value) {
+			return
+		}
+	}
+	if len(x.GenesisSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.GenesisSeed)
+		if !f(fd_VrfParams_genesis_seed, value) {
+			return
+		}
+	}
+	if x.BeaconId != "" {
+		value := protoreflect.ValueOfString(x.BeaconId)
+		if !f(fd_VrfParams_beacon_id, value) {
+			return
+		}
+	}
+	if x.BootstrapEnableDelayBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BootstrapEnableDelayBlocks)
+		if !f(fd_VrfParams_bootstrap_enable_delay_blocks, value) {
+			return
+		}
+	}
+	if x.InvariantCheckIntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InvariantCheckIntervalBlocks)
+		if !f(fd_VrfParams_invariant_check_interval_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		return len(x.ChainHash) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		return len(x.PublicKey) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		return x.PeriodSeconds != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		return x.GenesisUnixSec != int64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		return x.SafetyMarginSeconds != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		return x.Enabled != false
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		return x.ReshareEpoch != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		return x.SlashingGraceBlocks != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		return x.RoundTolerance != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		return x.ParticipationRetentionBlocks != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		return len(x.RewardPerBlock) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		return x.RewardFeeShareBps != uint32(0)
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		return len(x.RequestBaseFee) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		return len(x.RequestFeePerWord) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		return x.SchemeId != ""
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		return x.PartialThreshold != uint32(0)
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		return x.EcvrfFallback != false
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		return len(x.GenesisSeed) != 0
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		return x.BeaconId != ""
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		return x.BootstrapEnableDelayBlocks != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		return x.InvariantCheckIntervalBlocks != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		x.ChainHash = nil
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		x.PublicKey = nil
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		x.PeriodSeconds = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		x.GenesisUnixSec = int64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		x.SafetyMarginSeconds = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		x.Enabled = false
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		x.ReshareEpoch = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		x.SlashingGraceBlocks = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		x.RoundTolerance = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		x.ParticipationRetentionBlocks = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		x.RewardPerBlock = nil
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		x.RewardFeeShareBps = uint32(0)
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		x.RequestBaseFee = nil
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		x.RequestFeePerWord = nil
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		x.SchemeId = ""
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		x.PartialThreshold = uint32(0)
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		x.EcvrfFallback = false
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		x.GenesisSeed = nil
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		x.BeaconId = ""
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		x.BootstrapEnableDelayBlocks = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		x.InvariantCheckIntervalBlocks = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		value := x.ChainHash
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		value := x.PeriodSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		value := x.GenesisUnixSec
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		value := x.SafetyMarginSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		value := x.ReshareEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		value := x.SlashingGraceBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		value := x.RoundTolerance
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		value := x.ParticipationRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		if len(x.RewardPerBlock) == 0 {
+			return protoreflect.ValueOfList(&_VrfParams_11_list{})
+		}
+		listValue := &_VrfParams_11_list{list: &x.RewardPerBlock}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		value := x.RewardFeeShareBps
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		if len(x.RequestBaseFee) == 0 {
+			return protoreflect.ValueOfList(&_VrfParams_13_list{})
+		}
+		listValue := &_VrfParams_13_list{list: &x.RequestBaseFee}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		if len(x.RequestFeePerWord) == 0 {
+			return protoreflect.ValueOfList(&_VrfParams_14_list{})
+		}
+		listValue := &_VrfParams_14_list{list: &x.RequestFeePerWord}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		value := x.SchemeId
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		value := x.PartialThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		value := x.EcvrfFallback
+		return protoreflect.ValueOfBool(value)
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		value := x.GenesisSeed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		value := x.BeaconId
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		value := x.BootstrapEnableDelayBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		value := x.InvariantCheckIntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		x.ChainHash = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		x.PublicKey = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		x.PeriodSeconds = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		x.GenesisUnixSec = value.Int()
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		x.SafetyMarginSeconds = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		x.Enabled = value.Bool()
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		x.ReshareEpoch = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		x.SlashingGraceBlocks = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		x.RoundTolerance = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		x.ParticipationRetentionBlocks = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		lv := value.List()
+		clv := lv.(*_VrfParams_11_list)
+		x.RewardPerBlock = *clv.list
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		x.RewardFeeShareBps = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		lv := value.List()
+		clv := lv.(*_VrfParams_13_list)
+		x.RequestBaseFee = *clv.list
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		lv := value.List()
+		clv := lv.(*_VrfParams_14_list)
+		x.RequestFeePerWord = *clv.list
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		x.SchemeId = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		x.PartialThreshold = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		x.EcvrfFallback = value.Bool()
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		x.GenesisSeed = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		x.BeaconId = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		x.BootstrapEnableDelayBlocks = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		x.InvariantCheckIntervalBlocks = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		if x.RewardPerBlock == nil {
+			x.RewardPerBlock = []*v1beta1.Coin{}
+		}
+		value := &_VrfParams_11_list{list: &x.RewardPerBlock}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		if x.RequestBaseFee == nil {
+			x.RequestBaseFee = []*v1beta1.Coin{}
+		}
+		value := &_VrfParams_13_list{list: &x.RequestBaseFee}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		if x.RequestFeePerWord == nil {
+			x.RequestFeePerWord = []*v1beta1.Coin{}
+		}
+		value := &_VrfParams_14_list{list: &x.RequestFeePerWord}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		panic(fmt.Errorf("field chain_hash of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		panic(fmt.Errorf("field public_key of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		panic(fmt.Errorf("field period_seconds of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		panic(fmt.Errorf("field genesis_unix_sec of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		panic(fmt.Errorf("field safety_margin_seconds of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		panic(fmt.Errorf("field enabled of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		panic(fmt.Errorf("field reshare_epoch of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		panic(fmt.Errorf("field slashing_grace_blocks of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		panic(fmt.Errorf("field round_tolerance of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		panic(fmt.Errorf("field participation_retention_blocks of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		panic(fmt.Errorf("field reward_fee_share_bps of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		panic(fmt.Errorf("field scheme_id of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		panic(fmt.Errorf("field partial_threshold of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		panic(fmt.Errorf("field ecvrf_fallback of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		panic(fmt.Errorf("field genesis_seed of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		panic(fmt.Errorf("field beacon_id of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		panic(fmt.Errorf("field bootstrap_enable_delay_blocks of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		panic(fmt.Errorf("field invariant_check_interval_blocks of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfParams.public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.round_tolerance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.participation_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.reward_per_block":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VrfParams_11_list{list: &list})
+	case "digitalkitchen.vrf.v1.VrfParams.reward_fee_share_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.VrfParams.request_base_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VrfParams_13_list{list: &list})
+	case "digitalkitchen.vrf.v1.VrfParams.request_fee_per_word":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VrfParams_14_list{list: &list})
+	case "digitalkitchen.vrf.v1.VrfParams.scheme_id":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.VrfParams.partial_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.VrfParams.ecvrf_fallback":
+		return protoreflect.ValueOfBool(false)
+	case "digitalkitchen.vrf.v1.VrfParams.genesis_seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfParams.beacon_id":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.VrfParams.bootstrap_enable_delay_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParams.invariant_check_interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PeriodSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.PeriodSeconds))
+		}
+		if x.GenesisUnixSec != 0 {
+			n += 1 + runtime.Sov(uint64(x.GenesisUnixSec))
+		}
+		if x.SafetyMarginSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.SafetyMarginSeconds))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.ReshareEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReshareEpoch))
+		}
+		if x.SlashingGraceBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashingGraceBlocks))
+		}
+		if x.RoundTolerance != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundTolerance))
+		}
+		if x.ParticipationRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParticipationRetentionBlocks))
+		}
+		if len(x.RewardPerBlock) > 0 {
+			for _, e := range x.RewardPerBlock {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RewardFeeShareBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.RewardFeeShareBps))
+		}
+		if len(x.RequestBaseFee) > 0 {
+			for _, e := range x.RequestBaseFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RequestFeePerWord) > 0 {
+			for _, e := range x.RequestFeePerWord {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SchemeId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PartialThreshold != 0 {
+			n += 2 + runtime.Sov(uint64(x.PartialThreshold))
+		}
+		if x.EcvrfFallback {
+			n += 3
+		}
+		l = len(x.GenesisSeed)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BeaconId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.BootstrapEnableDelayBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.BootstrapEnableDelayBlocks))
+		}
+		if x.InvariantCheckIntervalBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.InvariantCheckIntervalBlocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InvariantCheckIntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvariantCheckIntervalBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.BootstrapEnableDelayBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BootstrapEnableDelayBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.BeaconId) > 0 {
+			i -= len(x.BeaconId)
+			copy(dAtA[i:], x.BeaconId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeaconId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.GenesisSeed) > 0 {
+			i -= len(x.GenesisSeed)
+			copy(dAtA[i:], x.GenesisSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GenesisSeed)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.EcvrfFallback {
+			i--
+			if x.EcvrfFallback {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.PartialThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PartialThreshold))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.SchemeId) > 0 {
+			i -= len(x.SchemeId)
+			copy(dAtA[i:], x.SchemeId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SchemeId)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.RequestFeePerWord) > 0 {
+			for iNdEx := len(x.RequestFeePerWord) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RequestFeePerWord[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.RequestBaseFee) > 0 {
+			for iNdEx := len(x.RequestBaseFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RequestBaseFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.RewardFeeShareBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardFeeShareBps))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.RewardPerBlock) > 0 {
+			for iNdEx := len(x.RewardPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardPerBlock[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.ParticipationRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParticipationRetentionBlocks))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RoundTolerance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundTolerance))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SlashingGraceBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashingGraceBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ReshareEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReshareEpoch))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SafetyMarginSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SafetyMarginSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.GenesisUnixSec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenesisUnixSec))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PeriodSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainHash) > 0 {
+			i -= len(x.ChainHash)
+			copy(dAtA[i:], x.ChainHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ChainHash == nil {
+					x.ChainHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PublicKey == nil {
+					x.PublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+				}
+				x.PeriodSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PeriodSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
+				}
+				x.GenesisUnixSec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GenesisUnixSec |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SafetyMarginSeconds", wireType)
+				}
+				x.SafetyMarginSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SafetyMarginSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+				}
+				x.ReshareEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReshareEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingGraceBlocks", wireType)
+				}
+				x.SlashingGraceBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashingGraceBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundTolerance", wireType)
+				}
+				x.RoundTolerance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundTolerance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipationRetentionBlocks", wireType)
+				}
+				x.ParticipationRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParticipationRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardPerBlock = append(x.RewardPerBlock, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardPerBlock[len(x.RewardPerBlock)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardFeeShareBps", wireType)
+				}
+				x.RewardFeeShareBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardFeeShareBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestBaseFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestBaseFee = append(x.RequestBaseFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RequestBaseFee[len(x.RequestBaseFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestFeePerWord", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestFeePerWord = append(x.RequestFeePerWord, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RequestFeePerWord[len(x.RequestFeePerWord)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemeId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SchemeId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartialThreshold", wireType)
+				}
+				x.PartialThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PartialThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfFallback", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EcvrfFallback = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GenesisSeed = append(x.GenesisSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.GenesisSeed == nil {
+					x.GenesisSeed = []byte{}
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeaconId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeaconId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BootstrapEnableDelayBlocks", wireType)
+				}
+				x.BootstrapEnableDelayBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BootstrapEnableDelayBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvariantCheckIntervalBlocks", wireType)
+				}
+				x.InvariantCheckIntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvariantCheckIntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VrfPendingParams                   protoreflect.MessageDescriptor
+	fd_VrfPendingParams_params            protoreflect.FieldDescriptor
+	fd_VrfPendingParams_activation_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	md_VrfPendingParams = File_digitalkitchen_vrf_v1_genesis_proto.Messages().ByName("VrfPendingParams")
+	fd_VrfPendingParams_params = md_VrfPendingParams.Fields().ByName("params")
+	fd_VrfPendingParams_activation_height = md_VrfPendingParams.Fields().ByName("activation_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfPendingParams)(nil)
+
+type fastReflection_VrfPendingParams VrfPendingParams
+
+func (x *VrfPendingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfPendingParams)(x)
+}
+
+func (x *VrfPendingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfPendingParams_messageType fastReflection_VrfPendingParams_messageType
+var _ protoreflect.MessageType = fastReflection_VrfPendingParams_messageType{}
+
+type fastReflection_VrfPendingParams_messageType struct{}
+
+func (x fastReflection_VrfPendingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfPendingParams)(nil)
+}
+func (x fastReflection_VrfPendingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfPendingParams)
+}
+func (x fastReflection_VrfPendingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfPendingParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfPendingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfPendingParams
+}
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VrfParams) Type() protoreflect.MessageType {
-	return _fastReflection_VrfParams_messageType
+func (x *fastReflection_VrfPendingParams) Type() protoreflect.MessageType {
+	return _fastReflection_VrfPendingParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VrfParams) New() protoreflect.Message {
-	return new(fastReflection_VrfParams)
+func (x *fastReflection_VrfPendingParams) New() protoreflect.Message {
+	return new(fastReflection_VrfPendingParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VrfParams) Interface() protoreflect.ProtoMessage {
-	return (*VrfParams)(x)
+func (x *fastReflection_VrfPendingParams) Interface() protoreflect.ProtoMessage {
+	return (*VrfPendingParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -893,52 +5063,16 @@ func (x *fastReflection_VrfParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VrfParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ChainHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.ChainHash)
-		if !f(fd_VrfParams_chain_hash, value) {
-			return
-		}
-	}
-	if len(x.PublicKey) != 0 {
-		value := protoreflect.ValueOfBytes(x.PublicKey)
-		if !f(fd_VrfParams_public_key, value) {
-			return
-		}
-	}
-	if x.PeriodSeconds != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PeriodSeconds)
-		if !f(fd_VrfParams_period_seconds, value) {
-			return
-		}
-	}
-	if x.GenesisUnixSec != int64(0) {
-		value := protoreflect.ValueOfInt64(x.GenesisUnixSec)
-		if !f(fd_VrfParams_genesis_unix_sec, value) {
-			return
-		}
-	}
-	if x.SafetyMarginSeconds != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SafetyMarginSeconds)
-		if !f(fd_VrfParams_safety_margin_seconds, value) {
-			return
-		}
-	}
-	if x.Enabled != false {
-		value := protoreflect.ValueOfBool(x.Enabled)
-		if !f(fd_VrfParams_enabled, value) {
-			return
-		}
-	}
-	if x.ReshareEpoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ReshareEpoch)
-		if !f(fd_VrfParams_reshare_epoch, value) {
+func (x *fastReflection_VrfPendingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_VrfPendingParams_params, value) {
 			return
 		}
 	}
-	if x.SlashingGraceBlocks != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SlashingGraceBlocks)
-		if !f(fd_VrfParams_slashing_grace_blocks, value) {
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_VrfPendingParams_activation_height, value) {
 			return
 		}
 	}
@@ -946,38 +5080,26 @@ func (x *fastReflection_VrfParams) Range(f func(protoreflect.FieldDescriptor, pr
 
 // Has reports whether a field is populated.
 //
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_VrfParams) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		return len(x.ChainHash) != 0
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		return len(x.PublicKey) != 0
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		return x.PeriodSeconds != uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
-		return x.GenesisUnixSec != int64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		return x.SafetyMarginSeconds != uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		return x.Enabled != false
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		return x.ReshareEpoch != uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		return x.SlashingGraceBlocks != uint64(0)
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfPendingParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		return x.Params != nil
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
+		return x.ActivationHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -987,29 +5109,17 @@ func (x *fastReflection_VrfParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VrfPendingParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		x.ChainHash = nil
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		x.PublicKey = nil
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		x.PeriodSeconds = uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
-		x.GenesisUnixSec = int64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		x.SafetyMarginSeconds = uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		x.Enabled = false
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		x.ReshareEpoch = uint64(0)
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		x.SlashingGraceBlocks = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		x.Params = nil
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
+		x.ActivationHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1019,37 +5129,19 @@ func (x *fastReflection_VrfParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VrfParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfPendingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		value := x.ChainHash
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		value := x.PublicKey
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		value := x.PeriodSeconds
-		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
-		value := x.GenesisUnixSec
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
+		value := x.ActivationHeight
 		return protoreflect.ValueOfInt64(value)
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		value := x.SafetyMarginSeconds
-		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		value := x.Enabled
-		return protoreflect.ValueOfBool(value)
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		value := x.ReshareEpoch
-		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		value := x.SlashingGraceBlocks
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1063,29 +5155,17 @@ func (x *fastReflection_VrfParams) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VrfPendingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		x.ChainHash = value.Bytes()
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		x.PublicKey = value.Bytes()
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		x.PeriodSeconds = value.Uint()
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
-		x.GenesisUnixSec = value.Int()
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		x.SafetyMarginSeconds = value.Uint()
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		x.Enabled = value.Bool()
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		x.ReshareEpoch = value.Uint()
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		x.SlashingGraceBlocks = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		x.Params = value.Message().Interface().(*VrfParams)
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
+		x.ActivationHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1099,68 +5179,48 @@ func (x *fastReflection_VrfParams) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfPendingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		panic(fmt.Errorf("field chain_hash of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		panic(fmt.Errorf("field public_key of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		panic(fmt.Errorf("field period_seconds of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
-		panic(fmt.Errorf("field genesis_unix_sec of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		panic(fmt.Errorf("field safety_margin_seconds of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		panic(fmt.Errorf("field enabled of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		panic(fmt.Errorf("field reshare_epoch of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		panic(fmt.Errorf("field slashing_grace_blocks of message digitalkitchen.vrf.v1.VrfParams is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		if x.Params == nil {
+			x.Params = new(VrfParams)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
+		panic(fmt.Errorf("field activation_height of message digitalkitchen.vrf.v1.VrfPendingParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VrfParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfPendingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfParams.chain_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.vrf.v1.VrfParams.public_key":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.vrf.v1.VrfParams.period_seconds":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.vrf.v1.VrfParams.genesis_unix_sec":
+	case "digitalkitchen.vrf.v1.VrfPendingParams.params":
+		m := new(VrfParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "digitalkitchen.vrf.v1.VrfPendingParams.activation_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "digitalkitchen.vrf.v1.VrfParams.safety_margin_seconds":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.vrf.v1.VrfParams.enabled":
-		return protoreflect.ValueOfBool(false)
-	case "digitalkitchen.vrf.v1.VrfParams.reshare_epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.vrf.v1.VrfParams.slashing_grace_blocks":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfPendingParams"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfPendingParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VrfParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VrfPendingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfPendingParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1168,7 +5228,7 @@ func (x *fastReflection_VrfParams) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VrfParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VrfPendingParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1179,7 +5239,7 @@ func (x *fastReflection_VrfParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VrfPendingParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1191,7 +5251,7 @@ func (x *fastReflection_VrfParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VrfParams) IsValid() bool {
+func (x *fastReflection_VrfPendingParams) IsValid() bool {
 	return x != nil
 }
 
@@ -1201,9 +5261,9 @@ func (x *fastReflection_VrfParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VrfPendingParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VrfParams)
+		x := input.Message.Interface().(*VrfPendingParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1215,31 +5275,12 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ChainHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PublicKey)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.PeriodSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.PeriodSeconds))
-		}
-		if x.GenesisUnixSec != 0 {
-			n += 1 + runtime.Sov(uint64(x.GenesisUnixSec))
-		}
-		if x.SafetyMarginSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.SafetyMarginSeconds))
-		}
-		if x.Enabled {
-			n += 2
-		}
-		if x.ReshareEpoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.ReshareEpoch))
-		}
-		if x.SlashingGraceBlocks != 0 {
-			n += 1 + runtime.Sov(uint64(x.SlashingGraceBlocks))
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1251,7 +5292,7 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VrfParams)
+		x := input.Message.Interface().(*VrfPendingParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1270,52 +5311,22 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SlashingGraceBlocks != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashingGraceBlocks))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.ReshareEpoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReshareEpoch))
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
 			i--
-			dAtA[i] = 0x38
+			dAtA[i] = 0x10
 		}
-		if x.Enabled {
-			i--
-			if x.Enabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.SafetyMarginSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SafetyMarginSeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.GenesisUnixSec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenesisUnixSec))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.PeriodSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodSeconds))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ChainHash) > 0 {
-			i -= len(x.ChainHash)
-			copy(dAtA[i:], x.ChainHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1330,7 +5341,7 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VrfParams)
+		x := input.Message.Interface().(*VrfPendingParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1349,64 +5360,30 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParams: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParams: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.ChainHash == nil {
-					x.ChainHash = []byte{}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 2:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfPendingParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfPendingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1416,127 +5393,33 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PublicKey = append(x.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-				if x.PublicKey == nil {
-					x.PublicKey = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
-				}
-				x.PeriodSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PeriodSeconds |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
-				}
-				x.GenesisUnixSec = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GenesisUnixSec |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SafetyMarginSeconds", wireType)
-				}
-				x.SafetyMarginSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SafetyMarginSeconds |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Enabled = bool(v != 0)
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+				if x.Params == nil {
+					x.Params = &VrfParams{}
 				}
-				x.ReshareEpoch = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ReshareEpoch |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 8:
+				iNdEx = postIndex
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingGraceBlocks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 				}
-				x.SlashingGraceBlocks = 0
+				x.ActivationHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1546,7 +5429,7 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SlashingGraceBlocks |= uint64(b&0x7F) << shift
+					x.ActivationHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1570,96 +5453,336 @@ func (x *fastReflection_VrfParams) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: digitalkitchen/vrf/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the vrf module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params defines the module parameters.
+	Params *VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// latest_beacon is the canonical drand beacon for the current height, if any.
+	LatestBeacon *VrfBeacon `protobuf:"bytes,2,opt,name=latest_beacon,json=latestBeacon,proto3" json:"latest_beacon,omitempty"`
+	// committee contains allowlisted authorities for VRF emergency disable and
+	// resharing scheduling.
+	Committee []*AllowlistEntry `protobuf:"bytes,3,rep,name=committee,proto3" json:"committee,omitempty"`
+	// identities contains validator -> drand identity bindings.
+	Identities []*VrfIdentity `protobuf:"bytes,4,rep,name=identities,proto3" json:"identities,omitempty"`
+	// pending_params is the scheduled params change, if any.
+	PendingParams *VrfPendingParams `protobuf:"bytes,5,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+	// requests are the randomness requests in every status. Pending requests
+	// are queued for fulfillment again on import.
+	Requests []*RandomnessRequest `protobuf:"bytes,6,rep,name=requests,proto3" json:"requests,omitempty"`
+	// next_request_id is the id the next randomness request receives.
+	NextRequestId uint64 `protobuf:"varint,7,opt,name=next_request_id,json=nextRequestId,proto3" json:"next_request_id,omitempty"`
+	// request_escrow is the fee held in the vrf module account for the pending
+	// requests. It must equal the sum of their fees.
+	RequestEscrow []*v1beta1.Coin `protobuf:"bytes,8,rep,name=request_escrow,json=requestEscrow,proto3" json:"request_escrow,omitempty"`
+	// timelocked are the time-locked messages in every status. Pending
+	// messages are queued for decryption again on import.
+	Timelocked []*TimelockedMessage `protobuf:"bytes,9,rep,name=timelocked,proto3" json:"timelocked,omitempty"`
+	// next_timelock_id is the id the next time-locked message receives.
+	NextTimelockId uint64 `protobuf:"varint,10,opt,name=next_timelock_id,json=nextTimelockId,proto3" json:"next_timelock_id,omitempty"`
+	// emergency_txs are the included emergency disable txs that have not timed
+	// out yet. The ante handler rejects them if they are sent again.
+	EmergencyTxs []*EmergencyTxRecord `protobuf:"bytes,11,rep,name=emergency_txs,json=emergencyTxs,proto3" json:"emergency_txs,omitempty"`
+	// ecvrf_fallback_seed is the ECVRF fallback seed recorded at the exported
+	// height, if any. PreBlock of the next height verifies the ECVRF proofs of
+	// the vote extensions against it.
+	EcvrfFallbackSeed *EcvrfFallbackSeed `protobuf:"bytes,12,opt,name=ecvrf_fallback_seed,json=ecvrfFallbackSeed,proto3" json:"ecvrf_fallback_seed,omitempty"`
+	// last_drand_round is the round of the latest finalized drand beacon. It
+	// outlives the ECVRF fallback beacons stored after it, so that no earlier
+	// drand round is finalized again.
+	LastDrandRound uint64 `protobuf:"varint,13,opt,name=last_drand_round,json=lastDrandRound,proto3" json:"last_drand_round,omitempty"`
+	// ecvrf_keys are the registered ECVRF fallback keys of the validators.
+	EcvrfKeys []*EcvrfKey `protobuf:"bytes,14,rep,name=ecvrf_keys,json=ecvrfKeys,proto3" json:"ecvrf_keys,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *VrfParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLatestBeacon() *VrfBeacon {
+	if x != nil {
+		return x.LatestBeacon
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCommittee() []*AllowlistEntry {
+	if x != nil {
+		return x.Committee
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIdentities() []*VrfIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingParams() *VrfPendingParams {
+	if x != nil {
+		return x.PendingParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRequests() []*RandomnessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextRequestId() uint64 {
+	if x != nil {
+		return x.NextRequestId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetRequestEscrow() []*v1beta1.Coin {
+	if x != nil {
+		return x.RequestEscrow
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTimelocked() []*TimelockedMessage {
+	if x != nil {
+		return x.Timelocked
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextTimelockId() uint64 {
+	if x != nil {
+		return x.NextTimelockId
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *GenesisState) GetEmergencyTxs() []*EmergencyTxRecord {
+	if x != nil {
+		return x.EmergencyTxs
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: digitalkitchen/vrf/v1/genesis.proto
+func (x *GenesisState) GetEcvrfFallbackSeed() *EcvrfFallbackSeed {
+	if x != nil {
+		return x.EcvrfFallbackSeed
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *GenesisState) GetLastDrandRound() uint64 {
+	if x != nil {
+		return x.LastDrandRound
+	}
+	return 0
+}
 
-// GenesisState defines the vrf module's genesis state.
-type GenesisState struct {
+func (x *GenesisState) GetEcvrfKeys() []*EcvrfKey {
+	if x != nil {
+		return x.EcvrfKeys
+	}
+	return nil
+}
+
+// EcvrfFallbackSeed is a recorded ECVRF fallback seed.
+type EcvrfFallbackSeed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params defines the module parameters.
-	Params *VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// latest_beacon is the canonical drand beacon for the current height, if any.
-	LatestBeacon *VrfBeacon `protobuf:"bytes,2,opt,name=latest_beacon,json=latestBeacon,proto3" json:"latest_beacon,omitempty"`
-	// committee contains allowlisted authorities for VRF emergency disable and
-	// resharing scheduling.
-	Committee []*AllowlistEntry `protobuf:"bytes,3,rep,name=committee,proto3" json:"committee,omitempty"`
-	// identities contains validator -> drand identity bindings.
-	Identities []*VrfIdentity `protobuf:"bytes,4,rep,name=identities,proto3" json:"identities,omitempty"`
+	// seed is the randomness of the latest beacon when the seed was recorded.
+	// It is empty before the first beacon.
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
+func (x *EcvrfFallbackSeed) Reset() {
+	*x = EcvrfFallbackSeed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[0]
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenesisState) String() string {
+func (x *EcvrfFallbackSeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisState) ProtoMessage() {}
+func (*EcvrfFallbackSeed) ProtoMessage() {}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EcvrfFallbackSeed.ProtoReflect.Descriptor instead.
+func (*EcvrfFallbackSeed) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GenesisState) GetParams() *VrfParams {
+func (x *EcvrfFallbackSeed) GetSeed() []byte {
 	if x != nil {
-		return x.Params
+		return x.Seed
 	}
 	return nil
 }
 
-func (x *GenesisState) GetLatestBeacon() *VrfBeacon {
+// EcvrfKey is the registered ECVRF fallback key of a validator.
+type EcvrfKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_address is the validator operator address.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// public_key is the ed25519 public key the validator's ECVRF fallback
+	// proofs verify against.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *EcvrfKey) Reset() {
+	*x = EcvrfKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EcvrfKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EcvrfKey) ProtoMessage() {}
+
+// Deprecated: Use EcvrfKey.ProtoReflect.Descriptor instead.
+func (*EcvrfKey) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EcvrfKey) GetValidatorAddress() string {
 	if x != nil {
-		return x.LatestBeacon
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *EcvrfKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
 	}
 	return nil
 }
 
-func (x *GenesisState) GetCommittee() []*AllowlistEntry {
+// EmergencyTxRecord is an included emergency disable tx.
+type EmergencyTxRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_hash is the hash of the tx bytes.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// timeout_height is the timeout height of the tx, after which the record
+	// is pruned.
+	TimeoutHeight uint64 `protobuf:"varint,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// signer is the first signer of the tx. The records of a signer count
+	// against the per-signer cap on emergency disable txs.
+	Signer []byte `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *EmergencyTxRecord) Reset() {
+	*x = EmergencyTxRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmergencyTxRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyTxRecord) ProtoMessage() {}
+
+// Deprecated: Use EmergencyTxRecord.ProtoReflect.Descriptor instead.
+func (*EmergencyTxRecord) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmergencyTxRecord) GetTxHash() []byte {
 	if x != nil {
-		return x.Committee
+		return x.TxHash
 	}
 	return nil
 }
 
-func (x *GenesisState) GetIdentities() []*VrfIdentity {
+func (x *EmergencyTxRecord) GetTimeoutHeight() uint64 {
 	if x != nil {
-		return x.Identities
+		return x.TimeoutHeight
+	}
+	return 0
+}
+
+func (x *EmergencyTxRecord) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
 	}
 	return nil
 }
@@ -1689,12 +5812,59 @@ type VrfParams struct {
 	ReshareEpoch uint64 `protobuf:"varint,7,opt,name=reshare_epoch,json=reshareEpoch,proto3" json:"reshare_epoch,omitempty"`
 	// slashing_grace_blocks configures the slashing grace window.
 	SlashingGraceBlocks uint64 `protobuf:"varint,8,opt,name=slashing_grace_blocks,json=slashingGraceBlocks,proto3" json:"slashing_grace_blocks,omitempty"`
+	// round_tolerance is the number of drand rounds below target_round(H) that
+	// PreBlock accepts when no quorum forms on the target round itself. Zero
+	// requires the exact target round.
+	RoundTolerance uint64 `protobuf:"varint,9,opt,name=round_tolerance,json=roundTolerance,proto3" json:"round_tolerance,omitempty"`
+	// participation_retention_blocks is the number of heights for which
+	// per-height participation records are kept. Zero disables recording.
+	ParticipationRetentionBlocks uint64 `protobuf:"varint,10,opt,name=participation_retention_blocks,json=participationRetentionBlocks,proto3" json:"participation_retention_blocks,omitempty"`
+	// reward_per_block is paid from the vrf module account at every finalized
+	// height to the validators whose vote extensions backed the beacon, in
+	// proportion to their voting power. Empty disables rewards.
+	RewardPerBlock []*v1beta1.Coin `protobuf:"bytes,11,rep,name=reward_per_block,json=rewardPerBlock,proto3" json:"reward_per_block,omitempty"`
+	// reward_fee_share_bps is the share of the fee collector balance, in basis
+	// points, moved into the vrf module account at every finalized height.
+	RewardFeeShareBps uint32 `protobuf:"varint,12,opt,name=reward_fee_share_bps,json=rewardFeeShareBps,proto3" json:"reward_fee_share_bps,omitempty"`
+	// request_base_fee is charged for every MsgRequestRandomness.
+	RequestBaseFee []*v1beta1.Coin `protobuf:"bytes,13,rep,name=request_base_fee,json=requestBaseFee,proto3" json:"request_base_fee,omitempty"`
+	// request_fee_per_word is charged per requested word on top of
+	// request_base_fee.
+	RequestFeePerWord []*v1beta1.Coin `protobuf:"bytes,14,rep,name=request_fee_per_word,json=requestFeePerWord,proto3" json:"request_fee_per_word,omitempty"`
+	// scheme_id is the drand signature scheme of the chain: "pedersen-bls-chained"
+	// (the default when empty) or "pedersen-bls-unchained". Only unchained chains
+	// support time-lock encryption.
+	SchemeId string `protobuf:"bytes,15,opt,name=scheme_id,json=schemeId,proto3" json:"scheme_id,omitempty"`
+	// partial_threshold, when non-zero, switches the chain to threshold mode:
+	// vote extensions carry partial signatures from the validators' drand key
+	// shares and PreBlock recovers the beacon from at least partial_threshold
+	// of them. It requires an unchained scheme_id.
+	PartialThreshold uint32 `protobuf:"varint,16,opt,name=partial_threshold,json=partialThreshold,proto3" json:"partial_threshold,omitempty"`
+	// ecvrf_fallback makes validators add an ECVRF proof, made with their
+	// registered ECVRF key, to their vote extensions. When no drand round reaches
+	// quorum, PreBlock combines the proofs into a beacon with source
+	// VRF_BEACON_SOURCE_ECVRF instead of rejecting the block.
+	EcvrfFallback bool `protobuf:"varint,17,opt,name=ecvrf_fallback,json=ecvrfFallback,proto3" json:"ecvrf_fallback,omitempty"`
+	// genesis_seed is the drand group's genesis seed, which the chain hash
+	// commits to.
+	GenesisSeed []byte `protobuf:"bytes,18,opt,name=genesis_seed,json=genesisSeed,proto3" json:"genesis_seed,omitempty"`
+	// beacon_id is the drand beacon ID. Empty and "default" both denote the
+	// default beacon, which the chain hash does not commit to.
+	BeaconId string `protobuf:"bytes,19,opt,name=beacon_id,json=beaconId,proto3" json:"beacon_id,omitempty"`
+	// bootstrap_enable_delay_blocks, when non-zero, lets a MsgInitialDkg that
+	// carries a verified bootstrap_beacon also enable VRF, at the latest of its
+	// activation_height and this many blocks after its inclusion.
+	BootstrapEnableDelayBlocks uint64 `protobuf:"varint,20,opt,name=bootstrap_enable_delay_blocks,json=bootstrapEnableDelayBlocks,proto3" json:"bootstrap_enable_delay_blocks,omitempty"`
+	// invariant_check_interval_blocks, when non-zero, makes EndBlock check the
+	// cheap module invariants every this many blocks and halt the chain when
+	// one is broken. Zero disables the check.
+	InvariantCheckIntervalBlocks uint64 `protobuf:"varint,21,opt,name=invariant_check_interval_blocks,json=invariantCheckIntervalBlocks,proto3" json:"invariant_check_interval_blocks,omitempty"`
 }
 
 func (x *VrfParams) Reset() {
 	*x = VrfParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[1]
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1708,7 +5878,7 @@ func (*VrfParams) ProtoMessage() {}
 
 // Deprecated: Use VrfParams.ProtoReflect.Descriptor instead.
 func (*VrfParams) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VrfParams) GetChainHash() []byte {
@@ -1767,6 +5937,146 @@ func (x *VrfParams) GetSlashingGraceBlocks() uint64 {
 	return 0
 }
 
+func (x *VrfParams) GetRoundTolerance() uint64 {
+	if x != nil {
+		return x.RoundTolerance
+	}
+	return 0
+}
+
+func (x *VrfParams) GetParticipationRetentionBlocks() uint64 {
+	if x != nil {
+		return x.ParticipationRetentionBlocks
+	}
+	return 0
+}
+
+func (x *VrfParams) GetRewardPerBlock() []*v1beta1.Coin {
+	if x != nil {
+		return x.RewardPerBlock
+	}
+	return nil
+}
+
+func (x *VrfParams) GetRewardFeeShareBps() uint32 {
+	if x != nil {
+		return x.RewardFeeShareBps
+	}
+	return 0
+}
+
+func (x *VrfParams) GetRequestBaseFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.RequestBaseFee
+	}
+	return nil
+}
+
+func (x *VrfParams) GetRequestFeePerWord() []*v1beta1.Coin {
+	if x != nil {
+		return x.RequestFeePerWord
+	}
+	return nil
+}
+
+func (x *VrfParams) GetSchemeId() string {
+	if x != nil {
+		return x.SchemeId
+	}
+	return ""
+}
+
+func (x *VrfParams) GetPartialThreshold() uint32 {
+	if x != nil {
+		return x.PartialThreshold
+	}
+	return 0
+}
+
+func (x *VrfParams) GetEcvrfFallback() bool {
+	if x != nil {
+		return x.EcvrfFallback
+	}
+	return false
+}
+
+func (x *VrfParams) GetGenesisSeed() []byte {
+	if x != nil {
+		return x.GenesisSeed
+	}
+	return nil
+}
+
+func (x *VrfParams) GetBeaconId() string {
+	if x != nil {
+		return x.BeaconId
+	}
+	return ""
+}
+
+func (x *VrfParams) GetBootstrapEnableDelayBlocks() uint64 {
+	if x != nil {
+		return x.BootstrapEnableDelayBlocks
+	}
+	return 0
+}
+
+func (x *VrfParams) GetInvariantCheckIntervalBlocks() uint64 {
+	if x != nil {
+		return x.InvariantCheckIntervalBlocks
+	}
+	return 0
+}
+
+// VrfPendingParams is a params change scheduled by MsgUpdateParams or
+// MsgInitialDkg. The params govern PreBlock from activation_height on, and the
+// vote extensions of the height before it, which PreBlock at
+// activation_height aggregates.
+type VrfPendingParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params are the scheduled parameters.
+	Params *VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// activation_height is the first height whose PreBlock uses params.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (x *VrfPendingParams) Reset() {
+	*x = VrfPendingParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfPendingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfPendingParams) ProtoMessage() {}
+
+// Deprecated: Use VrfPendingParams.ProtoReflect.Descriptor instead.
+func (*VrfPendingParams) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VrfPendingParams) GetParams() *VrfParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *VrfPendingParams) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
 var File_digitalkitchen_vrf_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_digitalkitchen_vrf_v1_genesis_proto_rawDesc = []byte{
@@ -1775,50 +6085,178 @@ var file_digitalkitchen_vrf_v1_genesis_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
 	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f,
-	0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x85, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
+	0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x49,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
+	0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x77, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x12, 0x4e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x0d, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x54, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0c, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x78, 0x73, 0x12,
+	0x58, 0x0a, 0x13, 0x65, 0x63, 0x76, 0x72, 0x66, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x76, 0x72, 0x66, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x52, 0x11, 0x65, 0x63, 0x76, 0x72, 0x66, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x63, 0x76, 0x72, 0x66, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
 	0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0d,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x48,
-	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x09, 0x56, 0x72, 0x66,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x67,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x55, 0x6e,
-	0x69, 0x78, 0x53, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f,
-	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6c, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x47, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x42, 0xcd, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
+	0x65, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x45, 0x63, 0x76,
+	0x72, 0x66, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x22, 0x79, 0x0a, 0x08, 0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x12, 0x4e,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x6b, 0x0a,
+	0x11, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x78, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x9e, 0x09, 0x0a, 0x09, 0x56,
+	0x72, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x1e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x7a, 0x0a,
+	0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46,
+	0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x70, 0x73, 0x12, 0x7a, 0x0a, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x63, 0x76, 0x72, 0x66, 0x5f, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x63,
+	0x76, 0x72, 0x66, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x1d, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x1a, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x45,
+	0x0a, 0x1f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1c, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x10,
+	0x56, 0x72, 0x66, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0xcd, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
 	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
 	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
 	0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
@@ -1846,24 +6284,42 @@ func file_digitalkitchen_vrf_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_vrf_v1_genesis_proto_rawDescData
 }
 
-var file_digitalkitchen_vrf_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_digitalkitchen_vrf_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_digitalkitchen_vrf_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),   // 0: digitalkitchen.vrf.v1.GenesisState
-	(*VrfParams)(nil),      // 1: digitalkitchen.vrf.v1.VrfParams
-	(*VrfBeacon)(nil),      // 2: digitalkitchen.vrf.v1.VrfBeacon
-	(*AllowlistEntry)(nil), // 3: digitalkitchen.vrf.v1.AllowlistEntry
-	(*VrfIdentity)(nil),    // 4: digitalkitchen.vrf.v1.VrfIdentity
+	(*GenesisState)(nil),      // 0: digitalkitchen.vrf.v1.GenesisState
+	(*EcvrfFallbackSeed)(nil), // 1: digitalkitchen.vrf.v1.EcvrfFallbackSeed
+	(*EcvrfKey)(nil),          // 2: digitalkitchen.vrf.v1.EcvrfKey
+	(*EmergencyTxRecord)(nil), // 3: digitalkitchen.vrf.v1.EmergencyTxRecord
+	(*VrfParams)(nil),         // 4: digitalkitchen.vrf.v1.VrfParams
+	(*VrfPendingParams)(nil),  // 5: digitalkitchen.vrf.v1.VrfPendingParams
+	(*VrfBeacon)(nil),         // 6: digitalkitchen.vrf.v1.VrfBeacon
+	(*AllowlistEntry)(nil),    // 7: digitalkitchen.vrf.v1.AllowlistEntry
+	(*VrfIdentity)(nil),       // 8: digitalkitchen.vrf.v1.VrfIdentity
+	(*RandomnessRequest)(nil), // 9: digitalkitchen.vrf.v1.RandomnessRequest
+	(*v1beta1.Coin)(nil),      // 10: cosmos.base.v1beta1.Coin
+	(*TimelockedMessage)(nil), // 11: digitalkitchen.vrf.v1.TimelockedMessage
 }
 var file_digitalkitchen_vrf_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: digitalkitchen.vrf.v1.GenesisState.params:type_name -> digitalkitchen.vrf.v1.VrfParams
-	2, // 1: digitalkitchen.vrf.v1.GenesisState.latest_beacon:type_name -> digitalkitchen.vrf.v1.VrfBeacon
-	3, // 2: digitalkitchen.vrf.v1.GenesisState.committee:type_name -> digitalkitchen.vrf.v1.AllowlistEntry
-	4, // 3: digitalkitchen.vrf.v1.GenesisState.identities:type_name -> digitalkitchen.vrf.v1.VrfIdentity
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4,  // 0: digitalkitchen.vrf.v1.GenesisState.params:type_name -> digitalkitchen.vrf.v1.VrfParams
+	6,  // 1: digitalkitchen.vrf.v1.GenesisState.latest_beacon:type_name -> digitalkitchen.vrf.v1.VrfBeacon
+	7,  // 2: digitalkitchen.vrf.v1.GenesisState.committee:type_name -> digitalkitchen.vrf.v1.AllowlistEntry
+	8,  // 3: digitalkitchen.vrf.v1.GenesisState.identities:type_name -> digitalkitchen.vrf.v1.VrfIdentity
+	5,  // 4: digitalkitchen.vrf.v1.GenesisState.pending_params:type_name -> digitalkitchen.vrf.v1.VrfPendingParams
+	9,  // 5: digitalkitchen.vrf.v1.GenesisState.requests:type_name -> digitalkitchen.vrf.v1.RandomnessRequest
+	10, // 6: digitalkitchen.vrf.v1.GenesisState.request_escrow:type_name -> cosmos.base.v1beta1.Coin
+	11, // 7: digitalkitchen.vrf.v1.GenesisState.timelocked:type_name -> digitalkitchen.vrf.v1.TimelockedMessage
+	3,  // 8: digitalkitchen.vrf.v1.GenesisState.emergency_txs:type_name -> digitalkitchen.vrf.v1.EmergencyTxRecord
+	1,  // 9: digitalkitchen.vrf.v1.GenesisState.ecvrf_fallback_seed:type_name -> digitalkitchen.vrf.v1.EcvrfFallbackSeed
+	2,  // 10: digitalkitchen.vrf.v1.GenesisState.ecvrf_keys:type_name -> digitalkitchen.vrf.v1.EcvrfKey
+	10, // 11: digitalkitchen.vrf.v1.VrfParams.reward_per_block:type_name -> cosmos.base.v1beta1.Coin
+	10, // 12: digitalkitchen.vrf.v1.VrfParams.request_base_fee:type_name -> cosmos.base.v1beta1.Coin
+	10, // 13: digitalkitchen.vrf.v1.VrfParams.request_fee_per_word:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 14: digitalkitchen.vrf.v1.VrfPendingParams.params:type_name -> digitalkitchen.vrf.v1.VrfParams
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_digitalkitchen_vrf_v1_genesis_proto_init() }
@@ -1886,6 +6342,42 @@ func file_digitalkitchen_vrf_v1_genesis_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EcvrfFallbackSeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EcvrfKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmergencyTxRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VrfParams); i {
 			case 0:
 				return &v.state
@@ -1897,6 +6389,18 @@ func file_digitalkitchen_vrf_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_vrf_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfPendingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1904,7 +6408,7 @@ func file_digitalkitchen_vrf_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_vrf_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -5,6 +5,7 @@ import (
 	_ "cosmossdk.io/api/amino"
 	_ "cosmossdk.io/api/cosmos/query/v1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -808,23 +809,23 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBeaconRequest protoreflect.MessageDescriptor
+	md_QueryPendingParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_query_proto_init()
-	md_QueryBeaconRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryBeaconRequest")
+	md_QueryPendingParamsRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryPendingParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBeaconRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPendingParamsRequest)(nil)
 
-type fastReflection_QueryBeaconRequest QueryBeaconRequest
+type fastReflection_QueryPendingParamsRequest QueryPendingParamsRequest
 
-func (x *QueryBeaconRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBeaconRequest)(x)
+func (x *QueryPendingParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingParamsRequest)(x)
 }
 
-func (x *QueryBeaconRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPendingParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,43 +837,43 @@ func (x *QueryBeaconRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBeaconRequest_messageType fastReflection_QueryBeaconRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBeaconRequest_messageType{}
+var _fastReflection_QueryPendingParamsRequest_messageType fastReflection_QueryPendingParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingParamsRequest_messageType{}
 
-type fastReflection_QueryBeaconRequest_messageType struct{}
+type fastReflection_QueryPendingParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryBeaconRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBeaconRequest)(nil)
+func (x fastReflection_QueryPendingParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingParamsRequest)(nil)
 }
-func (x fastReflection_QueryBeaconRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBeaconRequest)
+func (x fastReflection_QueryPendingParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingParamsRequest)
 }
-func (x fastReflection_QueryBeaconRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBeaconRequest
+func (x fastReflection_QueryPendingParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBeaconRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBeaconRequest
+func (x *fastReflection_QueryPendingParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBeaconRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBeaconRequest_messageType
+func (x *fastReflection_QueryPendingParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBeaconRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryBeaconRequest)
+func (x *fastReflection_QueryPendingParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBeaconRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryBeaconRequest)(x)
+func (x *fastReflection_QueryPendingParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -880,7 +881,7 @@ func (x *fastReflection_QueryBeaconRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBeaconRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPendingParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -894,13 +895,13 @@ func (x *fastReflection_QueryBeaconRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBeaconRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -910,13 +911,13 @@ func (x *fastReflection_QueryBeaconRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -926,13 +927,13 @@ func (x *fastReflection_QueryBeaconRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBeaconRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -946,13 +947,13 @@ func (x *fastReflection_QueryBeaconRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -966,36 +967,36 @@ func (x *fastReflection_QueryBeaconRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBeaconRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBeaconRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryBeaconRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryPendingParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1003,7 +1004,7 @@ func (x *fastReflection_QueryBeaconRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBeaconRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1014,7 +1015,7 @@ func (x *fastReflection_QueryBeaconRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1026,7 +1027,7 @@ func (x *fastReflection_QueryBeaconRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBeaconRequest) IsValid() bool {
+func (x *fastReflection_QueryPendingParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1036,9 +1037,9 @@ func (x *fastReflection_QueryBeaconRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBeaconRequest)
+		x := input.Message.Interface().(*QueryPendingParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1060,7 +1061,7 @@ func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBeaconRequest)
+		x := input.Message.Interface().(*QueryPendingParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1090,7 +1091,7 @@ func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBeaconRequest)
+		x := input.Message.Interface().(*QueryPendingParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1122,10 +1123,10 @@ func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -1164,25 +1165,25 @@ func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryBeaconResponse        protoreflect.MessageDescriptor
-	fd_QueryBeaconResponse_beacon protoreflect.FieldDescriptor
+	md_QueryPendingParamsResponse                protoreflect.MessageDescriptor
+	fd_QueryPendingParamsResponse_pending_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_query_proto_init()
-	md_QueryBeaconResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryBeaconResponse")
-	fd_QueryBeaconResponse_beacon = md_QueryBeaconResponse.Fields().ByName("beacon")
+	md_QueryPendingParamsResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryPendingParamsResponse")
+	fd_QueryPendingParamsResponse_pending_params = md_QueryPendingParamsResponse.Fields().ByName("pending_params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBeaconResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPendingParamsResponse)(nil)
 
-type fastReflection_QueryBeaconResponse QueryBeaconResponse
+type fastReflection_QueryPendingParamsResponse QueryPendingParamsResponse
 
-func (x *QueryBeaconResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBeaconResponse)(x)
+func (x *QueryPendingParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingParamsResponse)(x)
 }
 
-func (x *QueryBeaconResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPendingParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1194,43 +1195,43 @@ func (x *QueryBeaconResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBeaconResponse_messageType fastReflection_QueryBeaconResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBeaconResponse_messageType{}
+var _fastReflection_QueryPendingParamsResponse_messageType fastReflection_QueryPendingParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingParamsResponse_messageType{}
 
-type fastReflection_QueryBeaconResponse_messageType struct{}
+type fastReflection_QueryPendingParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryBeaconResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBeaconResponse)(nil)
+func (x fastReflection_QueryPendingParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingParamsResponse)(nil)
 }
-func (x fastReflection_QueryBeaconResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBeaconResponse)
+func (x fastReflection_QueryPendingParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingParamsResponse)
 }
-func (x fastReflection_QueryBeaconResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBeaconResponse
+func (x fastReflection_QueryPendingParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBeaconResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBeaconResponse
+func (x *fastReflection_QueryPendingParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBeaconResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBeaconResponse_messageType
+func (x *fastReflection_QueryPendingParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBeaconResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryBeaconResponse)
+func (x *fastReflection_QueryPendingParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBeaconResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryBeaconResponse)(x)
+func (x *fastReflection_QueryPendingParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1238,10 +1239,10 @@ func (x *fastReflection_QueryBeaconResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBeaconResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Beacon != nil {
-		value := protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
-		if !f(fd_QueryBeaconResponse_beacon, value) {
+func (x *fastReflection_QueryPendingParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PendingParams != nil {
+		value := protoreflect.ValueOfMessage(x.PendingParams.ProtoReflect())
+		if !f(fd_QueryPendingParamsResponse_pending_params, value) {
 			return
 		}
 	}
@@ -1258,15 +1259,15 @@ func (x *fastReflection_QueryBeaconResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBeaconResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		return x.Beacon != nil
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		return x.PendingParams != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1276,15 +1277,15 @@ func (x *fastReflection_QueryBeaconResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		x.Beacon = nil
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		x.PendingParams = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1294,16 +1295,16 @@ func (x *fastReflection_QueryBeaconResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBeaconResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		value := x.Beacon
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		value := x.PendingParams
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1317,15 +1318,15 @@ func (x *fastReflection_QueryBeaconResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		x.Beacon = value.Message().Interface().(*VrfBeacon)
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		x.PendingParams = value.Message().Interface().(*VrfPendingParams)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1339,44 +1340,44 @@ func (x *fastReflection_QueryBeaconResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		if x.Beacon == nil {
-			x.Beacon = new(VrfBeacon)
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		if x.PendingParams == nil {
+			x.PendingParams = new(VrfPendingParams)
 		}
-		return protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.PendingParams.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBeaconResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
-		m := new(VrfBeacon)
+	case "digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params":
+		m := new(VrfPendingParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryPendingParamsResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryPendingParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBeaconResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryBeaconResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryPendingParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1384,7 +1385,7 @@ func (x *fastReflection_QueryBeaconResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBeaconResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1395,7 +1396,7 @@ func (x *fastReflection_QueryBeaconResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBeaconResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1407,7 +1408,7 @@ func (x *fastReflection_QueryBeaconResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBeaconResponse) IsValid() bool {
+func (x *fastReflection_QueryPendingParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1417,9 +1418,9 @@ func (x *fastReflection_QueryBeaconResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBeaconResponse)
+		x := input.Message.Interface().(*QueryPendingParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1431,8 +1432,8 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Beacon != nil {
-			l = options.Size(x.Beacon)
+		if x.PendingParams != nil {
+			l = options.Size(x.PendingParams)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1445,7 +1446,7 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBeaconResponse)
+		x := input.Message.Interface().(*QueryPendingParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1464,8 +1465,8 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Beacon != nil {
-			encoded, err := options.Marshal(x.Beacon)
+		if x.PendingParams != nil {
+			encoded, err := options.Marshal(x.PendingParams)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1489,7 +1490,7 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBeaconResponse)
+		x := input.Message.Interface().(*QueryPendingParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1521,15 +1522,15 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1556,10 +1557,10 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Beacon == nil {
-					x.Beacon = &VrfBeacon{}
+				if x.PendingParams == nil {
+					x.PendingParams = &VrfPendingParams{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beacon); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingParams); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1599,27 +1600,23 @@ func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryRandomWordsRequest           protoreflect.MessageDescriptor
-	fd_QueryRandomWordsRequest_count     protoreflect.FieldDescriptor
-	fd_QueryRandomWordsRequest_user_seed protoreflect.FieldDescriptor
+	md_QueryBeaconRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_query_proto_init()
-	md_QueryRandomWordsRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomWordsRequest")
-	fd_QueryRandomWordsRequest_count = md_QueryRandomWordsRequest.Fields().ByName("count")
-	fd_QueryRandomWordsRequest_user_seed = md_QueryRandomWordsRequest.Fields().ByName("user_seed")
+	md_QueryBeaconRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryBeaconRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRandomWordsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBeaconRequest)(nil)
 
-type fastReflection_QueryRandomWordsRequest QueryRandomWordsRequest
+type fastReflection_QueryBeaconRequest QueryBeaconRequest
 
-func (x *QueryRandomWordsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRandomWordsRequest)(x)
+func (x *QueryBeaconRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBeaconRequest)(x)
 }
 
-func (x *QueryRandomWordsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryBeaconRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1631,43 +1628,43 @@ func (x *QueryRandomWordsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRandomWordsRequest_messageType fastReflection_QueryRandomWordsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRandomWordsRequest_messageType{}
+var _fastReflection_QueryBeaconRequest_messageType fastReflection_QueryBeaconRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBeaconRequest_messageType{}
 
-type fastReflection_QueryRandomWordsRequest_messageType struct{}
+type fastReflection_QueryBeaconRequest_messageType struct{}
 
-func (x fastReflection_QueryRandomWordsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRandomWordsRequest)(nil)
+func (x fastReflection_QueryBeaconRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBeaconRequest)(nil)
 }
-func (x fastReflection_QueryRandomWordsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRandomWordsRequest)
+func (x fastReflection_QueryBeaconRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBeaconRequest)
 }
-func (x fastReflection_QueryRandomWordsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRandomWordsRequest
+func (x fastReflection_QueryBeaconRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBeaconRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRandomWordsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRandomWordsRequest
+func (x *fastReflection_QueryBeaconRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBeaconRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRandomWordsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRandomWordsRequest_messageType
+func (x *fastReflection_QueryBeaconRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBeaconRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRandomWordsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryRandomWordsRequest)
+func (x *fastReflection_QueryBeaconRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBeaconRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRandomWordsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryRandomWordsRequest)(x)
+func (x *fastReflection_QueryBeaconRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBeaconRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1675,19 +1672,7 @@ func (x *fastReflection_QueryRandomWordsRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRandomWordsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Count != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Count)
-		if !f(fd_QueryRandomWordsRequest_count, value) {
-			return
-		}
-	}
-	if len(x.UserSeed) != 0 {
-		value := protoreflect.ValueOfBytes(x.UserSeed)
-		if !f(fd_QueryRandomWordsRequest_user_seed, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryBeaconRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1701,17 +1686,13 @@ func (x *fastReflection_QueryRandomWordsRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRandomWordsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBeaconRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		return x.Count != uint32(0)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		return len(x.UserSeed) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1721,17 +1702,13 @@ func (x *fastReflection_QueryRandomWordsRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBeaconRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		x.Count = uint32(0)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		x.UserSeed = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1741,19 +1718,13 @@ func (x *fastReflection_QueryRandomWordsRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRandomWordsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		value := x.Count
-		return protoreflect.ValueOfUint32(value)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		value := x.UserSeed
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1767,17 +1738,13 @@ func (x *fastReflection_QueryRandomWordsRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBeaconRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		x.Count = uint32(value.Uint())
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		x.UserSeed = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1791,44 +1758,36 @@ func (x *fastReflection_QueryRandomWordsRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		panic(fmt.Errorf("field count of message digitalkitchen.vrf.v1.QueryRandomWordsRequest is not mutable"))
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		panic(fmt.Errorf("field user_seed of message digitalkitchen.vrf.v1.QueryRandomWordsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRandomWordsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconRequest"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRandomWordsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBeaconRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomWordsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryBeaconRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1836,7 +1795,7 @@ func (x *fastReflection_QueryRandomWordsRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRandomWordsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBeaconRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1847,7 +1806,7 @@ func (x *fastReflection_QueryRandomWordsRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBeaconRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1859,7 +1818,7 @@ func (x *fastReflection_QueryRandomWordsRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRandomWordsRequest) IsValid() bool {
+func (x *fastReflection_QueryBeaconRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1869,9 +1828,9 @@ func (x *fastReflection_QueryRandomWordsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBeaconRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		x := input.Message.Interface().(*QueryBeaconRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1883,13 +1842,6 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Count != 0 {
-			n += 1 + runtime.Sov(uint64(x.Count))
-		}
-		l = len(x.UserSeed)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1900,7 +1852,7 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		x := input.Message.Interface().(*QueryBeaconRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1919,18 +1871,6 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.UserSeed) > 0 {
-			i -= len(x.UserSeed)
-			copy(dAtA[i:], x.UserSeed)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UserSeed)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Count != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1942,7 +1882,7 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		x := input.Message.Interface().(*QueryBeaconRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1974,65 +1914,12 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-				}
-				x.Count = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Count |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.UserSeed = append(x.UserSeed[:0], dAtA[iNdEx:postIndex]...)
-				if x.UserSeed == nil {
-					x.UserSeed = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2068,76 +1955,26 @@ func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryRandomWordsResponse_3_list)(nil)
-
-type _QueryRandomWordsResponse_3_list struct {
-	list *[][]byte
-}
-
-func (x *_QueryRandomWordsResponse_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryRandomWordsResponse_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfBytes((*x.list)[i])
-}
-
-func (x *_QueryRandomWordsResponse_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryRandomWordsResponse_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryRandomWordsResponse_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryRandomWordsResponse at list field Words as it is not of Message kind"))
-}
-
-func (x *_QueryRandomWordsResponse_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryRandomWordsResponse_3_list) NewElement() protoreflect.Value {
-	var v []byte
-	return protoreflect.ValueOfBytes(v)
-}
-
-func (x *_QueryRandomWordsResponse_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryRandomWordsResponse             protoreflect.MessageDescriptor
-	fd_QueryRandomWordsResponse_drand_round protoreflect.FieldDescriptor
-	fd_QueryRandomWordsResponse_seed        protoreflect.FieldDescriptor
-	fd_QueryRandomWordsResponse_words       protoreflect.FieldDescriptor
+	md_QueryBeaconResponse        protoreflect.MessageDescriptor
+	fd_QueryBeaconResponse_beacon protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_query_proto_init()
-	md_QueryRandomWordsResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomWordsResponse")
-	fd_QueryRandomWordsResponse_drand_round = md_QueryRandomWordsResponse.Fields().ByName("drand_round")
-	fd_QueryRandomWordsResponse_seed = md_QueryRandomWordsResponse.Fields().ByName("seed")
-	fd_QueryRandomWordsResponse_words = md_QueryRandomWordsResponse.Fields().ByName("words")
+	md_QueryBeaconResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryBeaconResponse")
+	fd_QueryBeaconResponse_beacon = md_QueryBeaconResponse.Fields().ByName("beacon")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryRandomWordsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBeaconResponse)(nil)
 
-type fastReflection_QueryRandomWordsResponse QueryRandomWordsResponse
+type fastReflection_QueryBeaconResponse QueryBeaconResponse
 
-func (x *QueryRandomWordsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryRandomWordsResponse)(x)
+func (x *QueryBeaconResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBeaconResponse)(x)
 }
 
-func (x *QueryRandomWordsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryBeaconResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2149,43 +1986,43 @@ func (x *QueryRandomWordsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryRandomWordsResponse_messageType fastReflection_QueryRandomWordsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryRandomWordsResponse_messageType{}
+var _fastReflection_QueryBeaconResponse_messageType fastReflection_QueryBeaconResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBeaconResponse_messageType{}
 
-type fastReflection_QueryRandomWordsResponse_messageType struct{}
+type fastReflection_QueryBeaconResponse_messageType struct{}
 
-func (x fastReflection_QueryRandomWordsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryRandomWordsResponse)(nil)
+func (x fastReflection_QueryBeaconResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBeaconResponse)(nil)
 }
-func (x fastReflection_QueryRandomWordsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryRandomWordsResponse)
+func (x fastReflection_QueryBeaconResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBeaconResponse)
 }
-func (x fastReflection_QueryRandomWordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRandomWordsResponse
+func (x fastReflection_QueryBeaconResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBeaconResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryRandomWordsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryRandomWordsResponse
+func (x *fastReflection_QueryBeaconResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBeaconResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryRandomWordsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryRandomWordsResponse_messageType
+func (x *fastReflection_QueryBeaconResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBeaconResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryRandomWordsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryRandomWordsResponse)
+func (x *fastReflection_QueryBeaconResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBeaconResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryRandomWordsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryRandomWordsResponse)(x)
+func (x *fastReflection_QueryBeaconResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBeaconResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2193,22 +2030,10 @@ func (x *fastReflection_QueryRandomWordsResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryRandomWordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DrandRound != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DrandRound)
-		if !f(fd_QueryRandomWordsResponse_drand_round, value) {
-			return
-		}
-	}
-	if len(x.Seed) != 0 {
-		value := protoreflect.ValueOfBytes(x.Seed)
-		if !f(fd_QueryRandomWordsResponse_seed, value) {
-			return
-		}
-	}
-	if len(x.Words) != 0 {
-		value := protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{list: &x.Words})
-		if !f(fd_QueryRandomWordsResponse_words, value) {
+func (x *fastReflection_QueryBeaconResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Beacon != nil {
+		value := protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
+		if !f(fd_QueryBeaconResponse_beacon, value) {
 			return
 		}
 	}
@@ -2225,19 +2050,15 @@ func (x *fastReflection_QueryRandomWordsResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryRandomWordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBeaconResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		return x.DrandRound != uint64(0)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		return len(x.Seed) != 0
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		return len(x.Words) != 0
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		return x.Beacon != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2247,19 +2068,15 @@ func (x *fastReflection_QueryRandomWordsResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBeaconResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		x.DrandRound = uint64(0)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		x.Seed = nil
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		x.Words = nil
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		x.Beacon = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2269,25 +2086,16 @@ func (x *fastReflection_QueryRandomWordsResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryRandomWordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		value := x.DrandRound
-		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		value := x.Seed
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		if len(x.Words) == 0 {
-			return protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{})
-		}
-		listValue := &_QueryRandomWordsResponse_3_list{list: &x.Words}
-		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		value := x.Beacon
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2301,21 +2109,15 @@ func (x *fastReflection_QueryRandomWordsResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBeaconResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		x.DrandRound = value.Uint()
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		x.Seed = value.Bytes()
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		lv := value.List()
-		clv := lv.(*_QueryRandomWordsResponse_3_list)
-		x.Words = *clv.list
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		x.Beacon = value.Message().Interface().(*VrfBeacon)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2329,53 +2131,44 @@ func (x *fastReflection_QueryRandomWordsResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		if x.Words == nil {
-			x.Words = [][]byte{}
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		if x.Beacon == nil {
+			x.Beacon = new(VrfBeacon)
 		}
-		value := &_QueryRandomWordsResponse_3_list{list: &x.Words}
-		return protoreflect.ValueOfList(value)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.QueryRandomWordsResponse is not mutable"))
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		panic(fmt.Errorf("field seed of message digitalkitchen.vrf.v1.QueryRandomWordsResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Beacon.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryRandomWordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBeaconResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
-		list := [][]byte{}
-		return protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{list: &list})
+	case "digitalkitchen.vrf.v1.QueryBeaconResponse.beacon":
+		m := new(VrfBeacon)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryBeaconResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryBeaconResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryRandomWordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBeaconResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomWordsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryBeaconResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2383,7 +2176,7 @@ func (x *fastReflection_QueryRandomWordsResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryRandomWordsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBeaconResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2394,7 +2187,7 @@ func (x *fastReflection_QueryRandomWordsResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryRandomWordsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBeaconResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2406,7 +2199,7 @@ func (x *fastReflection_QueryRandomWordsResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryRandomWordsResponse) IsValid() bool {
+func (x *fastReflection_QueryBeaconResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2416,9 +2209,9 @@ func (x *fastReflection_QueryRandomWordsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBeaconResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		x := input.Message.Interface().(*QueryBeaconResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2430,19 +2223,10 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.DrandRound != 0 {
-			n += 1 + runtime.Sov(uint64(x.DrandRound))
-		}
-		l = len(x.Seed)
-		if l > 0 {
+		if x.Beacon != nil {
+			l = options.Size(x.Beacon)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Words) > 0 {
-			for _, b := range x.Words {
-				l = len(b)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2453,7 +2237,7 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		x := input.Message.Interface().(*QueryBeaconResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2472,26 +2256,19 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Words) > 0 {
-			for iNdEx := len(x.Words) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Words[iNdEx])
-				copy(dAtA[i:], x.Words[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Words[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
+		if x.Beacon != nil {
+			encoded, err := options.Marshal(x.Beacon)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if len(x.Seed) > 0 {
-			i -= len(x.Seed)
-			copy(dAtA[i:], x.Seed)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.DrandRound != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2504,7 +2281,7 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		x := input.Message.Interface().(*QueryBeaconResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2536,36 +2313,17 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBeaconResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
-				}
-				x.DrandRound = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DrandRound |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2575,57 +2333,27 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Seed = append(x.Seed[:0], dAtA[iNdEx:postIndex]...)
-				if x.Seed == nil {
-					x.Seed = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Beacon == nil {
+					x.Beacon = &VrfBeacon{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Beacon); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Words = append(x.Words, make([]byte, postIndex-iNdEx))
-				copy(x.Words[len(x.Words)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2662,46 +2390,5934 @@ func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: digitalkitchen/vrf/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryRandomWordsRequest           protoreflect.MessageDescriptor
+	fd_QueryRandomWordsRequest_count     protoreflect.FieldDescriptor
+	fd_QueryRandomWordsRequest_user_seed protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest requests the current VRF parameters.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryRandomWordsRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomWordsRequest")
+	fd_QueryRandomWordsRequest_count = md_QueryRandomWordsRequest.Fields().ByName("count")
+	fd_QueryRandomWordsRequest_user_seed = md_QueryRandomWordsRequest.Fields().ByName("user_seed")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryRandomWordsRequest)(nil)
+
+type fastReflection_QueryRandomWordsRequest QueryRandomWordsRequest
+
+func (x *QueryRandomWordsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRandomWordsRequest)(x)
+}
+
+func (x *QueryRandomWordsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryRandomWordsRequest_messageType fastReflection_QueryRandomWordsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRandomWordsRequest_messageType{}
 
-func (*QueryParamsRequest) ProtoMessage() {}
+type fastReflection_QueryRandomWordsRequest_messageType struct{}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryRandomWordsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRandomWordsRequest)(nil)
 }
-
+func (x fastReflection_QueryRandomWordsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomWordsRequest)
+}
+func (x fastReflection_QueryRandomWordsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomWordsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRandomWordsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomWordsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRandomWordsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRandomWordsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRandomWordsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomWordsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRandomWordsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRandomWordsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRandomWordsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Count != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Count)
+		if !f(fd_QueryRandomWordsRequest_count, value) {
+			return
+		}
+	}
+	if len(x.UserSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.UserSeed)
+		if !f(fd_QueryRandomWordsRequest_user_seed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRandomWordsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		return x.Count != uint32(0)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		return len(x.UserSeed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		x.Count = uint32(0)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		x.UserSeed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRandomWordsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		value := x.Count
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		value := x.UserSeed
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		x.Count = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		x.UserSeed = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		panic(fmt.Errorf("field count of message digitalkitchen.vrf.v1.QueryRandomWordsRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		panic(fmt.Errorf("field user_seed of message digitalkitchen.vrf.v1.QueryRandomWordsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRandomWordsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.QueryRandomWordsRequest.user_seed":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRandomWordsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomWordsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRandomWordsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRandomWordsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRandomWordsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		l = len(x.UserSeed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UserSeed) > 0 {
+			i -= len(x.UserSeed)
+			copy(dAtA[i:], x.UserSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UserSeed)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomWordsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UserSeed = append(x.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.UserSeed == nil {
+					x.UserSeed = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRandomWordsResponse_3_list)(nil)
+
+type _QueryRandomWordsResponse_3_list struct {
+	list *[][]byte
+}
+
+func (x *_QueryRandomWordsResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRandomWordsResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_QueryRandomWordsResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRandomWordsResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRandomWordsResponse_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryRandomWordsResponse at list field Words as it is not of Message kind"))
+}
+
+func (x *_QueryRandomWordsResponse_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRandomWordsResponse_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_QueryRandomWordsResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRandomWordsResponse             protoreflect.MessageDescriptor
+	fd_QueryRandomWordsResponse_drand_round protoreflect.FieldDescriptor
+	fd_QueryRandomWordsResponse_seed        protoreflect.FieldDescriptor
+	fd_QueryRandomWordsResponse_words       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryRandomWordsResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomWordsResponse")
+	fd_QueryRandomWordsResponse_drand_round = md_QueryRandomWordsResponse.Fields().ByName("drand_round")
+	fd_QueryRandomWordsResponse_seed = md_QueryRandomWordsResponse.Fields().ByName("seed")
+	fd_QueryRandomWordsResponse_words = md_QueryRandomWordsResponse.Fields().ByName("words")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRandomWordsResponse)(nil)
+
+type fastReflection_QueryRandomWordsResponse QueryRandomWordsResponse
+
+func (x *QueryRandomWordsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRandomWordsResponse)(x)
+}
+
+func (x *QueryRandomWordsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRandomWordsResponse_messageType fastReflection_QueryRandomWordsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRandomWordsResponse_messageType{}
+
+type fastReflection_QueryRandomWordsResponse_messageType struct{}
+
+func (x fastReflection_QueryRandomWordsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRandomWordsResponse)(nil)
+}
+func (x fastReflection_QueryRandomWordsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomWordsResponse)
+}
+func (x fastReflection_QueryRandomWordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomWordsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRandomWordsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomWordsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRandomWordsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRandomWordsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRandomWordsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomWordsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRandomWordsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRandomWordsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRandomWordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_QueryRandomWordsResponse_drand_round, value) {
+			return
+		}
+	}
+	if len(x.Seed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seed)
+		if !f(fd_QueryRandomWordsResponse_seed, value) {
+			return
+		}
+	}
+	if len(x.Words) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{list: &x.Words})
+		if !f(fd_QueryRandomWordsResponse_words, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRandomWordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		return len(x.Seed) != 0
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		return len(x.Words) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		x.Seed = nil
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		x.Words = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRandomWordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		value := x.Seed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		if len(x.Words) == 0 {
+			return protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{})
+		}
+		listValue := &_QueryRandomWordsResponse_3_list{list: &x.Words}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		x.Seed = value.Bytes()
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		lv := value.List()
+		clv := lv.(*_QueryRandomWordsResponse_3_list)
+		x.Words = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		if x.Words == nil {
+			x.Words = [][]byte{}
+		}
+		value := &_QueryRandomWordsResponse_3_list{list: &x.Words}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.QueryRandomWordsResponse is not mutable"))
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		panic(fmt.Errorf("field seed of message digitalkitchen.vrf.v1.QueryRandomWordsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRandomWordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.QueryRandomWordsResponse.words":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_QueryRandomWordsResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomWordsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomWordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRandomWordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomWordsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRandomWordsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomWordsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRandomWordsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRandomWordsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		l = len(x.Seed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Words) > 0 {
+			for _, b := range x.Words {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Words) > 0 {
+			for iNdEx := len(x.Words) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Words[iNdEx])
+				copy(dAtA[i:], x.Words[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Words[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Seed) > 0 {
+			i -= len(x.Seed)
+			copy(dAtA[i:], x.Seed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomWordsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomWordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seed = append(x.Seed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seed == nil {
+					x.Seed = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Words = append(x.Words, make([]byte, postIndex-iNdEx))
+				copy(x.Words[len(x.Words)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParticipationRequest        protoreflect.MessageDescriptor
+	fd_QueryParticipationRequest_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryParticipationRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryParticipationRequest")
+	fd_QueryParticipationRequest_height = md_QueryParticipationRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParticipationRequest)(nil)
+
+type fastReflection_QueryParticipationRequest QueryParticipationRequest
+
+func (x *QueryParticipationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParticipationRequest)(x)
+}
+
+func (x *QueryParticipationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParticipationRequest_messageType fastReflection_QueryParticipationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParticipationRequest_messageType{}
+
+type fastReflection_QueryParticipationRequest_messageType struct{}
+
+func (x fastReflection_QueryParticipationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParticipationRequest)(nil)
+}
+func (x fastReflection_QueryParticipationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationRequest)
+}
+func (x fastReflection_QueryParticipationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParticipationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParticipationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParticipationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParticipationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParticipationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParticipationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParticipationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_QueryParticipationRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParticipationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParticipationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		panic(fmt.Errorf("field height of message digitalkitchen.vrf.v1.QueryParticipationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParticipationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParticipationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryParticipationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParticipationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParticipationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParticipationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParticipationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParticipationResponse               protoreflect.MessageDescriptor
+	fd_QueryParticipationResponse_participation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryParticipationResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryParticipationResponse")
+	fd_QueryParticipationResponse_participation = md_QueryParticipationResponse.Fields().ByName("participation")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParticipationResponse)(nil)
+
+type fastReflection_QueryParticipationResponse QueryParticipationResponse
+
+func (x *QueryParticipationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParticipationResponse)(x)
+}
+
+func (x *QueryParticipationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParticipationResponse_messageType fastReflection_QueryParticipationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParticipationResponse_messageType{}
+
+type fastReflection_QueryParticipationResponse_messageType struct{}
+
+func (x fastReflection_QueryParticipationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParticipationResponse)(nil)
+}
+func (x fastReflection_QueryParticipationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationResponse)
+}
+func (x fastReflection_QueryParticipationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParticipationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParticipationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParticipationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParticipationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParticipationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParticipationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParticipationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParticipationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParticipationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Participation != nil {
+		value := protoreflect.ValueOfMessage(x.Participation.ProtoReflect())
+		if !f(fd_QueryParticipationResponse_participation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParticipationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		return x.Participation != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		x.Participation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParticipationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		value := x.Participation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		x.Participation = value.Message().Interface().(*VrfParticipation)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		if x.Participation == nil {
+			x.Participation = new(VrfParticipation)
+		}
+		return protoreflect.ValueOfMessage(x.Participation.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParticipationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryParticipationResponse.participation":
+		m := new(VrfParticipation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParticipationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryParticipationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParticipationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParticipationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParticipationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParticipationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParticipationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Participation != nil {
+			l = options.Size(x.Participation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Participation != nil {
+			encoded, err := options.Marshal(x.Participation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParticipationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Participation == nil {
+					x.Participation = &VrfParticipation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorParticipationRequest                   protoreflect.MessageDescriptor
+	fd_QueryValidatorParticipationRequest_consensus_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryValidatorParticipationRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryValidatorParticipationRequest")
+	fd_QueryValidatorParticipationRequest_consensus_address = md_QueryValidatorParticipationRequest.Fields().ByName("consensus_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorParticipationRequest)(nil)
+
+type fastReflection_QueryValidatorParticipationRequest QueryValidatorParticipationRequest
+
+func (x *QueryValidatorParticipationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorParticipationRequest)(x)
+}
+
+func (x *QueryValidatorParticipationRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorParticipationRequest_messageType fastReflection_QueryValidatorParticipationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorParticipationRequest_messageType{}
+
+type fastReflection_QueryValidatorParticipationRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorParticipationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorParticipationRequest)(nil)
+}
+func (x fastReflection_QueryValidatorParticipationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorParticipationRequest)
+}
+func (x fastReflection_QueryValidatorParticipationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorParticipationRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorParticipationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorParticipationRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorParticipationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorParticipationRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorParticipationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorParticipationRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorParticipationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorParticipationRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorParticipationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsensusAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsensusAddress)
+		if !f(fd_QueryValidatorParticipationRequest_consensus_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorParticipationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		return x.ConsensusAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		x.ConsensusAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorParticipationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		value := x.ConsensusAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		x.ConsensusAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		panic(fmt.Errorf("field consensus_address of message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorParticipationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest.consensus_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorParticipationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryValidatorParticipationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorParticipationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorParticipationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorParticipationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorParticipationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConsensusAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorParticipationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ConsensusAddress) > 0 {
+			i -= len(x.ConsensusAddress)
+			copy(dAtA[i:], x.ConsensusAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorParticipationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorParticipationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorParticipationResponse         protoreflect.MessageDescriptor
+	fd_QueryValidatorParticipationResponse_summary protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryValidatorParticipationResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryValidatorParticipationResponse")
+	fd_QueryValidatorParticipationResponse_summary = md_QueryValidatorParticipationResponse.Fields().ByName("summary")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorParticipationResponse)(nil)
+
+type fastReflection_QueryValidatorParticipationResponse QueryValidatorParticipationResponse
+
+func (x *QueryValidatorParticipationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorParticipationResponse)(x)
+}
+
+func (x *QueryValidatorParticipationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorParticipationResponse_messageType fastReflection_QueryValidatorParticipationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorParticipationResponse_messageType{}
+
+type fastReflection_QueryValidatorParticipationResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorParticipationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorParticipationResponse)(nil)
+}
+func (x fastReflection_QueryValidatorParticipationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorParticipationResponse)
+}
+func (x fastReflection_QueryValidatorParticipationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorParticipationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorParticipationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorParticipationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorParticipationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorParticipationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorParticipationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorParticipationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorParticipationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorParticipationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorParticipationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Summary != nil {
+		value := protoreflect.ValueOfMessage(x.Summary.ProtoReflect())
+		if !f(fd_QueryValidatorParticipationResponse_summary, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorParticipationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		return x.Summary != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		x.Summary = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorParticipationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		value := x.Summary
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		x.Summary = value.Message().Interface().(*VrfParticipationSummary)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		if x.Summary == nil {
+			x.Summary = new(VrfParticipationSummary)
+		}
+		return protoreflect.ValueOfMessage(x.Summary.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorParticipationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary":
+		m := new(VrfParticipationSummary)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryValidatorParticipationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorParticipationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryValidatorParticipationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorParticipationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorParticipationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorParticipationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorParticipationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorParticipationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Summary != nil {
+			l = options.Size(x.Summary)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorParticipationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Summary != nil {
+			encoded, err := options.Marshal(x.Summary)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorParticipationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorParticipationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Summary == nil {
+					x.Summary = &VrfParticipationSummary{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Summary); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRandomnessRequestRequest    protoreflect.MessageDescriptor
+	fd_QueryRandomnessRequestRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryRandomnessRequestRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomnessRequestRequest")
+	fd_QueryRandomnessRequestRequest_id = md_QueryRandomnessRequestRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRandomnessRequestRequest)(nil)
+
+type fastReflection_QueryRandomnessRequestRequest QueryRandomnessRequestRequest
+
+func (x *QueryRandomnessRequestRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRandomnessRequestRequest)(x)
+}
+
+func (x *QueryRandomnessRequestRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRandomnessRequestRequest_messageType fastReflection_QueryRandomnessRequestRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRandomnessRequestRequest_messageType{}
+
+type fastReflection_QueryRandomnessRequestRequest_messageType struct{}
+
+func (x fastReflection_QueryRandomnessRequestRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRandomnessRequestRequest)(nil)
+}
+func (x fastReflection_QueryRandomnessRequestRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomnessRequestRequest)
+}
+func (x fastReflection_QueryRandomnessRequestRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomnessRequestRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRandomnessRequestRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomnessRequestRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRandomnessRequestRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRandomnessRequestRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRandomnessRequestRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomnessRequestRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRandomnessRequestRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRandomnessRequestRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRandomnessRequestRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryRandomnessRequestRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRandomnessRequestRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRandomnessRequestRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		panic(fmt.Errorf("field id of message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRandomnessRequestRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRandomnessRequestRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomnessRequestRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRandomnessRequestRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRandomnessRequestRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRandomnessRequestRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRandomnessRequestRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomnessRequestRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomnessRequestRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomnessRequestRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomnessRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRandomnessRequestResponse         protoreflect.MessageDescriptor
+	fd_QueryRandomnessRequestResponse_request protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryRandomnessRequestResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryRandomnessRequestResponse")
+	fd_QueryRandomnessRequestResponse_request = md_QueryRandomnessRequestResponse.Fields().ByName("request")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRandomnessRequestResponse)(nil)
+
+type fastReflection_QueryRandomnessRequestResponse QueryRandomnessRequestResponse
+
+func (x *QueryRandomnessRequestResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRandomnessRequestResponse)(x)
+}
+
+func (x *QueryRandomnessRequestResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRandomnessRequestResponse_messageType fastReflection_QueryRandomnessRequestResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRandomnessRequestResponse_messageType{}
+
+type fastReflection_QueryRandomnessRequestResponse_messageType struct{}
+
+func (x fastReflection_QueryRandomnessRequestResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRandomnessRequestResponse)(nil)
+}
+func (x fastReflection_QueryRandomnessRequestResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomnessRequestResponse)
+}
+func (x fastReflection_QueryRandomnessRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomnessRequestResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRandomnessRequestResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRandomnessRequestResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRandomnessRequestResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRandomnessRequestResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRandomnessRequestResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRandomnessRequestResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRandomnessRequestResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRandomnessRequestResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRandomnessRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != nil {
+		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		if !f(fd_QueryRandomnessRequestResponse_request, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRandomnessRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		return x.Request != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		x.Request = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRandomnessRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		value := x.Request
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		x.Request = value.Message().Interface().(*RandomnessRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		if x.Request == nil {
+			x.Request = new(RandomnessRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRandomnessRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request":
+		m := new(RandomnessRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryRandomnessRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRandomnessRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryRandomnessRequestResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRandomnessRequestResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRandomnessRequestResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRandomnessRequestResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRandomnessRequestResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRandomnessRequestResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Request != nil {
+			l = options.Size(x.Request)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomnessRequestResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Request != nil {
+			encoded, err := options.Marshal(x.Request)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRandomnessRequestResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomnessRequestResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRandomnessRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Request == nil {
+					x.Request = &RandomnessRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTimelockedRequest    protoreflect.MessageDescriptor
+	fd_QueryTimelockedRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryTimelockedRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryTimelockedRequest")
+	fd_QueryTimelockedRequest_id = md_QueryTimelockedRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTimelockedRequest)(nil)
+
+type fastReflection_QueryTimelockedRequest QueryTimelockedRequest
+
+func (x *QueryTimelockedRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTimelockedRequest)(x)
+}
+
+func (x *QueryTimelockedRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTimelockedRequest_messageType fastReflection_QueryTimelockedRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTimelockedRequest_messageType{}
+
+type fastReflection_QueryTimelockedRequest_messageType struct{}
+
+func (x fastReflection_QueryTimelockedRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTimelockedRequest)(nil)
+}
+func (x fastReflection_QueryTimelockedRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTimelockedRequest)
+}
+func (x fastReflection_QueryTimelockedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTimelockedRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTimelockedRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTimelockedRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTimelockedRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTimelockedRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTimelockedRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTimelockedRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTimelockedRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTimelockedRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTimelockedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryTimelockedRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTimelockedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTimelockedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		panic(fmt.Errorf("field id of message digitalkitchen.vrf.v1.QueryTimelockedRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTimelockedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTimelockedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryTimelockedRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTimelockedRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTimelockedRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTimelockedRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTimelockedRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTimelockedRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTimelockedRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTimelockedRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTimelockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTimelockedResponse         protoreflect.MessageDescriptor
+	fd_QueryTimelockedResponse_message protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryTimelockedResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryTimelockedResponse")
+	fd_QueryTimelockedResponse_message = md_QueryTimelockedResponse.Fields().ByName("message")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTimelockedResponse)(nil)
+
+type fastReflection_QueryTimelockedResponse QueryTimelockedResponse
+
+func (x *QueryTimelockedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTimelockedResponse)(x)
+}
+
+func (x *QueryTimelockedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTimelockedResponse_messageType fastReflection_QueryTimelockedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTimelockedResponse_messageType{}
+
+type fastReflection_QueryTimelockedResponse_messageType struct{}
+
+func (x fastReflection_QueryTimelockedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTimelockedResponse)(nil)
+}
+func (x fastReflection_QueryTimelockedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTimelockedResponse)
+}
+func (x fastReflection_QueryTimelockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTimelockedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTimelockedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTimelockedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTimelockedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTimelockedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTimelockedResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTimelockedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTimelockedResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTimelockedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTimelockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_QueryTimelockedResponse_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTimelockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		return x.Message != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		x.Message = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTimelockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		x.Message = value.Message().Interface().(*TimelockedMessage)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		if x.Message == nil {
+			x.Message = new(TimelockedMessage)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTimelockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryTimelockedResponse.message":
+		m := new(TimelockedMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTimelockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryTimelockedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTimelockedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTimelockedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTimelockedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTimelockedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTimelockedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTimelockedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTimelockedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTimelockedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTimelockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &TimelockedMessage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInvariantsRequest       protoreflect.MessageDescriptor
+	fd_QueryInvariantsRequest_route protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryInvariantsRequest = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryInvariantsRequest")
+	fd_QueryInvariantsRequest_route = md_QueryInvariantsRequest.Fields().ByName("route")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInvariantsRequest)(nil)
+
+type fastReflection_QueryInvariantsRequest QueryInvariantsRequest
+
+func (x *QueryInvariantsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInvariantsRequest)(x)
+}
+
+func (x *QueryInvariantsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInvariantsRequest_messageType fastReflection_QueryInvariantsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInvariantsRequest_messageType{}
+
+type fastReflection_QueryInvariantsRequest_messageType struct{}
+
+func (x fastReflection_QueryInvariantsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInvariantsRequest)(nil)
+}
+func (x fastReflection_QueryInvariantsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInvariantsRequest)
+}
+func (x fastReflection_QueryInvariantsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInvariantsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInvariantsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInvariantsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInvariantsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInvariantsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInvariantsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInvariantsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInvariantsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInvariantsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInvariantsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Route != "" {
+		value := protoreflect.ValueOfString(x.Route)
+		if !f(fd_QueryInvariantsRequest_route, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInvariantsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		return x.Route != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		x.Route = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInvariantsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		value := x.Route
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		x.Route = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		panic(fmt.Errorf("field route of message digitalkitchen.vrf.v1.QueryInvariantsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInvariantsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsRequest.route":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInvariantsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryInvariantsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInvariantsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInvariantsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInvariantsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInvariantsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Route)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInvariantsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Route) > 0 {
+			i -= len(x.Route)
+			copy(dAtA[i:], x.Route)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Route)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInvariantsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Route = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryInvariantsResponse_1_list)(nil)
+
+type _QueryInvariantsResponse_1_list struct {
+	list *[]*InvariantResult
+}
+
+func (x *_QueryInvariantsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryInvariantsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryInvariantsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InvariantResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryInvariantsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InvariantResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryInvariantsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(InvariantResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInvariantsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryInvariantsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(InvariantResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInvariantsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryInvariantsResponse         protoreflect.MessageDescriptor
+	fd_QueryInvariantsResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_QueryInvariantsResponse = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("QueryInvariantsResponse")
+	fd_QueryInvariantsResponse_results = md_QueryInvariantsResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInvariantsResponse)(nil)
+
+type fastReflection_QueryInvariantsResponse QueryInvariantsResponse
+
+func (x *QueryInvariantsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInvariantsResponse)(x)
+}
+
+func (x *QueryInvariantsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInvariantsResponse_messageType fastReflection_QueryInvariantsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInvariantsResponse_messageType{}
+
+type fastReflection_QueryInvariantsResponse_messageType struct{}
+
+func (x fastReflection_QueryInvariantsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInvariantsResponse)(nil)
+}
+func (x fastReflection_QueryInvariantsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInvariantsResponse)
+}
+func (x fastReflection_QueryInvariantsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInvariantsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInvariantsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInvariantsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInvariantsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInvariantsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInvariantsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInvariantsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInvariantsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInvariantsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInvariantsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_QueryInvariantsResponse_1_list{list: &x.Results})
+		if !f(fd_QueryInvariantsResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInvariantsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInvariantsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_QueryInvariantsResponse_1_list{})
+		}
+		listValue := &_QueryInvariantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		lv := value.List()
+		clv := lv.(*_QueryInvariantsResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		if x.Results == nil {
+			x.Results = []*InvariantResult{}
+		}
+		value := &_QueryInvariantsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInvariantsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.QueryInvariantsResponse.results":
+		list := []*InvariantResult{}
+		return protoreflect.ValueOfList(&_QueryInvariantsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.QueryInvariantsResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.QueryInvariantsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInvariantsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.QueryInvariantsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInvariantsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInvariantsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInvariantsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInvariantsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInvariantsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInvariantsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInvariantsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &InvariantResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InvariantResult         protoreflect.MessageDescriptor
+	fd_InvariantResult_route   protoreflect.FieldDescriptor
+	fd_InvariantResult_broken  protoreflect.FieldDescriptor
+	fd_InvariantResult_message protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_query_proto_init()
+	md_InvariantResult = File_digitalkitchen_vrf_v1_query_proto.Messages().ByName("InvariantResult")
+	fd_InvariantResult_route = md_InvariantResult.Fields().ByName("route")
+	fd_InvariantResult_broken = md_InvariantResult.Fields().ByName("broken")
+	fd_InvariantResult_message = md_InvariantResult.Fields().ByName("message")
+}
+
+var _ protoreflect.Message = (*fastReflection_InvariantResult)(nil)
+
+type fastReflection_InvariantResult InvariantResult
+
+func (x *InvariantResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InvariantResult)(x)
+}
+
+func (x *InvariantResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InvariantResult_messageType fastReflection_InvariantResult_messageType
+var _ protoreflect.MessageType = fastReflection_InvariantResult_messageType{}
+
+type fastReflection_InvariantResult_messageType struct{}
+
+func (x fastReflection_InvariantResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InvariantResult)(nil)
+}
+func (x fastReflection_InvariantResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_InvariantResult)
+}
+func (x fastReflection_InvariantResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvariantResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InvariantResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvariantResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InvariantResult) Type() protoreflect.MessageType {
+	return _fastReflection_InvariantResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InvariantResult) New() protoreflect.Message {
+	return new(fastReflection_InvariantResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InvariantResult) Interface() protoreflect.ProtoMessage {
+	return (*InvariantResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InvariantResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Route != "" {
+		value := protoreflect.ValueOfString(x.Route)
+		if !f(fd_InvariantResult_route, value) {
+			return
+		}
+	}
+	if x.Broken != false {
+		value := protoreflect.ValueOfBool(x.Broken)
+		if !f(fd_InvariantResult_broken, value) {
+			return
+		}
+	}
+	if x.Message != "" {
+		value := protoreflect.ValueOfString(x.Message)
+		if !f(fd_InvariantResult_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InvariantResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		return x.Route != ""
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		return x.Broken != false
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		return x.Message != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		x.Route = ""
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		x.Broken = false
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		x.Message = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InvariantResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		value := x.Route
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		value := x.Broken
+		return protoreflect.ValueOfBool(value)
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		value := x.Message
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		x.Route = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		x.Broken = value.Bool()
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		x.Message = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		panic(fmt.Errorf("field route of message digitalkitchen.vrf.v1.InvariantResult is not mutable"))
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		panic(fmt.Errorf("field broken of message digitalkitchen.vrf.v1.InvariantResult is not mutable"))
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		panic(fmt.Errorf("field message of message digitalkitchen.vrf.v1.InvariantResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InvariantResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.InvariantResult.route":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.InvariantResult.broken":
+		return protoreflect.ValueOfBool(false)
+	case "digitalkitchen.vrf.v1.InvariantResult.message":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.InvariantResult"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.InvariantResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InvariantResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.InvariantResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InvariantResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InvariantResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InvariantResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InvariantResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Route)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Broken {
+			n += 2
+		}
+		l = len(x.Message)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InvariantResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Message) > 0 {
+			i -= len(x.Message)
+			copy(dAtA[i:], x.Message)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Message)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Broken {
+			i--
+			if x.Broken {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Route) > 0 {
+			i -= len(x.Route)
+			copy(dAtA[i:], x.Route)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Route)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InvariantResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Route = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Broken = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Message = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: digitalkitchen/vrf/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest requests the current VRF parameters.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
 // QueryParamsResponse carries the current VRF parameters. The fields mirror
 // x/vrf's VrfParams type; the module keeper converts between the two.
 type QueryParamsResponse struct {
@@ -2709,200 +8325,683 @@ type QueryParamsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params *VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params *VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *VrfParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryPendingParamsRequest requests the scheduled params change.
+type QueryPendingParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryPendingParamsRequest) Reset() {
+	*x = QueryPendingParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingParamsRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+// QueryPendingParamsResponse carries the scheduled params change. It is unset
+// when no change is pending.
+type QueryPendingParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingParams *VrfPendingParams `protobuf:"bytes,1,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+}
+
+func (x *QueryPendingParamsResponse) Reset() {
+	*x = QueryPendingParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingParamsResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryPendingParamsResponse) GetPendingParams() *VrfPendingParams {
+	if x != nil {
+		return x.PendingParams
+	}
+	return nil
+}
+
+// QueryBeaconRequest requests the VrfBeacon for the current context height.
+// Historical requests use the ABCI height parameter; no explicit height field
+// is needed here.
+type QueryBeaconRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryBeaconRequest) Reset() {
+	*x = QueryBeaconRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBeaconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBeaconRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBeaconRequest.ProtoReflect.Descriptor instead.
+func (*QueryBeaconRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QueryBeaconResponse carries the canonical VrfBeacon for the requested height.
+type QueryBeaconResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beacon *VrfBeacon `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon,omitempty"`
+}
+
+func (x *QueryBeaconResponse) Reset() {
+	*x = QueryBeaconResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBeaconResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBeaconResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBeaconResponse.ProtoReflect.Descriptor instead.
+func (*QueryBeaconResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryBeaconResponse) GetBeacon() *VrfBeacon {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
+
+// QueryRandomWordsRequest requests N random words derived from the beacon at
+// the current context height, using the derivation described in the PRD.
+type QueryRandomWordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// count is the number of random words to derive.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// user_seed is an application-supplied seed that is mixed into the
+	// derivation to give callers domain separation.
+	UserSeed []byte `protobuf:"bytes,2,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+}
+
+func (x *QueryRandomWordsRequest) Reset() {
+	*x = QueryRandomWordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRandomWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRandomWordsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRandomWordsRequest.ProtoReflect.Descriptor instead.
+func (*QueryRandomWordsRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryRandomWordsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QueryRandomWordsRequest) GetUserSeed() []byte {
+	if x != nil {
+		return x.UserSeed
+	}
+	return nil
+}
+
+// QueryRandomWordsResponse returns the underlying drand round, the raw seed,
+// and the derived random words.
+type QueryRandomWordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrandRound uint64   `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	Seed       []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Words      [][]byte `protobuf:"bytes,3,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *QueryRandomWordsResponse) Reset() {
+	*x = QueryRandomWordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRandomWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRandomWordsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRandomWordsResponse.ProtoReflect.Descriptor instead.
+func (*QueryRandomWordsResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryRandomWordsResponse) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *QueryRandomWordsResponse) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *QueryRandomWordsResponse) GetWords() [][]byte {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+// QueryParticipationRequest requests the participation record for a height.
+type QueryParticipationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryParticipationRequest) Reset() {
+	*x = QueryParticipationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParticipationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParticipationRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParticipationRequest.ProtoReflect.Descriptor instead.
+func (*QueryParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryParticipationRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// QueryParticipationResponse carries the participation record for a height.
+type QueryParticipationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participation *VrfParticipation `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation,omitempty"`
+}
+
+func (x *QueryParticipationResponse) Reset() {
+	*x = QueryParticipationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParticipationResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParticipationResponse.ProtoReflect.Descriptor instead.
+func (*QueryParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryParticipationResponse) GetParticipation() *VrfParticipation {
+	if x != nil {
+		return x.Participation
+	}
+	return nil
+}
+
+// QueryValidatorParticipationRequest requests a validator's participation
+// summary.
+type QueryValidatorParticipationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// consensus_address is the bech32 validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (x *QueryValidatorParticipationRequest) Reset() {
+	*x = QueryValidatorParticipationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorParticipationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorParticipationRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorParticipationRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryValidatorParticipationRequest) GetConsensusAddress() string {
+	if x != nil {
+		return x.ConsensusAddress
+	}
+	return ""
+}
+
+// QueryValidatorParticipationResponse carries a validator's participation
+// summary.
+type QueryValidatorParticipationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summary *VrfParticipationSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *QueryValidatorParticipationResponse) Reset() {
+	*x = QueryValidatorParticipationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorParticipationResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorParticipationResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryValidatorParticipationResponse) GetSummary() *VrfParticipationSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// QueryRandomnessRequestRequest requests a paid randomness request by id.
+type QueryRandomnessRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryRandomnessRequestRequest) Reset() {
+	*x = QueryRandomnessRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRandomnessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRandomnessRequestRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRandomnessRequestRequest.ProtoReflect.Descriptor instead.
+func (*QueryRandomnessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryRandomnessRequestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// QueryRandomnessRequestResponse carries a paid randomness request.
+type QueryRandomnessRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *RandomnessRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryRandomnessRequestResponse) Reset() {
+	*x = QueryRandomnessRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[1]
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryRandomnessRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryRandomnessRequestResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryRandomnessRequestResponse.ProtoReflect.Descriptor instead.
+func (*QueryRandomnessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryParamsResponse) GetParams() *VrfParams {
+func (x *QueryRandomnessRequestResponse) GetRequest() *RandomnessRequest {
 	if x != nil {
-		return x.Params
+		return x.Request
 	}
 	return nil
 }
 
-// QueryBeaconRequest requests the VrfBeacon for the current context height.
-// Historical requests use the ABCI height parameter; no explicit height field
-// is needed here.
-type QueryBeaconRequest struct {
+// QueryTimelockedRequest requests a time-locked ciphertext by id.
+type QueryTimelockedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryBeaconRequest) Reset() {
-	*x = QueryBeaconRequest{}
+func (x *QueryTimelockedRequest) Reset() {
+	*x = QueryTimelockedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[2]
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBeaconRequest) String() string {
+func (x *QueryTimelockedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBeaconRequest) ProtoMessage() {}
+func (*QueryTimelockedRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryBeaconRequest.ProtoReflect.Descriptor instead.
-func (*QueryBeaconRequest) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryTimelockedRequest.ProtoReflect.Descriptor instead.
+func (*QueryTimelockedRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-// QueryBeaconResponse carries the canonical VrfBeacon for the requested height.
-type QueryBeaconResponse struct {
+func (x *QueryTimelockedRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// QueryTimelockedResponse carries a time-locked ciphertext.
+type QueryTimelockedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Beacon *VrfBeacon `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	Message *TimelockedMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *QueryBeaconResponse) Reset() {
-	*x = QueryBeaconResponse{}
+func (x *QueryTimelockedResponse) Reset() {
+	*x = QueryTimelockedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[3]
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBeaconResponse) String() string {
+func (x *QueryTimelockedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBeaconResponse) ProtoMessage() {}
+func (*QueryTimelockedResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryBeaconResponse.ProtoReflect.Descriptor instead.
-func (*QueryBeaconResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryTimelockedResponse.ProtoReflect.Descriptor instead.
+func (*QueryTimelockedResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryBeaconResponse) GetBeacon() *VrfBeacon {
+func (x *QueryTimelockedResponse) GetMessage() *TimelockedMessage {
 	if x != nil {
-		return x.Beacon
+		return x.Message
 	}
 	return nil
 }
 
-// QueryRandomWordsRequest requests N random words derived from the beacon at
-// the current context height, using the derivation described in the PRD.
-type QueryRandomWordsRequest struct {
+// QueryInvariantsRequest requests an invariant check.
+type QueryInvariantsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// count is the number of random words to derive.
-	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// user_seed is an application-supplied seed that is mixed into the
-	// derivation to give callers domain separation.
-	UserSeed []byte `protobuf:"bytes,2,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+	// route selects a single invariant. Empty checks all of them.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
 }
 
-func (x *QueryRandomWordsRequest) Reset() {
-	*x = QueryRandomWordsRequest{}
+func (x *QueryInvariantsRequest) Reset() {
+	*x = QueryInvariantsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[4]
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRandomWordsRequest) String() string {
+func (x *QueryInvariantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRandomWordsRequest) ProtoMessage() {}
+func (*QueryInvariantsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRandomWordsRequest.ProtoReflect.Descriptor instead.
-func (*QueryRandomWordsRequest) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryInvariantsRequest.ProtoReflect.Descriptor instead.
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryRandomWordsRequest) GetCount() uint32 {
+func (x *QueryInvariantsRequest) GetRoute() string {
 	if x != nil {
-		return x.Count
+		return x.Route
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryRandomWordsRequest) GetUserSeed() []byte {
+// QueryInvariantsResponse carries the outcome of every checked invariant.
+type QueryInvariantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *QueryInvariantsResponse) Reset() {
+	*x = QueryInvariantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryInvariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInvariantsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryInvariantsResponse.ProtoReflect.Descriptor instead.
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryInvariantsResponse) GetResults() []*InvariantResult {
 	if x != nil {
-		return x.UserSeed
+		return x.Results
 	}
 	return nil
 }
 
-// QueryRandomWordsResponse returns the underlying drand round, the raw seed,
-// and the derived random words.
-type QueryRandomWordsResponse struct {
+// InvariantResult is the outcome of one invariant check.
+type InvariantResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DrandRound uint64   `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
-	Seed       []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
-	Words      [][]byte `protobuf:"bytes,3,rep,name=words,proto3" json:"words,omitempty"`
+	// route names the invariant.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true when the state violates the invariant.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the violation of a broken invariant.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *QueryRandomWordsResponse) Reset() {
-	*x = QueryRandomWordsResponse{}
+func (x *InvariantResult) Reset() {
+	*x = InvariantResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[5]
+		mi := &file_digitalkitchen_vrf_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRandomWordsResponse) String() string {
+func (x *InvariantResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRandomWordsResponse) ProtoMessage() {}
+func (*InvariantResult) ProtoMessage() {}
 
-// Deprecated: Use QueryRandomWordsResponse.ProtoReflect.Descriptor instead.
-func (*QueryRandomWordsResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use InvariantResult.ProtoReflect.Descriptor instead.
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryRandomWordsResponse) GetDrandRound() uint64 {
+func (x *InvariantResult) GetRoute() string {
 	if x != nil {
-		return x.DrandRound
+		return x.Route
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryRandomWordsResponse) GetSeed() []byte {
+func (x *InvariantResult) GetBroken() bool {
 	if x != nil {
-		return x.Seed
+		return x.Broken
 	}
-	return nil
+	return false
 }
 
-func (x *QueryRandomWordsResponse) GetWords() [][]byte {
+func (x *InvariantResult) GetMessage() string {
 	if x != nil {
-		return x.Words
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
 var File_digitalkitchen_vrf_v1_query_proto protoreflect.FileDescriptor
@@ -2914,79 +9013,211 @@ var file_digitalkitchen_vrf_v1_query_proto_rawDesc = []byte{
 	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x64, 0x69, 0x67, 0x69,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x64, 0x69, 0x67, 0x69,
 	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76,
-	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f,
-	0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x32, 0x97, 0x03,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64,
+	0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
 	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
-	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x7c, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12,
-	0x29, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6c,
+	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0e,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x14, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x22, 0x4c,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x76, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
+	0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x74, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x7a, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
+	0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0x2f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x68,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x0f, 0x49,
+	0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xec, 0x0a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x7c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
+	0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x99,
+	0x01, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x30, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x7c, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
 	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x67,
+	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x88, 0xe7, 0xb0,
+	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x91, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x88, 0xe7, 0xb0, 0x2a, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0xa1, 0x01, 0x0a,
+	0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
+	0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d,
+	0x12, 0xcd, 0x01, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x76, 0x72, 0x66,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0xa4, 0x01, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x91, 0x01, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0a,
+	0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x64, 0x69, 0x67,
 	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x12, 0x91, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f,
-	0x72, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x42, 0xcb, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
-	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
-	0x72, 0x66, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x72, 0x66, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x44, 0x56, 0x58, 0xaa, 0x02, 0x15, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x56, 0x72, 0x66, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x15, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x44, 0x69,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x72,
-	0x66, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x42, 0xcb, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x72, 0x66, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x44, 0x56, 0x58, 0xaa, 0x02, 0x15, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x56, 0x72, 0x66, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15,
+	0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56,
+	0x72, 0x66, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x44, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x72, 0x66, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3001,31 +9232,67 @@ func file_digitalkitchen_vrf_v1_query_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_vrf_v1_query_proto_rawDescData
 }
 
-var file_digitalkitchen_vrf_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_digitalkitchen_vrf_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_digitalkitchen_vrf_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),       // 0: digitalkitchen.vrf.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),      // 1: digitalkitchen.vrf.v1.QueryParamsResponse
-	(*QueryBeaconRequest)(nil),       // 2: digitalkitchen.vrf.v1.QueryBeaconRequest
-	(*QueryBeaconResponse)(nil),      // 3: digitalkitchen.vrf.v1.QueryBeaconResponse
-	(*QueryRandomWordsRequest)(nil),  // 4: digitalkitchen.vrf.v1.QueryRandomWordsRequest
-	(*QueryRandomWordsResponse)(nil), // 5: digitalkitchen.vrf.v1.QueryRandomWordsResponse
-	(*VrfParams)(nil),                // 6: digitalkitchen.vrf.v1.VrfParams
-	(*VrfBeacon)(nil),                // 7: digitalkitchen.vrf.v1.VrfBeacon
+	(*QueryParamsRequest)(nil),                  // 0: digitalkitchen.vrf.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                 // 1: digitalkitchen.vrf.v1.QueryParamsResponse
+	(*QueryPendingParamsRequest)(nil),           // 2: digitalkitchen.vrf.v1.QueryPendingParamsRequest
+	(*QueryPendingParamsResponse)(nil),          // 3: digitalkitchen.vrf.v1.QueryPendingParamsResponse
+	(*QueryBeaconRequest)(nil),                  // 4: digitalkitchen.vrf.v1.QueryBeaconRequest
+	(*QueryBeaconResponse)(nil),                 // 5: digitalkitchen.vrf.v1.QueryBeaconResponse
+	(*QueryRandomWordsRequest)(nil),             // 6: digitalkitchen.vrf.v1.QueryRandomWordsRequest
+	(*QueryRandomWordsResponse)(nil),            // 7: digitalkitchen.vrf.v1.QueryRandomWordsResponse
+	(*QueryParticipationRequest)(nil),           // 8: digitalkitchen.vrf.v1.QueryParticipationRequest
+	(*QueryParticipationResponse)(nil),          // 9: digitalkitchen.vrf.v1.QueryParticipationResponse
+	(*QueryValidatorParticipationRequest)(nil),  // 10: digitalkitchen.vrf.v1.QueryValidatorParticipationRequest
+	(*QueryValidatorParticipationResponse)(nil), // 11: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse
+	(*QueryRandomnessRequestRequest)(nil),       // 12: digitalkitchen.vrf.v1.QueryRandomnessRequestRequest
+	(*QueryRandomnessRequestResponse)(nil),      // 13: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse
+	(*QueryTimelockedRequest)(nil),              // 14: digitalkitchen.vrf.v1.QueryTimelockedRequest
+	(*QueryTimelockedResponse)(nil),             // 15: digitalkitchen.vrf.v1.QueryTimelockedResponse
+	(*QueryInvariantsRequest)(nil),              // 16: digitalkitchen.vrf.v1.QueryInvariantsRequest
+	(*QueryInvariantsResponse)(nil),             // 17: digitalkitchen.vrf.v1.QueryInvariantsResponse
+	(*InvariantResult)(nil),                     // 18: digitalkitchen.vrf.v1.InvariantResult
+	(*VrfParams)(nil),                           // 19: digitalkitchen.vrf.v1.VrfParams
+	(*VrfPendingParams)(nil),                    // 20: digitalkitchen.vrf.v1.VrfPendingParams
+	(*VrfBeacon)(nil),                           // 21: digitalkitchen.vrf.v1.VrfBeacon
+	(*VrfParticipation)(nil),                    // 22: digitalkitchen.vrf.v1.VrfParticipation
+	(*VrfParticipationSummary)(nil),             // 23: digitalkitchen.vrf.v1.VrfParticipationSummary
+	(*RandomnessRequest)(nil),                   // 24: digitalkitchen.vrf.v1.RandomnessRequest
+	(*TimelockedMessage)(nil),                   // 25: digitalkitchen.vrf.v1.TimelockedMessage
 }
 var file_digitalkitchen_vrf_v1_query_proto_depIdxs = []int32{
-	6, // 0: digitalkitchen.vrf.v1.QueryParamsResponse.params:type_name -> digitalkitchen.vrf.v1.VrfParams
-	7, // 1: digitalkitchen.vrf.v1.QueryBeaconResponse.beacon:type_name -> digitalkitchen.vrf.v1.VrfBeacon
-	0, // 2: digitalkitchen.vrf.v1.Query.Params:input_type -> digitalkitchen.vrf.v1.QueryParamsRequest
-	2, // 3: digitalkitchen.vrf.v1.Query.Beacon:input_type -> digitalkitchen.vrf.v1.QueryBeaconRequest
-	4, // 4: digitalkitchen.vrf.v1.Query.RandomWords:input_type -> digitalkitchen.vrf.v1.QueryRandomWordsRequest
-	1, // 5: digitalkitchen.vrf.v1.Query.Params:output_type -> digitalkitchen.vrf.v1.QueryParamsResponse
-	3, // 6: digitalkitchen.vrf.v1.Query.Beacon:output_type -> digitalkitchen.vrf.v1.QueryBeaconResponse
-	5, // 7: digitalkitchen.vrf.v1.Query.RandomWords:output_type -> digitalkitchen.vrf.v1.QueryRandomWordsResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	19, // 0: digitalkitchen.vrf.v1.QueryParamsResponse.params:type_name -> digitalkitchen.vrf.v1.VrfParams
+	20, // 1: digitalkitchen.vrf.v1.QueryPendingParamsResponse.pending_params:type_name -> digitalkitchen.vrf.v1.VrfPendingParams
+	21, // 2: digitalkitchen.vrf.v1.QueryBeaconResponse.beacon:type_name -> digitalkitchen.vrf.v1.VrfBeacon
+	22, // 3: digitalkitchen.vrf.v1.QueryParticipationResponse.participation:type_name -> digitalkitchen.vrf.v1.VrfParticipation
+	23, // 4: digitalkitchen.vrf.v1.QueryValidatorParticipationResponse.summary:type_name -> digitalkitchen.vrf.v1.VrfParticipationSummary
+	24, // 5: digitalkitchen.vrf.v1.QueryRandomnessRequestResponse.request:type_name -> digitalkitchen.vrf.v1.RandomnessRequest
+	25, // 6: digitalkitchen.vrf.v1.QueryTimelockedResponse.message:type_name -> digitalkitchen.vrf.v1.TimelockedMessage
+	18, // 7: digitalkitchen.vrf.v1.QueryInvariantsResponse.results:type_name -> digitalkitchen.vrf.v1.InvariantResult
+	0,  // 8: digitalkitchen.vrf.v1.Query.Params:input_type -> digitalkitchen.vrf.v1.QueryParamsRequest
+	2,  // 9: digitalkitchen.vrf.v1.Query.PendingParams:input_type -> digitalkitchen.vrf.v1.QueryPendingParamsRequest
+	4,  // 10: digitalkitchen.vrf.v1.Query.Beacon:input_type -> digitalkitchen.vrf.v1.QueryBeaconRequest
+	6,  // 11: digitalkitchen.vrf.v1.Query.RandomWords:input_type -> digitalkitchen.vrf.v1.QueryRandomWordsRequest
+	8,  // 12: digitalkitchen.vrf.v1.Query.Participation:input_type -> digitalkitchen.vrf.v1.QueryParticipationRequest
+	10, // 13: digitalkitchen.vrf.v1.Query.ValidatorParticipation:input_type -> digitalkitchen.vrf.v1.QueryValidatorParticipationRequest
+	12, // 14: digitalkitchen.vrf.v1.Query.RandomnessRequest:input_type -> digitalkitchen.vrf.v1.QueryRandomnessRequestRequest
+	14, // 15: digitalkitchen.vrf.v1.Query.Timelocked:input_type -> digitalkitchen.vrf.v1.QueryTimelockedRequest
+	16, // 16: digitalkitchen.vrf.v1.Query.Invariants:input_type -> digitalkitchen.vrf.v1.QueryInvariantsRequest
+	1,  // 17: digitalkitchen.vrf.v1.Query.Params:output_type -> digitalkitchen.vrf.v1.QueryParamsResponse
+	3,  // 18: digitalkitchen.vrf.v1.Query.PendingParams:output_type -> digitalkitchen.vrf.v1.QueryPendingParamsResponse
+	5,  // 19: digitalkitchen.vrf.v1.Query.Beacon:output_type -> digitalkitchen.vrf.v1.QueryBeaconResponse
+	7,  // 20: digitalkitchen.vrf.v1.Query.RandomWords:output_type -> digitalkitchen.vrf.v1.QueryRandomWordsResponse
+	9,  // 21: digitalkitchen.vrf.v1.Query.Participation:output_type -> digitalkitchen.vrf.v1.QueryParticipationResponse
+	11, // 22: digitalkitchen.vrf.v1.Query.ValidatorParticipation:output_type -> digitalkitchen.vrf.v1.QueryValidatorParticipationResponse
+	13, // 23: digitalkitchen.vrf.v1.Query.RandomnessRequest:output_type -> digitalkitchen.vrf.v1.QueryRandomnessRequestResponse
+	15, // 24: digitalkitchen.vrf.v1.Query.Timelocked:output_type -> digitalkitchen.vrf.v1.QueryTimelockedResponse
+	17, // 25: digitalkitchen.vrf.v1.Query.Invariants:output_type -> digitalkitchen.vrf.v1.QueryInvariantsResponse
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_digitalkitchen_vrf_v1_query_proto_init() }
@@ -3061,7 +9328,7 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBeaconRequest); i {
+			switch v := v.(*QueryPendingParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3073,7 +9340,7 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBeaconResponse); i {
+			switch v := v.(*QueryPendingParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3085,7 +9352,7 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRandomWordsRequest); i {
+			switch v := v.(*QueryBeaconRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3097,6 +9364,30 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBeaconResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRandomWordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRandomWordsResponse); i {
 			case 0:
 				return &v.state
@@ -3108,6 +9399,138 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParticipationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParticipationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorParticipationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorParticipationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRandomnessRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRandomnessRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTimelockedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTimelockedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInvariantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInvariantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvariantResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3115,7 +9538,7 @@ func file_digitalkitchen_vrf_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_vrf_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
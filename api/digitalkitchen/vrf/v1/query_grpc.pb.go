@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName      = "/digitalkitchen.vrf.v1.Query/Params"
-	Query_Beacon_FullMethodName      = "/digitalkitchen.vrf.v1.Query/Beacon"
-	Query_RandomWords_FullMethodName = "/digitalkitchen.vrf.v1.Query/RandomWords"
+	Query_Params_FullMethodName                 = "/digitalkitchen.vrf.v1.Query/Params"
+	Query_PendingParams_FullMethodName          = "/digitalkitchen.vrf.v1.Query/PendingParams"
+	Query_Beacon_FullMethodName                 = "/digitalkitchen.vrf.v1.Query/Beacon"
+	Query_RandomWords_FullMethodName            = "/digitalkitchen.vrf.v1.Query/RandomWords"
+	Query_Participation_FullMethodName          = "/digitalkitchen.vrf.v1.Query/Participation"
+	Query_ValidatorParticipation_FullMethodName = "/digitalkitchen.vrf.v1.Query/ValidatorParticipation"
+	Query_RandomnessRequest_FullMethodName      = "/digitalkitchen.vrf.v1.Query/RandomnessRequest"
+	Query_Timelocked_FullMethodName             = "/digitalkitchen.vrf.v1.Query/Timelocked"
+	Query_Invariants_FullMethodName             = "/digitalkitchen.vrf.v1.Query/Invariants"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,10 +38,23 @@ const (
 type QueryClient interface {
 	// Params returns the on-chain VRF parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParams returns the scheduled params change, if any.
+	PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error)
 	// Beacon returns the latest VrfBeacon for the current or specified height
 	Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error)
 	// RandomWords expands the current block's VRF seed into N random words
 	RandomWords(ctx context.Context, in *QueryRandomWordsRequest, opts ...grpc.CallOption) (*QueryRandomWordsResponse, error)
+	// Participation returns the vote extension participation record for a height
+	Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error)
+	// ValidatorParticipation summarizes a validator's participation over the
+	// retained participation records
+	ValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error)
+	// RandomnessRequest returns a paid randomness request by id
+	RandomnessRequest(ctx context.Context, in *QueryRandomnessRequestRequest, opts ...grpc.CallOption) (*QueryRandomnessRequestResponse, error)
+	// Timelocked returns a time-locked ciphertext and its plaintext, if decrypted
+	Timelocked(ctx context.Context, in *QueryTimelockedRequest, opts ...grpc.CallOption) (*QueryTimelockedResponse, error)
+	// Invariants checks the module invariants against the current state
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -56,6 +75,16 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingParamsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryBeaconResponse)
@@ -76,6 +105,56 @@ func (c *queryClient) RandomWords(ctx context.Context, in *QueryRandomWordsReque
 	return out, nil
 }
 
+func (c *queryClient) Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_Participation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorParticipation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RandomnessRequest(ctx context.Context, in *QueryRandomnessRequestRequest, opts ...grpc.CallOption) (*QueryRandomnessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRandomnessRequestResponse)
+	err := c.cc.Invoke(ctx, Query_RandomnessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Timelocked(ctx context.Context, in *QueryTimelockedRequest, opts ...grpc.CallOption) (*QueryTimelockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTimelockedResponse)
+	err := c.cc.Invoke(ctx, Query_Timelocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -84,10 +163,23 @@ func (c *queryClient) RandomWords(ctx context.Context, in *QueryRandomWordsReque
 type QueryServer interface {
 	// Params returns the on-chain VRF parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParams returns the scheduled params change, if any.
+	PendingParams(context.Context, *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error)
 	// Beacon returns the latest VrfBeacon for the current or specified height
 	Beacon(context.Context, *QueryBeaconRequest) (*QueryBeaconResponse, error)
 	// RandomWords expands the current block's VRF seed into N random words
 	RandomWords(context.Context, *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error)
+	// Participation returns the vote extension participation record for a height
+	Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error)
+	// ValidatorParticipation summarizes a validator's participation over the
+	// retained participation records
+	ValidatorParticipation(context.Context, *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error)
+	// RandomnessRequest returns a paid randomness request by id
+	RandomnessRequest(context.Context, *QueryRandomnessRequestRequest) (*QueryRandomnessRequestResponse, error)
+	// Timelocked returns a time-locked ciphertext and its plaintext, if decrypted
+	Timelocked(context.Context, *QueryTimelockedRequest) (*QueryTimelockedResponse, error)
+	// Invariants checks the module invariants against the current state
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -101,12 +193,30 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingParams(context.Context, *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PendingParams not implemented")
+}
 func (UnimplementedQueryServer) Beacon(context.Context, *QueryBeaconRequest) (*QueryBeaconResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Beacon not implemented")
 }
 func (UnimplementedQueryServer) RandomWords(context.Context, *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RandomWords not implemented")
 }
+func (UnimplementedQueryServer) Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Participation not implemented")
+}
+func (UnimplementedQueryServer) ValidatorParticipation(context.Context, *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidatorParticipation not implemented")
+}
+func (UnimplementedQueryServer) RandomnessRequest(context.Context, *QueryRandomnessRequestRequest) (*QueryRandomnessRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RandomnessRequest not implemented")
+}
+func (UnimplementedQueryServer) Timelocked(context.Context, *QueryTimelockedRequest) (*QueryTimelockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Timelocked not implemented")
+}
+func (UnimplementedQueryServer) Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Invariants not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +256,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParams(ctx, req.(*QueryPendingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Beacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBeaconRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +310,96 @@ func _Query_RandomWords_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Participation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participation(ctx, req.(*QueryParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorParticipation(ctx, req.(*QueryValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomnessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomnessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomnessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RandomnessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomnessRequest(ctx, req.(*QueryRandomnessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Timelocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Timelocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Timelocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Timelocked(ctx, req.(*QueryTimelockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +411,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingParams",
+			Handler:    _Query_PendingParams_Handler,
+		},
 		{
 			MethodName: "Beacon",
 			Handler:    _Query_Beacon_Handler,
@@ -201,6 +423,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RandomWords",
 			Handler:    _Query_RandomWords_Handler,
 		},
+		{
+			MethodName: "Participation",
+			Handler:    _Query_Participation_Handler,
+		},
+		{
+			MethodName: "ValidatorParticipation",
+			Handler:    _Query_ValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "RandomnessRequest",
+			Handler:    _Query_RandomnessRequest_Handler,
+		},
+		{
+			MethodName: "Timelocked",
+			Handler:    _Query_Timelocked_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "digitalkitchen/vrf/v1/query.proto",
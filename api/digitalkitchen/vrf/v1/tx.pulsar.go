@@ -3,6 +3,7 @@ package vrfv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -857,12 +858,16 @@ func (x *fastReflection_MsgVrfEmergencyDisableResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_MsgInitialDkg                  protoreflect.MessageDescriptor
-	fd_MsgInitialDkg_initiator        protoreflect.FieldDescriptor
-	fd_MsgInitialDkg_chain_hash       protoreflect.FieldDescriptor
-	fd_MsgInitialDkg_public_key       protoreflect.FieldDescriptor
-	fd_MsgInitialDkg_period_seconds   protoreflect.FieldDescriptor
-	fd_MsgInitialDkg_genesis_unix_sec protoreflect.FieldDescriptor
+	md_MsgInitialDkg                   protoreflect.MessageDescriptor
+	fd_MsgInitialDkg_initiator         protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_chain_hash        protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_public_key        protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_period_seconds    protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_genesis_unix_sec  protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_activation_height protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_genesis_seed      protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_beacon_id         protoreflect.FieldDescriptor
+	fd_MsgInitialDkg_bootstrap_beacon  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -873,6 +878,10 @@ func init() {
 	fd_MsgInitialDkg_public_key = md_MsgInitialDkg.Fields().ByName("public_key")
 	fd_MsgInitialDkg_period_seconds = md_MsgInitialDkg.Fields().ByName("period_seconds")
 	fd_MsgInitialDkg_genesis_unix_sec = md_MsgInitialDkg.Fields().ByName("genesis_unix_sec")
+	fd_MsgInitialDkg_activation_height = md_MsgInitialDkg.Fields().ByName("activation_height")
+	fd_MsgInitialDkg_genesis_seed = md_MsgInitialDkg.Fields().ByName("genesis_seed")
+	fd_MsgInitialDkg_beacon_id = md_MsgInitialDkg.Fields().ByName("beacon_id")
+	fd_MsgInitialDkg_bootstrap_beacon = md_MsgInitialDkg.Fields().ByName("bootstrap_beacon")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgInitialDkg)(nil)
@@ -970,6 +979,30 @@ func (x *fastReflection_MsgInitialDkg) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_MsgInitialDkg_activation_height, value) {
+			return
+		}
+	}
+	if len(x.GenesisSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.GenesisSeed)
+		if !f(fd_MsgInitialDkg_genesis_seed, value) {
+			return
+		}
+	}
+	if x.BeaconId != "" {
+		value := protoreflect.ValueOfString(x.BeaconId)
+		if !f(fd_MsgInitialDkg_beacon_id, value) {
+			return
+		}
+	}
+	if x.BootstrapBeacon != nil {
+		value := protoreflect.ValueOfMessage(x.BootstrapBeacon.ProtoReflect())
+		if !f(fd_MsgInitialDkg_bootstrap_beacon, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -995,6 +1028,14 @@ func (x *fastReflection_MsgInitialDkg) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PeriodSeconds != uint64(0)
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		return x.GenesisUnixSec != int64(0)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		return len(x.GenesisSeed) != 0
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		return x.BeaconId != ""
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		return x.BootstrapBeacon != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1021,6 +1062,14 @@ func (x *fastReflection_MsgInitialDkg) Clear(fd protoreflect.FieldDescriptor) {
 		x.PeriodSeconds = uint64(0)
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		x.GenesisUnixSec = int64(0)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		x.ActivationHeight = int64(0)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		x.GenesisSeed = nil
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		x.BeaconId = ""
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		x.BootstrapBeacon = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1052,6 +1101,18 @@ func (x *fastReflection_MsgInitialDkg) Get(descriptor protoreflect.FieldDescript
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		value := x.GenesisUnixSec
 		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		value := x.GenesisSeed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		value := x.BeaconId
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		value := x.BootstrapBeacon
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1082,6 +1143,14 @@ func (x *fastReflection_MsgInitialDkg) Set(fd protoreflect.FieldDescriptor, valu
 		x.PeriodSeconds = value.Uint()
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		x.GenesisUnixSec = value.Int()
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		x.ActivationHeight = value.Int()
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		x.GenesisSeed = value.Bytes()
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		x.BeaconId = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		x.BootstrapBeacon = value.Message().Interface().(*VrfBeacon)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1102,6 +1171,11 @@ func (x *fastReflection_MsgInitialDkg) Set(fd protoreflect.FieldDescriptor, valu
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgInitialDkg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		if x.BootstrapBeacon == nil {
+			x.BootstrapBeacon = new(VrfBeacon)
+		}
+		return protoreflect.ValueOfMessage(x.BootstrapBeacon.ProtoReflect())
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.initiator":
 		panic(fmt.Errorf("field initiator of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.chain_hash":
@@ -1112,6 +1186,12 @@ func (x *fastReflection_MsgInitialDkg) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field period_seconds of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		panic(fmt.Errorf("field genesis_unix_sec of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		panic(fmt.Errorf("field activation_height of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		panic(fmt.Errorf("field genesis_seed of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		panic(fmt.Errorf("field beacon_id of message digitalkitchen.vrf.v1.MsgInitialDkg is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1135,6 +1215,15 @@ func (x *fastReflection_MsgInitialDkg) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_unix_sec":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.genesis_seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.beacon_id":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon":
+		m := new(VrfBeacon)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgInitialDkg"))
@@ -1222,6 +1311,21 @@ func (x *fastReflection_MsgInitialDkg) ProtoMethods() *protoiface.Methods {
 		if x.GenesisUnixSec != 0 {
 			n += 1 + runtime.Sov(uint64(x.GenesisUnixSec))
 		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		l = len(x.GenesisSeed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BeaconId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BootstrapBeacon != nil {
+			l = options.Size(x.BootstrapBeacon)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1251,6 +1355,39 @@ func (x *fastReflection_MsgInitialDkg) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BootstrapBeacon != nil {
+			encoded, err := options.Marshal(x.BootstrapBeacon)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.BeaconId) > 0 {
+			i -= len(x.BeaconId)
+			copy(dAtA[i:], x.BeaconId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeaconId)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.GenesisSeed) > 0 {
+			i -= len(x.GenesisSeed)
+			copy(dAtA[i:], x.GenesisSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GenesisSeed)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.GenesisUnixSec != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.GenesisUnixSec))
 			i--
@@ -1469,6 +1606,127 @@ func (x *fastReflection_MsgInitialDkg) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GenesisSeed = append(x.GenesisSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.GenesisSeed == nil {
+					x.GenesisSeed = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeaconId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeaconId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BootstrapBeacon", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BootstrapBeacon == nil {
+					x.BootstrapBeacon = &VrfBeacon{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BootstrapBeacon); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1861,9 +2119,10 @@ func (x *fastReflection_MsgInitialDkgResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgUpdateParams                   protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority         protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params            protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_activation_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1871,6 +2130,7 @@ func init() {
 	md_MsgUpdateParams = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgUpdateParams")
 	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
 	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	fd_MsgUpdateParams_activation_height = md_MsgUpdateParams.Fields().ByName("activation_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
@@ -1950,6 +2210,12 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_MsgUpdateParams_activation_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1969,6 +2235,8 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 		return x.Authority != ""
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.params":
 		return x.Params != nil
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		return x.ActivationHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -1989,6 +2257,8 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 		x.Authority = ""
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.params":
 		x.Params = nil
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		x.ActivationHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -2011,6 +2281,9 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -2035,6 +2308,8 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 		x.Authority = value.Interface().(string)
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.params":
 		x.Params = value.Message().Interface().(*VrfParams)
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		x.ActivationHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -2062,6 +2337,8 @@ func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.authority":
 		panic(fmt.Errorf("field authority of message digitalkitchen.vrf.v1.MsgUpdateParams is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		panic(fmt.Errorf("field activation_height of message digitalkitchen.vrf.v1.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -2080,6 +2357,8 @@ func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescripto
 	case "digitalkitchen.vrf.v1.MsgUpdateParams.params":
 		m := new(VrfParams)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "digitalkitchen.vrf.v1.MsgUpdateParams.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgUpdateParams"))
@@ -2157,6 +2436,9 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2186,6 +2468,11 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -2324,6 +2611,25 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2715,11 +3021,63 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgAddVrfCommitteeMember_4_list)(nil)
+
+type _MsgAddVrfCommitteeMember_4_list struct {
+	list *[]*VrfCommitteeRoleGrant
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfCommitteeRoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfCommitteeRoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) AppendMutable() protoreflect.Value {
+	v := new(VrfCommitteeRoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) NewElement() protoreflect.Value {
+	v := new(VrfCommitteeRoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgAddVrfCommitteeMember_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgAddVrfCommitteeMember           protoreflect.MessageDescriptor
 	fd_MsgAddVrfCommitteeMember_authority protoreflect.FieldDescriptor
 	fd_MsgAddVrfCommitteeMember_address   protoreflect.FieldDescriptor
 	fd_MsgAddVrfCommitteeMember_label     protoreflect.FieldDescriptor
+	fd_MsgAddVrfCommitteeMember_roles     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2728,6 +3086,7 @@ func init() {
 	fd_MsgAddVrfCommitteeMember_authority = md_MsgAddVrfCommitteeMember.Fields().ByName("authority")
 	fd_MsgAddVrfCommitteeMember_address = md_MsgAddVrfCommitteeMember.Fields().ByName("address")
 	fd_MsgAddVrfCommitteeMember_label = md_MsgAddVrfCommitteeMember.Fields().ByName("label")
+	fd_MsgAddVrfCommitteeMember_roles = md_MsgAddVrfCommitteeMember.Fields().ByName("roles")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgAddVrfCommitteeMember)(nil)
@@ -2813,6 +3172,12 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if len(x.Roles) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddVrfCommitteeMember_4_list{list: &x.Roles})
+		if !f(fd_MsgAddVrfCommitteeMember_roles, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2834,6 +3199,8 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Has(fd protoreflect.FieldDescr
 		return x.Address != ""
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.label":
 		return x.Label != ""
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		return len(x.Roles) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember"))
@@ -2856,6 +3223,8 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Clear(fd protoreflect.FieldDes
 		x.Address = ""
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.label":
 		x.Label = ""
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		x.Roles = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember"))
@@ -2881,6 +3250,12 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Get(descriptor protoreflect.Fi
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.label":
 		value := x.Label
 		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		if len(x.Roles) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddVrfCommitteeMember_4_list{})
+		}
+		listValue := &_MsgAddVrfCommitteeMember_4_list{list: &x.Roles}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember"))
@@ -2907,6 +3282,10 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Set(fd protoreflect.FieldDescr
 		x.Address = value.Interface().(string)
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.label":
 		x.Label = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		lv := value.List()
+		clv := lv.(*_MsgAddVrfCommitteeMember_4_list)
+		x.Roles = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember"))
@@ -2927,6 +3306,12 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) Set(fd protoreflect.FieldDescr
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgAddVrfCommitteeMember) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		if x.Roles == nil {
+			x.Roles = []*VrfCommitteeRoleGrant{}
+		}
+		value := &_MsgAddVrfCommitteeMember_4_list{list: &x.Roles}
+		return protoreflect.ValueOfList(value)
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.authority":
 		panic(fmt.Errorf("field authority of message digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember is not mutable"))
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.address":
@@ -2952,6 +3337,9 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) NewField(fd protoreflect.Field
 		return protoreflect.ValueOfString("")
 	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.label":
 		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles":
+		list := []*VrfCommitteeRoleGrant{}
+		return protoreflect.ValueOfList(&_MsgAddVrfCommitteeMember_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember"))
@@ -3033,6 +3421,12 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Roles) > 0 {
+			for _, e := range x.Roles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3062,6 +3456,22 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Roles) > 0 {
+			for iNdEx := len(x.Roles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Roles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.Label) > 0 {
 			i -= len(x.Label)
 			copy(dAtA[i:], x.Label)
@@ -3228,6 +3638,40 @@ func (x *fastReflection_MsgAddVrfCommitteeMember) ProtoMethods() *protoiface.Met
 				}
 				x.Label = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Roles = append(x.Roles, &VrfCommitteeRoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Roles[len(x.Roles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4463,6 +4907,8 @@ var (
 	md_MsgRegisterVrfIdentity                      protoreflect.MessageDescriptor
 	fd_MsgRegisterVrfIdentity_operator             protoreflect.FieldDescriptor
 	fd_MsgRegisterVrfIdentity_drand_bls_public_key protoreflect.FieldDescriptor
+	fd_MsgRegisterVrfIdentity_share_index          protoreflect.FieldDescriptor
+	fd_MsgRegisterVrfIdentity_drand_bls_proof      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4470,6 +4916,8 @@ func init() {
 	md_MsgRegisterVrfIdentity = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgRegisterVrfIdentity")
 	fd_MsgRegisterVrfIdentity_operator = md_MsgRegisterVrfIdentity.Fields().ByName("operator")
 	fd_MsgRegisterVrfIdentity_drand_bls_public_key = md_MsgRegisterVrfIdentity.Fields().ByName("drand_bls_public_key")
+	fd_MsgRegisterVrfIdentity_share_index = md_MsgRegisterVrfIdentity.Fields().ByName("share_index")
+	fd_MsgRegisterVrfIdentity_drand_bls_proof = md_MsgRegisterVrfIdentity.Fields().ByName("drand_bls_proof")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRegisterVrfIdentity)(nil)
@@ -4549,6 +4997,18 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.ShareIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ShareIndex)
+		if !f(fd_MsgRegisterVrfIdentity_share_index, value) {
+			return
+		}
+	}
+	if len(x.DrandBlsProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.DrandBlsProof)
+		if !f(fd_MsgRegisterVrfIdentity_drand_bls_proof, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4568,6 +5028,10 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Has(fd protoreflect.FieldDescrip
 		return x.Operator != ""
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		return len(x.DrandBlsPublicKey) != 0
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		return x.ShareIndex != uint32(0)
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		return len(x.DrandBlsProof) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4588,6 +5052,10 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Clear(fd protoreflect.FieldDescr
 		x.Operator = ""
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		x.DrandBlsPublicKey = nil
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		x.ShareIndex = uint32(0)
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		x.DrandBlsProof = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4610,6 +5078,12 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Get(descriptor protoreflect.Fiel
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		value := x.DrandBlsPublicKey
 		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		value := x.ShareIndex
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		value := x.DrandBlsProof
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4634,6 +5108,10 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Set(fd protoreflect.FieldDescrip
 		x.Operator = value.Interface().(string)
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		x.DrandBlsPublicKey = value.Bytes()
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		x.ShareIndex = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		x.DrandBlsProof = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4658,6 +5136,10 @@ func (x *fastReflection_MsgRegisterVrfIdentity) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field operator of message digitalkitchen.vrf.v1.MsgRegisterVrfIdentity is not mutable"))
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		panic(fmt.Errorf("field drand_bls_public_key of message digitalkitchen.vrf.v1.MsgRegisterVrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		panic(fmt.Errorf("field share_index of message digitalkitchen.vrf.v1.MsgRegisterVrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		panic(fmt.Errorf("field drand_bls_proof of message digitalkitchen.vrf.v1.MsgRegisterVrfIdentity is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4675,6 +5157,10 @@ func (x *fastReflection_MsgRegisterVrfIdentity) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfString("")
 	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_public_key":
 		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.share_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity.drand_bls_proof":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity"))
@@ -4752,6 +5238,13 @@ func (x *fastReflection_MsgRegisterVrfIdentity) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ShareIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.ShareIndex))
+		}
+		l = len(x.DrandBlsProof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4781,6 +5274,18 @@ func (x *fastReflection_MsgRegisterVrfIdentity) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DrandBlsProof) > 0 {
+			i -= len(x.DrandBlsProof)
+			copy(dAtA[i:], x.DrandBlsProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DrandBlsProof)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ShareIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ShareIndex))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.DrandBlsPublicKey) > 0 {
 			i -= len(x.DrandBlsPublicKey)
 			copy(dAtA[i:], x.DrandBlsPublicKey)
@@ -4910,9 +5415,62 @@ func (x *fastReflection_MsgRegisterVrfIdentity) ProtoMethods() *protoiface.Metho
 					x.DrandBlsPublicKey = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
+				}
+				x.ShareIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ShareIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandBlsProof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DrandBlsProof = append(x.DrandBlsProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.DrandBlsProof == nil {
+					x.DrandBlsProof = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
 				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
@@ -5302,29 +5860,29 @@ func (x *fastReflection_MsgRegisterVrfIdentityResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_MsgScheduleVrfReshare               protoreflect.MessageDescriptor
-	fd_MsgScheduleVrfReshare_scheduler     protoreflect.FieldDescriptor
-	fd_MsgScheduleVrfReshare_reshare_epoch protoreflect.FieldDescriptor
-	fd_MsgScheduleVrfReshare_reason        protoreflect.FieldDescriptor
+	md_MsgRegisterEcvrfKey                  protoreflect.MessageDescriptor
+	fd_MsgRegisterEcvrfKey_operator         protoreflect.FieldDescriptor
+	fd_MsgRegisterEcvrfKey_ecvrf_public_key protoreflect.FieldDescriptor
+	fd_MsgRegisterEcvrfKey_ecvrf_proof      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_tx_proto_init()
-	md_MsgScheduleVrfReshare = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgScheduleVrfReshare")
-	fd_MsgScheduleVrfReshare_scheduler = md_MsgScheduleVrfReshare.Fields().ByName("scheduler")
-	fd_MsgScheduleVrfReshare_reshare_epoch = md_MsgScheduleVrfReshare.Fields().ByName("reshare_epoch")
-	fd_MsgScheduleVrfReshare_reason = md_MsgScheduleVrfReshare.Fields().ByName("reason")
+	md_MsgRegisterEcvrfKey = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgRegisterEcvrfKey")
+	fd_MsgRegisterEcvrfKey_operator = md_MsgRegisterEcvrfKey.Fields().ByName("operator")
+	fd_MsgRegisterEcvrfKey_ecvrf_public_key = md_MsgRegisterEcvrfKey.Fields().ByName("ecvrf_public_key")
+	fd_MsgRegisterEcvrfKey_ecvrf_proof = md_MsgRegisterEcvrfKey.Fields().ByName("ecvrf_proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgScheduleVrfReshare)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRegisterEcvrfKey)(nil)
 
-type fastReflection_MsgScheduleVrfReshare MsgScheduleVrfReshare
+type fastReflection_MsgRegisterEcvrfKey MsgRegisterEcvrfKey
 
-func (x *MsgScheduleVrfReshare) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgScheduleVrfReshare)(x)
+func (x *MsgRegisterEcvrfKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterEcvrfKey)(x)
 }
 
-func (x *MsgScheduleVrfReshare) slowProtoReflect() protoreflect.Message {
+func (x *MsgRegisterEcvrfKey) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5336,43 +5894,43 @@ func (x *MsgScheduleVrfReshare) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgScheduleVrfReshare_messageType fastReflection_MsgScheduleVrfReshare_messageType
-var _ protoreflect.MessageType = fastReflection_MsgScheduleVrfReshare_messageType{}
+var _fastReflection_MsgRegisterEcvrfKey_messageType fastReflection_MsgRegisterEcvrfKey_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterEcvrfKey_messageType{}
 
-type fastReflection_MsgScheduleVrfReshare_messageType struct{}
+type fastReflection_MsgRegisterEcvrfKey_messageType struct{}
 
-func (x fastReflection_MsgScheduleVrfReshare_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgScheduleVrfReshare)(nil)
+func (x fastReflection_MsgRegisterEcvrfKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterEcvrfKey)(nil)
 }
-func (x fastReflection_MsgScheduleVrfReshare_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgScheduleVrfReshare)
+func (x fastReflection_MsgRegisterEcvrfKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterEcvrfKey)
 }
-func (x fastReflection_MsgScheduleVrfReshare_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgScheduleVrfReshare
+func (x fastReflection_MsgRegisterEcvrfKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterEcvrfKey
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgScheduleVrfReshare) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgScheduleVrfReshare
+func (x *fastReflection_MsgRegisterEcvrfKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterEcvrfKey
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgScheduleVrfReshare) Type() protoreflect.MessageType {
-	return _fastReflection_MsgScheduleVrfReshare_messageType
+func (x *fastReflection_MsgRegisterEcvrfKey) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterEcvrfKey_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgScheduleVrfReshare) New() protoreflect.Message {
-	return new(fastReflection_MsgScheduleVrfReshare)
+func (x *fastReflection_MsgRegisterEcvrfKey) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterEcvrfKey)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgScheduleVrfReshare) Interface() protoreflect.ProtoMessage {
-	return (*MsgScheduleVrfReshare)(x)
+func (x *fastReflection_MsgRegisterEcvrfKey) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterEcvrfKey)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5380,22 +5938,22 @@ func (x *fastReflection_MsgScheduleVrfReshare) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgScheduleVrfReshare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Scheduler != "" {
-		value := protoreflect.ValueOfString(x.Scheduler)
-		if !f(fd_MsgScheduleVrfReshare_scheduler, value) {
+func (x *fastReflection_MsgRegisterEcvrfKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_MsgRegisterEcvrfKey_operator, value) {
 			return
 		}
 	}
-	if x.ReshareEpoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ReshareEpoch)
-		if !f(fd_MsgScheduleVrfReshare_reshare_epoch, value) {
+	if len(x.EcvrfPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.EcvrfPublicKey)
+		if !f(fd_MsgRegisterEcvrfKey_ecvrf_public_key, value) {
 			return
 		}
 	}
-	if x.Reason != "" {
-		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_MsgScheduleVrfReshare_reason, value) {
+	if len(x.EcvrfProof) != 0 {
+		value := protoreflect.ValueOfBytes(x.EcvrfProof)
+		if !f(fd_MsgRegisterEcvrfKey_ecvrf_proof, value) {
 			return
 		}
 	}
@@ -5412,19 +5970,19 @@ func (x *fastReflection_MsgScheduleVrfReshare) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgScheduleVrfReshare) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRegisterEcvrfKey) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		return x.Scheduler != ""
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		return x.ReshareEpoch != uint64(0)
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
-		return x.Reason != ""
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
+		return x.Operator != ""
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		return len(x.EcvrfPublicKey) != 0
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		return len(x.EcvrfProof) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5434,19 +5992,19 @@ func (x *fastReflection_MsgScheduleVrfReshare) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshare) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRegisterEcvrfKey) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		x.Scheduler = ""
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		x.ReshareEpoch = uint64(0)
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
-		x.Reason = ""
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
+		x.Operator = ""
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		x.EcvrfPublicKey = nil
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		x.EcvrfProof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5456,22 +6014,22 @@ func (x *fastReflection_MsgScheduleVrfReshare) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgScheduleVrfReshare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterEcvrfKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		value := x.Scheduler
-		return protoreflect.ValueOfString(value)
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		value := x.ReshareEpoch
-		return protoreflect.ValueOfUint64(value)
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
-		value := x.Reason
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
+		value := x.Operator
 		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		value := x.EcvrfPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		value := x.EcvrfProof
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5485,19 +6043,19 @@ func (x *fastReflection_MsgScheduleVrfReshare) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRegisterEcvrfKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		x.Scheduler = value.Interface().(string)
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		x.ReshareEpoch = value.Uint()
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
-		x.Reason = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
+		x.Operator = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		x.EcvrfPublicKey = value.Bytes()
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		x.EcvrfProof = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5511,48 +6069,48 @@ func (x *fastReflection_MsgScheduleVrfReshare) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterEcvrfKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		panic(fmt.Errorf("field scheduler of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		panic(fmt.Errorf("field reshare_epoch of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
-		panic(fmt.Errorf("field reason of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
+		panic(fmt.Errorf("field operator of message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		panic(fmt.Errorf("field ecvrf_public_key of message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		panic(fmt.Errorf("field ecvrf_proof of message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgScheduleVrfReshare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterEcvrfKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
-		return protoreflect.ValueOfString("")
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.operator":
 		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey.ecvrf_proof":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKey does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgScheduleVrfReshare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRegisterEcvrfKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgScheduleVrfReshare", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgRegisterEcvrfKey", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5560,7 +6118,7 @@ func (x *fastReflection_MsgScheduleVrfReshare) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgScheduleVrfReshare) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRegisterEcvrfKey) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5571,7 +6129,7 @@ func (x *fastReflection_MsgScheduleVrfReshare) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshare) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRegisterEcvrfKey) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5583,7 +6141,7 @@ func (x *fastReflection_MsgScheduleVrfReshare) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgScheduleVrfReshare) IsValid() bool {
+func (x *fastReflection_MsgRegisterEcvrfKey) IsValid() bool {
 	return x != nil
 }
 
@@ -5593,9 +6151,9 @@ func (x *fastReflection_MsgScheduleVrfReshare) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRegisterEcvrfKey) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		x := input.Message.Interface().(*MsgRegisterEcvrfKey)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5607,14 +6165,15 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Scheduler)
+		l = len(x.Operator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ReshareEpoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.ReshareEpoch))
+		l = len(x.EcvrfPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Reason)
+		l = len(x.EcvrfProof)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5628,7 +6187,7 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		x := input.Message.Interface().(*MsgRegisterEcvrfKey)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5647,22 +6206,24 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reason) > 0 {
-			i -= len(x.Reason)
-			copy(dAtA[i:], x.Reason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+		if len(x.EcvrfProof) > 0 {
+			i -= len(x.EcvrfProof)
+			copy(dAtA[i:], x.EcvrfProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcvrfProof)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.ReshareEpoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReshareEpoch))
+		if len(x.EcvrfPublicKey) > 0 {
+			i -= len(x.EcvrfPublicKey)
+			copy(dAtA[i:], x.EcvrfPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcvrfPublicKey)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Scheduler) > 0 {
-			i -= len(x.Scheduler)
-			copy(dAtA[i:], x.Scheduler)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scheduler)))
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5677,7 +6238,7 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		x := input.Message.Interface().(*MsgRegisterEcvrfKey)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5709,15 +6270,15 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshare: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterEcvrfKey: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshare: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterEcvrfKey: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5745,13 +6306,13 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Scheduler = string(dAtA[iNdEx:postIndex])
+				x.Operator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfPublicKey", wireType)
 				}
-				x.ReshareEpoch = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5761,16 +6322,31 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ReshareEpoch |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcvrfPublicKey = append(x.EcvrfPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.EcvrfPublicKey == nil {
+					x.EcvrfPublicKey = []byte{}
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcvrfProof", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5780,23 +6356,25 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reason = string(dAtA[iNdEx:postIndex])
+				x.EcvrfProof = append(x.EcvrfProof[:0], dAtA[iNdEx:postIndex]...)
+				if x.EcvrfProof == nil {
+					x.EcvrfProof = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5834,23 +6412,23 @@ func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgScheduleVrfReshareResponse protoreflect.MessageDescriptor
+	md_MsgRegisterEcvrfKeyResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_tx_proto_init()
-	md_MsgScheduleVrfReshareResponse = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgScheduleVrfReshareResponse")
+	md_MsgRegisterEcvrfKeyResponse = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgRegisterEcvrfKeyResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgScheduleVrfReshareResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRegisterEcvrfKeyResponse)(nil)
 
-type fastReflection_MsgScheduleVrfReshareResponse MsgScheduleVrfReshareResponse
+type fastReflection_MsgRegisterEcvrfKeyResponse MsgRegisterEcvrfKeyResponse
 
-func (x *MsgScheduleVrfReshareResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgScheduleVrfReshareResponse)(x)
+func (x *MsgRegisterEcvrfKeyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterEcvrfKeyResponse)(x)
 }
 
-func (x *MsgScheduleVrfReshareResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRegisterEcvrfKeyResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5862,43 +6440,43 @@ func (x *MsgScheduleVrfReshareResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgScheduleVrfReshareResponse_messageType fastReflection_MsgScheduleVrfReshareResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgScheduleVrfReshareResponse_messageType{}
+var _fastReflection_MsgRegisterEcvrfKeyResponse_messageType fastReflection_MsgRegisterEcvrfKeyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterEcvrfKeyResponse_messageType{}
 
-type fastReflection_MsgScheduleVrfReshareResponse_messageType struct{}
+type fastReflection_MsgRegisterEcvrfKeyResponse_messageType struct{}
 
-func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgScheduleVrfReshareResponse)(nil)
+func (x fastReflection_MsgRegisterEcvrfKeyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterEcvrfKeyResponse)(nil)
 }
-func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgScheduleVrfReshareResponse)
+func (x fastReflection_MsgRegisterEcvrfKeyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterEcvrfKeyResponse)
 }
-func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgScheduleVrfReshareResponse
+func (x fastReflection_MsgRegisterEcvrfKeyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterEcvrfKeyResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgScheduleVrfReshareResponse
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterEcvrfKeyResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgScheduleVrfReshareResponse_messageType
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterEcvrfKeyResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgScheduleVrfReshareResponse)
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterEcvrfKeyResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgScheduleVrfReshareResponse)(x)
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterEcvrfKeyResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5906,7 +6484,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5920,13 +6498,13 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5936,13 +6514,13 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5952,11 +6530,899 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterEcvrfKeyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterEcvrfKeyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterEcvrfKeyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterEcvrfKeyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterEcvrfKeyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterEcvrfKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleVrfReshare               protoreflect.MessageDescriptor
+	fd_MsgScheduleVrfReshare_scheduler     protoreflect.FieldDescriptor
+	fd_MsgScheduleVrfReshare_reshare_epoch protoreflect.FieldDescriptor
+	fd_MsgScheduleVrfReshare_reason        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgScheduleVrfReshare = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgScheduleVrfReshare")
+	fd_MsgScheduleVrfReshare_scheduler = md_MsgScheduleVrfReshare.Fields().ByName("scheduler")
+	fd_MsgScheduleVrfReshare_reshare_epoch = md_MsgScheduleVrfReshare.Fields().ByName("reshare_epoch")
+	fd_MsgScheduleVrfReshare_reason = md_MsgScheduleVrfReshare.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleVrfReshare)(nil)
+
+type fastReflection_MsgScheduleVrfReshare MsgScheduleVrfReshare
+
+func (x *MsgScheduleVrfReshare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleVrfReshare)(x)
+}
+
+func (x *MsgScheduleVrfReshare) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleVrfReshare_messageType fastReflection_MsgScheduleVrfReshare_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleVrfReshare_messageType{}
+
+type fastReflection_MsgScheduleVrfReshare_messageType struct{}
+
+func (x fastReflection_MsgScheduleVrfReshare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleVrfReshare)(nil)
+}
+func (x fastReflection_MsgScheduleVrfReshare_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleVrfReshare)
+}
+func (x fastReflection_MsgScheduleVrfReshare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleVrfReshare
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleVrfReshare) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleVrfReshare
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleVrfReshare) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleVrfReshare_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleVrfReshare) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleVrfReshare)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleVrfReshare) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleVrfReshare)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleVrfReshare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Scheduler != "" {
+		value := protoreflect.ValueOfString(x.Scheduler)
+		if !f(fd_MsgScheduleVrfReshare_scheduler, value) {
+			return
+		}
+	}
+	if x.ReshareEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReshareEpoch)
+		if !f(fd_MsgScheduleVrfReshare_reshare_epoch, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgScheduleVrfReshare_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleVrfReshare) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		return x.Scheduler != ""
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		return x.ReshareEpoch != uint64(0)
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshare) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		x.Scheduler = ""
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		x.ReshareEpoch = uint64(0)
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleVrfReshare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		value := x.Scheduler
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		value := x.ReshareEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		x.Scheduler = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		x.ReshareEpoch = value.Uint()
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		panic(fmt.Errorf("field scheduler of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		panic(fmt.Errorf("field reshare_epoch of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		panic(fmt.Errorf("field reason of message digitalkitchen.vrf.v1.MsgScheduleVrfReshare is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleVrfReshare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.scheduler":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reshare_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.MsgScheduleVrfReshare.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshare"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshare does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleVrfReshare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgScheduleVrfReshare", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleVrfReshare) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshare) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleVrfReshare) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleVrfReshare) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Scheduler)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReshareEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReshareEpoch))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReshareEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReshareEpoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Scheduler) > 0 {
+			i -= len(x.Scheduler)
+			copy(dAtA[i:], x.Scheduler)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scheduler)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleVrfReshare)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshare: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshare: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Scheduler = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+				}
+				x.ReshareEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReshareEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleVrfReshareResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgScheduleVrfReshareResponse = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgScheduleVrfReshareResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleVrfReshareResponse)(nil)
+
+type fastReflection_MsgScheduleVrfReshareResponse MsgScheduleVrfReshareResponse
+
+func (x *MsgScheduleVrfReshareResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleVrfReshareResponse)(x)
+}
+
+func (x *MsgScheduleVrfReshareResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleVrfReshareResponse_messageType fastReflection_MsgScheduleVrfReshareResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleVrfReshareResponse_messageType{}
+
+type fastReflection_MsgScheduleVrfReshareResponse_messageType struct{}
+
+func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleVrfReshareResponse)(nil)
+}
+func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleVrfReshareResponse)
+}
+func (x fastReflection_MsgScheduleVrfReshareResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleVrfReshareResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleVrfReshareResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleVrfReshareResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleVrfReshareResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleVrfReshareResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
 		}
 		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", descriptor.FullName()))
 	}
@@ -5972,13 +7438,2195 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshareResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRequestRandomness_4_list)(nil)
+
+type _MsgRequestRandomness_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgRequestRandomness_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRequestRandomness_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRequestRandomness_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRequestRandomness_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRequestRandomness_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestRandomness_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRequestRandomness_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestRandomness_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRequestRandomness           protoreflect.MessageDescriptor
+	fd_MsgRequestRandomness_requester protoreflect.FieldDescriptor
+	fd_MsgRequestRandomness_num_words protoreflect.FieldDescriptor
+	fd_MsgRequestRandomness_user_seed protoreflect.FieldDescriptor
+	fd_MsgRequestRandomness_max_fee   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgRequestRandomness = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgRequestRandomness")
+	fd_MsgRequestRandomness_requester = md_MsgRequestRandomness.Fields().ByName("requester")
+	fd_MsgRequestRandomness_num_words = md_MsgRequestRandomness.Fields().ByName("num_words")
+	fd_MsgRequestRandomness_user_seed = md_MsgRequestRandomness.Fields().ByName("user_seed")
+	fd_MsgRequestRandomness_max_fee = md_MsgRequestRandomness.Fields().ByName("max_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestRandomness)(nil)
+
+type fastReflection_MsgRequestRandomness MsgRequestRandomness
+
+func (x *MsgRequestRandomness) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestRandomness)(x)
+}
+
+func (x *MsgRequestRandomness) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestRandomness_messageType fastReflection_MsgRequestRandomness_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestRandomness_messageType{}
+
+type fastReflection_MsgRequestRandomness_messageType struct{}
+
+func (x fastReflection_MsgRequestRandomness_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestRandomness)(nil)
+}
+func (x fastReflection_MsgRequestRandomness_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestRandomness)
+}
+func (x fastReflection_MsgRequestRandomness_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestRandomness
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestRandomness) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestRandomness
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestRandomness) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestRandomness_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestRandomness) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestRandomness)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestRandomness) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestRandomness)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestRandomness) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_MsgRequestRandomness_requester, value) {
+			return
+		}
+	}
+	if x.NumWords != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NumWords)
+		if !f(fd_MsgRequestRandomness_num_words, value) {
+			return
+		}
+	}
+	if len(x.UserSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.UserSeed)
+		if !f(fd_MsgRequestRandomness_user_seed, value) {
+			return
+		}
+	}
+	if len(x.MaxFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRequestRandomness_4_list{list: &x.MaxFee})
+		if !f(fd_MsgRequestRandomness_max_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestRandomness) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		return x.Requester != ""
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		return x.NumWords != uint32(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		return len(x.UserSeed) != 0
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		return len(x.MaxFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomness) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		x.Requester = ""
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		x.NumWords = uint32(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		x.UserSeed = nil
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		x.MaxFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestRandomness) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		value := x.NumWords
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		value := x.UserSeed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		if len(x.MaxFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgRequestRandomness_4_list{})
+		}
+		listValue := &_MsgRequestRandomness_4_list{list: &x.MaxFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomness) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		x.Requester = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		x.NumWords = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		x.UserSeed = value.Bytes()
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		lv := value.List()
+		clv := lv.(*_MsgRequestRandomness_4_list)
+		x.MaxFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomness) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		if x.MaxFee == nil {
+			x.MaxFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgRequestRandomness_4_list{list: &x.MaxFee}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		panic(fmt.Errorf("field requester of message digitalkitchen.vrf.v1.MsgRequestRandomness is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		panic(fmt.Errorf("field num_words of message digitalkitchen.vrf.v1.MsgRequestRandomness is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		panic(fmt.Errorf("field user_seed of message digitalkitchen.vrf.v1.MsgRequestRandomness is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestRandomness) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.requester":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.num_words":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.user_seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgRequestRandomness_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomness"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomness does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestRandomness) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgRequestRandomness", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestRandomness) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomness) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestRandomness) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestRandomness) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestRandomness)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumWords != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumWords))
+		}
+		l = len(x.UserSeed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MaxFee) > 0 {
+			for _, e := range x.MaxFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestRandomness)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxFee) > 0 {
+			for iNdEx := len(x.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.UserSeed) > 0 {
+			i -= len(x.UserSeed)
+			copy(dAtA[i:], x.UserSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UserSeed)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NumWords != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumWords))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestRandomness)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestRandomness: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestRandomness: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumWords", wireType)
+				}
+				x.NumWords = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumWords |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UserSeed = append(x.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.UserSeed == nil {
+					x.UserSeed = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFee = append(x.MaxFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxFee[len(x.MaxFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRequestRandomnessResponse_3_list)(nil)
+
+type _MsgRequestRandomnessResponse_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestRandomnessResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRequestRandomnessResponse                 protoreflect.MessageDescriptor
+	fd_MsgRequestRandomnessResponse_request_id      protoreflect.FieldDescriptor
+	fd_MsgRequestRandomnessResponse_min_drand_round protoreflect.FieldDescriptor
+	fd_MsgRequestRandomnessResponse_fee             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgRequestRandomnessResponse = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgRequestRandomnessResponse")
+	fd_MsgRequestRandomnessResponse_request_id = md_MsgRequestRandomnessResponse.Fields().ByName("request_id")
+	fd_MsgRequestRandomnessResponse_min_drand_round = md_MsgRequestRandomnessResponse.Fields().ByName("min_drand_round")
+	fd_MsgRequestRandomnessResponse_fee = md_MsgRequestRandomnessResponse.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestRandomnessResponse)(nil)
+
+type fastReflection_MsgRequestRandomnessResponse MsgRequestRandomnessResponse
+
+func (x *MsgRequestRandomnessResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestRandomnessResponse)(x)
+}
+
+func (x *MsgRequestRandomnessResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestRandomnessResponse_messageType fastReflection_MsgRequestRandomnessResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestRandomnessResponse_messageType{}
+
+type fastReflection_MsgRequestRandomnessResponse_messageType struct{}
+
+func (x fastReflection_MsgRequestRandomnessResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestRandomnessResponse)(nil)
+}
+func (x fastReflection_MsgRequestRandomnessResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestRandomnessResponse)
+}
+func (x fastReflection_MsgRequestRandomnessResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestRandomnessResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestRandomnessResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestRandomnessResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestRandomnessResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestRandomnessResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestRandomnessResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestRandomnessResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestRandomnessResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestRandomnessResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestRandomnessResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_MsgRequestRandomnessResponse_request_id, value) {
+			return
+		}
+	}
+	if x.MinDrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinDrandRound)
+		if !f(fd_MsgRequestRandomnessResponse_min_drand_round, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRequestRandomnessResponse_3_list{list: &x.Fee})
+		if !f(fd_MsgRequestRandomnessResponse_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestRandomnessResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		return x.RequestId != uint64(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		return x.MinDrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		return len(x.Fee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomnessResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		x.RequestId = uint64(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		x.MinDrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		x.Fee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestRandomnessResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		value := x.MinDrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_MsgRequestRandomnessResponse_3_list{})
+		}
+		listValue := &_MsgRequestRandomnessResponse_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomnessResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		x.RequestId = value.Uint()
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		x.MinDrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		lv := value.List()
+		clv := lv.(*_MsgRequestRandomnessResponse_3_list)
+		x.Fee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomnessResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_MsgRequestRandomnessResponse_3_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		panic(fmt.Errorf("field request_id of message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		panic(fmt.Errorf("field min_drand_round of message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestRandomnessResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.min_drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgRequestRandomnessResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgRequestRandomnessResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestRandomnessResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgRequestRandomnessResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestRandomnessResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestRandomnessResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestRandomnessResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestRandomnessResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestRandomnessResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.MinDrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinDrandRound))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestRandomnessResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.MinDrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinDrandRound))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestRandomnessResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestRandomnessResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDrandRound", wireType)
+				}
+				x.MinDrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinDrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitTimelocked             protoreflect.MessageDescriptor
+	fd_MsgSubmitTimelocked_submitter   protoreflect.FieldDescriptor
+	fd_MsgSubmitTimelocked_drand_round protoreflect.FieldDescriptor
+	fd_MsgSubmitTimelocked_ciphertext  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgSubmitTimelocked = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgSubmitTimelocked")
+	fd_MsgSubmitTimelocked_submitter = md_MsgSubmitTimelocked.Fields().ByName("submitter")
+	fd_MsgSubmitTimelocked_drand_round = md_MsgSubmitTimelocked.Fields().ByName("drand_round")
+	fd_MsgSubmitTimelocked_ciphertext = md_MsgSubmitTimelocked.Fields().ByName("ciphertext")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitTimelocked)(nil)
+
+type fastReflection_MsgSubmitTimelocked MsgSubmitTimelocked
+
+func (x *MsgSubmitTimelocked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitTimelocked)(x)
+}
+
+func (x *MsgSubmitTimelocked) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitTimelocked_messageType fastReflection_MsgSubmitTimelocked_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitTimelocked_messageType{}
+
+type fastReflection_MsgSubmitTimelocked_messageType struct{}
+
+func (x fastReflection_MsgSubmitTimelocked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitTimelocked)(nil)
+}
+func (x fastReflection_MsgSubmitTimelocked_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitTimelocked)
+}
+func (x fastReflection_MsgSubmitTimelocked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitTimelocked
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitTimelocked) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitTimelocked
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitTimelocked) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitTimelocked_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitTimelocked) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitTimelocked)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitTimelocked) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitTimelocked)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitTimelocked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Submitter != "" {
+		value := protoreflect.ValueOfString(x.Submitter)
+		if !f(fd_MsgSubmitTimelocked_submitter, value) {
+			return
+		}
+	}
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_MsgSubmitTimelocked_drand_round, value) {
+			return
+		}
+	}
+	if x.Ciphertext != nil {
+		value := protoreflect.ValueOfMessage(x.Ciphertext.ProtoReflect())
+		if !f(fd_MsgSubmitTimelocked_ciphertext, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitTimelocked) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		return x.Submitter != ""
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		return x.Ciphertext != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelocked) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		x.Submitter = ""
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		x.Ciphertext = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitTimelocked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		value := x.Submitter
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		value := x.Ciphertext
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelocked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		x.Submitter = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		x.Ciphertext = value.Message().Interface().(*TimelockCiphertext)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelocked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		if x.Ciphertext == nil {
+			x.Ciphertext = new(TimelockCiphertext)
+		}
+		return protoreflect.ValueOfMessage(x.Ciphertext.ProtoReflect())
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		panic(fmt.Errorf("field submitter of message digitalkitchen.vrf.v1.MsgSubmitTimelocked is not mutable"))
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.MsgSubmitTimelocked is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitTimelocked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.submitter":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext":
+		m := new(TimelockCiphertext)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelocked"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelocked does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitTimelocked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgSubmitTimelocked", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitTimelocked) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelocked) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitTimelocked) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitTimelocked) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitTimelocked)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Submitter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		if x.Ciphertext != nil {
+			l = options.Size(x.Ciphertext)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitTimelocked)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Ciphertext != nil {
+			encoded, err := options.Marshal(x.Ciphertext)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Submitter) > 0 {
+			i -= len(x.Submitter)
+			copy(dAtA[i:], x.Submitter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Submitter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitTimelocked)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitTimelocked: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitTimelocked: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Submitter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Ciphertext == nil {
+					x.Ciphertext = &TimelockCiphertext{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Ciphertext); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitTimelockedResponse    protoreflect.MessageDescriptor
+	fd_MsgSubmitTimelockedResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_tx_proto_init()
+	md_MsgSubmitTimelockedResponse = File_digitalkitchen_vrf_v1_tx_proto.Messages().ByName("MsgSubmitTimelockedResponse")
+	fd_MsgSubmitTimelockedResponse_id = md_MsgSubmitTimelockedResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitTimelockedResponse)(nil)
+
+type fastReflection_MsgSubmitTimelockedResponse MsgSubmitTimelockedResponse
+
+func (x *MsgSubmitTimelockedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitTimelockedResponse)(x)
+}
+
+func (x *MsgSubmitTimelockedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitTimelockedResponse_messageType fastReflection_MsgSubmitTimelockedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitTimelockedResponse_messageType{}
+
+type fastReflection_MsgSubmitTimelockedResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitTimelockedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitTimelockedResponse)(nil)
+}
+func (x fastReflection_MsgSubmitTimelockedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitTimelockedResponse)
+}
+func (x fastReflection_MsgSubmitTimelockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitTimelockedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitTimelockedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitTimelockedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitTimelockedResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitTimelockedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitTimelockedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgSubmitTimelockedResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitTimelockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		x.Id = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5992,36 +9640,40 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitTimelockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		panic(fmt.Errorf("field id of message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitTimelockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitTimelockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6029,7 +9681,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitTimelockedResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6040,7 +9692,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitTimelockedResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6052,7 +9704,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) IsValid() bool {
+func (x *fastReflection_MsgSubmitTimelockedResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6062,9 +9714,9 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitTimelockedResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		x := input.Message.Interface().(*MsgSubmitTimelockedResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6076,6 +9728,9 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6086,7 +9741,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		x := input.Message.Interface().(*MsgSubmitTimelockedResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6105,6 +9760,11 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6116,7 +9776,7 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgScheduleVrfReshareResponse)
+		x := input.Message.Interface().(*MsgSubmitTimelockedResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6148,12 +9808,31 @@ func (x *fastReflection_MsgScheduleVrfReshareResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshareResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitTimelockedResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleVrfReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitTimelockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6202,8 +9881,9 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// MsgVrfEmergencyDisable is a gasless, committee-only message that requests
-// emergency disabling of VRF for the chain. The effective state change
+// MsgVrfEmergencyDisable is a gasless message, restricted to committee members
+// with the emergency role, that requests emergency disabling of VRF for the
+// chain. The effective state change
 // (toggling VrfParams.enabled = false) is handled in PreBlock once an
 // authorized transaction including this message has been verified.
 type MsgVrfEmergencyDisable struct {
@@ -6287,7 +9967,8 @@ type MsgInitialDkg struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// initiator is the bech32 account address initiating initial DKG bootstrap.
+	// initiator is the bech32 account address initiating initial DKG bootstrap
+	// (must hold the dkg-bootstrap committee role).
 	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	// chain_hash is the drand chain hash identifier.
 	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
@@ -6297,6 +9978,17 @@ type MsgInitialDkg struct {
 	PeriodSeconds uint64 `protobuf:"varint,4,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
 	// genesis_unix_sec is the drand genesis time (UNIX seconds) for round 1.
 	GenesisUnixSec int64 `protobuf:"varint,5,opt,name=genesis_unix_sec,json=genesisUnixSec,proto3" json:"genesis_unix_sec,omitempty"`
+	// activation_height is the height from which the chain-info takes effect.
+	// Zero selects the earliest allowed height, two blocks after inclusion.
+	ActivationHeight int64 `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// genesis_seed is the drand group's genesis seed.
+	GenesisSeed []byte `protobuf:"bytes,7,opt,name=genesis_seed,json=genesisSeed,proto3" json:"genesis_seed,omitempty"`
+	// beacon_id is the drand beacon ID; empty selects the default beacon.
+	BeaconId string `protobuf:"bytes,8,opt,name=beacon_id,json=beaconId,proto3" json:"beacon_id,omitempty"`
+	// bootstrap_beacon is an optional recent beacon of the drand chain, proving
+	// that the group is producing beacons. It is verified against public_key
+	// and the scheme_id of the params.
+	BootstrapBeacon *VrfBeacon `protobuf:"bytes,9,opt,name=bootstrap_beacon,json=bootstrapBeacon,proto3" json:"bootstrap_beacon,omitempty"`
 }
 
 func (x *MsgInitialDkg) Reset() {
@@ -6354,6 +10046,34 @@ func (x *MsgInitialDkg) GetGenesisUnixSec() int64 {
 	return 0
 }
 
+func (x *MsgInitialDkg) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *MsgInitialDkg) GetGenesisSeed() []byte {
+	if x != nil {
+		return x.GenesisSeed
+	}
+	return nil
+}
+
+func (x *MsgInitialDkg) GetBeaconId() string {
+	if x != nil {
+		return x.BeaconId
+	}
+	return ""
+}
+
+func (x *MsgInitialDkg) GetBootstrapBeacon() *VrfBeacon {
+	if x != nil {
+		return x.BootstrapBeacon
+	}
+	return nil
+}
+
 // MsgInitialDkgResponse is returned on successful delivery of MsgInitialDkg.
 type MsgInitialDkgResponse struct {
 	state         protoimpl.MessageState
@@ -6393,6 +10113,9 @@ type MsgUpdateParams struct {
 	//
 	// NOTE: All parameters must be supplied.
 	Params *VrfParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// activation_height is the height from which params take effect. Zero
+	// selects the earliest allowed height, two blocks after inclusion.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (x *MsgUpdateParams) Reset() {
@@ -6429,6 +10152,13 @@ func (x *MsgUpdateParams) GetParams() *VrfParams {
 	return nil
 }
 
+func (x *MsgUpdateParams) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
 // MsgUpdateParamsResponse is returned on successful delivery of MsgUpdateParams.
 type MsgUpdateParamsResponse struct {
 	state         protoimpl.MessageState
@@ -6468,6 +10198,9 @@ type MsgAddVrfCommitteeMember struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// label is free-form metadata for auditing.
 	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// roles are the permissions granted to the member. They replace the roles
+	// of an existing member.
+	Roles []*VrfCommitteeRoleGrant `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
 func (x *MsgAddVrfCommitteeMember) Reset() {
@@ -6501,273 +10234,602 @@ func (x *MsgAddVrfCommitteeMember) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
-	return ""
+	return ""
+}
+
+func (x *MsgAddVrfCommitteeMember) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MsgAddVrfCommitteeMember) GetRoles() []*VrfCommitteeRoleGrant {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// MsgAddVrfCommitteeMemberResponse is returned on successful delivery of
+// MsgAddVrfCommitteeMember.
+type MsgAddVrfCommitteeMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAddVrfCommitteeMemberResponse) Reset() {
+	*x = MsgAddVrfCommitteeMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddVrfCommitteeMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddVrfCommitteeMemberResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddVrfCommitteeMemberResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddVrfCommitteeMemberResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgRemoveVrfCommitteeMember removes an account from the VRF committee allowlist.
+type MsgRemoveVrfCommitteeMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *MsgRemoveVrfCommitteeMember) Reset() {
+	*x = MsgRemoveVrfCommitteeMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRemoveVrfCommitteeMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveVrfCommitteeMember) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveVrfCommitteeMember.ProtoReflect.Descriptor instead.
+func (*MsgRemoveVrfCommitteeMember) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRemoveVrfCommitteeMember) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgRemoveVrfCommitteeMember) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// MsgRemoveVrfCommitteeMemberResponse is returned on successful delivery of
+// MsgRemoveVrfCommitteeMember.
+type MsgRemoveVrfCommitteeMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRemoveVrfCommitteeMemberResponse) Reset() {
+	*x = MsgRemoveVrfCommitteeMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRemoveVrfCommitteeMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveVrfCommitteeMemberResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveVrfCommitteeMemberResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveVrfCommitteeMemberResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgRegisterVrfIdentity binds a validator operator to its drand BLS identity/share.
+type MsgRegisterVrfIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator is the bech32 account address controlling the validator.
+	// The corresponding validator operator address is derived from this value.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// drand_bls_public_key is the validator's drand BLS public key/share.
+	DrandBlsPublicKey []byte `protobuf:"bytes,2,opt,name=drand_bls_public_key,json=drandBlsPublicKey,proto3" json:"drand_bls_public_key,omitempty"`
+	// share_index is the validator's index in the drand group.
+	ShareIndex uint32 `protobuf:"varint,3,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
+	// drand_bls_proof is a signature by the private key of drand_bls_public_key
+	// over the chain id, the validator operator address and share_index. It
+	// proves that the operator holds the key it registers.
+	DrandBlsProof []byte `protobuf:"bytes,4,opt,name=drand_bls_proof,json=drandBlsProof,proto3" json:"drand_bls_proof,omitempty"`
+}
+
+func (x *MsgRegisterVrfIdentity) Reset() {
+	*x = MsgRegisterVrfIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterVrfIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterVrfIdentity) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterVrfIdentity.ProtoReflect.Descriptor instead.
+func (*MsgRegisterVrfIdentity) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRegisterVrfIdentity) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgRegisterVrfIdentity) GetDrandBlsPublicKey() []byte {
+	if x != nil {
+		return x.DrandBlsPublicKey
+	}
+	return nil
+}
+
+func (x *MsgRegisterVrfIdentity) GetShareIndex() uint32 {
+	if x != nil {
+		return x.ShareIndex
+	}
+	return 0
+}
+
+func (x *MsgRegisterVrfIdentity) GetDrandBlsProof() []byte {
+	if x != nil {
+		return x.DrandBlsProof
+	}
+	return nil
+}
+
+// MsgRegisterVrfIdentityResponse is returned on successful delivery of
+// MsgRegisterVrfIdentity.
+type MsgRegisterVrfIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRegisterVrfIdentityResponse) Reset() {
+	*x = MsgRegisterVrfIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterVrfIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterVrfIdentityResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterVrfIdentityResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterVrfIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgRegisterEcvrfKey registers the ed25519 public key that verifies the
+// ECVRF fallback proofs of the operator's validator, replacing any earlier
+// one.
+type MsgRegisterEcvrfKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operator is the bech32 account address controlling the validator.
+	// The corresponding validator operator address is derived from this value.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// ecvrf_public_key is the ed25519 public key of the validator's ECVRF key.
+	// It must not be the validator's consensus key.
+	EcvrfPublicKey []byte `protobuf:"bytes,2,opt,name=ecvrf_public_key,json=ecvrfPublicKey,proto3" json:"ecvrf_public_key,omitempty"`
+	// ecvrf_proof is an ECVRF proof under ecvrf_public_key over the chain id
+	// and the validator operator address. It proves that the operator holds
+	// the key it registers.
+	EcvrfProof []byte `protobuf:"bytes,3,opt,name=ecvrf_proof,json=ecvrfProof,proto3" json:"ecvrf_proof,omitempty"`
+}
+
+func (x *MsgRegisterEcvrfKey) Reset() {
+	*x = MsgRegisterEcvrfKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterEcvrfKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterEcvrfKey) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterEcvrfKey.ProtoReflect.Descriptor instead.
+func (*MsgRegisterEcvrfKey) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgRegisterEcvrfKey) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MsgRegisterEcvrfKey) GetEcvrfPublicKey() []byte {
+	if x != nil {
+		return x.EcvrfPublicKey
+	}
+	return nil
 }
 
-func (x *MsgAddVrfCommitteeMember) GetLabel() string {
+func (x *MsgRegisterEcvrfKey) GetEcvrfProof() []byte {
 	if x != nil {
-		return x.Label
+		return x.EcvrfProof
 	}
-	return ""
+	return nil
 }
 
-// MsgAddVrfCommitteeMemberResponse is returned on successful delivery of
-// MsgAddVrfCommitteeMember.
-type MsgAddVrfCommitteeMemberResponse struct {
+// MsgRegisterEcvrfKeyResponse is returned on successful delivery of
+// MsgRegisterEcvrfKey.
+type MsgRegisterEcvrfKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgAddVrfCommitteeMemberResponse) Reset() {
-	*x = MsgAddVrfCommitteeMemberResponse{}
+func (x *MsgRegisterEcvrfKeyResponse) Reset() {
+	*x = MsgRegisterEcvrfKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[7]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddVrfCommitteeMemberResponse) String() string {
+func (x *MsgRegisterEcvrfKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddVrfCommitteeMemberResponse) ProtoMessage() {}
+func (*MsgRegisterEcvrfKeyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddVrfCommitteeMemberResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddVrfCommitteeMemberResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgRegisterEcvrfKeyResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterEcvrfKeyResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-// MsgRemoveVrfCommitteeMember removes an account from the VRF committee allowlist.
-type MsgRemoveVrfCommitteeMember struct {
+// MsgScheduleVrfReshare increments VrfParams.reshare_epoch to signal a new
+// resharing round.
+type MsgScheduleVrfReshare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// scheduler is the account requesting the reshare (must hold the
+	// reshare-scheduler committee role).
+	Scheduler string `protobuf:"bytes,1,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// reshare_epoch is the new reshare epoch value (must be strictly greater than the current).
+	ReshareEpoch uint64 `protobuf:"varint,2,opt,name=reshare_epoch,json=reshareEpoch,proto3" json:"reshare_epoch,omitempty"`
+	// reason is a free-form human-readable description of why resharing is being scheduled.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgRemoveVrfCommitteeMember) Reset() {
-	*x = MsgRemoveVrfCommitteeMember{}
+func (x *MsgScheduleVrfReshare) Reset() {
+	*x = MsgScheduleVrfReshare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[8]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveVrfCommitteeMember) String() string {
+func (x *MsgScheduleVrfReshare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveVrfCommitteeMember) ProtoMessage() {}
+func (*MsgScheduleVrfReshare) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveVrfCommitteeMember.ProtoReflect.Descriptor instead.
-func (*MsgRemoveVrfCommitteeMember) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgScheduleVrfReshare.ProtoReflect.Descriptor instead.
+func (*MsgScheduleVrfReshare) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgRemoveVrfCommitteeMember) GetAuthority() string {
+func (x *MsgScheduleVrfReshare) GetScheduler() string {
 	if x != nil {
-		return x.Authority
+		return x.Scheduler
 	}
 	return ""
 }
 
-func (x *MsgRemoveVrfCommitteeMember) GetAddress() string {
+func (x *MsgScheduleVrfReshare) GetReshareEpoch() uint64 {
 	if x != nil {
-		return x.Address
+		return x.ReshareEpoch
+	}
+	return 0
+}
+
+func (x *MsgScheduleVrfReshare) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// MsgRemoveVrfCommitteeMemberResponse is returned on successful delivery of
-// MsgRemoveVrfCommitteeMember.
-type MsgRemoveVrfCommitteeMemberResponse struct {
+// MsgScheduleVrfReshareResponse is returned on successful delivery of
+// MsgScheduleVrfReshare.
+type MsgScheduleVrfReshareResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRemoveVrfCommitteeMemberResponse) Reset() {
-	*x = MsgRemoveVrfCommitteeMemberResponse{}
+func (x *MsgScheduleVrfReshareResponse) Reset() {
+	*x = MsgScheduleVrfReshareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[9]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveVrfCommitteeMemberResponse) String() string {
+func (x *MsgScheduleVrfReshareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveVrfCommitteeMemberResponse) ProtoMessage() {}
+func (*MsgScheduleVrfReshareResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveVrfCommitteeMemberResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveVrfCommitteeMemberResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgScheduleVrfReshareResponse.ProtoReflect.Descriptor instead.
+func (*MsgScheduleVrfReshareResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-// MsgRegisterVrfIdentity binds a validator operator to its drand BLS identity/share.
-type MsgRegisterVrfIdentity struct {
+// MsgRequestRandomness pays for random words derived from the first beacon
+// finalized at or above a drand round that was not yet published.
+type MsgRequestRandomness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// operator is the bech32 account address controlling the validator.
-	// The corresponding validator operator address is derived from this value.
-	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	// drand_bls_public_key is the validator's drand BLS public key/share.
-	DrandBlsPublicKey []byte `protobuf:"bytes,2,opt,name=drand_bls_public_key,json=drandBlsPublicKey,proto3" json:"drand_bls_public_key,omitempty"`
+	// requester is the account paying the request fee.
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	// num_words is the number of 32-byte words to derive.
+	NumWords uint32 `protobuf:"varint,2,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// user_seed is mixed into the word derivation.
+	UserSeed []byte `protobuf:"bytes,3,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+	// max_fee bounds the fee charged under the current fee schedule. Empty
+	// accepts any fee.
+	MaxFee []*v1beta1.Coin `protobuf:"bytes,4,rep,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
 }
 
-func (x *MsgRegisterVrfIdentity) Reset() {
-	*x = MsgRegisterVrfIdentity{}
+func (x *MsgRequestRandomness) Reset() {
+	*x = MsgRequestRandomness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[10]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRegisterVrfIdentity) String() string {
+func (x *MsgRequestRandomness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRegisterVrfIdentity) ProtoMessage() {}
+func (*MsgRequestRandomness) ProtoMessage() {}
 
-// Deprecated: Use MsgRegisterVrfIdentity.ProtoReflect.Descriptor instead.
-func (*MsgRegisterVrfIdentity) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgRequestRandomness.ProtoReflect.Descriptor instead.
+func (*MsgRequestRandomness) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgRegisterVrfIdentity) GetOperator() string {
+func (x *MsgRequestRandomness) GetRequester() string {
 	if x != nil {
-		return x.Operator
+		return x.Requester
 	}
 	return ""
 }
 
-func (x *MsgRegisterVrfIdentity) GetDrandBlsPublicKey() []byte {
+func (x *MsgRequestRandomness) GetNumWords() uint32 {
 	if x != nil {
-		return x.DrandBlsPublicKey
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *MsgRequestRandomness) GetUserSeed() []byte {
+	if x != nil {
+		return x.UserSeed
 	}
 	return nil
 }
 
-// MsgRegisterVrfIdentityResponse is returned on successful delivery of
-// MsgRegisterVrfIdentity.
-type MsgRegisterVrfIdentityResponse struct {
+func (x *MsgRequestRandomness) GetMaxFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.MaxFee
+	}
+	return nil
+}
+
+// MsgRequestRandomnessResponse is returned on successful delivery of
+// MsgRequestRandomness.
+type MsgRequestRandomnessResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RequestId     uint64          `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinDrandRound uint64          `protobuf:"varint,2,opt,name=min_drand_round,json=minDrandRound,proto3" json:"min_drand_round,omitempty"`
+	Fee           []*v1beta1.Coin `protobuf:"bytes,3,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *MsgRegisterVrfIdentityResponse) Reset() {
-	*x = MsgRegisterVrfIdentityResponse{}
+func (x *MsgRequestRandomnessResponse) Reset() {
+	*x = MsgRequestRandomnessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[11]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRegisterVrfIdentityResponse) String() string {
+func (x *MsgRequestRandomnessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRegisterVrfIdentityResponse) ProtoMessage() {}
+func (*MsgRequestRandomnessResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRegisterVrfIdentityResponse.ProtoReflect.Descriptor instead.
-func (*MsgRegisterVrfIdentityResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgRequestRandomnessResponse.ProtoReflect.Descriptor instead.
+func (*MsgRequestRandomnessResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// MsgScheduleVrfReshare increments VrfParams.reshare_epoch to signal a new
-// resharing round.
-type MsgScheduleVrfReshare struct {
+func (x *MsgRequestRandomnessResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *MsgRequestRandomnessResponse) GetMinDrandRound() uint64 {
+	if x != nil {
+		return x.MinDrandRound
+	}
+	return 0
+}
+
+func (x *MsgRequestRandomnessResponse) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// MsgSubmitTimelocked submits a ciphertext that can only be decrypted with the
+// signature of drand_round.
+type MsgSubmitTimelocked struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// scheduler is the account requesting the reshare (must be a committee member).
-	Scheduler string `protobuf:"bytes,1,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
-	// reshare_epoch is the new reshare epoch value (must be strictly greater than the current).
-	ReshareEpoch uint64 `protobuf:"varint,2,opt,name=reshare_epoch,json=reshareEpoch,proto3" json:"reshare_epoch,omitempty"`
-	// reason is a free-form human-readable description of why resharing is being scheduled.
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// submitter is the account submitting the ciphertext.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// drand_round is the round the ciphertext is encrypted to. It must not have
+	// been published at the block time of submission.
+	DrandRound uint64 `protobuf:"varint,2,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// ciphertext is the time-lock encrypted payload.
+	Ciphertext *TimelockCiphertext `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
 }
 
-func (x *MsgScheduleVrfReshare) Reset() {
-	*x = MsgScheduleVrfReshare{}
+func (x *MsgSubmitTimelocked) Reset() {
+	*x = MsgSubmitTimelocked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[12]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgScheduleVrfReshare) String() string {
+func (x *MsgSubmitTimelocked) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgScheduleVrfReshare) ProtoMessage() {}
+func (*MsgSubmitTimelocked) ProtoMessage() {}
 
-// Deprecated: Use MsgScheduleVrfReshare.ProtoReflect.Descriptor instead.
-func (*MsgScheduleVrfReshare) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgSubmitTimelocked.ProtoReflect.Descriptor instead.
+func (*MsgSubmitTimelocked) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgScheduleVrfReshare) GetScheduler() string {
+func (x *MsgSubmitTimelocked) GetSubmitter() string {
 	if x != nil {
-		return x.Scheduler
+		return x.Submitter
 	}
 	return ""
 }
 
-func (x *MsgScheduleVrfReshare) GetReshareEpoch() uint64 {
+func (x *MsgSubmitTimelocked) GetDrandRound() uint64 {
 	if x != nil {
-		return x.ReshareEpoch
+		return x.DrandRound
 	}
 	return 0
 }
 
-func (x *MsgScheduleVrfReshare) GetReason() string {
+func (x *MsgSubmitTimelocked) GetCiphertext() *TimelockCiphertext {
 	if x != nil {
-		return x.Reason
+		return x.Ciphertext
 	}
-	return ""
+	return nil
 }
 
-// MsgScheduleVrfReshareResponse is returned on successful delivery of
-// MsgScheduleVrfReshare.
-type MsgScheduleVrfReshareResponse struct {
+// MsgSubmitTimelockedResponse returns the id of the stored ciphertext.
+type MsgSubmitTimelockedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgScheduleVrfReshareResponse) Reset() {
-	*x = MsgScheduleVrfReshareResponse{}
+func (x *MsgSubmitTimelockedResponse) Reset() {
+	*x = MsgSubmitTimelockedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[13]
+		mi := &file_digitalkitchen_vrf_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgScheduleVrfReshareResponse) String() string {
+func (x *MsgSubmitTimelockedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgScheduleVrfReshareResponse) ProtoMessage() {}
+func (*MsgSubmitTimelockedResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgScheduleVrfReshareResponse.ProtoReflect.Descriptor instead.
-func (*MsgScheduleVrfReshareResponse) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgSubmitTimelockedResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitTimelockedResponse) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgSubmitTimelockedResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 var File_digitalkitchen_vrf_v1_tx_proto protoreflect.FileDescriptor
@@ -6777,186 +10839,301 @@ var file_digitalkitchen_vrf_v1_tx_proto_rawDesc = []byte{
 	0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x15, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
 	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
-	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23,
 	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76,
 	0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x4d, 0x73,
-	0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76,
-	0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
-	0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67,
-	0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x0d,
-	0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x12, 0x36, 0x0a,
-	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x55, 0x6e, 0x69,
-	0x78, 0x53, 0x65, 0x63, 0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
-	0xb0, 0x2a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x17, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x4d,
+	0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
 	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
-	0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x34, 0x88,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f,
-	0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb,
-	0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x3a, 0x3d, 0x88,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f,
-	0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20,
-	0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xcb, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72,
-	0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f,
+	0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73,
+	0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x03, 0x0a,
+	0x0d, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x12, 0x36,
+	0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x55, 0x6e,
+	0x69, 0x78, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x4b, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x0f,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x3a,
+	0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x76, 0x72, 0x66, 0x2f,
+	0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x44, 0x6b, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x44, 0x6b, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a,
+	0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x34, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x19, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x3a, 0x3d, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x22, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x3a, 0x40, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x25,
+	0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x02, 0x0a,
+	0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x40, 0x88, 0xa0,
-	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76,
-	0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x25,
-	0x0a, 0x23, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f,
-	0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x42, 0x6c, 0x73, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a,
+	0x14, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x72, 0x61,
+	0x6e, 0x64, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x26, 0x0a, 0x0f, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x42,
+	0x6c, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
 	0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7,
 	0xb0, 0x2a, 0x20, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67,
 	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x74, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
 	0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12,
-	0x36, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
-	0xb0, 0x2a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1f, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x63,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x63, 0x76, 0x72, 0x66, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65,
+	0x63, 0x76, 0x72, 0x66, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x63, 0x76, 0x72, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x65, 0x63, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x3a, 0x37,
+	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f,
+	0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45,
+	0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x53, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56,
-	0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xdb, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x7b, 0x0a, 0x13, 0x56, 0x72, 0x66,
-	0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45,
-	0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x1a,
-	0x35, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d,
-	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x44, 0x6b, 0x67, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
-	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x12, 0x36, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x1f, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x69, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x3a, 0x39, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e,
+	0x65, 0x73, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x72, 0x61, 0x6e, 0x64,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69,
+	0x6e, 0x44, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x62, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22,
+	0xfe, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x54, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1d, 0x76, 0x72, 0x66, 0x2f, 0x78, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x22, 0x2d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x32,
+	0xba, 0x09, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x7b, 0x0a, 0x13, 0x56, 0x72, 0x66, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2d,
+	0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
+	0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x35, 0x2e,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
+	0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x72, 0x66, 0x45, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44,
+	0x6b, 0x67, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x1a, 0x2c, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
 	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x1a, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x81, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x64, 0x69, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x37, 0x2e, 0x64, 0x69,
-	0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56,
-	0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x3a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7b, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x6b, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e,
+	0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e,
+	0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81,
+	0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
 	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x35, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78,
-	0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x12, 0x2c, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
+	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x37, 0x2e, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
+	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x1a, 0x3a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7b, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x35, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79,
+	0x12, 0x2a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x1a, 0x32, 0x2e, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x45, 0x63, 0x76, 0x72, 0x66, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52,
+	0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2c, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x1a, 0x34, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
 	0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
 	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x1a, 0x34, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x72, 0x66, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42,
-	0xc8, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x72, 0x66, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x56, 0x58, 0xaa, 0x02, 0x15, 0x44,
-	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x56, 0x72,
-	0x66, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69,
-	0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x44,
-	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72,
-	0x66, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x17, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x3a, 0x3a, 0x56, 0x72, 0x66, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x2b, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
+	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x1a, 0x33, 0x2e, 0x64,
+	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x72, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2a, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x1a, 0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xc8, 0x01, 0x0a,
+	0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x72,
+	0x66, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x56, 0x58, 0xaa, 0x02, 0x15, 0x44, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x56, 0x72, 0x66, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x15, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x44, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5c, 0x56, 0x72, 0x66, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x3a, 0x3a,
+	0x56, 0x72, 0x66, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6971,7 +11148,7 @@ func file_digitalkitchen_vrf_v1_tx_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_vrf_v1_tx_proto_rawDescData
 }
 
-var file_digitalkitchen_vrf_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_digitalkitchen_vrf_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_digitalkitchen_vrf_v1_tx_proto_goTypes = []interface{}{
 	(*MsgVrfEmergencyDisable)(nil),              // 0: digitalkitchen.vrf.v1.MsgVrfEmergencyDisable
 	(*MsgVrfEmergencyDisableResponse)(nil),      // 1: digitalkitchen.vrf.v1.MsgVrfEmergencyDisableResponse
@@ -6985,31 +11162,52 @@ var file_digitalkitchen_vrf_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRemoveVrfCommitteeMemberResponse)(nil), // 9: digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMemberResponse
 	(*MsgRegisterVrfIdentity)(nil),              // 10: digitalkitchen.vrf.v1.MsgRegisterVrfIdentity
 	(*MsgRegisterVrfIdentityResponse)(nil),      // 11: digitalkitchen.vrf.v1.MsgRegisterVrfIdentityResponse
-	(*MsgScheduleVrfReshare)(nil),               // 12: digitalkitchen.vrf.v1.MsgScheduleVrfReshare
-	(*MsgScheduleVrfReshareResponse)(nil),       // 13: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse
-	(*VrfParams)(nil),                           // 14: digitalkitchen.vrf.v1.VrfParams
+	(*MsgRegisterEcvrfKey)(nil),                 // 12: digitalkitchen.vrf.v1.MsgRegisterEcvrfKey
+	(*MsgRegisterEcvrfKeyResponse)(nil),         // 13: digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse
+	(*MsgScheduleVrfReshare)(nil),               // 14: digitalkitchen.vrf.v1.MsgScheduleVrfReshare
+	(*MsgScheduleVrfReshareResponse)(nil),       // 15: digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse
+	(*MsgRequestRandomness)(nil),                // 16: digitalkitchen.vrf.v1.MsgRequestRandomness
+	(*MsgRequestRandomnessResponse)(nil),        // 17: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse
+	(*MsgSubmitTimelocked)(nil),                 // 18: digitalkitchen.vrf.v1.MsgSubmitTimelocked
+	(*MsgSubmitTimelockedResponse)(nil),         // 19: digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse
+	(*VrfBeacon)(nil),                           // 20: digitalkitchen.vrf.v1.VrfBeacon
+	(*VrfParams)(nil),                           // 21: digitalkitchen.vrf.v1.VrfParams
+	(*VrfCommitteeRoleGrant)(nil),               // 22: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant
+	(*v1beta1.Coin)(nil),                        // 23: cosmos.base.v1beta1.Coin
+	(*TimelockCiphertext)(nil),                  // 24: digitalkitchen.vrf.v1.TimelockCiphertext
 }
 var file_digitalkitchen_vrf_v1_tx_proto_depIdxs = []int32{
-	14, // 0: digitalkitchen.vrf.v1.MsgUpdateParams.params:type_name -> digitalkitchen.vrf.v1.VrfParams
-	0,  // 1: digitalkitchen.vrf.v1.Msg.VrfEmergencyDisable:input_type -> digitalkitchen.vrf.v1.MsgVrfEmergencyDisable
-	2,  // 2: digitalkitchen.vrf.v1.Msg.InitialDkg:input_type -> digitalkitchen.vrf.v1.MsgInitialDkg
-	4,  // 3: digitalkitchen.vrf.v1.Msg.UpdateParams:input_type -> digitalkitchen.vrf.v1.MsgUpdateParams
-	6,  // 4: digitalkitchen.vrf.v1.Msg.AddVrfCommitteeMember:input_type -> digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember
-	8,  // 5: digitalkitchen.vrf.v1.Msg.RemoveVrfCommitteeMember:input_type -> digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMember
-	10, // 6: digitalkitchen.vrf.v1.Msg.RegisterVrfIdentity:input_type -> digitalkitchen.vrf.v1.MsgRegisterVrfIdentity
-	12, // 7: digitalkitchen.vrf.v1.Msg.ScheduleVrfReshare:input_type -> digitalkitchen.vrf.v1.MsgScheduleVrfReshare
-	1,  // 8: digitalkitchen.vrf.v1.Msg.VrfEmergencyDisable:output_type -> digitalkitchen.vrf.v1.MsgVrfEmergencyDisableResponse
-	3,  // 9: digitalkitchen.vrf.v1.Msg.InitialDkg:output_type -> digitalkitchen.vrf.v1.MsgInitialDkgResponse
-	5,  // 10: digitalkitchen.vrf.v1.Msg.UpdateParams:output_type -> digitalkitchen.vrf.v1.MsgUpdateParamsResponse
-	7,  // 11: digitalkitchen.vrf.v1.Msg.AddVrfCommitteeMember:output_type -> digitalkitchen.vrf.v1.MsgAddVrfCommitteeMemberResponse
-	9,  // 12: digitalkitchen.vrf.v1.Msg.RemoveVrfCommitteeMember:output_type -> digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMemberResponse
-	11, // 13: digitalkitchen.vrf.v1.Msg.RegisterVrfIdentity:output_type -> digitalkitchen.vrf.v1.MsgRegisterVrfIdentityResponse
-	13, // 14: digitalkitchen.vrf.v1.Msg.ScheduleVrfReshare:output_type -> digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	20, // 0: digitalkitchen.vrf.v1.MsgInitialDkg.bootstrap_beacon:type_name -> digitalkitchen.vrf.v1.VrfBeacon
+	21, // 1: digitalkitchen.vrf.v1.MsgUpdateParams.params:type_name -> digitalkitchen.vrf.v1.VrfParams
+	22, // 2: digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember.roles:type_name -> digitalkitchen.vrf.v1.VrfCommitteeRoleGrant
+	23, // 3: digitalkitchen.vrf.v1.MsgRequestRandomness.max_fee:type_name -> cosmos.base.v1beta1.Coin
+	23, // 4: digitalkitchen.vrf.v1.MsgRequestRandomnessResponse.fee:type_name -> cosmos.base.v1beta1.Coin
+	24, // 5: digitalkitchen.vrf.v1.MsgSubmitTimelocked.ciphertext:type_name -> digitalkitchen.vrf.v1.TimelockCiphertext
+	0,  // 6: digitalkitchen.vrf.v1.Msg.VrfEmergencyDisable:input_type -> digitalkitchen.vrf.v1.MsgVrfEmergencyDisable
+	2,  // 7: digitalkitchen.vrf.v1.Msg.InitialDkg:input_type -> digitalkitchen.vrf.v1.MsgInitialDkg
+	4,  // 8: digitalkitchen.vrf.v1.Msg.UpdateParams:input_type -> digitalkitchen.vrf.v1.MsgUpdateParams
+	6,  // 9: digitalkitchen.vrf.v1.Msg.AddVrfCommitteeMember:input_type -> digitalkitchen.vrf.v1.MsgAddVrfCommitteeMember
+	8,  // 10: digitalkitchen.vrf.v1.Msg.RemoveVrfCommitteeMember:input_type -> digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMember
+	10, // 11: digitalkitchen.vrf.v1.Msg.RegisterVrfIdentity:input_type -> digitalkitchen.vrf.v1.MsgRegisterVrfIdentity
+	12, // 12: digitalkitchen.vrf.v1.Msg.RegisterEcvrfKey:input_type -> digitalkitchen.vrf.v1.MsgRegisterEcvrfKey
+	14, // 13: digitalkitchen.vrf.v1.Msg.ScheduleVrfReshare:input_type -> digitalkitchen.vrf.v1.MsgScheduleVrfReshare
+	16, // 14: digitalkitchen.vrf.v1.Msg.RequestRandomness:input_type -> digitalkitchen.vrf.v1.MsgRequestRandomness
+	18, // 15: digitalkitchen.vrf.v1.Msg.SubmitTimelocked:input_type -> digitalkitchen.vrf.v1.MsgSubmitTimelocked
+	1,  // 16: digitalkitchen.vrf.v1.Msg.VrfEmergencyDisable:output_type -> digitalkitchen.vrf.v1.MsgVrfEmergencyDisableResponse
+	3,  // 17: digitalkitchen.vrf.v1.Msg.InitialDkg:output_type -> digitalkitchen.vrf.v1.MsgInitialDkgResponse
+	5,  // 18: digitalkitchen.vrf.v1.Msg.UpdateParams:output_type -> digitalkitchen.vrf.v1.MsgUpdateParamsResponse
+	7,  // 19: digitalkitchen.vrf.v1.Msg.AddVrfCommitteeMember:output_type -> digitalkitchen.vrf.v1.MsgAddVrfCommitteeMemberResponse
+	9,  // 20: digitalkitchen.vrf.v1.Msg.RemoveVrfCommitteeMember:output_type -> digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMemberResponse
+	11, // 21: digitalkitchen.vrf.v1.Msg.RegisterVrfIdentity:output_type -> digitalkitchen.vrf.v1.MsgRegisterVrfIdentityResponse
+	13, // 22: digitalkitchen.vrf.v1.Msg.RegisterEcvrfKey:output_type -> digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse
+	15, // 23: digitalkitchen.vrf.v1.Msg.ScheduleVrfReshare:output_type -> digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse
+	17, // 24: digitalkitchen.vrf.v1.Msg.RequestRandomness:output_type -> digitalkitchen.vrf.v1.MsgRequestRandomnessResponse
+	19, // 25: digitalkitchen.vrf.v1.Msg.SubmitTimelocked:output_type -> digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_digitalkitchen_vrf_v1_tx_proto_init() }
@@ -7018,6 +11216,7 @@ func file_digitalkitchen_vrf_v1_tx_proto_init() {
 		return
 	}
 	file_digitalkitchen_vrf_v1_genesis_proto_init()
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgVrfEmergencyDisable); i {
@@ -7164,7 +11363,7 @@ func file_digitalkitchen_vrf_v1_tx_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgScheduleVrfReshare); i {
+			switch v := v.(*MsgRegisterEcvrfKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7176,6 +11375,30 @@ func file_digitalkitchen_vrf_v1_tx_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterEcvrfKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleVrfReshare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgScheduleVrfReshareResponse); i {
 			case 0:
 				return &v.state
@@ -7187,6 +11410,54 @@ func file_digitalkitchen_vrf_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestRandomness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestRandomnessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitTimelocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitTimelockedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7194,7 +11465,7 @@ func file_digitalkitchen_vrf_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_vrf_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,7 +25,10 @@ const (
 	Msg_AddVrfCommitteeMember_FullMethodName    = "/digitalkitchen.vrf.v1.Msg/AddVrfCommitteeMember"
 	Msg_RemoveVrfCommitteeMember_FullMethodName = "/digitalkitchen.vrf.v1.Msg/RemoveVrfCommitteeMember"
 	Msg_RegisterVrfIdentity_FullMethodName      = "/digitalkitchen.vrf.v1.Msg/RegisterVrfIdentity"
+	Msg_RegisterEcvrfKey_FullMethodName         = "/digitalkitchen.vrf.v1.Msg/RegisterEcvrfKey"
 	Msg_ScheduleVrfReshare_FullMethodName       = "/digitalkitchen.vrf.v1.Msg/ScheduleVrfReshare"
+	Msg_RequestRandomness_FullMethodName        = "/digitalkitchen.vrf.v1.Msg/RequestRandomness"
+	Msg_SubmitTimelocked_FullMethodName         = "/digitalkitchen.vrf.v1.Msg/SubmitTimelocked"
 )
 
 // MsgClient is the client API for Msg service.
@@ -53,8 +56,19 @@ type MsgClient interface {
 	RemoveVrfCommitteeMember(ctx context.Context, in *MsgRemoveVrfCommitteeMember, opts ...grpc.CallOption) (*MsgRemoveVrfCommitteeMemberResponse, error)
 	// RegisterVrfIdentity binds a validator to its drand identity/share.
 	RegisterVrfIdentity(ctx context.Context, in *MsgRegisterVrfIdentity, opts ...grpc.CallOption) (*MsgRegisterVrfIdentityResponse, error)
+	// RegisterEcvrfKey binds a validator to the ed25519 key of its ECVRF
+	// fallback proofs.
+	RegisterEcvrfKey(ctx context.Context, in *MsgRegisterEcvrfKey, opts ...grpc.CallOption) (*MsgRegisterEcvrfKeyResponse, error)
 	// ScheduleVrfReshare bumps VrfParams.reshare_epoch to signal resharing.
 	ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfReshare, opts ...grpc.CallOption) (*MsgScheduleVrfReshareResponse, error)
+	// RequestRandomness pays for randomness committed to a future drand round.
+	// The fee is escrowed in the vrf module account and paid to the validators
+	// whose vote extensions finalized the fulfilling beacon.
+	RequestRandomness(ctx context.Context, in *MsgRequestRandomness, opts ...grpc.CallOption) (*MsgRequestRandomnessResponse, error)
+	// SubmitTimelocked stores a ciphertext time-lock encrypted to a future drand
+	// round. PreBlock decrypts it with the beacon signature once that round is
+	// finalized.
+	SubmitTimelocked(ctx context.Context, in *MsgSubmitTimelocked, opts ...grpc.CallOption) (*MsgSubmitTimelockedResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +139,16 @@ func (c *msgClient) RegisterVrfIdentity(ctx context.Context, in *MsgRegisterVrfI
 	return out, nil
 }
 
+func (c *msgClient) RegisterEcvrfKey(ctx context.Context, in *MsgRegisterEcvrfKey, opts ...grpc.CallOption) (*MsgRegisterEcvrfKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterEcvrfKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterEcvrfKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfReshare, opts ...grpc.CallOption) (*MsgScheduleVrfReshareResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgScheduleVrfReshareResponse)
@@ -135,6 +159,26 @@ func (c *msgClient) ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfRe
 	return out, nil
 }
 
+func (c *msgClient) RequestRandomness(ctx context.Context, in *MsgRequestRandomness, opts ...grpc.CallOption) (*MsgRequestRandomnessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRequestRandomnessResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestRandomness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitTimelocked(ctx context.Context, in *MsgSubmitTimelocked, opts ...grpc.CallOption) (*MsgSubmitTimelockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitTimelockedResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitTimelocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -160,8 +204,19 @@ type MsgServer interface {
 	RemoveVrfCommitteeMember(context.Context, *MsgRemoveVrfCommitteeMember) (*MsgRemoveVrfCommitteeMemberResponse, error)
 	// RegisterVrfIdentity binds a validator to its drand identity/share.
 	RegisterVrfIdentity(context.Context, *MsgRegisterVrfIdentity) (*MsgRegisterVrfIdentityResponse, error)
+	// RegisterEcvrfKey binds a validator to the ed25519 key of its ECVRF
+	// fallback proofs.
+	RegisterEcvrfKey(context.Context, *MsgRegisterEcvrfKey) (*MsgRegisterEcvrfKeyResponse, error)
 	// ScheduleVrfReshare bumps VrfParams.reshare_epoch to signal resharing.
 	ScheduleVrfReshare(context.Context, *MsgScheduleVrfReshare) (*MsgScheduleVrfReshareResponse, error)
+	// RequestRandomness pays for randomness committed to a future drand round.
+	// The fee is escrowed in the vrf module account and paid to the validators
+	// whose vote extensions finalized the fulfilling beacon.
+	RequestRandomness(context.Context, *MsgRequestRandomness) (*MsgRequestRandomnessResponse, error)
+	// SubmitTimelocked stores a ciphertext time-lock encrypted to a future drand
+	// round. PreBlock decrypts it with the beacon signature once that round is
+	// finalized.
+	SubmitTimelocked(context.Context, *MsgSubmitTimelocked) (*MsgSubmitTimelockedResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -190,9 +245,18 @@ func (UnimplementedMsgServer) RemoveVrfCommitteeMember(context.Context, *MsgRemo
 func (UnimplementedMsgServer) RegisterVrfIdentity(context.Context, *MsgRegisterVrfIdentity) (*MsgRegisterVrfIdentityResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterVrfIdentity not implemented")
 }
+func (UnimplementedMsgServer) RegisterEcvrfKey(context.Context, *MsgRegisterEcvrfKey) (*MsgRegisterEcvrfKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterEcvrfKey not implemented")
+}
 func (UnimplementedMsgServer) ScheduleVrfReshare(context.Context, *MsgScheduleVrfReshare) (*MsgScheduleVrfReshareResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ScheduleVrfReshare not implemented")
 }
+func (UnimplementedMsgServer) RequestRandomness(context.Context, *MsgRequestRandomness) (*MsgRequestRandomnessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestRandomness not implemented")
+}
+func (UnimplementedMsgServer) SubmitTimelocked(context.Context, *MsgSubmitTimelocked) (*MsgSubmitTimelockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitTimelocked not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -322,6 +386,24 @@ func _Msg_RegisterVrfIdentity_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterEcvrfKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterEcvrfKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterEcvrfKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterEcvrfKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterEcvrfKey(ctx, req.(*MsgRegisterEcvrfKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ScheduleVrfReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgScheduleVrfReshare)
 	if err := dec(in); err != nil {
@@ -340,6 +422,42 @@ func _Msg_ScheduleVrfReshare_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRandomness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRandomness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestRandomness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRandomness(ctx, req.(*MsgRequestRandomness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTimelocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTimelocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTimelocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitTimelocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTimelocked(ctx, req.(*MsgSubmitTimelocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,10 +489,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterVrfIdentity",
 			Handler:    _Msg_RegisterVrfIdentity_Handler,
 		},
+		{
+			MethodName: "RegisterEcvrfKey",
+			Handler:    _Msg_RegisterEcvrfKey_Handler,
+		},
 		{
 			MethodName: "ScheduleVrfReshare",
 			Handler:    _Msg_ScheduleVrfReshare_Handler,
 		},
+		{
+			MethodName: "RequestRandomness",
+			Handler:    _Msg_RequestRandomness_Handler,
+		},
+		{
+			MethodName: "SubmitTimelocked",
+			Handler:    _Msg_SubmitTimelocked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "digitalkitchen/vrf/v1/tx.proto",
@@ -2,6 +2,8 @@
 package vrfv1
 
 import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -20,6 +22,7 @@ var (
 	fd_VrfBeacon_randomness         protoreflect.FieldDescriptor
 	fd_VrfBeacon_signature          protoreflect.FieldDescriptor
 	fd_VrfBeacon_previous_signature protoreflect.FieldDescriptor
+	fd_VrfBeacon_source             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +32,7 @@ func init() {
 	fd_VrfBeacon_randomness = md_VrfBeacon.Fields().ByName("randomness")
 	fd_VrfBeacon_signature = md_VrfBeacon.Fields().ByName("signature")
 	fd_VrfBeacon_previous_signature = md_VrfBeacon.Fields().ByName("previous_signature")
+	fd_VrfBeacon_source = md_VrfBeacon.Fields().ByName("source")
 }
 
 var _ protoreflect.Message = (*fastReflection_VrfBeacon)(nil)
@@ -120,6 +124,12 @@ func (x *fastReflection_VrfBeacon) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Source != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Source))
+		if !f(fd_VrfBeacon_source, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -143,6 +153,8 @@ func (x *fastReflection_VrfBeacon) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Signature) != 0
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		return len(x.PreviousSignature) != 0
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		return x.Source != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -167,6 +179,8 @@ func (x *fastReflection_VrfBeacon) Clear(fd protoreflect.FieldDescriptor) {
 		x.Signature = nil
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		x.PreviousSignature = nil
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		x.Source = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -195,6 +209,9 @@ func (x *fastReflection_VrfBeacon) Get(descriptor protoreflect.FieldDescriptor)
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		value := x.PreviousSignature
 		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		value := x.Source
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -223,6 +240,8 @@ func (x *fastReflection_VrfBeacon) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Signature = value.Bytes()
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		x.PreviousSignature = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		x.Source = (VrfBeaconSource)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -251,6 +270,8 @@ func (x *fastReflection_VrfBeacon) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field signature of message digitalkitchen.vrf.v1.VrfBeacon is not mutable"))
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		panic(fmt.Errorf("field previous_signature of message digitalkitchen.vrf.v1.VrfBeacon is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		panic(fmt.Errorf("field source of message digitalkitchen.vrf.v1.VrfBeacon is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -272,6 +293,8 @@ func (x *fastReflection_VrfBeacon) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfBytes(nil)
 	case "digitalkitchen.vrf.v1.VrfBeacon.previous_signature":
 		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfBeacon.source":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfBeacon"))
@@ -356,6 +379,9 @@ func (x *fastReflection_VrfBeacon) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Source != 0 {
+			n += 1 + runtime.Sov(uint64(x.Source))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -385,6 +411,11 @@ func (x *fastReflection_VrfBeacon) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Source != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Source))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.PreviousSignature) > 0 {
 			i -= len(x.PreviousSignature)
 			copy(dAtA[i:], x.PreviousSignature)
@@ -581,6 +612,25 @@ func (x *fastReflection_VrfBeacon) ProtoMethods() *protoiface.Methods {
 					x.PreviousSignature = []byte{}
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				x.Source = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Source |= VrfBeaconSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -616,10 +666,62 @@ func (x *fastReflection_VrfBeacon) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AllowlistEntry_3_list)(nil)
+
+type _AllowlistEntry_3_list struct {
+	list *[]*VrfCommitteeRoleGrant
+}
+
+func (x *_AllowlistEntry_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AllowlistEntry_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AllowlistEntry_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfCommitteeRoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AllowlistEntry_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfCommitteeRoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AllowlistEntry_3_list) AppendMutable() protoreflect.Value {
+	v := new(VrfCommitteeRoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AllowlistEntry_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AllowlistEntry_3_list) NewElement() protoreflect.Value {
+	v := new(VrfCommitteeRoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AllowlistEntry_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_AllowlistEntry         protoreflect.MessageDescriptor
 	fd_AllowlistEntry_address protoreflect.FieldDescriptor
 	fd_AllowlistEntry_label   protoreflect.FieldDescriptor
+	fd_AllowlistEntry_roles   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -627,6 +729,7 @@ func init() {
 	md_AllowlistEntry = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("AllowlistEntry")
 	fd_AllowlistEntry_address = md_AllowlistEntry.Fields().ByName("address")
 	fd_AllowlistEntry_label = md_AllowlistEntry.Fields().ByName("label")
+	fd_AllowlistEntry_roles = md_AllowlistEntry.Fields().ByName("roles")
 }
 
 var _ protoreflect.Message = (*fastReflection_AllowlistEntry)(nil)
@@ -706,6 +809,12 @@ func (x *fastReflection_AllowlistEntry) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Roles) != 0 {
+		value := protoreflect.ValueOfList(&_AllowlistEntry_3_list{list: &x.Roles})
+		if !f(fd_AllowlistEntry_roles, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -725,6 +834,8 @@ func (x *fastReflection_AllowlistEntry) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Address != ""
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
 		return x.Label != ""
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		return len(x.Roles) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.AllowlistEntry"))
@@ -745,6 +856,8 @@ func (x *fastReflection_AllowlistEntry) Clear(fd protoreflect.FieldDescriptor) {
 		x.Address = ""
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
 		x.Label = ""
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		x.Roles = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.AllowlistEntry"))
@@ -767,6 +880,12 @@ func (x *fastReflection_AllowlistEntry) Get(descriptor protoreflect.FieldDescrip
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
 		value := x.Label
 		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		if len(x.Roles) == 0 {
+			return protoreflect.ValueOfList(&_AllowlistEntry_3_list{})
+		}
+		listValue := &_AllowlistEntry_3_list{list: &x.Roles}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.AllowlistEntry"))
@@ -791,6 +910,10 @@ func (x *fastReflection_AllowlistEntry) Set(fd protoreflect.FieldDescriptor, val
 		x.Address = value.Interface().(string)
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
 		x.Label = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		lv := value.List()
+		clv := lv.(*_AllowlistEntry_3_list)
+		x.Roles = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.AllowlistEntry"))
@@ -811,6 +934,12 @@ func (x *fastReflection_AllowlistEntry) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_AllowlistEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		if x.Roles == nil {
+			x.Roles = []*VrfCommitteeRoleGrant{}
+		}
+		value := &_AllowlistEntry_3_list{list: &x.Roles}
+		return protoreflect.ValueOfList(value)
 	case "digitalkitchen.vrf.v1.AllowlistEntry.address":
 		panic(fmt.Errorf("field address of message digitalkitchen.vrf.v1.AllowlistEntry is not mutable"))
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
@@ -832,6 +961,9 @@ func (x *fastReflection_AllowlistEntry) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "digitalkitchen.vrf.v1.AllowlistEntry.label":
 		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.AllowlistEntry.roles":
+		list := []*VrfCommitteeRoleGrant{}
+		return protoreflect.ValueOfList(&_AllowlistEntry_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.AllowlistEntry"))
@@ -909,6 +1041,12 @@ func (x *fastReflection_AllowlistEntry) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Roles) > 0 {
+			for _, e := range x.Roles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -938,6 +1076,22 @@ func (x *fastReflection_AllowlistEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Roles) > 0 {
+			for iNdEx := len(x.Roles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Roles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Label) > 0 {
 			i -= len(x.Label)
 			copy(dAtA[i:], x.Label)
@@ -1065,6 +1219,40 @@ func (x *fastReflection_AllowlistEntry) ProtoMethods() *protoiface.Methods {
 				}
 				x.Label = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Roles = append(x.Roles, &VrfCommitteeRoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Roles[len(x.Roles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1101,33 +1289,27 @@ func (x *fastReflection_AllowlistEntry) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_VrfIdentity                      protoreflect.MessageDescriptor
-	fd_VrfIdentity_validator_address    protoreflect.FieldDescriptor
-	fd_VrfIdentity_drand_bls_public_key protoreflect.FieldDescriptor
-	fd_VrfIdentity_chain_hash           protoreflect.FieldDescriptor
-	fd_VrfIdentity_signal_unix_sec      protoreflect.FieldDescriptor
-	fd_VrfIdentity_signal_reshare_epoch protoreflect.FieldDescriptor
+	md_VrfCommitteeRoleGrant               protoreflect.MessageDescriptor
+	fd_VrfCommitteeRoleGrant_role          protoreflect.FieldDescriptor
+	fd_VrfCommitteeRoleGrant_expiry_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_digitalkitchen_vrf_v1_vrf_proto_init()
-	md_VrfIdentity = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfIdentity")
-	fd_VrfIdentity_validator_address = md_VrfIdentity.Fields().ByName("validator_address")
-	fd_VrfIdentity_drand_bls_public_key = md_VrfIdentity.Fields().ByName("drand_bls_public_key")
-	fd_VrfIdentity_chain_hash = md_VrfIdentity.Fields().ByName("chain_hash")
-	fd_VrfIdentity_signal_unix_sec = md_VrfIdentity.Fields().ByName("signal_unix_sec")
-	fd_VrfIdentity_signal_reshare_epoch = md_VrfIdentity.Fields().ByName("signal_reshare_epoch")
+	md_VrfCommitteeRoleGrant = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfCommitteeRoleGrant")
+	fd_VrfCommitteeRoleGrant_role = md_VrfCommitteeRoleGrant.Fields().ByName("role")
+	fd_VrfCommitteeRoleGrant_expiry_height = md_VrfCommitteeRoleGrant.Fields().ByName("expiry_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_VrfIdentity)(nil)
+var _ protoreflect.Message = (*fastReflection_VrfCommitteeRoleGrant)(nil)
 
-type fastReflection_VrfIdentity VrfIdentity
+type fastReflection_VrfCommitteeRoleGrant VrfCommitteeRoleGrant
 
-func (x *VrfIdentity) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VrfIdentity)(x)
+func (x *VrfCommitteeRoleGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfCommitteeRoleGrant)(x)
 }
 
-func (x *VrfIdentity) slowProtoReflect() protoreflect.Message {
+func (x *VrfCommitteeRoleGrant) slowProtoReflect() protoreflect.Message {
 	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1139,43 +1321,43 @@ func (x *VrfIdentity) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VrfIdentity_messageType fastReflection_VrfIdentity_messageType
-var _ protoreflect.MessageType = fastReflection_VrfIdentity_messageType{}
+var _fastReflection_VrfCommitteeRoleGrant_messageType fastReflection_VrfCommitteeRoleGrant_messageType
+var _ protoreflect.MessageType = fastReflection_VrfCommitteeRoleGrant_messageType{}
 
-type fastReflection_VrfIdentity_messageType struct{}
+type fastReflection_VrfCommitteeRoleGrant_messageType struct{}
 
-func (x fastReflection_VrfIdentity_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VrfIdentity)(nil)
+func (x fastReflection_VrfCommitteeRoleGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfCommitteeRoleGrant)(nil)
 }
-func (x fastReflection_VrfIdentity_messageType) New() protoreflect.Message {
-	return new(fastReflection_VrfIdentity)
+func (x fastReflection_VrfCommitteeRoleGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfCommitteeRoleGrant)
 }
-func (x fastReflection_VrfIdentity_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VrfIdentity
+func (x fastReflection_VrfCommitteeRoleGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfCommitteeRoleGrant
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VrfIdentity) Descriptor() protoreflect.MessageDescriptor {
-	return md_VrfIdentity
+func (x *fastReflection_VrfCommitteeRoleGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfCommitteeRoleGrant
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VrfIdentity) Type() protoreflect.MessageType {
-	return _fastReflection_VrfIdentity_messageType
+func (x *fastReflection_VrfCommitteeRoleGrant) Type() protoreflect.MessageType {
+	return _fastReflection_VrfCommitteeRoleGrant_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VrfIdentity) New() protoreflect.Message {
-	return new(fastReflection_VrfIdentity)
+func (x *fastReflection_VrfCommitteeRoleGrant) New() protoreflect.Message {
+	return new(fastReflection_VrfCommitteeRoleGrant)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VrfIdentity) Interface() protoreflect.ProtoMessage {
-	return (*VrfIdentity)(x)
+func (x *fastReflection_VrfCommitteeRoleGrant) Interface() protoreflect.ProtoMessage {
+	return (*VrfCommitteeRoleGrant)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1183,34 +1365,16 @@ func (x *fastReflection_VrfIdentity) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VrfIdentity) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_VrfIdentity_validator_address, value) {
-			return
-		}
-	}
-	if len(x.DrandBlsPublicKey) != 0 {
-		value := protoreflect.ValueOfBytes(x.DrandBlsPublicKey)
-		if !f(fd_VrfIdentity_drand_bls_public_key, value) {
-			return
-		}
-	}
-	if len(x.ChainHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.ChainHash)
-		if !f(fd_VrfIdentity_chain_hash, value) {
-			return
-		}
-	}
-	if x.SignalUnixSec != int64(0) {
-		value := protoreflect.ValueOfInt64(x.SignalUnixSec)
-		if !f(fd_VrfIdentity_signal_unix_sec, value) {
+func (x *fastReflection_VrfCommitteeRoleGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_VrfCommitteeRoleGrant_role, value) {
 			return
 		}
 	}
-	if x.SignalReshareEpoch != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SignalReshareEpoch)
-		if !f(fd_VrfIdentity_signal_reshare_epoch, value) {
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_VrfCommitteeRoleGrant_expiry_height, value) {
 			return
 		}
 	}
@@ -1227,23 +1391,17 @@ func (x *fastReflection_VrfIdentity) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VrfIdentity) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VrfCommitteeRoleGrant) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		return x.ValidatorAddress != ""
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		return len(x.DrandBlsPublicKey) != 0
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		return len(x.ChainHash) != 0
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
-		return x.SignalUnixSec != int64(0)
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		return x.SignalReshareEpoch != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		return x.Role != 0
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
+		return x.ExpiryHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1253,23 +1411,17 @@ func (x *fastReflection_VrfIdentity) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfIdentity) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VrfCommitteeRoleGrant) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		x.ValidatorAddress = ""
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		x.DrandBlsPublicKey = nil
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		x.ChainHash = nil
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
-		x.SignalUnixSec = int64(0)
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		x.SignalReshareEpoch = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		x.Role = 0
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
+		x.ExpiryHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1279,28 +1431,19 @@ func (x *fastReflection_VrfIdentity) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VrfIdentity) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfCommitteeRoleGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		value := x.ValidatorAddress
-		return protoreflect.ValueOfString(value)
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		value := x.DrandBlsPublicKey
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		value := x.ChainHash
-		return protoreflect.ValueOfBytes(value)
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
-		value := x.SignalUnixSec
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
+		value := x.ExpiryHeight
 		return protoreflect.ValueOfInt64(value)
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		value := x.SignalReshareEpoch
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1314,23 +1457,17 @@ func (x *fastReflection_VrfIdentity) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfIdentity) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VrfCommitteeRoleGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		x.DrandBlsPublicKey = value.Bytes()
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		x.ChainHash = value.Bytes()
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
-		x.SignalUnixSec = value.Int()
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		x.SignalReshareEpoch = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		x.Role = (VrfCommitteeRole)(value.Enum())
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
+		x.ExpiryHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1344,56 +1481,44 @@ func (x *fastReflection_VrfIdentity) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfIdentity) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfCommitteeRoleGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		panic(fmt.Errorf("field validator_address of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		panic(fmt.Errorf("field drand_bls_public_key of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		panic(fmt.Errorf("field chain_hash of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
-		panic(fmt.Errorf("field signal_unix_sec of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		panic(fmt.Errorf("field signal_reshare_epoch of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		panic(fmt.Errorf("field role of message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VrfIdentity) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VrfCommitteeRoleGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
-		return protoreflect.ValueOfString("")
-	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role":
+		return protoreflect.ValueOfEnum(0)
+	case "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.expiry_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant"))
 		}
-		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfCommitteeRoleGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VrfIdentity) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VrfCommitteeRoleGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfIdentity", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfCommitteeRoleGrant", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1401,7 +1526,7 @@ func (x *fastReflection_VrfIdentity) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VrfIdentity) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VrfCommitteeRoleGrant) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1412,7 +1537,7 @@ func (x *fastReflection_VrfIdentity) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VrfIdentity) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VrfCommitteeRoleGrant) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1424,7 +1549,7 @@ func (x *fastReflection_VrfIdentity) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VrfIdentity) IsValid() bool {
+func (x *fastReflection_VrfCommitteeRoleGrant) IsValid() bool {
 	return x != nil
 }
 
@@ -1434,9 +1559,9 @@ func (x *fastReflection_VrfIdentity) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VrfCommitteeRoleGrant) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VrfIdentity)
+		x := input.Message.Interface().(*VrfCommitteeRoleGrant)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1448,23 +1573,11 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DrandBlsPublicKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ChainHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
 		}
-		if x.SignalUnixSec != 0 {
-			n += 1 + runtime.Sov(uint64(x.SignalUnixSec))
-		}
-		if x.SignalReshareEpoch != 0 {
-			n += 1 + runtime.Sov(uint64(x.SignalReshareEpoch))
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1476,7 +1589,7 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VrfIdentity)
+		x := input.Message.Interface().(*VrfCommitteeRoleGrant)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1495,36 +1608,15 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SignalReshareEpoch != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalReshareEpoch))
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x10
 		}
-		if x.SignalUnixSec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalUnixSec))
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
 			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.ChainHash) > 0 {
-			i -= len(x.ChainHash)
-			copy(dAtA[i:], x.ChainHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.DrandBlsPublicKey) > 0 {
-			i -= len(x.DrandBlsPublicKey)
-			copy(dAtA[i:], x.DrandBlsPublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DrandBlsPublicKey)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1537,7 +1629,7 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VrfIdentity)
+		x := input.Message.Interface().(*VrfCommitteeRoleGrant)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1569,117 +1661,17 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfIdentity: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfCommitteeRoleGrant: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfCommitteeRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DrandBlsPublicKey = append(x.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
-				if x.DrandBlsPublicKey == nil {
-					x.DrandBlsPublicKey = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.ChainHash == nil {
-					x.ChainHash = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalUnixSec", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 				}
-				x.SignalUnixSec = 0
+				x.Role = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1689,16 +1681,16 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SignalUnixSec |= int64(b&0x7F) << shift
+					x.Role |= VrfCommitteeRole(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalReshareEpoch", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 				}
-				x.SignalReshareEpoch = 0
+				x.ExpiryHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1708,7 +1700,7 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SignalReshareEpoch |= uint64(b&0x7F) << shift
+					x.ExpiryHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1732,218 +1724,6457 @@ func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VrfIdentity                      protoreflect.MessageDescriptor
+	fd_VrfIdentity_validator_address    protoreflect.FieldDescriptor
+	fd_VrfIdentity_drand_bls_public_key protoreflect.FieldDescriptor
+	fd_VrfIdentity_chain_hash           protoreflect.FieldDescriptor
+	fd_VrfIdentity_signal_unix_sec      protoreflect.FieldDescriptor
+	fd_VrfIdentity_signal_reshare_epoch protoreflect.FieldDescriptor
+	fd_VrfIdentity_share_index          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_VrfIdentity = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfIdentity")
+	fd_VrfIdentity_validator_address = md_VrfIdentity.Fields().ByName("validator_address")
+	fd_VrfIdentity_drand_bls_public_key = md_VrfIdentity.Fields().ByName("drand_bls_public_key")
+	fd_VrfIdentity_chain_hash = md_VrfIdentity.Fields().ByName("chain_hash")
+	fd_VrfIdentity_signal_unix_sec = md_VrfIdentity.Fields().ByName("signal_unix_sec")
+	fd_VrfIdentity_signal_reshare_epoch = md_VrfIdentity.Fields().ByName("signal_reshare_epoch")
+	fd_VrfIdentity_share_index = md_VrfIdentity.Fields().ByName("share_index")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfIdentity)(nil)
+
+type fastReflection_VrfIdentity VrfIdentity
+
+func (x *VrfIdentity) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfIdentity)(x)
+}
+
+func (x *VrfIdentity) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfIdentity_messageType fastReflection_VrfIdentity_messageType
+var _ protoreflect.MessageType = fastReflection_VrfIdentity_messageType{}
+
+type fastReflection_VrfIdentity_messageType struct{}
+
+func (x fastReflection_VrfIdentity_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfIdentity)(nil)
+}
+func (x fastReflection_VrfIdentity_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfIdentity)
+}
+func (x fastReflection_VrfIdentity_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfIdentity
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfIdentity) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfIdentity
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfIdentity) Type() protoreflect.MessageType {
+	return _fastReflection_VrfIdentity_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfIdentity) New() protoreflect.Message {
+	return new(fastReflection_VrfIdentity)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfIdentity) Interface() protoreflect.ProtoMessage {
+	return (*VrfIdentity)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfIdentity) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_VrfIdentity_validator_address, value) {
+			return
+		}
+	}
+	if len(x.DrandBlsPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.DrandBlsPublicKey)
+		if !f(fd_VrfIdentity_drand_bls_public_key, value) {
+			return
+		}
+	}
+	if len(x.ChainHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.ChainHash)
+		if !f(fd_VrfIdentity_chain_hash, value) {
+			return
+		}
+	}
+	if x.SignalUnixSec != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SignalUnixSec)
+		if !f(fd_VrfIdentity_signal_unix_sec, value) {
+			return
+		}
+	}
+	if x.SignalReshareEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SignalReshareEpoch)
+		if !f(fd_VrfIdentity_signal_reshare_epoch, value) {
+			return
+		}
+	}
+	if x.ShareIndex != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ShareIndex)
+		if !f(fd_VrfIdentity_share_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfIdentity) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		return x.ValidatorAddress != ""
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		return len(x.DrandBlsPublicKey) != 0
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		return len(x.ChainHash) != 0
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		return x.SignalUnixSec != int64(0)
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		return x.SignalReshareEpoch != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		return x.ShareIndex != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfIdentity) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		x.ValidatorAddress = ""
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		x.DrandBlsPublicKey = nil
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		x.ChainHash = nil
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		x.SignalUnixSec = int64(0)
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		x.SignalReshareEpoch = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		x.ShareIndex = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfIdentity) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		value := x.DrandBlsPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		value := x.ChainHash
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		value := x.SignalUnixSec
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		value := x.SignalReshareEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		value := x.ShareIndex
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfIdentity) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		x.DrandBlsPublicKey = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		x.ChainHash = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		x.SignalUnixSec = value.Int()
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		x.SignalReshareEpoch = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		x.ShareIndex = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfIdentity) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		panic(fmt.Errorf("field validator_address of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		panic(fmt.Errorf("field drand_bls_public_key of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		panic(fmt.Errorf("field chain_hash of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		panic(fmt.Errorf("field signal_unix_sec of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		panic(fmt.Errorf("field signal_reshare_epoch of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		panic(fmt.Errorf("field share_index of message digitalkitchen.vrf.v1.VrfIdentity is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfIdentity) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfIdentity.validator_address":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.VrfIdentity.drand_bls_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfIdentity.chain_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_unix_sec":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.VrfIdentity.signal_reshare_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfIdentity.share_index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfIdentity"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfIdentity does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfIdentity) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfIdentity", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfIdentity) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfIdentity) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfIdentity) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfIdentity) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfIdentity)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DrandBlsPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChainHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SignalUnixSec != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignalUnixSec))
+		}
+		if x.SignalReshareEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignalReshareEpoch))
+		}
+		if x.ShareIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.ShareIndex))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfIdentity)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ShareIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ShareIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SignalReshareEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalReshareEpoch))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SignalUnixSec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignalUnixSec))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ChainHash) > 0 {
+			i -= len(x.ChainHash)
+			copy(dAtA[i:], x.ChainHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DrandBlsPublicKey) > 0 {
+			i -= len(x.DrandBlsPublicKey)
+			copy(dAtA[i:], x.DrandBlsPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DrandBlsPublicKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfIdentity)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfIdentity: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DrandBlsPublicKey = append(x.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.DrandBlsPublicKey == nil {
+					x.DrandBlsPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainHash = append(x.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.ChainHash == nil {
+					x.ChainHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalUnixSec", wireType)
+				}
+				x.SignalUnixSec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignalUnixSec |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalReshareEpoch", wireType)
+				}
+				x.SignalReshareEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignalReshareEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
+				}
+				x.ShareIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ShareIndex |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VrfParticipation_4_list)(nil)
+
+type _VrfParticipation_4_list struct {
+	list *[][]byte
+}
+
+func (x *_VrfParticipation_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParticipation_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_VrfParticipation_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParticipation_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParticipation_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VrfParticipation at list field Validators as it is not of Message kind"))
+}
+
+func (x *_VrfParticipation_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParticipation_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_VrfParticipation_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VrfParticipation_6_list)(nil)
+
+type _VrfParticipation_6_list struct {
+	list *[]VrfParticipationReason
+}
+
+func (x *_VrfParticipation_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParticipation_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_VrfParticipation_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (VrfParticipationReason)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParticipation_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (VrfParticipationReason)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParticipation_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VrfParticipation at list field Reasons as it is not of Message kind"))
+}
+
+func (x *_VrfParticipation_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParticipation_6_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_VrfParticipation_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VrfParticipation              protoreflect.MessageDescriptor
+	fd_VrfParticipation_height       protoreflect.FieldDescriptor
+	fd_VrfParticipation_target_round protoreflect.FieldDescriptor
+	fd_VrfParticipation_drand_round  protoreflect.FieldDescriptor
+	fd_VrfParticipation_validators   protoreflect.FieldDescriptor
+	fd_VrfParticipation_contributed  protoreflect.FieldDescriptor
+	fd_VrfParticipation_reasons      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_VrfParticipation = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfParticipation")
+	fd_VrfParticipation_height = md_VrfParticipation.Fields().ByName("height")
+	fd_VrfParticipation_target_round = md_VrfParticipation.Fields().ByName("target_round")
+	fd_VrfParticipation_drand_round = md_VrfParticipation.Fields().ByName("drand_round")
+	fd_VrfParticipation_validators = md_VrfParticipation.Fields().ByName("validators")
+	fd_VrfParticipation_contributed = md_VrfParticipation.Fields().ByName("contributed")
+	fd_VrfParticipation_reasons = md_VrfParticipation.Fields().ByName("reasons")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfParticipation)(nil)
+
+type fastReflection_VrfParticipation VrfParticipation
+
+func (x *VrfParticipation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfParticipation)(x)
+}
+
+func (x *VrfParticipation) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfParticipation_messageType fastReflection_VrfParticipation_messageType
+var _ protoreflect.MessageType = fastReflection_VrfParticipation_messageType{}
+
+type fastReflection_VrfParticipation_messageType struct{}
+
+func (x fastReflection_VrfParticipation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfParticipation)(nil)
+}
+func (x fastReflection_VrfParticipation_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipation)
+}
+func (x fastReflection_VrfParticipation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfParticipation) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfParticipation) Type() protoreflect.MessageType {
+	return _fastReflection_VrfParticipation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfParticipation) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfParticipation) Interface() protoreflect.ProtoMessage {
+	return (*VrfParticipation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfParticipation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_VrfParticipation_height, value) {
+			return
+		}
+	}
+	if x.TargetRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetRound)
+		if !f(fd_VrfParticipation_target_round, value) {
+			return
+		}
+	}
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_VrfParticipation_drand_round, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParticipation_4_list{list: &x.Validators})
+		if !f(fd_VrfParticipation_validators, value) {
+			return
+		}
+	}
+	if len(x.Contributed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Contributed)
+		if !f(fd_VrfParticipation_contributed, value) {
+			return
+		}
+	}
+	if len(x.Reasons) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParticipation_6_list{list: &x.Reasons})
+		if !f(fd_VrfParticipation_reasons, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfParticipation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		return x.Height != int64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		return x.TargetRound != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		return len(x.Validators) != 0
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		return len(x.Contributed) != 0
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		return len(x.Reasons) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		x.Height = int64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		x.TargetRound = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		x.Validators = nil
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		x.Contributed = nil
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		x.Reasons = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfParticipation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		value := x.TargetRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_VrfParticipation_4_list{})
+		}
+		listValue := &_VrfParticipation_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		value := x.Contributed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		if len(x.Reasons) == 0 {
+			return protoreflect.ValueOfList(&_VrfParticipation_6_list{})
+		}
+		listValue := &_VrfParticipation_6_list{list: &x.Reasons}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		x.Height = value.Int()
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		x.TargetRound = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		lv := value.List()
+		clv := lv.(*_VrfParticipation_4_list)
+		x.Validators = *clv.list
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		x.Contributed = value.Bytes()
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		lv := value.List()
+		clv := lv.(*_VrfParticipation_6_list)
+		x.Reasons = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		if x.Validators == nil {
+			x.Validators = [][]byte{}
+		}
+		value := &_VrfParticipation_4_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		if x.Reasons == nil {
+			x.Reasons = []VrfParticipationReason{}
+		}
+		value := &_VrfParticipation_6_list{list: &x.Reasons}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		panic(fmt.Errorf("field height of message digitalkitchen.vrf.v1.VrfParticipation is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		panic(fmt.Errorf("field target_round of message digitalkitchen.vrf.v1.VrfParticipation is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.VrfParticipation is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		panic(fmt.Errorf("field contributed of message digitalkitchen.vrf.v1.VrfParticipation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfParticipation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipation.target_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipation.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipation.validators":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_VrfParticipation_4_list{list: &list})
+	case "digitalkitchen.vrf.v1.VrfParticipation.contributed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.VrfParticipation.reasons":
+		list := []VrfParticipationReason{}
+		return protoreflect.ValueOfList(&_VrfParticipation_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipation"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfParticipation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfParticipation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfParticipation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfParticipation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfParticipation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfParticipation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.TargetRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetRound))
+		}
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		if len(x.Validators) > 0 {
+			for _, b := range x.Validators {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Contributed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Reasons) > 0 {
+			l = 0
+			for _, e := range x.Reasons {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reasons) > 0 {
+			var pksize2 int
+			for _, num := range x.Reasons {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.Reasons {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Contributed) > 0 {
+			i -= len(x.Contributed)
+			copy(dAtA[i:], x.Contributed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contributed)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Validators[iNdEx])
+				copy(dAtA[i:], x.Validators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validators[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TargetRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetRound))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetRound", wireType)
+				}
+				x.TargetRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, make([]byte, postIndex-iNdEx))
+				copy(x.Validators[len(x.Validators)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contributed = append(x.Contributed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Contributed == nil {
+					x.Contributed = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType == 0 {
+					var v VrfParticipationReason
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VrfParticipationReason(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Reasons = append(x.Reasons, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.Reasons) == 0 {
+						x.Reasons = make([]VrfParticipationReason, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v VrfParticipationReason
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= VrfParticipationReason(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Reasons = append(x.Reasons, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VrfParticipationReasonCount        protoreflect.MessageDescriptor
+	fd_VrfParticipationReasonCount_reason protoreflect.FieldDescriptor
+	fd_VrfParticipationReasonCount_count  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_VrfParticipationReasonCount = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfParticipationReasonCount")
+	fd_VrfParticipationReasonCount_reason = md_VrfParticipationReasonCount.Fields().ByName("reason")
+	fd_VrfParticipationReasonCount_count = md_VrfParticipationReasonCount.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfParticipationReasonCount)(nil)
+
+type fastReflection_VrfParticipationReasonCount VrfParticipationReasonCount
+
+func (x *VrfParticipationReasonCount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfParticipationReasonCount)(x)
+}
+
+func (x *VrfParticipationReasonCount) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfParticipationReasonCount_messageType fastReflection_VrfParticipationReasonCount_messageType
+var _ protoreflect.MessageType = fastReflection_VrfParticipationReasonCount_messageType{}
+
+type fastReflection_VrfParticipationReasonCount_messageType struct{}
+
+func (x fastReflection_VrfParticipationReasonCount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfParticipationReasonCount)(nil)
+}
+func (x fastReflection_VrfParticipationReasonCount_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipationReasonCount)
+}
+func (x fastReflection_VrfParticipationReasonCount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipationReasonCount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfParticipationReasonCount) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipationReasonCount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfParticipationReasonCount) Type() protoreflect.MessageType {
+	return _fastReflection_VrfParticipationReasonCount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfParticipationReasonCount) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipationReasonCount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfParticipationReasonCount) Interface() protoreflect.ProtoMessage {
+	return (*VrfParticipationReasonCount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfParticipationReasonCount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_VrfParticipationReasonCount_reason, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_VrfParticipationReasonCount_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfParticipationReasonCount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		return x.Reason != 0
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		return x.Count != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationReasonCount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		x.Reason = 0
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		x.Count = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfParticipationReasonCount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationReasonCount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		x.Reason = (VrfParticipationReason)(value.Enum())
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		x.Count = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationReasonCount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		panic(fmt.Errorf("field reason of message digitalkitchen.vrf.v1.VrfParticipationReasonCount is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		panic(fmt.Errorf("field count of message digitalkitchen.vrf.v1.VrfParticipationReasonCount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfParticipationReasonCount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationReasonCount.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationReasonCount"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationReasonCount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfParticipationReasonCount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfParticipationReasonCount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfParticipationReasonCount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationReasonCount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfParticipationReasonCount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfParticipationReasonCount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfParticipationReasonCount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipationReasonCount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipationReasonCount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipationReasonCount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipationReasonCount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= VrfParticipationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VrfParticipationSummary_4_list)(nil)
+
+type _VrfParticipationSummary_4_list struct {
+	list *[]*VrfParticipationReasonCount
+}
+
+func (x *_VrfParticipationSummary_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VrfParticipationSummary_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VrfParticipationSummary_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfParticipationReasonCount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VrfParticipationSummary_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VrfParticipationReasonCount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VrfParticipationSummary_4_list) AppendMutable() protoreflect.Value {
+	v := new(VrfParticipationReasonCount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParticipationSummary_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VrfParticipationSummary_4_list) NewElement() protoreflect.Value {
+	v := new(VrfParticipationReasonCount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VrfParticipationSummary_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VrfParticipationSummary                         protoreflect.MessageDescriptor
+	fd_VrfParticipationSummary_consensus_address       protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_heights                 protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_contributed             protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_reason_counts           protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_last_height             protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_last_reason             protoreflect.FieldDescriptor
+	fd_VrfParticipationSummary_last_contributed_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_VrfParticipationSummary = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("VrfParticipationSummary")
+	fd_VrfParticipationSummary_consensus_address = md_VrfParticipationSummary.Fields().ByName("consensus_address")
+	fd_VrfParticipationSummary_heights = md_VrfParticipationSummary.Fields().ByName("heights")
+	fd_VrfParticipationSummary_contributed = md_VrfParticipationSummary.Fields().ByName("contributed")
+	fd_VrfParticipationSummary_reason_counts = md_VrfParticipationSummary.Fields().ByName("reason_counts")
+	fd_VrfParticipationSummary_last_height = md_VrfParticipationSummary.Fields().ByName("last_height")
+	fd_VrfParticipationSummary_last_reason = md_VrfParticipationSummary.Fields().ByName("last_reason")
+	fd_VrfParticipationSummary_last_contributed_height = md_VrfParticipationSummary.Fields().ByName("last_contributed_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_VrfParticipationSummary)(nil)
+
+type fastReflection_VrfParticipationSummary VrfParticipationSummary
+
+func (x *VrfParticipationSummary) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VrfParticipationSummary)(x)
+}
+
+func (x *VrfParticipationSummary) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VrfParticipationSummary_messageType fastReflection_VrfParticipationSummary_messageType
+var _ protoreflect.MessageType = fastReflection_VrfParticipationSummary_messageType{}
+
+type fastReflection_VrfParticipationSummary_messageType struct{}
+
+func (x fastReflection_VrfParticipationSummary_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VrfParticipationSummary)(nil)
+}
+func (x fastReflection_VrfParticipationSummary_messageType) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipationSummary)
+}
+func (x fastReflection_VrfParticipationSummary_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipationSummary
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VrfParticipationSummary) Descriptor() protoreflect.MessageDescriptor {
+	return md_VrfParticipationSummary
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VrfParticipationSummary) Type() protoreflect.MessageType {
+	return _fastReflection_VrfParticipationSummary_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VrfParticipationSummary) New() protoreflect.Message {
+	return new(fastReflection_VrfParticipationSummary)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VrfParticipationSummary) Interface() protoreflect.ProtoMessage {
+	return (*VrfParticipationSummary)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VrfParticipationSummary) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsensusAddress != "" {
+		value := protoreflect.ValueOfString(x.ConsensusAddress)
+		if !f(fd_VrfParticipationSummary_consensus_address, value) {
+			return
+		}
+	}
+	if x.Heights != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Heights)
+		if !f(fd_VrfParticipationSummary_heights, value) {
+			return
+		}
+	}
+	if x.Contributed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Contributed)
+		if !f(fd_VrfParticipationSummary_contributed, value) {
+			return
+		}
+	}
+	if len(x.ReasonCounts) != 0 {
+		value := protoreflect.ValueOfList(&_VrfParticipationSummary_4_list{list: &x.ReasonCounts})
+		if !f(fd_VrfParticipationSummary_reason_counts, value) {
+			return
+		}
+	}
+	if x.LastHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastHeight)
+		if !f(fd_VrfParticipationSummary_last_height, value) {
+			return
+		}
+	}
+	if x.LastReason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.LastReason))
+		if !f(fd_VrfParticipationSummary_last_reason, value) {
+			return
+		}
+	}
+	if x.LastContributedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastContributedHeight)
+		if !f(fd_VrfParticipationSummary_last_contributed_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VrfParticipationSummary) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		return x.ConsensusAddress != ""
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		return x.Heights != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		return x.Contributed != uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		return len(x.ReasonCounts) != 0
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		return x.LastHeight != int64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		return x.LastReason != 0
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		return x.LastContributedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationSummary) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		x.ConsensusAddress = ""
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		x.Heights = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		x.Contributed = uint64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		x.ReasonCounts = nil
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		x.LastHeight = int64(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		x.LastReason = 0
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		x.LastContributedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VrfParticipationSummary) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		value := x.ConsensusAddress
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		value := x.Heights
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		value := x.Contributed
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		if len(x.ReasonCounts) == 0 {
+			return protoreflect.ValueOfList(&_VrfParticipationSummary_4_list{})
+		}
+		listValue := &_VrfParticipationSummary_4_list{list: &x.ReasonCounts}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		value := x.LastReason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		value := x.LastContributedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationSummary) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		x.ConsensusAddress = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		x.Heights = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		x.Contributed = value.Uint()
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		lv := value.List()
+		clv := lv.(*_VrfParticipationSummary_4_list)
+		x.ReasonCounts = *clv.list
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		x.LastHeight = value.Int()
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		x.LastReason = (VrfParticipationReason)(value.Enum())
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		x.LastContributedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationSummary) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		if x.ReasonCounts == nil {
+			x.ReasonCounts = []*VrfParticipationReasonCount{}
+		}
+		value := &_VrfParticipationSummary_4_list{list: &x.ReasonCounts}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		panic(fmt.Errorf("field consensus_address of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		panic(fmt.Errorf("field heights of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		panic(fmt.Errorf("field contributed of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		panic(fmt.Errorf("field last_height of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		panic(fmt.Errorf("field last_reason of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		panic(fmt.Errorf("field last_contributed_height of message digitalkitchen.vrf.v1.VrfParticipationSummary is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VrfParticipationSummary) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.consensus_address":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.heights":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.contributed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts":
+		list := []*VrfParticipationReasonCount{}
+		return protoreflect.ValueOfList(&_VrfParticipationSummary_4_list{list: &list})
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason":
+		return protoreflect.ValueOfEnum(0)
+	case "digitalkitchen.vrf.v1.VrfParticipationSummary.last_contributed_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.VrfParticipationSummary"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.VrfParticipationSummary does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VrfParticipationSummary) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.VrfParticipationSummary", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VrfParticipationSummary) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VrfParticipationSummary) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VrfParticipationSummary) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VrfParticipationSummary) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VrfParticipationSummary)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ConsensusAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Heights != 0 {
+			n += 1 + runtime.Sov(uint64(x.Heights))
+		}
+		if x.Contributed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Contributed))
+		}
+		if len(x.ReasonCounts) > 0 {
+			for _, e := range x.ReasonCounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.LastReason != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastReason))
+		}
+		if x.LastContributedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastContributedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipationSummary)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastContributedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastContributedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.LastReason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastReason))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ReasonCounts) > 0 {
+			for iNdEx := len(x.ReasonCounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReasonCounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Contributed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Contributed))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Heights != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Heights))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ConsensusAddress) > 0 {
+			i -= len(x.ConsensusAddress)
+			copy(dAtA[i:], x.ConsensusAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsensusAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VrfParticipationSummary)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipationSummary: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VrfParticipationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+				}
+				x.Heights = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Heights |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
+				}
+				x.Contributed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Contributed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReasonCounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReasonCounts = append(x.ReasonCounts, &VrfParticipationReasonCount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReasonCounts[len(x.ReasonCounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastReason", wireType)
+				}
+				x.LastReason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastReason |= VrfParticipationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastContributedHeight", wireType)
+				}
+				x.LastContributedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastContributedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RandomnessRequest_5_list)(nil)
+
+type _RandomnessRequest_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RandomnessRequest_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RandomnessRequest_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RandomnessRequest_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RandomnessRequest_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RandomnessRequest_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RandomnessRequest_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RandomnessRequest_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RandomnessRequest_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RandomnessRequest_11_list)(nil)
+
+type _RandomnessRequest_11_list struct {
+	list *[][]byte
+}
+
+func (x *_RandomnessRequest_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RandomnessRequest_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_RandomnessRequest_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RandomnessRequest_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RandomnessRequest_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RandomnessRequest at list field Words as it is not of Message kind"))
+}
+
+func (x *_RandomnessRequest_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RandomnessRequest_11_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_RandomnessRequest_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RandomnessRequest                  protoreflect.MessageDescriptor
+	fd_RandomnessRequest_id               protoreflect.FieldDescriptor
+	fd_RandomnessRequest_requester        protoreflect.FieldDescriptor
+	fd_RandomnessRequest_num_words        protoreflect.FieldDescriptor
+	fd_RandomnessRequest_user_seed        protoreflect.FieldDescriptor
+	fd_RandomnessRequest_fee              protoreflect.FieldDescriptor
+	fd_RandomnessRequest_min_drand_round  protoreflect.FieldDescriptor
+	fd_RandomnessRequest_request_height   protoreflect.FieldDescriptor
+	fd_RandomnessRequest_status           protoreflect.FieldDescriptor
+	fd_RandomnessRequest_drand_round      protoreflect.FieldDescriptor
+	fd_RandomnessRequest_fulfilled_height protoreflect.FieldDescriptor
+	fd_RandomnessRequest_words            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_RandomnessRequest = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("RandomnessRequest")
+	fd_RandomnessRequest_id = md_RandomnessRequest.Fields().ByName("id")
+	fd_RandomnessRequest_requester = md_RandomnessRequest.Fields().ByName("requester")
+	fd_RandomnessRequest_num_words = md_RandomnessRequest.Fields().ByName("num_words")
+	fd_RandomnessRequest_user_seed = md_RandomnessRequest.Fields().ByName("user_seed")
+	fd_RandomnessRequest_fee = md_RandomnessRequest.Fields().ByName("fee")
+	fd_RandomnessRequest_min_drand_round = md_RandomnessRequest.Fields().ByName("min_drand_round")
+	fd_RandomnessRequest_request_height = md_RandomnessRequest.Fields().ByName("request_height")
+	fd_RandomnessRequest_status = md_RandomnessRequest.Fields().ByName("status")
+	fd_RandomnessRequest_drand_round = md_RandomnessRequest.Fields().ByName("drand_round")
+	fd_RandomnessRequest_fulfilled_height = md_RandomnessRequest.Fields().ByName("fulfilled_height")
+	fd_RandomnessRequest_words = md_RandomnessRequest.Fields().ByName("words")
+}
+
+var _ protoreflect.Message = (*fastReflection_RandomnessRequest)(nil)
+
+type fastReflection_RandomnessRequest RandomnessRequest
+
+func (x *RandomnessRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RandomnessRequest)(x)
+}
+
+func (x *RandomnessRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RandomnessRequest_messageType fastReflection_RandomnessRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RandomnessRequest_messageType{}
+
+type fastReflection_RandomnessRequest_messageType struct{}
+
+func (x fastReflection_RandomnessRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RandomnessRequest)(nil)
+}
+func (x fastReflection_RandomnessRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RandomnessRequest)
+}
+func (x fastReflection_RandomnessRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RandomnessRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RandomnessRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RandomnessRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RandomnessRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RandomnessRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RandomnessRequest) New() protoreflect.Message {
+	return new(fastReflection_RandomnessRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RandomnessRequest) Interface() protoreflect.ProtoMessage {
+	return (*RandomnessRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RandomnessRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RandomnessRequest_id, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_RandomnessRequest_requester, value) {
+			return
+		}
+	}
+	if x.NumWords != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NumWords)
+		if !f(fd_RandomnessRequest_num_words, value) {
+			return
+		}
+	}
+	if len(x.UserSeed) != 0 {
+		value := protoreflect.ValueOfBytes(x.UserSeed)
+		if !f(fd_RandomnessRequest_user_seed, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_RandomnessRequest_5_list{list: &x.Fee})
+		if !f(fd_RandomnessRequest_fee, value) {
+			return
+		}
+	}
+	if x.MinDrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinDrandRound)
+		if !f(fd_RandomnessRequest_min_drand_round, value) {
+			return
+		}
+	}
+	if x.RequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestHeight)
+		if !f(fd_RandomnessRequest_request_height, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_RandomnessRequest_status, value) {
+			return
+		}
+	}
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_RandomnessRequest_drand_round, value) {
+			return
+		}
+	}
+	if x.FulfilledHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FulfilledHeight)
+		if !f(fd_RandomnessRequest_fulfilled_height, value) {
+			return
+		}
+	}
+	if len(x.Words) != 0 {
+		value := protoreflect.ValueOfList(&_RandomnessRequest_11_list{list: &x.Words})
+		if !f(fd_RandomnessRequest_words, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RandomnessRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		return x.Id != uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		return x.Requester != ""
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		return x.NumWords != uint32(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		return len(x.UserSeed) != 0
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		return len(x.Fee) != 0
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		return x.MinDrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		return x.RequestHeight != int64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		return x.Status != 0
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		return x.FulfilledHeight != int64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		return len(x.Words) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RandomnessRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		x.Id = uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		x.Requester = ""
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		x.NumWords = uint32(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		x.UserSeed = nil
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		x.Fee = nil
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		x.MinDrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		x.RequestHeight = int64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		x.Status = 0
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		x.FulfilledHeight = int64(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		x.Words = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RandomnessRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		value := x.NumWords
+		return protoreflect.ValueOfUint32(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		value := x.UserSeed
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_RandomnessRequest_5_list{})
+		}
+		listValue := &_RandomnessRequest_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		value := x.MinDrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		value := x.RequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		value := x.FulfilledHeight
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		if len(x.Words) == 0 {
+			return protoreflect.ValueOfList(&_RandomnessRequest_11_list{})
+		}
+		listValue := &_RandomnessRequest_11_list{list: &x.Words}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RandomnessRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		x.Id = value.Uint()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		x.Requester = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		x.NumWords = uint32(value.Uint())
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		x.UserSeed = value.Bytes()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		lv := value.List()
+		clv := lv.(*_RandomnessRequest_5_list)
+		x.Fee = *clv.list
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		x.MinDrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		x.RequestHeight = value.Int()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		x.Status = (RandomnessRequestStatus)(value.Enum())
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		x.FulfilledHeight = value.Int()
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		lv := value.List()
+		clv := lv.(*_RandomnessRequest_11_list)
+		x.Words = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RandomnessRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_RandomnessRequest_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		if x.Words == nil {
+			x.Words = [][]byte{}
+		}
+		value := &_RandomnessRequest_11_list{list: &x.Words}
+		return protoreflect.ValueOfList(value)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		panic(fmt.Errorf("field id of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		panic(fmt.Errorf("field requester of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		panic(fmt.Errorf("field num_words of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		panic(fmt.Errorf("field user_seed of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		panic(fmt.Errorf("field min_drand_round of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		panic(fmt.Errorf("field request_height of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		panic(fmt.Errorf("field status of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		panic(fmt.Errorf("field fulfilled_height of message digitalkitchen.vrf.v1.RandomnessRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RandomnessRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.RandomnessRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.requester":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.RandomnessRequest.num_words":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.user_seed":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RandomnessRequest_5_list{list: &list})
+	case "digitalkitchen.vrf.v1.RandomnessRequest.min_drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "digitalkitchen.vrf.v1.RandomnessRequest.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.fulfilled_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.RandomnessRequest.words":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_RandomnessRequest_11_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.RandomnessRequest"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.RandomnessRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RandomnessRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.RandomnessRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RandomnessRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RandomnessRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RandomnessRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RandomnessRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RandomnessRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumWords != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumWords))
+		}
+		l = len(x.UserSeed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinDrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinDrandRound))
+		}
+		if x.RequestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestHeight))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		if x.FulfilledHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FulfilledHeight))
+		}
+		if len(x.Words) > 0 {
+			for _, b := range x.Words {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RandomnessRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Words) > 0 {
+			for iNdEx := len(x.Words) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Words[iNdEx])
+				copy(dAtA[i:], x.Words[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Words[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.FulfilledHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FulfilledHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.RequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MinDrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinDrandRound))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.UserSeed) > 0 {
+			i -= len(x.UserSeed)
+			copy(dAtA[i:], x.UserSeed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UserSeed)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.NumWords != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumWords))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RandomnessRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RandomnessRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumWords", wireType)
+				}
+				x.NumWords = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumWords |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UserSeed = append(x.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+				if x.UserSeed == nil {
+					x.UserSeed = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDrandRound", wireType)
+				}
+				x.MinDrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinDrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				}
+				x.RequestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= RandomnessRequestStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FulfilledHeight", wireType)
+				}
+				x.FulfilledHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FulfilledHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Words = append(x.Words, make([]byte, postIndex-iNdEx))
+				copy(x.Words[len(x.Words)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TimelockCiphertext         protoreflect.MessageDescriptor
+	fd_TimelockCiphertext_u       protoreflect.FieldDescriptor
+	fd_TimelockCiphertext_v       protoreflect.FieldDescriptor
+	fd_TimelockCiphertext_w       protoreflect.FieldDescriptor
+	fd_TimelockCiphertext_payload protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_TimelockCiphertext = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("TimelockCiphertext")
+	fd_TimelockCiphertext_u = md_TimelockCiphertext.Fields().ByName("u")
+	fd_TimelockCiphertext_v = md_TimelockCiphertext.Fields().ByName("v")
+	fd_TimelockCiphertext_w = md_TimelockCiphertext.Fields().ByName("w")
+	fd_TimelockCiphertext_payload = md_TimelockCiphertext.Fields().ByName("payload")
+}
+
+var _ protoreflect.Message = (*fastReflection_TimelockCiphertext)(nil)
+
+type fastReflection_TimelockCiphertext TimelockCiphertext
+
+func (x *TimelockCiphertext) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TimelockCiphertext)(x)
+}
+
+func (x *TimelockCiphertext) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TimelockCiphertext_messageType fastReflection_TimelockCiphertext_messageType
+var _ protoreflect.MessageType = fastReflection_TimelockCiphertext_messageType{}
+
+type fastReflection_TimelockCiphertext_messageType struct{}
+
+func (x fastReflection_TimelockCiphertext_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TimelockCiphertext)(nil)
+}
+func (x fastReflection_TimelockCiphertext_messageType) New() protoreflect.Message {
+	return new(fastReflection_TimelockCiphertext)
+}
+func (x fastReflection_TimelockCiphertext_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockCiphertext
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TimelockCiphertext) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockCiphertext
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TimelockCiphertext) Type() protoreflect.MessageType {
+	return _fastReflection_TimelockCiphertext_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TimelockCiphertext) New() protoreflect.Message {
+	return new(fastReflection_TimelockCiphertext)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TimelockCiphertext) Interface() protoreflect.ProtoMessage {
+	return (*TimelockCiphertext)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TimelockCiphertext) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.U) != 0 {
+		value := protoreflect.ValueOfBytes(x.U)
+		if !f(fd_TimelockCiphertext_u, value) {
+			return
+		}
+	}
+	if len(x.V) != 0 {
+		value := protoreflect.ValueOfBytes(x.V)
+		if !f(fd_TimelockCiphertext_v, value) {
+			return
+		}
+	}
+	if len(x.W) != 0 {
+		value := protoreflect.ValueOfBytes(x.W)
+		if !f(fd_TimelockCiphertext_w, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_TimelockCiphertext_payload, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TimelockCiphertext) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		return len(x.U) != 0
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		return len(x.V) != 0
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		return len(x.W) != 0
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		return len(x.Payload) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockCiphertext) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		x.U = nil
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		x.V = nil
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		x.W = nil
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		x.Payload = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TimelockCiphertext) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		value := x.U
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		value := x.V
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		value := x.W
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockCiphertext) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		x.U = value.Bytes()
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		x.V = value.Bytes()
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		x.W = value.Bytes()
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		x.Payload = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockCiphertext) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		panic(fmt.Errorf("field u of message digitalkitchen.vrf.v1.TimelockCiphertext is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		panic(fmt.Errorf("field v of message digitalkitchen.vrf.v1.TimelockCiphertext is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		panic(fmt.Errorf("field w of message digitalkitchen.vrf.v1.TimelockCiphertext is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		panic(fmt.Errorf("field payload of message digitalkitchen.vrf.v1.TimelockCiphertext is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TimelockCiphertext) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.u":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.v":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.w":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.TimelockCiphertext.payload":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockCiphertext"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockCiphertext does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TimelockCiphertext) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.TimelockCiphertext", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TimelockCiphertext) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockCiphertext) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TimelockCiphertext) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TimelockCiphertext) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TimelockCiphertext)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.U)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.V)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.W)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockCiphertext)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.W) > 0 {
+			i -= len(x.W)
+			copy(dAtA[i:], x.W)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.W)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.V) > 0 {
+			i -= len(x.V)
+			copy(dAtA[i:], x.V)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.V)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.U) > 0 {
+			i -= len(x.U)
+			copy(dAtA[i:], x.U)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.U)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockCiphertext)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockCiphertext: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockCiphertext: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field U", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.U = append(x.U[:0], dAtA[iNdEx:postIndex]...)
+				if x.U == nil {
+					x.U = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.V = append(x.V[:0], dAtA[iNdEx:postIndex]...)
+				if x.V == nil {
+					x.V = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field W", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.W = append(x.W[:0], dAtA[iNdEx:postIndex]...)
+				if x.W == nil {
+					x.W = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TimelockedMessage                  protoreflect.MessageDescriptor
+	fd_TimelockedMessage_id               protoreflect.FieldDescriptor
+	fd_TimelockedMessage_submitter        protoreflect.FieldDescriptor
+	fd_TimelockedMessage_drand_round      protoreflect.FieldDescriptor
+	fd_TimelockedMessage_ciphertext       protoreflect.FieldDescriptor
+	fd_TimelockedMessage_submit_height    protoreflect.FieldDescriptor
+	fd_TimelockedMessage_status           protoreflect.FieldDescriptor
+	fd_TimelockedMessage_plaintext        protoreflect.FieldDescriptor
+	fd_TimelockedMessage_decrypted_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_digitalkitchen_vrf_v1_vrf_proto_init()
+	md_TimelockedMessage = File_digitalkitchen_vrf_v1_vrf_proto.Messages().ByName("TimelockedMessage")
+	fd_TimelockedMessage_id = md_TimelockedMessage.Fields().ByName("id")
+	fd_TimelockedMessage_submitter = md_TimelockedMessage.Fields().ByName("submitter")
+	fd_TimelockedMessage_drand_round = md_TimelockedMessage.Fields().ByName("drand_round")
+	fd_TimelockedMessage_ciphertext = md_TimelockedMessage.Fields().ByName("ciphertext")
+	fd_TimelockedMessage_submit_height = md_TimelockedMessage.Fields().ByName("submit_height")
+	fd_TimelockedMessage_status = md_TimelockedMessage.Fields().ByName("status")
+	fd_TimelockedMessage_plaintext = md_TimelockedMessage.Fields().ByName("plaintext")
+	fd_TimelockedMessage_decrypted_height = md_TimelockedMessage.Fields().ByName("decrypted_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_TimelockedMessage)(nil)
+
+type fastReflection_TimelockedMessage TimelockedMessage
+
+func (x *TimelockedMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TimelockedMessage)(x)
+}
+
+func (x *TimelockedMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TimelockedMessage_messageType fastReflection_TimelockedMessage_messageType
+var _ protoreflect.MessageType = fastReflection_TimelockedMessage_messageType{}
+
+type fastReflection_TimelockedMessage_messageType struct{}
+
+func (x fastReflection_TimelockedMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TimelockedMessage)(nil)
+}
+func (x fastReflection_TimelockedMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_TimelockedMessage)
+}
+func (x fastReflection_TimelockedMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockedMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TimelockedMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimelockedMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TimelockedMessage) Type() protoreflect.MessageType {
+	return _fastReflection_TimelockedMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TimelockedMessage) New() protoreflect.Message {
+	return new(fastReflection_TimelockedMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TimelockedMessage) Interface() protoreflect.ProtoMessage {
+	return (*TimelockedMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TimelockedMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_TimelockedMessage_id, value) {
+			return
+		}
+	}
+	if x.Submitter != "" {
+		value := protoreflect.ValueOfString(x.Submitter)
+		if !f(fd_TimelockedMessage_submitter, value) {
+			return
+		}
+	}
+	if x.DrandRound != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrandRound)
+		if !f(fd_TimelockedMessage_drand_round, value) {
+			return
+		}
+	}
+	if x.Ciphertext != nil {
+		value := protoreflect.ValueOfMessage(x.Ciphertext.ProtoReflect())
+		if !f(fd_TimelockedMessage_ciphertext, value) {
+			return
+		}
+	}
+	if x.SubmitHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SubmitHeight)
+		if !f(fd_TimelockedMessage_submit_height, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_TimelockedMessage_status, value) {
+			return
+		}
+	}
+	if len(x.Plaintext) != 0 {
+		value := protoreflect.ValueOfBytes(x.Plaintext)
+		if !f(fd_TimelockedMessage_plaintext, value) {
+			return
+		}
+	}
+	if x.DecryptedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DecryptedHeight)
+		if !f(fd_TimelockedMessage_decrypted_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TimelockedMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		return x.Id != uint64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		return x.Submitter != ""
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		return x.DrandRound != uint64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		return x.Ciphertext != nil
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		return x.SubmitHeight != int64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		return x.Status != 0
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		return len(x.Plaintext) != 0
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		return x.DecryptedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		x.Id = uint64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		x.Submitter = ""
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		x.DrandRound = uint64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		x.Ciphertext = nil
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		x.SubmitHeight = int64(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		x.Status = 0
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		x.Plaintext = nil
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		x.DecryptedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TimelockedMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		value := x.Submitter
+		return protoreflect.ValueOfString(value)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		value := x.DrandRound
+		return protoreflect.ValueOfUint64(value)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		value := x.Ciphertext
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		value := x.SubmitHeight
+		return protoreflect.ValueOfInt64(value)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		value := x.Plaintext
+		return protoreflect.ValueOfBytes(value)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		value := x.DecryptedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		x.Id = value.Uint()
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		x.Submitter = value.Interface().(string)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		x.DrandRound = value.Uint()
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		x.Ciphertext = value.Message().Interface().(*TimelockCiphertext)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		x.SubmitHeight = value.Int()
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		x.Status = (TimelockStatus)(value.Enum())
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		x.Plaintext = value.Bytes()
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		x.DecryptedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		if x.Ciphertext == nil {
+			x.Ciphertext = new(TimelockCiphertext)
+		}
+		return protoreflect.ValueOfMessage(x.Ciphertext.ProtoReflect())
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		panic(fmt.Errorf("field id of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		panic(fmt.Errorf("field submitter of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		panic(fmt.Errorf("field drand_round of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		panic(fmt.Errorf("field submit_height of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		panic(fmt.Errorf("field status of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		panic(fmt.Errorf("field plaintext of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		panic(fmt.Errorf("field decrypted_height of message digitalkitchen.vrf.v1.TimelockedMessage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TimelockedMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "digitalkitchen.vrf.v1.TimelockedMessage.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submitter":
+		return protoreflect.ValueOfString("")
+	case "digitalkitchen.vrf.v1.TimelockedMessage.drand_round":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.ciphertext":
+		m := new(TimelockCiphertext)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "digitalkitchen.vrf.v1.TimelockedMessage.submit_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "digitalkitchen.vrf.v1.TimelockedMessage.status":
+		return protoreflect.ValueOfEnum(0)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.plaintext":
+		return protoreflect.ValueOfBytes(nil)
+	case "digitalkitchen.vrf.v1.TimelockedMessage.decrypted_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: digitalkitchen.vrf.v1.TimelockedMessage"))
+		}
+		panic(fmt.Errorf("message digitalkitchen.vrf.v1.TimelockedMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TimelockedMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in digitalkitchen.vrf.v1.TimelockedMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TimelockedMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimelockedMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TimelockedMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TimelockedMessage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TimelockedMessage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Submitter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DrandRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrandRound))
+		}
+		if x.Ciphertext != nil {
+			l = options.Size(x.Ciphertext)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmitHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitHeight))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Plaintext)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecryptedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecryptedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockedMessage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DecryptedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecryptedHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Plaintext) > 0 {
+			i -= len(x.Plaintext)
+			copy(dAtA[i:], x.Plaintext)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Plaintext)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SubmitHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Ciphertext != nil {
+			encoded, err := options.Marshal(x.Ciphertext)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DrandRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrandRound))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Submitter) > 0 {
+			i -= len(x.Submitter)
+			copy(dAtA[i:], x.Submitter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Submitter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TimelockedMessage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockedMessage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimelockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Submitter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				}
+				x.DrandRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrandRound |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Ciphertext == nil {
+					x.Ciphertext = &TimelockCiphertext{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Ciphertext); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+				}
+				x.SubmitHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TimelockStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Plaintext", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Plaintext = append(x.Plaintext[:0], dAtA[iNdEx:postIndex]...)
+				if x.Plaintext == nil {
+					x.Plaintext = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecryptedHeight", wireType)
+				}
+				x.DecryptedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecryptedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: digitalkitchen/vrf/v1/vrf.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VrfBeaconSource identifies the origin of a VrfBeacon.
+type VrfBeaconSource int32
+
+const (
+	// VRF_BEACON_SOURCE_DRAND marks a verified drand beacon. It is the zero
+	// value so that beacons stored before sources existed read as drand.
+	VrfBeaconSource_VRF_BEACON_SOURCE_DRAND VrfBeaconSource = 0
+	// VRF_BEACON_SOURCE_ECVRF marks the weaker fallback randomness combined from
+	// the validators' ECVRF proofs while no drand round reached quorum. Its
+	// drand_round and signatures are empty.
+	VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF VrfBeaconSource = 1
+)
+
+// Enum value maps for VrfBeaconSource.
+var (
+	VrfBeaconSource_name = map[int32]string{
+		0: "VRF_BEACON_SOURCE_DRAND",
+		1: "VRF_BEACON_SOURCE_ECVRF",
+	}
+	VrfBeaconSource_value = map[string]int32{
+		"VRF_BEACON_SOURCE_DRAND": 0,
+		"VRF_BEACON_SOURCE_ECVRF": 1,
+	}
+)
+
+func (x VrfBeaconSource) Enum() *VrfBeaconSource {
+	p := new(VrfBeaconSource)
+	*p = x
+	return p
+}
+
+func (x VrfBeaconSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VrfBeaconSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[0].Descriptor()
+}
+
+func (VrfBeaconSource) Type() protoreflect.EnumType {
+	return &file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[0]
+}
+
+func (x VrfBeaconSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VrfBeaconSource.Descriptor instead.
+func (VrfBeaconSource) EnumDescriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{0}
+}
+
+// VrfCommitteeRole is a permission of a committee member. The module
+// authority holds every role.
+type VrfCommitteeRole int32
+
+const (
+	// VRF_COMMITTEE_ROLE_UNSPECIFIED is not a valid role.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED VrfCommitteeRole = 0
+	// VRF_COMMITTEE_ROLE_EMERGENCY allows MsgVrfEmergencyDisable.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY VrfCommitteeRole = 1
+	// VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP allows MsgInitialDkg.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP VrfCommitteeRole = 2
+	// VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER allows MsgScheduleVrfReshare.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER VrfCommitteeRole = 3
+)
+
+// Enum value maps for VrfCommitteeRole.
+var (
+	VrfCommitteeRole_name = map[int32]string{
+		0: "VRF_COMMITTEE_ROLE_UNSPECIFIED",
+		1: "VRF_COMMITTEE_ROLE_EMERGENCY",
+		2: "VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP",
+		3: "VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER",
+	}
+	VrfCommitteeRole_value = map[string]int32{
+		"VRF_COMMITTEE_ROLE_UNSPECIFIED":       0,
+		"VRF_COMMITTEE_ROLE_EMERGENCY":         1,
+		"VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP":     2,
+		"VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER": 3,
+	}
+)
+
+func (x VrfCommitteeRole) Enum() *VrfCommitteeRole {
+	p := new(VrfCommitteeRole)
+	*p = x
+	return p
+}
+
+func (x VrfCommitteeRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VrfCommitteeRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[1].Descriptor()
+}
+
+func (VrfCommitteeRole) Type() protoreflect.EnumType {
+	return &file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[1]
+}
+
+func (x VrfCommitteeRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VrfCommitteeRole.Descriptor instead.
+func (VrfCommitteeRole) EnumDescriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{1}
+}
+
+// VrfParticipationReason classifies a validator's vote extension at a height.
+type VrfParticipationReason int32
+
+const (
+	// VRF_PARTICIPATION_REASON_UNSPECIFIED is the zero value and is never recorded.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED VrfParticipationReason = 0
+	// VRF_PARTICIPATION_REASON_CONTRIBUTED marks a valid beacon for the finalized round.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED VrfParticipationReason = 1
+	// VRF_PARTICIPATION_REASON_ABSENT marks a validator that did not commit a vote.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_ABSENT VrfParticipationReason = 2
+	// VRF_PARTICIPATION_REASON_EMPTY marks a committed vote without an extension.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY VrfParticipationReason = 3
+	// VRF_PARTICIPATION_REASON_DECODE_FAILED marks an extension that could not be
+	// decoded or has an unsupported version.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED VrfParticipationReason = 4
+	// VRF_PARTICIPATION_REASON_WRONG_ROUND marks an extension without any beacon
+	// inside the round_tolerance window.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND VrfParticipationReason = 5
+	// VRF_PARTICIPATION_REASON_HASH_MISMATCH marks an extension whose beacons
+	// failed the randomness == SHA256(signature) check.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_HASH_MISMATCH VrfParticipationReason = 6
+	// VRF_PARTICIPATION_REASON_BLS_FAILED marks an extension whose beacons failed
+	// BLS verification.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED VrfParticipationReason = 7
+	// VRF_PARTICIPATION_REASON_OTHER_ROUND marks an extension with valid beacons,
+	// none of which is for the finalized round.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_OTHER_ROUND VrfParticipationReason = 8
+	// VRF_PARTICIPATION_REASON_UNKNOWN_SHARE marks a partial signature from a
+	// validator without a registered identity or with a mismatching share index.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE VrfParticipationReason = 9
+	// VRF_PARTICIPATION_REASON_ECVRF_ONLY marks an extension carrying only an
+	// ECVRF fallback proof, sent when the validator could not reach drand.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_ECVRF_ONLY VrfParticipationReason = 10
+)
+
+// Enum value maps for VrfParticipationReason.
+var (
+	VrfParticipationReason_name = map[int32]string{
+		0:  "VRF_PARTICIPATION_REASON_UNSPECIFIED",
+		1:  "VRF_PARTICIPATION_REASON_CONTRIBUTED",
+		2:  "VRF_PARTICIPATION_REASON_ABSENT",
+		3:  "VRF_PARTICIPATION_REASON_EMPTY",
+		4:  "VRF_PARTICIPATION_REASON_DECODE_FAILED",
+		5:  "VRF_PARTICIPATION_REASON_WRONG_ROUND",
+		6:  "VRF_PARTICIPATION_REASON_HASH_MISMATCH",
+		7:  "VRF_PARTICIPATION_REASON_BLS_FAILED",
+		8:  "VRF_PARTICIPATION_REASON_OTHER_ROUND",
+		9:  "VRF_PARTICIPATION_REASON_UNKNOWN_SHARE",
+		10: "VRF_PARTICIPATION_REASON_ECVRF_ONLY",
+	}
+	VrfParticipationReason_value = map[string]int32{
+		"VRF_PARTICIPATION_REASON_UNSPECIFIED":   0,
+		"VRF_PARTICIPATION_REASON_CONTRIBUTED":   1,
+		"VRF_PARTICIPATION_REASON_ABSENT":        2,
+		"VRF_PARTICIPATION_REASON_EMPTY":         3,
+		"VRF_PARTICIPATION_REASON_DECODE_FAILED": 4,
+		"VRF_PARTICIPATION_REASON_WRONG_ROUND":   5,
+		"VRF_PARTICIPATION_REASON_HASH_MISMATCH": 6,
+		"VRF_PARTICIPATION_REASON_BLS_FAILED":    7,
+		"VRF_PARTICIPATION_REASON_OTHER_ROUND":   8,
+		"VRF_PARTICIPATION_REASON_UNKNOWN_SHARE": 9,
+		"VRF_PARTICIPATION_REASON_ECVRF_ONLY":    10,
+	}
+)
+
+func (x VrfParticipationReason) Enum() *VrfParticipationReason {
+	p := new(VrfParticipationReason)
+	*p = x
+	return p
+}
+
+func (x VrfParticipationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VrfParticipationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[2].Descriptor()
+}
+
+func (VrfParticipationReason) Type() protoreflect.EnumType {
+	return &file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[2]
+}
+
+func (x VrfParticipationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VrfParticipationReason.Descriptor instead.
+func (VrfParticipationReason) EnumDescriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{2}
+}
+
+// RandomnessRequestStatus is the lifecycle state of a paid randomness request.
+type RandomnessRequestStatus int32
+
+const (
+	// RANDOMNESS_REQUEST_STATUS_UNSPECIFIED is the zero value and is never stored.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_UNSPECIFIED RandomnessRequestStatus = 0
+	// RANDOMNESS_REQUEST_STATUS_PENDING waits for a beacon at or above min_drand_round.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING RandomnessRequestStatus = 1
+	// RANDOMNESS_REQUEST_STATUS_FULFILLED carries words derived from the
+	// fulfilling beacon; the fee was paid to its contributors.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_FULFILLED RandomnessRequestStatus = 2
+	// RANDOMNESS_REQUEST_STATUS_REFUNDED was refunded because VRF was disabled
+	// before fulfillment.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_REFUNDED RandomnessRequestStatus = 3
+)
+
+// Enum value maps for RandomnessRequestStatus.
+var (
+	RandomnessRequestStatus_name = map[int32]string{
+		0: "RANDOMNESS_REQUEST_STATUS_UNSPECIFIED",
+		1: "RANDOMNESS_REQUEST_STATUS_PENDING",
+		2: "RANDOMNESS_REQUEST_STATUS_FULFILLED",
+		3: "RANDOMNESS_REQUEST_STATUS_REFUNDED",
+	}
+	RandomnessRequestStatus_value = map[string]int32{
+		"RANDOMNESS_REQUEST_STATUS_UNSPECIFIED": 0,
+		"RANDOMNESS_REQUEST_STATUS_PENDING":     1,
+		"RANDOMNESS_REQUEST_STATUS_FULFILLED":   2,
+		"RANDOMNESS_REQUEST_STATUS_REFUNDED":    3,
+	}
+)
+
+func (x RandomnessRequestStatus) Enum() *RandomnessRequestStatus {
+	p := new(RandomnessRequestStatus)
+	*p = x
+	return p
+}
+
+func (x RandomnessRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RandomnessRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[3].Descriptor()
+}
+
+func (RandomnessRequestStatus) Type() protoreflect.EnumType {
+	return &file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[3]
+}
+
+func (x RandomnessRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RandomnessRequestStatus.Descriptor instead.
+func (RandomnessRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{3}
+}
+
+// TimelockStatus is the lifecycle state of a time-locked ciphertext.
+type TimelockStatus int32
+
+const (
+	// TIMELOCK_STATUS_UNSPECIFIED is the zero value and is never stored.
+	TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED TimelockStatus = 0
+	// TIMELOCK_STATUS_PENDING waits for drand_round to be finalized.
+	TimelockStatus_TIMELOCK_STATUS_PENDING TimelockStatus = 1
+	// TIMELOCK_STATUS_DECRYPTED carries the plaintext.
+	TimelockStatus_TIMELOCK_STATUS_DECRYPTED TimelockStatus = 2
+	// TIMELOCK_STATUS_FAILED could not be decrypted with the round signature.
+	TimelockStatus_TIMELOCK_STATUS_FAILED TimelockStatus = 3
+	// TIMELOCK_STATUS_SKIPPED was not decrypted because the chain finalized a
+	// later round without finalizing drand_round.
+	TimelockStatus_TIMELOCK_STATUS_SKIPPED TimelockStatus = 4
+)
+
+// Enum value maps for TimelockStatus.
+var (
+	TimelockStatus_name = map[int32]string{
+		0: "TIMELOCK_STATUS_UNSPECIFIED",
+		1: "TIMELOCK_STATUS_PENDING",
+		2: "TIMELOCK_STATUS_DECRYPTED",
+		3: "TIMELOCK_STATUS_FAILED",
+		4: "TIMELOCK_STATUS_SKIPPED",
+	}
+	TimelockStatus_value = map[string]int32{
+		"TIMELOCK_STATUS_UNSPECIFIED": 0,
+		"TIMELOCK_STATUS_PENDING":     1,
+		"TIMELOCK_STATUS_DECRYPTED":   2,
+		"TIMELOCK_STATUS_FAILED":      3,
+		"TIMELOCK_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x TimelockStatus) Enum() *TimelockStatus {
+	p := new(TimelockStatus)
+	*p = x
+	return p
+}
+
+func (x TimelockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[4].Descriptor()
+}
+
+func (TimelockStatus) Type() protoreflect.EnumType {
+	return &file_digitalkitchen_vrf_v1_vrf_proto_enumTypes[4]
+}
+
+func (x TimelockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelockStatus.Descriptor instead.
+func (TimelockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{4}
+}
+
+// VrfBeacon is the canonical drand beacon selected for a given block height.
+// It carries only the fields required for full on-chain verification.
+type VrfBeacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// drand_round is the drand round number.
+	DrandRound uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// randomness is SHA256(signature).
+	Randomness []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
+	// signature is the drand BLS signature for this round.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// previous_signature is the BLS signature of the previous round, required
+	// for chained-scheme verification.
+	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+	// source identifies where the randomness came from. Consumers that need
+	// drand's guarantees must refuse any other source.
+	Source VrfBeaconSource `protobuf:"varint,5,opt,name=source,proto3,enum=digitalkitchen.vrf.v1.VrfBeaconSource" json:"source,omitempty"`
+}
+
+func (x *VrfBeacon) Reset() {
+	*x = VrfBeacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfBeacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfBeacon) ProtoMessage() {}
+
+// Deprecated: Use VrfBeacon.ProtoReflect.Descriptor instead.
+func (*VrfBeacon) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VrfBeacon) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *VrfBeacon) GetRandomness() []byte {
+	if x != nil {
+		return x.Randomness
+	}
+	return nil
+}
+
+func (x *VrfBeacon) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *VrfBeacon) GetPreviousSignature() []byte {
+	if x != nil {
+		return x.PreviousSignature
+	}
+	return nil
+}
+
+func (x *VrfBeacon) GetSource() VrfBeaconSource {
+	if x != nil {
+		return x.Source
+	}
+	return VrfBeaconSource_VRF_BEACON_SOURCE_DRAND
+}
+
+// AllowlistEntry maps an address to a human-readable label for auditing and
+// to the committee roles it holds.
+type AllowlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the allowlisted account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// label is free-form metadata for operator UX / auditing.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// roles are the permissions granted to the member. A member without roles
+	// is only listed.
+	Roles []*VrfCommitteeRoleGrant `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *AllowlistEntry) Reset() {
+	*x = AllowlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowlistEntry) ProtoMessage() {}
+
+// Deprecated: Use AllowlistEntry.ProtoReflect.Descriptor instead.
+func (*AllowlistEntry) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AllowlistEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AllowlistEntry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AllowlistEntry) GetRoles() []*VrfCommitteeRoleGrant {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// VrfCommitteeRoleGrant grants a role to a committee member.
+type VrfCommitteeRoleGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// role is the granted permission.
+	Role VrfCommitteeRole `protobuf:"varint,1,opt,name=role,proto3,enum=digitalkitchen.vrf.v1.VrfCommitteeRole" json:"role,omitempty"`
+	// expiry_height, when non-zero, is the first height at which the grant no
+	// longer applies.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (x *VrfCommitteeRoleGrant) Reset() {
+	*x = VrfCommitteeRoleGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfCommitteeRoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfCommitteeRoleGrant) ProtoMessage() {}
+
+// Deprecated: Use VrfCommitteeRoleGrant.ProtoReflect.Descriptor instead.
+func (*VrfCommitteeRoleGrant) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VrfCommitteeRoleGrant) GetRole() VrfCommitteeRole {
+	if x != nil {
+		return x.Role
+	}
+	return VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED
+}
+
+func (x *VrfCommitteeRoleGrant) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+// VrfIdentity binds a validator to a drand BLS identity / share for monitoring
+// and resharing coordination.
+type VrfIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_address is the validator operator address.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// drand_bls_public_key is the validator's drand BLS public key/share.
+	DrandBlsPublicKey []byte `protobuf:"bytes,2,opt,name=drand_bls_public_key,json=drandBlsPublicKey,proto3" json:"drand_bls_public_key,omitempty"`
+	// chain_hash is the drand chain hash this identity is bound to.
+	ChainHash []byte `protobuf:"bytes,3,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// signal_unix_sec is the UNIX time when this identity was first registered.
+	SignalUnixSec int64 `protobuf:"varint,4,opt,name=signal_unix_sec,json=signalUnixSec,proto3" json:"signal_unix_sec,omitempty"`
+	// signal_reshare_epoch is the value of VrfParams.reshare_epoch when this
+	// identity was first registered.
+	SignalReshareEpoch uint64 `protobuf:"varint,5,opt,name=signal_reshare_epoch,json=signalReshareEpoch,proto3" json:"signal_reshare_epoch,omitempty"`
+	// share_index is the validator's index in the drand group. In threshold mode
+	// its partial signatures must carry this index and verify against
+	// drand_bls_public_key.
+	ShareIndex uint32 `protobuf:"varint,6,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
+}
+
+func (x *VrfIdentity) Reset() {
+	*x = VrfIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfIdentity) ProtoMessage() {}
+
+// Deprecated: Use VrfIdentity.ProtoReflect.Descriptor instead.
+func (*VrfIdentity) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VrfIdentity) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *VrfIdentity) GetDrandBlsPublicKey() []byte {
+	if x != nil {
+		return x.DrandBlsPublicKey
+	}
+	return nil
+}
+
+func (x *VrfIdentity) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *VrfIdentity) GetSignalUnixSec() int64 {
+	if x != nil {
+		return x.SignalUnixSec
+	}
+	return 0
+}
+
+func (x *VrfIdentity) GetSignalReshareEpoch() uint64 {
+	if x != nil {
+		return x.SignalReshareEpoch
+	}
+	return 0
+}
+
+func (x *VrfIdentity) GetShareIndex() uint32 {
+	if x != nil {
+		return x.ShareIndex
+	}
+	return 0
+}
+
+// VrfParticipation records how every validator in the injected commit
+// contributed to the beacon finalized at a height.
+type VrfParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height at which the beacon was finalized.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// target_round is target_round(H) computed by PreBlock.
+	TargetRound uint64 `protobuf:"varint,2,opt,name=target_round,json=targetRound,proto3" json:"target_round,omitempty"`
+	// drand_round is the round of the finalized beacon.
+	DrandRound uint64 `protobuf:"varint,3,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// validators are the consensus addresses in commit order.
+	Validators [][]byte `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	// contributed is a bitmap over validators; bit i (LSB first) is set when
+	// validators[i] contributed a valid beacon for drand_round.
+	Contributed []byte `protobuf:"bytes,5,opt,name=contributed,proto3" json:"contributed,omitempty"`
+	// reasons holds one reason code per entry in validators.
+	Reasons []VrfParticipationReason `protobuf:"varint,6,rep,packed,name=reasons,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"reasons,omitempty"`
+}
+
+func (x *VrfParticipation) Reset() {
+	*x = VrfParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfParticipation) ProtoMessage() {}
+
+// Deprecated: Use VrfParticipation.ProtoReflect.Descriptor instead.
+func (*VrfParticipation) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VrfParticipation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VrfParticipation) GetTargetRound() uint64 {
+	if x != nil {
+		return x.TargetRound
+	}
+	return 0
+}
+
+func (x *VrfParticipation) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *VrfParticipation) GetValidators() [][]byte {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *VrfParticipation) GetContributed() []byte {
+	if x != nil {
+		return x.Contributed
+	}
+	return nil
+}
+
+func (x *VrfParticipation) GetReasons() []VrfParticipationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// VrfParticipationReasonCount counts how often a reason was recorded.
+type VrfParticipationReasonCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason VrfParticipationReason `protobuf:"varint,1,opt,name=reason,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"reason,omitempty"`
+	Count  uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *VrfParticipationReasonCount) Reset() {
+	*x = VrfParticipationReasonCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfParticipationReasonCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfParticipationReasonCount) ProtoMessage() {}
+
+// Deprecated: Use VrfParticipationReasonCount.ProtoReflect.Descriptor instead.
+func (*VrfParticipationReasonCount) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VrfParticipationReasonCount) GetReason() VrfParticipationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED
+}
+
+func (x *VrfParticipationReasonCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// VrfParticipationSummary aggregates a validator's participation over the
+// retained participation records.
+type VrfParticipationSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// consensus_address is the validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// heights is the number of retained records in which the validator appears.
+	Heights uint64 `protobuf:"varint,2,opt,name=heights,proto3" json:"heights,omitempty"`
+	// contributed is the number of heights at which the validator contributed.
+	Contributed uint64 `protobuf:"varint,3,opt,name=contributed,proto3" json:"contributed,omitempty"`
+	// reason_counts breaks heights down by reason, ordered by reason code.
+	ReasonCounts []*VrfParticipationReasonCount `protobuf:"bytes,4,rep,name=reason_counts,json=reasonCounts,proto3" json:"reason_counts,omitempty"`
+	// last_height is the most recent retained height including the validator.
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// last_reason is the reason recorded at last_height.
+	LastReason VrfParticipationReason `protobuf:"varint,6,opt,name=last_reason,json=lastReason,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"last_reason,omitempty"`
+	// last_contributed_height is the most recent height at which the validator
+	// contributed, or zero if it did not contribute within the window.
+	LastContributedHeight int64 `protobuf:"varint,7,opt,name=last_contributed_height,json=lastContributedHeight,proto3" json:"last_contributed_height,omitempty"`
+}
+
+func (x *VrfParticipationSummary) Reset() {
+	*x = VrfParticipationSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfParticipationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfParticipationSummary) ProtoMessage() {}
+
+// Deprecated: Use VrfParticipationSummary.ProtoReflect.Descriptor instead.
+func (*VrfParticipationSummary) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VrfParticipationSummary) GetConsensusAddress() string {
+	if x != nil {
+		return x.ConsensusAddress
+	}
+	return ""
+}
+
+func (x *VrfParticipationSummary) GetHeights() uint64 {
+	if x != nil {
+		return x.Heights
+	}
+	return 0
+}
+
+func (x *VrfParticipationSummary) GetContributed() uint64 {
+	if x != nil {
+		return x.Contributed
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *VrfParticipationSummary) GetReasonCounts() []*VrfParticipationReasonCount {
+	if x != nil {
+		return x.ReasonCounts
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: digitalkitchen/vrf/v1/vrf.proto
+func (x *VrfParticipationSummary) GetLastHeight() int64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *VrfParticipationSummary) GetLastReason() VrfParticipationReason {
+	if x != nil {
+		return x.LastReason
+	}
+	return VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED
+}
 
-// VrfBeacon is the canonical drand beacon selected for a given block height.
-// It carries only the fields required for full on-chain verification.
-type VrfBeacon struct {
+func (x *VrfParticipationSummary) GetLastContributedHeight() int64 {
+	if x != nil {
+		return x.LastContributedHeight
+	}
+	return 0
+}
+
+// RandomnessRequest is a paid request for randomness committed to a drand
+// round that had not been published when the request was made.
+type RandomnessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// drand_round is the drand round number.
-	DrandRound uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
-	// randomness is SHA256(signature).
-	Randomness []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
-	// signature is the drand BLS signature for this round.
-	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	// previous_signature is the BLS signature of the previous round, required
-	// for chained-scheme verification.
-	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+	// id is the unique request identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// requester is the account that paid for the request.
+	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// num_words is the number of 32-byte words to derive.
+	NumWords uint32 `protobuf:"varint,3,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// user_seed is mixed into the word derivation.
+	UserSeed []byte `protobuf:"bytes,4,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+	// fee is the amount escrowed in the vrf module account.
+	Fee []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee,proto3" json:"fee,omitempty"`
+	// min_drand_round is the first drand round allowed to fulfill the request.
+	MinDrandRound uint64 `protobuf:"varint,6,opt,name=min_drand_round,json=minDrandRound,proto3" json:"min_drand_round,omitempty"`
+	// request_height is the height at which the request was made.
+	RequestHeight int64 `protobuf:"varint,7,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	// status is the request lifecycle state.
+	Status RandomnessRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=digitalkitchen.vrf.v1.RandomnessRequestStatus" json:"status,omitempty"`
+	// drand_round is the round of the fulfilling beacon.
+	DrandRound uint64 `protobuf:"varint,9,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// fulfilled_height is the height at which the request was fulfilled or
+	// refunded.
+	FulfilledHeight int64 `protobuf:"varint,10,opt,name=fulfilled_height,json=fulfilledHeight,proto3" json:"fulfilled_height,omitempty"`
+	// words are the derived random words once fulfilled.
+	Words [][]byte `protobuf:"bytes,11,rep,name=words,proto3" json:"words,omitempty"`
 }
 
-func (x *VrfBeacon) Reset() {
-	*x = VrfBeacon{}
+func (x *RandomnessRequest) Reset() {
+	*x = RandomnessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[0]
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfBeacon) String() string {
+func (x *RandomnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfBeacon) ProtoMessage() {}
+func (*RandomnessRequest) ProtoMessage() {}
 
-// Deprecated: Use VrfBeacon.ProtoReflect.Descriptor instead.
-func (*VrfBeacon) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RandomnessRequest.ProtoReflect.Descriptor instead.
+func (*RandomnessRequest) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VrfBeacon) GetDrandRound() uint64 {
+func (x *RandomnessRequest) GetId() uint64 {
 	if x != nil {
-		return x.DrandRound
+		return x.Id
 	}
 	return 0
 }
 
-func (x *VrfBeacon) GetRandomness() []byte {
+func (x *RandomnessRequest) GetRequester() string {
 	if x != nil {
-		return x.Randomness
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *RandomnessRequest) GetNumWords() uint32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *RandomnessRequest) GetUserSeed() []byte {
+	if x != nil {
+		return x.UserSeed
 	}
 	return nil
 }
 
-func (x *VrfBeacon) GetSignature() []byte {
+func (x *RandomnessRequest) GetFee() []*v1beta1.Coin {
 	if x != nil {
-		return x.Signature
+		return x.Fee
 	}
 	return nil
 }
 
-func (x *VrfBeacon) GetPreviousSignature() []byte {
+func (x *RandomnessRequest) GetMinDrandRound() uint64 {
 	if x != nil {
-		return x.PreviousSignature
+		return x.MinDrandRound
+	}
+	return 0
+}
+
+func (x *RandomnessRequest) GetRequestHeight() int64 {
+	if x != nil {
+		return x.RequestHeight
+	}
+	return 0
+}
+
+func (x *RandomnessRequest) GetStatus() RandomnessRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *RandomnessRequest) GetDrandRound() uint64 {
+	if x != nil {
+		return x.DrandRound
+	}
+	return 0
+}
+
+func (x *RandomnessRequest) GetFulfilledHeight() int64 {
+	if x != nil {
+		return x.FulfilledHeight
+	}
+	return 0
+}
+
+func (x *RandomnessRequest) GetWords() [][]byte {
+	if x != nil {
+		return x.Words
 	}
 	return nil
 }
 
-// AllowlistEntry maps an address to a human-readable label for auditing.
-type AllowlistEntry struct {
+// TimelockCiphertext is a payload time-lock encrypted to a drand round, as in
+// tlock: a 32-byte key is encrypted with Boneh-Franklin IBE to the identity
+// sha256(be64(round)), and the payload is sealed with ChaCha20-Poly1305 under
+// that key.
+type TimelockCiphertext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the allowlisted account address.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// label is free-form metadata for operator UX / auditing.
-	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// u is the IBE point rP on the key group.
+	U []byte `protobuf:"bytes,1,opt,name=u,proto3" json:"u,omitempty"`
+	// v is sigma XOR H(e(rP, Q_id)).
+	V []byte `protobuf:"bytes,2,opt,name=v,proto3" json:"v,omitempty"`
+	// w is the symmetric key XOR H(sigma).
+	W []byte `protobuf:"bytes,3,opt,name=w,proto3" json:"w,omitempty"`
+	// payload is the ChaCha20-Poly1305 sealed plaintext.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *AllowlistEntry) Reset() {
-	*x = AllowlistEntry{}
+func (x *TimelockCiphertext) Reset() {
+	*x = TimelockCiphertext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[1]
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllowlistEntry) String() string {
+func (x *TimelockCiphertext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowlistEntry) ProtoMessage() {}
+func (*TimelockCiphertext) ProtoMessage() {}
 
-// Deprecated: Use AllowlistEntry.ProtoReflect.Descriptor instead.
-func (*AllowlistEntry) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TimelockCiphertext.ProtoReflect.Descriptor instead.
+func (*TimelockCiphertext) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AllowlistEntry) GetAddress() string {
+func (x *TimelockCiphertext) GetU() []byte {
 	if x != nil {
-		return x.Address
+		return x.U
 	}
-	return ""
+	return nil
 }
 
-func (x *AllowlistEntry) GetLabel() string {
+func (x *TimelockCiphertext) GetV() []byte {
 	if x != nil {
-		return x.Label
+		return x.V
 	}
-	return ""
+	return nil
 }
 
-// VrfIdentity binds a validator to a drand BLS identity / share for monitoring
-// and resharing coordination.
-type VrfIdentity struct {
+func (x *TimelockCiphertext) GetW() []byte {
+	if x != nil {
+		return x.W
+	}
+	return nil
+}
+
+func (x *TimelockCiphertext) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// TimelockedMessage is a stored time-locked ciphertext and, once its round is
+// finalized, its plaintext.
+type TimelockedMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// validator_address is the validator operator address.
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// drand_bls_public_key is the validator's drand BLS public key/share.
-	DrandBlsPublicKey []byte `protobuf:"bytes,2,opt,name=drand_bls_public_key,json=drandBlsPublicKey,proto3" json:"drand_bls_public_key,omitempty"`
-	// chain_hash is the drand chain hash this identity is bound to.
-	ChainHash []byte `protobuf:"bytes,3,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
-	// signal_unix_sec is the UNIX time when this identity was first registered.
-	SignalUnixSec int64 `protobuf:"varint,4,opt,name=signal_unix_sec,json=signalUnixSec,proto3" json:"signal_unix_sec,omitempty"`
-	// signal_reshare_epoch is the value of VrfParams.reshare_epoch when this
-	// identity was first registered.
-	SignalReshareEpoch uint64 `protobuf:"varint,5,opt,name=signal_reshare_epoch,json=signalReshareEpoch,proto3" json:"signal_reshare_epoch,omitempty"`
+	// id is the unique ciphertext identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// submitter is the account that submitted the ciphertext.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// drand_round is the round the ciphertext is encrypted to.
+	DrandRound uint64 `protobuf:"varint,3,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// ciphertext is the submitted ciphertext.
+	Ciphertext *TimelockCiphertext `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// submit_height is the height at which the ciphertext was submitted.
+	SubmitHeight int64 `protobuf:"varint,5,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// status is the ciphertext lifecycle state.
+	Status TimelockStatus `protobuf:"varint,6,opt,name=status,proto3,enum=digitalkitchen.vrf.v1.TimelockStatus" json:"status,omitempty"`
+	// plaintext is the decrypted payload once decrypted.
+	Plaintext []byte `protobuf:"bytes,7,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// decrypted_height is the height at which decryption was attempted.
+	DecryptedHeight int64 `protobuf:"varint,8,opt,name=decrypted_height,json=decryptedHeight,proto3" json:"decrypted_height,omitempty"`
 }
 
-func (x *VrfIdentity) Reset() {
-	*x = VrfIdentity{}
+func (x *TimelockedMessage) Reset() {
+	*x = TimelockedMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[2]
+		mi := &file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfIdentity) String() string {
+func (x *TimelockedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfIdentity) ProtoMessage() {}
+func (*TimelockedMessage) ProtoMessage() {}
 
-// Deprecated: Use VrfIdentity.ProtoReflect.Descriptor instead.
-func (*VrfIdentity) Descriptor() ([]byte, []int) {
-	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TimelockedMessage.ProtoReflect.Descriptor instead.
+func (*TimelockedMessage) Descriptor() ([]byte, []int) {
+	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *VrfIdentity) GetValidatorAddress() string {
+func (x *TimelockedMessage) GetId() uint64 {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimelockedMessage) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
 	}
 	return ""
 }
 
-func (x *VrfIdentity) GetDrandBlsPublicKey() []byte {
+func (x *TimelockedMessage) GetDrandRound() uint64 {
 	if x != nil {
-		return x.DrandBlsPublicKey
+		return x.DrandRound
 	}
-	return nil
+	return 0
 }
 
-func (x *VrfIdentity) GetChainHash() []byte {
+func (x *TimelockedMessage) GetCiphertext() *TimelockCiphertext {
 	if x != nil {
-		return x.ChainHash
+		return x.Ciphertext
 	}
 	return nil
 }
 
-func (x *VrfIdentity) GetSignalUnixSec() int64 {
+func (x *TimelockedMessage) GetSubmitHeight() int64 {
 	if x != nil {
-		return x.SignalUnixSec
+		return x.SubmitHeight
 	}
 	return 0
 }
 
-func (x *VrfIdentity) GetSignalReshareEpoch() uint64 {
+func (x *TimelockedMessage) GetStatus() TimelockStatus {
 	if x != nil {
-		return x.SignalReshareEpoch
+		return x.Status
+	}
+	return TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED
+}
+
+func (x *TimelockedMessage) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *TimelockedMessage) GetDecryptedHeight() int64 {
+	if x != nil {
+		return x.DecryptedHeight
 	}
 	return 0
 }
@@ -1954,43 +8185,247 @@ var file_digitalkitchen_vrf_v1_vrf_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
 	0x2f, 0x76, 0x72, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x15, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x09, 0x56, 0x72,
-	0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64,
-	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72,
-	0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x60, 0x0a, 0x0e, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x32, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8d, 0x02,
-	0x0a, 0x0b, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a,
-	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
-	0x14, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x72, 0x61,
-	0x6e, 0x64, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a,
-	0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x55, 0x6e,
-	0x69, 0x78, 0x53, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc9, 0x01,
+	0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a,
+	0x09, 0x56, 0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72,
+	0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xaa,
+	0x01, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a, 0x15, 0x56,
+	0x72, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xae, 0x02, 0x0a,
+	0x0b, 0x56, 0x72, 0x66, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x11,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x14,
+	0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x55, 0x6e, 0x69,
+	0x78, 0x53, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xff, 0x01,
+	0x0a, 0x10, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0x80, 0x01, 0x0a, 0x1b, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x45, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
+	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xb3, 0x03, 0x0a, 0x17, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x4e,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12, 0x5d, 0x0a, 0x0d, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
+	0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf8, 0x03, 0x0a, 0x11, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64,
+	0x12, 0x62, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x72, 0x61, 0x6e,
+	0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x69, 0x6e, 0x44, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x5e, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x75, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x75, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x01, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x01, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x85, 0x03, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x54, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x69,
+	0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76,
+	0x72, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x4b, 0x0a, 0x0f, 0x56,
+	0x72, 0x66, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x56, 0x52, 0x46, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x56,
+	0x52, 0x46, 0x5f, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x45, 0x43, 0x56, 0x52, 0x46, 0x10, 0x01, 0x2a, 0xa8, 0x01, 0x0a, 0x10, 0x56, 0x72, 0x66,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x0a,
+	0x1e, 0x56, 0x52, 0x46, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x45, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x52, 0x46, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43,
+	0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x56, 0x52, 0x46, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x54, 0x45, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x4b, 0x47, 0x5f, 0x42, 0x4f,
+	0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x52, 0x46,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x52, 0x10, 0x03, 0x2a, 0xdf, 0x03, 0x0a, 0x16, 0x56, 0x72, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x24, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x52, 0x46, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43,
+	0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41,
+	0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x52, 0x46, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x03, 0x12, 0x2a, 0x0a, 0x26, 0x56,
+	0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x52, 0x46, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x05, 0x12, 0x2a, 0x0a, 0x26, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49,
+	0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06, 0x12, 0x27, 0x0a,
+	0x23, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08,
+	0x12, 0x2a, 0x0a, 0x26, 0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x09, 0x12, 0x27, 0x0a, 0x23,
+	0x56, 0x52, 0x46, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x43, 0x56, 0x52, 0x46, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x0a, 0x2a, 0xbc, 0x01, 0x0a, 0x17, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x4e, 0x45, 0x53, 0x53, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21,
+	0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x4e, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x55, 0x4c, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22,
+	0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0xa6, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x4d, 0x45, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49, 0x4d, 0x45,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x59, 0x50, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49, 0x4d, 0x45, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x42, 0xc9, 0x01,
 	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x6b, 0x69, 0x74,
 	0x63, 0x68, 0x65, 0x6e, 0x2e, 0x76, 0x72, 0x66, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x56, 0x72, 0x66,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
@@ -2019,18 +8454,43 @@ func file_digitalkitchen_vrf_v1_vrf_proto_rawDescGZIP() []byte {
 	return file_digitalkitchen_vrf_v1_vrf_proto_rawDescData
 }
 
-var file_digitalkitchen_vrf_v1_vrf_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_digitalkitchen_vrf_v1_vrf_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_digitalkitchen_vrf_v1_vrf_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_digitalkitchen_vrf_v1_vrf_proto_goTypes = []interface{}{
-	(*VrfBeacon)(nil),      // 0: digitalkitchen.vrf.v1.VrfBeacon
-	(*AllowlistEntry)(nil), // 1: digitalkitchen.vrf.v1.AllowlistEntry
-	(*VrfIdentity)(nil),    // 2: digitalkitchen.vrf.v1.VrfIdentity
+	(VrfBeaconSource)(0),                // 0: digitalkitchen.vrf.v1.VrfBeaconSource
+	(VrfCommitteeRole)(0),               // 1: digitalkitchen.vrf.v1.VrfCommitteeRole
+	(VrfParticipationReason)(0),         // 2: digitalkitchen.vrf.v1.VrfParticipationReason
+	(RandomnessRequestStatus)(0),        // 3: digitalkitchen.vrf.v1.RandomnessRequestStatus
+	(TimelockStatus)(0),                 // 4: digitalkitchen.vrf.v1.TimelockStatus
+	(*VrfBeacon)(nil),                   // 5: digitalkitchen.vrf.v1.VrfBeacon
+	(*AllowlistEntry)(nil),              // 6: digitalkitchen.vrf.v1.AllowlistEntry
+	(*VrfCommitteeRoleGrant)(nil),       // 7: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant
+	(*VrfIdentity)(nil),                 // 8: digitalkitchen.vrf.v1.VrfIdentity
+	(*VrfParticipation)(nil),            // 9: digitalkitchen.vrf.v1.VrfParticipation
+	(*VrfParticipationReasonCount)(nil), // 10: digitalkitchen.vrf.v1.VrfParticipationReasonCount
+	(*VrfParticipationSummary)(nil),     // 11: digitalkitchen.vrf.v1.VrfParticipationSummary
+	(*RandomnessRequest)(nil),           // 12: digitalkitchen.vrf.v1.RandomnessRequest
+	(*TimelockCiphertext)(nil),          // 13: digitalkitchen.vrf.v1.TimelockCiphertext
+	(*TimelockedMessage)(nil),           // 14: digitalkitchen.vrf.v1.TimelockedMessage
+	(*v1beta1.Coin)(nil),                // 15: cosmos.base.v1beta1.Coin
 }
 var file_digitalkitchen_vrf_v1_vrf_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: digitalkitchen.vrf.v1.VrfBeacon.source:type_name -> digitalkitchen.vrf.v1.VrfBeaconSource
+	7,  // 1: digitalkitchen.vrf.v1.AllowlistEntry.roles:type_name -> digitalkitchen.vrf.v1.VrfCommitteeRoleGrant
+	1,  // 2: digitalkitchen.vrf.v1.VrfCommitteeRoleGrant.role:type_name -> digitalkitchen.vrf.v1.VrfCommitteeRole
+	2,  // 3: digitalkitchen.vrf.v1.VrfParticipation.reasons:type_name -> digitalkitchen.vrf.v1.VrfParticipationReason
+	2,  // 4: digitalkitchen.vrf.v1.VrfParticipationReasonCount.reason:type_name -> digitalkitchen.vrf.v1.VrfParticipationReason
+	10, // 5: digitalkitchen.vrf.v1.VrfParticipationSummary.reason_counts:type_name -> digitalkitchen.vrf.v1.VrfParticipationReasonCount
+	2,  // 6: digitalkitchen.vrf.v1.VrfParticipationSummary.last_reason:type_name -> digitalkitchen.vrf.v1.VrfParticipationReason
+	15, // 7: digitalkitchen.vrf.v1.RandomnessRequest.fee:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 8: digitalkitchen.vrf.v1.RandomnessRequest.status:type_name -> digitalkitchen.vrf.v1.RandomnessRequestStatus
+	13, // 9: digitalkitchen.vrf.v1.TimelockedMessage.ciphertext:type_name -> digitalkitchen.vrf.v1.TimelockCiphertext
+	4,  // 10: digitalkitchen.vrf.v1.TimelockedMessage.status:type_name -> digitalkitchen.vrf.v1.TimelockStatus
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_digitalkitchen_vrf_v1_vrf_proto_init() }
@@ -2064,6 +8524,18 @@ func file_digitalkitchen_vrf_v1_vrf_proto_init() {
 			}
 		}
 		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfCommitteeRoleGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VrfIdentity); i {
 			case 0:
 				return &v.state
@@ -2075,19 +8547,92 @@ func file_digitalkitchen_vrf_v1_vrf_proto_init() {
 				return nil
 			}
 		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfParticipationReasonCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfParticipationSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelockCiphertext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_digitalkitchen_vrf_v1_vrf_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelockedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_digitalkitchen_vrf_v1_vrf_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_digitalkitchen_vrf_v1_vrf_proto_goTypes,
 		DependencyIndexes: file_digitalkitchen_vrf_v1_vrf_proto_depIdxs,
+		EnumInfos:         file_digitalkitchen_vrf_v1_vrf_proto_enumTypes,
 		MessageInfos:      file_digitalkitchen_vrf_v1_vrf_proto_msgTypes,
 	}.Build()
 	File_digitalkitchen_vrf_v1_vrf_proto = out.File
@@ -11,6 +11,7 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 
 	vrfante "github.com/dgtlkitchen/vrf/x/vrf/ante"
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
@@ -32,7 +33,11 @@ type HandlerOptions struct {
 	TxFeeChecker           authante.TxFeeChecker
 	SigVerifyOptions       []authante.SigVerificationDecoratorOption
 
-	VrfKeeper *vrfkeeper.Keeper
+	AuthzKeeper *authzkeeper.Keeper
+	VrfKeeper   *vrfkeeper.Keeper
+	// EmergencyLane receives the emergency-disable txs admitted by CheckTx.
+	// The app shares it with the VRF proposal handler.
+	EmergencyLane *vrfante.EmergencyLane
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -45,9 +50,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.SignModeHandler == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
+	if options.AuthzKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "authz keeper is required for ante builder")
+	}
 	if options.VrfKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "vrf keeper is required for ante builder")
 	}
+	if options.EmergencyLane == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "vrf emergency lane is required for ante builder")
+	}
 	accountKeeper, ok := options.AccountKeeper.(authkeeper.AccountKeeper)
 	if !ok {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "account keeper must be authkeeper.AccountKeeper, got %T", options.AccountKeeper)
@@ -68,7 +79,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		// VRF emergency-disable transactions must be gasless and bypass
 		// sequence/nonce checks. This decorator performs deterministic signature
 		// verification and short-circuits the ante chain for authorized txs.
-		vrfante.NewEmergencyDisableDecorator(accountKeeper, options.VrfKeeper, options.SignModeHandler),
+		vrfante.NewEmergencyDisableDecorator(accountKeeper, options.AuthzKeeper, options.VrfKeeper, options.SignModeHandler, options.EmergencyLane),
 
 		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		authante.NewSetPubKeyDecorator(options.AccountKeeper),
@@ -14,6 +14,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cast"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,7 +44,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtxconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -54,10 +58,13 @@ import (
 	"github.com/dgtlkitchen/vrf/app/keepers"
 	"github.com/dgtlkitchen/vrf/app/upgrades"
 	v2 "github.com/dgtlkitchen/vrf/app/upgrades/v2"
+	v3 "github.com/dgtlkitchen/vrf/app/upgrades/v3"
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
 	vrfabcicodec "github.com/dgtlkitchen/vrf/x/vrf/abci/codec"
 	vrfpreblock "github.com/dgtlkitchen/vrf/x/vrf/abci/preblock/vrf"
 	vrfproposals "github.com/dgtlkitchen/vrf/x/vrf/abci/proposals"
 	vrfve "github.com/dgtlkitchen/vrf/x/vrf/abci/ve"
+	vrfante "github.com/dgtlkitchen/vrf/x/vrf/ante"
 	vrfconfig "github.com/dgtlkitchen/vrf/x/vrf/config"
 	vrfsidecar "github.com/dgtlkitchen/vrf/x/vrf/sidecar"
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
@@ -74,6 +81,7 @@ var (
 
 	Upgrades = []upgrades.Upgrade{
 		v2.Upgrade,
+		v3.Upgrade,
 	}
 
 	_ runtime.AppI            = (*App)(nil)
@@ -99,6 +107,9 @@ type App struct {
 	BasicModuleManager module.BasicManager
 	configurator       module.Configurator
 
+	// simulation manager
+	sm *module.SimulationManager
+
 	vrfClient vrfsidecar.Client
 }
 
@@ -185,6 +196,11 @@ func New(
 		if err != nil {
 			panic(err)
 		}
+		tlsCfg, err := tlsconfig.NewClientConfig(vrfAppCfg.TLSFiles(cast.ToString(appOpts.Get(flags.FlagHome))))
+		if err != nil {
+			panic(err)
+		}
+		vrfClient.SetTLSConfig(tlsCfg)
 		if err := vrfClient.Start(context.Background()); err != nil {
 			panic(err)
 		}
@@ -216,6 +232,13 @@ func New(
 	app.ModuleManager.SetOrderInitGenesis(orderInitBlockers()...)
 	app.ModuleManager.SetOrderExportGenesis(orderInitBlockers()...)
 
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	overrideModules := map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AppKeepers.AccountKeeper, authsims.RandomGenesisAccounts, nil),
+	}
+	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
+	app.sm.RegisterStoreDecoders()
+
 	// setup query services
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
@@ -229,6 +252,9 @@ func New(
 	// initialize stores
 	app.MountKVStores(app.AppKeepers.GetKVStoreKeys())
 
+	// Emergency-disable txs admitted by CheckTx reach PrepareProposal through
+	// this lane rather than through the mempool reap.
+	emergencyLane := vrfante.NewEmergencyLane()
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:    app.AppKeepers.AccountKeeper,
@@ -241,7 +267,9 @@ func New(
 				// ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
 				// ante.WithMaxUnorderedTxTimeoutDuration(ante.DefaultMaxTimeoutDuration),
 			},
-			VrfKeeper: &app.AppKeepers.VrfKeeper,
+			AuthzKeeper:   &app.AppKeepers.AuthzKeeper,
+			VrfKeeper:     &app.AppKeepers.VrfKeeper,
+			EmergencyLane: emergencyLane,
 		},
 	)
 	if err != nil {
@@ -261,10 +289,12 @@ func New(
 		defaultProposalHandler.ProcessProposalHandler(),
 		&app.AppKeepers.VrfKeeper,
 		app.AppKeepers.AccountKeeper,
+		app.AppKeepers.AuthzKeeper,
 		txConfig.SignModeHandler(),
 		txConfig.TxDecoder(),
 		validateVoteExtensionsFn,
 		extendedCommitCodec,
+		vrfproposals.WithEmergencyLane(emergencyLane),
 	)
 	app.SetPrepareProposal(vrfProposalHandler.PrepareProposalHandler())
 	app.SetProcessProposal(vrfProposalHandler.ProcessProposalHandler())
@@ -275,6 +305,13 @@ func New(
 		&app.AppKeepers.VrfKeeper,
 		vrfAppCfg.ClientTimeout,
 	)
+	if vrfAppCfg.ECVRFKeyFile != "" {
+		key, err := vrfconfig.LoadECVRFKey(cast.ToString(appOpts.Get(flags.FlagHome)), vrfAppCfg.ECVRFKeyFile)
+		if err != nil {
+			panic(err)
+		}
+		vrfVoteExtHandler.SetFallbackKey(key)
+	}
 	app.SetExtendVoteHandler(vrfVoteExtHandler.ExtendVoteHandler())
 	app.SetVerifyVoteExtensionHandler(vrfVoteExtHandler.VerifyVoteExtensionHandler())
 
@@ -282,6 +319,7 @@ func New(
 		logger,
 		&app.AppKeepers.VrfKeeper,
 		app.AppKeepers.AccountKeeper,
+		app.AppKeepers.AuthzKeeper,
 		txConfig.SignModeHandler(),
 		txConfig.TxDecoder(),
 	)
@@ -474,8 +512,8 @@ func (app *App) RegisterNodeService(clientCtx client.Context, cfg config.Config)
 }
 
 // SimulationManager implements the SimulationApp interface
-func (*App) SimulationManager() *module.SimulationManager {
-	return nil
+func (app *App) SimulationManager() *module.SimulationManager {
+	return app.sm
 }
 
 func (app *App) Close() error {
@@ -20,6 +20,7 @@ import (
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
@@ -48,6 +49,7 @@ var maccPerms = map[string][]string{
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	govtypes.ModuleName:            {authtypes.Burner},
+	vrftypes.ModuleName:            nil,
 }
 
 type AppKeepers struct {
@@ -64,6 +66,7 @@ type AppKeepers struct {
 	UpgradeKeeper         *upgradekeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
+	AuthzKeeper           authzkeeper.Keeper
 	MintKeeper            mintkeeper.Keeper
 
 	// chain modules
@@ -175,10 +178,20 @@ func NewAppKeepers(
 		appKeepers.AccountKeeper,
 	)
 
+	appKeepers.AuthzKeeper = authzkeeper.NewKeeper(
+		runtime.NewKVStoreService(appKeepers.keys[authzkeeper.StoreKey]),
+		appCodec,
+		bApp.MsgServiceRouter(),
+		appKeepers.AccountKeeper,
+	)
+
 	appKeepers.VrfKeeper = vrfkeeper.NewKeeper(
 		runtime.NewKVStoreService(appKeepers.keys[vrftypes.StoreKey]),
 		appCodec,
 		govModAddress,
+		appKeepers.BankKeeper,
+		stakingKeeper,
+		appKeepers.DistrKeeper,
 	)
 
 	// register the proposal types
@@ -200,6 +213,7 @@ func NewAppKeepers(
 		govConfig,
 		govModAddress,
 	)
+	appKeepers.GovKeeper.SetLegacyRouter(govRouter)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -224,6 +238,8 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the vrf reward pool is funded through community-pool spends and transfers
+	delete(modAccAddrs, authtypes.NewModuleAddress(vrftypes.ModuleName).String())
 
 	return modAccAddrs
 }
@@ -12,6 +12,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus"
@@ -22,7 +24,6 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -56,6 +57,13 @@ func appModules(
 			app.AppKeepers.AccountKeeper,
 			nil,
 		),
+		authz.ModuleName: authzmodule.NewAppModule(
+			appCodec,
+			app.AppKeepers.AuthzKeeper,
+			app.AppKeepers.AccountKeeper,
+			app.AppKeepers.BankKeeper,
+			app.interfaceRegistry,
+		),
 		govtypes.ModuleName: gov.NewAppModule(
 			appCodec,
 			app.AppKeepers.GovKeeper,
@@ -97,7 +105,12 @@ func appModules(
 		),
 
 		// chain modules
-		vrftypes.ModuleName: vrfmodule.NewAppModule(appCodec, app.AppKeepers.VrfKeeper),
+		vrftypes.ModuleName: vrfmodule.NewAppModule(
+			appCodec,
+			app.AppKeepers.VrfKeeper,
+			app.AppKeepers.AccountKeeper,
+			app.AppKeepers.BankKeeper,
+		),
 	}
 }
 
@@ -105,7 +118,6 @@ func appModules(
 // BeginBlockers, which are run at the beginning of every block.
 func orderBeginBlockers() []string {
 	return []string{
-		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -113,6 +125,7 @@ func orderBeginBlockers() []string {
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
+		authz.ModuleName,
 		genutiltypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
@@ -127,11 +140,12 @@ func orderEndBlockers() []string {
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
-		minttypes.ModuleName,
 		genutiltypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		authz.ModuleName,
+		vrftypes.ModuleName,
 	}
 }
 
@@ -145,11 +159,11 @@ func orderInitBlockers() []string {
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
-		minttypes.ModuleName,
 		genutiltypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		authz.ModuleName,
 		vrftypes.ModuleName,
 	}
 }
@@ -163,6 +177,7 @@ var AppModuleBasics = module.NewBasicManager(
 	vesting.AppModuleBasic{},
 	bank.AppModuleBasic{},
 	gov.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
 	slashing.AppModuleBasic{},
 	distr.AppModuleBasic{},
 	staking.AppModuleBasic{},
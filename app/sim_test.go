@@ -0,0 +1,222 @@
+//go:build sims
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Get flags every time the simulator is run
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// newSimApp adapts New to the app factory signature of simsx, taking the home
+// directory from the app options.
+func newSimApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	loadLatest bool,
+	appOpts servertypes.AppOptions,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) *App {
+	return New(logger, db, traceStore, loadLatest, cast.ToString(appOpts.Get(flags.FlagHome)), appOpts, baseAppOptions...)
+}
+
+func setupStateFactory(app *App) simsx.SimStateFactory {
+	return simsx.SimStateFactory{
+		Codec:         app.AppCodec(),
+		AppStateFn:    simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		BlockedAddr:   app.ModuleAccountAddrs(),
+		AccountSource: app.AppKeepers.AccountKeeper,
+		BalanceSource: app.AppKeepers.BankKeeper,
+	}
+}
+
+var (
+	exportAllModules       []string
+	exportWithValidatorSet []string
+)
+
+// TestFullAppSimulation runs the simulation and then checks every x/vrf
+// invariant; EndBlock only checks the cheap ones.
+func TestFullAppSimulation(t *testing.T) {
+	simsx.Run(t, newSimApp, setupStateFactory, func(tb testing.TB, ti simsx.TestInstance[*App], _ []simtypes.Account) {
+		tb.Helper()
+		app := ti.App
+		ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+		require.NoError(tb, app.AppKeepers.VrfKeeper.AssertInvariants(ctx, false))
+	})
+}
+
+// TestAppImportExport exports the simulated state, imports it into a fresh
+// app and compares the two stores.
+func TestAppImportExport(t *testing.T) {
+	simsx.Run(t, newSimApp, setupStateFactory, func(tb testing.TB, ti simsx.TestInstance[*App], accs []simtypes.Account) {
+		tb.Helper()
+		app := ti.App
+		tb.Log("exporting genesis...\n")
+		exported, err := app.ExportAppStateAndValidators(false, exportWithValidatorSet, exportAllModules)
+		require.NoError(tb, err)
+
+		tb.Log("importing genesis...\n")
+		newTestInstance := simsx.NewSimulationAppInstance(tb, ti.Cfg, newSimApp)
+		newApp := newTestInstance.App
+		var genesisState GenesisState
+		require.NoError(tb, json.Unmarshal(exported.AppState, &genesisState))
+		ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+		_, err = newApp.ModuleManager.InitGenesis(ctxB, newApp.appCodec, genesisState)
+		if isEmptyValidatorSetErr(err) {
+			tb.Skip("Skipping simulation as all validators have been unbonded")
+			return
+		}
+		require.NoError(tb, err)
+		err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
+		require.NoError(tb, err)
+
+		tb.Log("comparing stores...")
+		// skip certain prefixes
+		skipPrefixes := map[string][][]byte{
+			stakingtypes.StoreKey: {
+				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+				stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
+				stakingtypes.UnbondingTypeKey,
+				stakingtypes.ValidatorUpdatesKey,
+			},
+			slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+			// the module version map is written by InitChainer, not by InitGenesis
+			upgradetypes.StoreKey: {{upgradetypes.VersionMapByte}},
+			// the grant expiry queue is rebuilt from the grants
+			authzkeeper.StoreKey: {authzkeeper.GrantQueuePrefix},
+			// last_block_time and prev_block_time are per-block bookkeeping
+			// that InitGenesis and the next block rebuild
+			vrftypes.StoreKey: {{0x02}, {0x06}},
+		}
+		AssertEqualStores(tb, app, newApp, app.SimulationManager().StoreDecoders, skipPrefixes)
+	})
+}
+
+// isEmptyValidatorSetErr reports whether InitGenesis failed because every
+// validator was unbonded during the simulation.
+func isEmptyValidatorSetErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "validator set is empty after InitGenesis")
+}
+
+// TestAppStateDeterminism runs every seed several times with inter-block
+// caching and requires the same app hash from each run.
+func TestAppStateDeterminism(t *testing.T) {
+	const numTimesToRunPerSeed = 3
+	var seeds []int64
+	if s := simcli.NewConfigFromFlags().Seed; s != simcli.DefaultSeedValue {
+		// We will be overriding the random seed and just run a single simulation on the provided seed value
+		for j := 0; j < numTimesToRunPerSeed; j++ { // multiple rounds
+			seeds = append(seeds, s)
+		}
+	} else {
+		// setup with 3 random seeds
+		for i := 0; i < 3; i++ {
+			seed := rand.Int63()
+			for j := 0; j < numTimesToRunPerSeed; j++ { // multiple rounds
+				seeds = append(seeds, seed)
+			}
+		}
+	}
+	interBlockCachingAppFactory := func(logger log.Logger, db dbm.DB, _ io.Writer, _ bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) *App {
+		return newSimApp(logger, db, nil, true, appOpts, append(baseAppOptions, baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager()))...)
+	}
+	var mx sync.Mutex
+	appHashResults := make(map[int64][][]byte)
+	appSimLogger := make(map[int64][]simulation.LogWriter)
+	captureAndCheckHash := func(tb testing.TB, ti simsx.TestInstance[*App], _ []simtypes.Account) {
+		tb.Helper()
+		seed, appHash := ti.Cfg.Seed, ti.App.LastCommitID().Hash
+		mx.Lock()
+		otherHashes, execWriters := appHashResults[seed], appSimLogger[seed]
+		if len(otherHashes) < numTimesToRunPerSeed-1 {
+			appHashResults[seed], appSimLogger[seed] = append(otherHashes, appHash), append(execWriters, ti.ExecLogWriter)
+		} else { // cleanup
+			delete(appHashResults, seed)
+			delete(appSimLogger, seed)
+		}
+		mx.Unlock()
+
+		var failNow bool
+		// and check that all app hashes per seed are equal for each iteration
+		for i := 0; i < len(otherHashes); i++ {
+			if !assert.Equal(tb, otherHashes[i], appHash) {
+				execWriters[i].PrintLogs()
+				failNow = true
+			}
+		}
+		if failNow {
+			ti.ExecLogWriter.PrintLogs()
+			tb.Fatalf("non-determinism in seed %d", seed)
+		}
+	}
+	// run simulations
+	simsx.RunWithSeeds(t, interBlockCachingAppFactory, setupStateFactory, seeds, []byte{}, captureAndCheckHash)
+}
+
+// AssertEqualStores compares the KV stores of two apps and fails the test if
+// they differ outside of skipPrefixes.
+func AssertEqualStores(
+	tb testing.TB,
+	app, newApp *App,
+	storeDecoders simtypes.StoreDecoderRegistry,
+	skipPrefixes map[string][][]byte,
+) {
+	tb.Helper()
+	ctxA := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	storeKeys := app.AppKeepers.GetKVStoreKeys()
+	require.NotEmpty(tb, storeKeys)
+
+	for keyName, appKeyA := range storeKeys {
+		appKeyB := newApp.AppKeepers.GetKey(keyName)
+
+		storeA := ctxA.KVStore(appKeyA)
+		storeB := ctxB.KVStore(appKeyB)
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, skipPrefixes[keyName])
+		require.Equal(tb, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare %s, key stores %s and %s", keyName, appKeyA, appKeyB)
+
+		tb.Logf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), appKeyA, appKeyB)
+		if !assert.Equal(tb, 0, len(failedKVAs), simtestutil.GetSimulationLog(keyName, storeDecoders, failedKVAs, failedKVBs)) {
+			for _, v := range failedKVAs {
+				tb.Logf("store mismatch: %q\n", v)
+			}
+			tb.FailNow()
+		}
+	}
+}
@@ -3,6 +3,8 @@ package v2
 import (
 	storetypes "cosmossdk.io/store/types"
 
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+
 	"github.com/dgtlkitchen/vrf/app/upgrades"
 )
 
@@ -11,5 +13,7 @@ const UpgradeName = "v2"
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateV2UpgradeHandler,
-	StoreUpgrades:        storetypes.StoreUpgrades{},
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{authzkeeper.StoreKey},
+	},
 }
@@ -0,0 +1,15 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/dgtlkitchen/vrf/app/upgrades"
+)
+
+const UpgradeName = "v3"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateV3UpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/dgtlkitchen/vrf/app/keepers"
+	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// CreateV3UpgradeHandler runs the x/vrf 3 to 4 migration, which indexes the
+// registered drand BLS public keys, and sets round_tolerance to its default.
+func CreateV3UpgradeHandler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	appKeepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := log.NewNopLogger()
+		if lc, ok := ctx.(interface{ Logger() log.Logger }); ok {
+			logger = lc.Logger()
+		}
+		logger = logger.With("upgrade", UpgradeName)
+
+		// Run migrations
+		logger.Info(fmt.Sprintf("v3: running migrations for: %v", vm))
+		versionMap, err := mm.RunMigrations(ctx, cfg, vm)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("v3: post migration check: %v", versionMap))
+
+		if err := setDefaultRoundTolerance(ctx, appKeepers.VrfKeeper); err != nil {
+			return nil, err
+		}
+
+		return versionMap, nil
+	}
+}
+
+// setDefaultRoundTolerance sets round_tolerance to its default on chains
+// that stored their params before the field existed, where it reads as 0.
+func setDefaultRoundTolerance(ctx context.Context, k vrfkeeper.Keeper) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("v3: loading vrf params: %w", err)
+	}
+	if params.RoundTolerance != 0 {
+		return nil
+	}
+
+	params.RoundTolerance = vrftypes.DefaultParams().RoundTolerance
+	if err := k.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("v3: setting vrf round_tolerance: %w", err)
+	}
+	return nil
+}
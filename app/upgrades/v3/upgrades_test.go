@@ -0,0 +1,73 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/dgtlkitchen/vrf/app/keepers"
+	v3 "github.com/dgtlkitchen/vrf/app/upgrades/v3"
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	vrfmodule "github.com/dgtlkitchen/vrf/x/vrf/module"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type UpgradeSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestUpgradeSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeSuite))
+}
+
+// runUpgrade runs the v3 upgrade handler on a store at x/vrf consensus
+// version 3 holding params, and returns the params it leaves.
+func (s *UpgradeSuite) runUpgrade(params vrftypes.VrfParams) vrftypes.VrfParams {
+	store := s.Ctx.KVStore(s.KeyVrf)
+	store.Set([]byte{0}, s.EncCfg.Codec.MustMarshal(&params))
+
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	am := vrfmodule.NewAppModule(s.EncCfg.Codec, k, nil, nil)
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(s.EncCfg.InterfaceRegistry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(s.EncCfg.InterfaceRegistry)
+	cfg := module.NewConfigurator(s.EncCfg.Codec, msgRouter, queryRouter)
+	am.RegisterServices(cfg)
+
+	handler := v3.CreateV3UpgradeHandler(module.NewManager(am), cfg, &keepers.AppKeepers{VrfKeeper: k})
+	versions, err := handler(s.Ctx, upgradetypes.Plan{Name: v3.UpgradeName}, module.VersionMap{vrftypes.ModuleName: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(vrfmodule.ConsensusVersion), versions[vrftypes.ModuleName])
+
+	got, err := k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	return got
+}
+
+func (s *UpgradeSuite) TestSetsDefaultRoundTolerance() {
+	// Params stored before round_tolerance existed read it as 0.
+	params := vrftypes.DefaultParams()
+	params.RoundTolerance = 0
+
+	got := s.runUpgrade(params)
+	s.Require().Equal(vrftypes.DefaultParams().RoundTolerance, got.RoundTolerance)
+
+	params.RoundTolerance = got.RoundTolerance
+	s.Require().Equal(params, got)
+}
+
+func (s *UpgradeSuite) TestKeepsSetRoundTolerance() {
+	params := vrftypes.DefaultParams()
+	params.RoundTolerance = 2
+
+	s.Require().Equal(params, s.runUpgrade(params))
+}
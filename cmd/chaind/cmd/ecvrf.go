@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	vrfconfig "github.com/dgtlkitchen/vrf/x/vrf/config"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+const (
+	flagECVRFKeyFile      = "file"
+	defaultECVRFKeyFile   = "config/ecvrf_key.json"
+	ecvrfKeyFileFlagUsage = "ECVRF key file, relative to the node home unless absolute"
+)
+
+// ECVRFKeyCmd creates the commands managing the ECVRF fallback key of a
+// validator node.
+func ECVRFKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ecvrf-key",
+		Short: "Manage the ECVRF key that signs the VRF fallback proofs",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ECVRFKeyInitCmd(),
+		ECVRFKeyProofCmd(),
+	)
+
+	return cmd
+}
+
+// ECVRFKeyInitCmd creates a new ECVRF key file.
+func ECVRFKeyInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create a new ECVRF key file",
+		Long: `Creates an ECVRF key file with a new ed25519 key. Point ecvrf_key_file in the
+[vrf] section of app.toml at it and register it with "tx vrf register-ecvrf-key".
+An existing file is never overwritten.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := cmd.Flags().GetString(flagECVRFKeyFile)
+			if err != nil {
+				return err
+			}
+
+			key, err := vrfconfig.GenECVRFKey(client.GetClientContextFromCmd(cmd).HomeDir, path)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(hex.EncodeToString(key.Public().(ed25519.PublicKey)))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagECVRFKeyFile, defaultECVRFKeyFile, ecvrfKeyFileFlagUsage)
+
+	return cmd
+}
+
+// ECVRFKeyProofCmd prints the MsgRegisterEcvrfKey flags of the local ECVRF key.
+func ECVRFKeyProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof <chain-id> <validator-address>",
+		Short: "Print the MsgRegisterEcvrfKey flags proving possession of the local ECVRF key",
+		Long: `Loads the ECVRF key file and proves the key for the given chain id and
+validator operator address. The output are the flags of "tx vrf register-ecvrf-key".`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := cmd.Flags().GetString(flagECVRFKeyFile)
+			if err != nil {
+				return err
+			}
+
+			key, err := vrfconfig.LoadECVRFKey(client.GetClientContextFromCmd(cmd).HomeDir, path)
+			if err != nil {
+				return err
+			}
+
+			proof, err := vrftypes.ECVRFProve(key, vrftypes.ECVRFKeyProofInput(args[0], args[1]))
+			if err != nil {
+				return fmt.Errorf("proving ECVRF key: %w", err)
+			}
+
+			cmd.Printf("--ecvrf-public-key %s --ecvrf-proof %s\n",
+				hex.EncodeToString(key.Public().(ed25519.PublicKey)), hex.EncodeToString(proof))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagECVRFKeyFile, defaultECVRFKeyFile, ecvrfKeyFileFlagUsage)
+
+	return cmd
+}
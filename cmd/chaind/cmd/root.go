@@ -217,6 +217,7 @@ func initRootCmd(
 		genutilcli.InitCmd(basicManager, app.DefaultNodeHome),
 		cmtcli.NewCompletionCmd(rootCmd, false),
 		DebugCmd(),
+		ECVRFKeyCmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(
 			newApp,
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/dgtlkitchen/vrf/sidecar/drand"
+)
+
+func newBeaconsCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "beacons",
+		Short:         "Export or import the sidecar's verified-beacon store (stop the sidecar first)",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	v := viper.New()
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+
+	root.PersistentFlags().String("file", "", "path to vrf.toml (overrides defaults when set)")
+	root.PersistentFlags().String("store", "", "path to the beacon store (defaults to the one configured in vrf.toml)")
+
+	_ = v.BindPFlag("file", root.PersistentFlags().Lookup("file"))
+	_ = v.BindEnv("file", "VRF_CONFIG")
+	_ = v.BindPFlag("store", root.PersistentFlags().Lookup("store"))
+
+	root.AddCommand(newBeaconsExportCmd(v))
+	root.AddCommand(newBeaconsImportCmd(v))
+
+	return root
+}
+
+func newBeaconsExportCmd(v *viper.Viper) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [file]",
+		Short: "Write every stored beacon as JSON lines to file, or stdout",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openConfiguredBeaconStore(v)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+
+			var out io.Writer = cmd.OutOrStdout()
+			if len(args) == 1 {
+				f, err := os.Create(args[0])
+				if err != nil {
+					return err
+				}
+				defer func() { _ = f.Close() }()
+				out = f
+			}
+
+			n, err := store.Export(out)
+			if err != nil {
+				return fmt.Errorf("exporting beacons: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "exported %d beacons\n", n)
+			return nil
+		},
+	}
+}
+
+func newBeaconsImportCmd(v *viper.Viper) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "Seed the beacon store from a file written by export (beacons are verified when served)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer func() { _ = f.Close() }()
+
+			store, err := openConfiguredBeaconStore(v)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+
+			n, err := store.Import(f)
+			if err != nil {
+				return fmt.Errorf("importing beacons: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "imported %d beacons\n", n)
+			return nil
+		},
+	}
+}
+
+// openConfiguredBeaconStore opens the store named by --store, or else the one
+// vrf.toml configures for `sidecar start`.
+func openConfiguredBeaconStore(v *viper.Viper) (*drand.BeaconStore, error) {
+	path := strings.TrimSpace(v.GetString("store"))
+	retention := uint64(drand.DefaultBeaconStoreRetention)
+
+	if path == "" || strings.TrimSpace(v.GetString("file")) != "" {
+		cfgPath, err := resolveVrfConfigPath(v)
+		if err != nil {
+			return nil, err
+		}
+		fileCfg, err := loadDrandFileConfig(cfgPath, true)
+		if err != nil {
+			return nil, err
+		}
+		retention = fileCfg.beaconStoreRetentionOr(retention)
+		if path == "" {
+			path = fileCfg.beaconStorePath(fileCfg.dataDirOr(""))
+		}
+	}
+	if path == "" {
+		return nil, fmt.Errorf("beacon store is disabled in vrf.toml (pass --store to use one anyway)")
+	}
+
+	return drand.OpenBeaconStore(path, retention)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -38,6 +39,9 @@ var (
 	errNilSidecarConfig               = errors.New("nil sidecar config")
 	errNilDynamicService              = errors.New("nil dynamic service")
 	errNilDKGManager                  = errors.New("nil dkg manager")
+	errDrandNotRunning                = errors.New("drand subprocess is not running")
+	errChainWatcherNotStarted         = errors.New("chain watcher is not started")
+	errChainGRPCDisconnected          = errors.New("chain gRPC connection is down")
 )
 
 type stringSliceFlag []string
@@ -116,6 +120,14 @@ func (c *drandController) Status() drand.ProcessStatus {
 	return proc.Status()
 }
 
+// CheckHealth reports whether the supervised drand subprocess is running.
+func (c *drandController) CheckHealth(context.Context) error {
+	if !c.Status().Running {
+		return errDrandNotRunning
+	}
+	return nil
+}
+
 func (c *drandController) TailLogs(n int) []drand.LogEntry {
 	if c == nil {
 		return nil
@@ -341,6 +353,50 @@ type chainWatchConfig struct {
 	reshareExtraArgs []string
 	reshareEnabled   bool
 	reshareTimeout   time.Duration
+	health           *chainWatchHealth
+}
+
+// chainWatchHealth tracks the connections of the chain watcher to the node:
+// the gRPC connection of the running watcher and the websocket delivering
+// the chain events.
+type chainWatchHealth struct {
+	ws *chainws.Pipeline
+
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+func newChainWatchHealth(ws *chainws.Pipeline) *chainWatchHealth {
+	return &chainWatchHealth{ws: ws}
+}
+
+// setConn records the gRPC connection of the running watcher, nil once it
+// stops.
+func (h *chainWatchHealth) setConn(conn *grpc.ClientConn) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	h.conn = conn
+	h.mu.Unlock()
+}
+
+// CheckHealth reports whether the chain watcher is running with a usable
+// gRPC connection and a subscribed websocket.
+func (h *chainWatchHealth) CheckHealth(ctx context.Context) error {
+	h.mu.Lock()
+	conn := h.conn
+	h.mu.Unlock()
+
+	if conn == nil {
+		return errChainWatcherNotStarted
+	}
+	switch state := conn.GetState(); state {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("%w: %s", errChainGRPCDisconnected, state)
+	}
+	return h.ws.CheckHealth(ctx)
 }
 
 func startChainWatcher(
@@ -405,9 +461,12 @@ func startChainWatcher(
 
 	enabled := params.Enabled
 	reshareEpoch := params.ReshareEpoch
-	serviceActive := false
+	var activeSvc *drand.DrandService
+	stopPrefetch := func() {}
 
+	opts.health.setConn(conn)
 	cleanup = func() {
+		opts.health.setConn(nil)
 		_ = conn.Close()
 	}
 
@@ -442,8 +501,17 @@ func startChainWatcher(
 			zap.String("reason", evt.Reason),
 		)
 
-		dyn.SetService(nil)
-		serviceActive = false
+		// Keep serving the rounds verified so far while drand is busy.
+		if activeSvc != nil {
+			dyn.SetService(activeSvc.Offline())
+		} else {
+			dyn.SetService(nil)
+		}
+		stopPrefetch()
+		if activeSvc != nil {
+			_ = activeSvc.Close()
+		}
+		activeSvc = nil
 
 		if err := runner.run(ctx, evt); err != nil {
 			logger.Error("drand reshare command failed", zap.Error(err))
@@ -502,7 +570,7 @@ func startChainWatcher(
 	}
 
 	tryStartService := func() {
-		if !chainConfigComplete(cfgSnapshot) || serviceActive {
+		if !chainConfigComplete(cfgSnapshot) || activeSvc != nil {
 			return
 		}
 
@@ -513,7 +581,11 @@ func startChainWatcher(
 		}
 
 		dyn.SetService(svc)
-		serviceActive = true
+		activeSvc = svc
+
+		prefetchCtx, cancel := context.WithCancel(ctx)
+		stopPrefetch = cancel
+		go svc.RunPrefetch(prefetchCtx)
 		logger.Info("drand service is ready")
 	}
 
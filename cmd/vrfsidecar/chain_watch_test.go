@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestChainWatchHealth(t *testing.T) {
+	ctx := context.Background()
+
+	// The websocket pipeline is never subscribed in this test.
+	h := newChainWatchHealth(nil)
+	if err := h.CheckHealth(ctx); !errors.Is(err, errChainWatcherNotStarted) {
+		t.Fatalf("expected errChainWatcherNotStarted, got %v", err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	conn.Connect()
+	waitCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if !conn.WaitForStateChange(waitCtx, state) {
+			t.Fatalf("gRPC connection not ready: %s", state)
+		}
+	}
+
+	// A ready gRPC connection still needs the websocket.
+	h.setConn(conn)
+	if err := h.CheckHealth(ctx); err == nil || errors.Is(err, errChainGRPCDisconnected) {
+		t.Fatalf("expected the websocket error, got %v", err)
+	}
+
+	_ = conn.Close()
+	if err := h.CheckHealth(ctx); !errors.Is(err, errChainGRPCDisconnected) {
+		t.Fatalf("expected errChainGRPCDisconnected, got %v", err)
+	}
+
+	h.setConn(nil)
+	if err := h.CheckHealth(ctx); !errors.Is(err, errChainWatcherNotStarted) {
+		t.Fatalf("expected errChainWatcherNotStarted after stop, got %v", err)
+	}
+}
@@ -360,6 +360,10 @@ func validateVrfTomlBytes(data []byte) error {
 	drandCfg := drand.Config{
 		DrandHTTP:                 fileCfg.httpOr(""),
 		DrandAllowNonLoopbackHTTP: allowNonLoopback,
+		DrandTransport:            drand.DrandTransport(fileCfg.transportOr(string(drand.DrandTransportHTTP))),
+		DrandGRPC:                 strings.TrimSpace(fileCfg.GRPCAddr),
+		DrandRelays:               fileCfg.relays(),
+		DrandCrossCheck:           fileCfg.crossCheckOr(false),
 		BinaryPath:                fileCfg.binaryOr("drand"),
 		DrandVersionCheck:         versionMode,
 		DrandDataDir:              fileCfg.dataDirOr(""),
@@ -375,6 +379,12 @@ func validateVrfTomlBytes(data []byte) error {
 	if err := drandCfg.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := fileCfg.serverTLS("").Validate(); err != nil {
+		return fmt.Errorf("invalid sidecar tls settings: %w", err)
+	}
+	if _, err := fileCfg.drandControlAuth(); err != nil {
+		return err
+	}
 
 	procCfg := drand.DrandProcessConfig{
 		BinaryPath:        drandCfg.BinaryPath,
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -10,9 +11,15 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
+)
+
+var errPlaintextToken = errors.New(
+	"refusing to send the control token in plaintext to a sidecar that is not on loopback or a unix socket; set --tls-ca-file",
 )
 
 func newDrandCmd() *cobra.Command {
@@ -28,8 +35,20 @@ func newDrandCmd() *cobra.Command {
 
 	root.PersistentFlags().String("addr", "", "sidecar gRPC address (unix://... or host:port)")
 
+	root.PersistentFlags().String("token", "", "bearer token for the sidecar's DrandControl service")
+	root.PersistentFlags().String("tls-ca-file", "", "CA bundle to verify the sidecar's TLS certificate (enables TLS)")
+	root.PersistentFlags().String("tls-server-name", "", "name the sidecar's TLS certificate must carry")
+	root.PersistentFlags().String("tls-cert-file", "", "client certificate for mutual TLS")
+	root.PersistentFlags().String("tls-key-file", "", "client key for mutual TLS")
+
 	_ = v.BindPFlag("addr", root.PersistentFlags().Lookup("addr"))
 	_ = v.BindEnv("addr", "SIDECAR_LISTEN_ADDR")
+	_ = v.BindPFlag("token", root.PersistentFlags().Lookup("token"))
+	_ = v.BindEnv("token", "SIDECAR_CONTROL_TOKEN")
+	for _, name := range []string{"tls-ca-file", "tls-server-name", "tls-cert-file", "tls-key-file"} {
+		_ = v.BindPFlag(name, root.PersistentFlags().Lookup(name))
+		_ = v.BindEnv(name, "SIDECAR_"+strings.ToUpper(strings.ReplaceAll(name, "-", "_")))
+	}
 
 	root.AddCommand(newDrandConfigCmd())
 	root.AddCommand(newDrandStartCmd(v))
@@ -66,7 +85,7 @@ func newDrandStartCmd(v *viper.Viper) *cobra.Command {
 			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
 			defer cancel()
 
-			conn, err := dialSidecar(ctx, addr)
+			conn, err := dialSidecar(ctx, addr, sidecarClientTLS(v), v.GetString("token"))
 			if err != nil {
 				return err
 			}
@@ -93,7 +112,7 @@ func newDrandStopCmd(v *viper.Viper) *cobra.Command {
 			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
 			defer cancel()
 
-			conn, err := dialSidecar(ctx, addr)
+			conn, err := dialSidecar(ctx, addr, sidecarClientTLS(v), v.GetString("token"))
 			if err != nil {
 				return err
 			}
@@ -125,7 +144,7 @@ func newDrandLogsCmd(v *viper.Viper) *cobra.Command {
 			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
 			defer cancel()
 
-			conn, err := dialSidecar(ctx, addr)
+			conn, err := dialSidecar(ctx, addr, sidecarClientTLS(v), v.GetString("token"))
 			if err != nil {
 				return err
 			}
@@ -198,12 +217,56 @@ func defaultSidecarAddr(v string) string {
 	return "unix:///var/run/vrf/sidecar.sock"
 }
 
-func dialSidecar(ctx context.Context, addr string) (*grpc.ClientConn, error) {
+// sidecarClientTLS returns the TLS settings of the drand subcommands. TLS is
+// used once a CA bundle is given.
+func sidecarClientTLS(v *viper.Viper) tlsconfig.ClientFiles {
+	caFile := strings.TrimSpace(v.GetString("tls-ca-file"))
+	return tlsconfig.ClientFiles{
+		Enabled:    caFile != "",
+		CAFile:     caFile,
+		CertFile:   strings.TrimSpace(v.GetString("tls-cert-file")),
+		KeyFile:    strings.TrimSpace(v.GetString("tls-key-file")),
+		ServerName: strings.TrimSpace(v.GetString("tls-server-name")),
+	}
+}
+
+// bearerCredentials sends a token as "authorization: Bearer <token>" on every
+// call. The sidecar may be reached over a unix socket or loopback without TLS,
+// so it does not require transport security; dialSidecar refuses any other
+// plaintext address.
+type bearerCredentials string
+
+func (b bearerCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(b)}, nil
+}
+
+func (bearerCredentials) RequireTransportSecurity() bool { return false }
+
+func dialSidecar(
+	ctx context.Context,
+	addr string,
+	tlsFiles tlsconfig.ClientFiles,
+	token string,
+) (*grpc.ClientConn, error) {
 	addr = strings.TrimSpace(addr)
 	if addr == "" {
 		return nil, fmt.Errorf("sidecar addr is empty")
 	}
 
+	token = strings.TrimSpace(token)
+	if token != "" && !tlsFiles.Enabled && !isLoopbackAddr(addr) {
+		return nil, fmt.Errorf("%w: %s", errPlaintextToken, addr)
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsFiles.Enabled {
+		tlsCfg, err := tlsconfig.NewClientConfig(tlsFiles)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
 	isUnix := strings.HasPrefix(addr, "unix://")
 	dialer := func(ctx context.Context, target string) (net.Conn, error) {
 		if isUnix {
@@ -213,13 +276,16 @@ func dialSidecar(ctx context.Context, addr string) (*grpc.ClientConn, error) {
 		return (&net.Dialer{}).DialContext(ctx, "tcp", target)
 	}
 
-	conn, err := grpc.DialContext(
-		ctx,
-		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
 		grpc.WithContextDialer(dialer),
 		grpc.WithBlock(),
-	)
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerCredentials(token)))
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2,14 +2,67 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	vrfserver "github.com/dgtlkitchen/vrf/sidecar/servers/vrf"
 )
 
+var errControlReadWithoutAdmin = errors.New(
+	"control_read_* settings in vrf.toml require control_token or control_allowed_uids for Start and Stop",
+)
+
+// drandControlAuth returns the caller policies of the DrandControl methods.
+// Start and Stop accept control_token and control_allowed_uids. The read-only
+// Status and Logs also accept control_read_token and
+// control_read_allowed_uids, or anyone if control_read_public is set. If
+// vrf.toml sets none of these, Status and Logs stay open and Start and Stop
+// only accept unix socket peers running as the sidecar's own UID.
+func (c drandFileConfig) drandControlAuth() (vrfserver.MethodAuth, error) {
+	adminToken := strings.TrimSpace(c.ControlToken)
+	readToken := strings.TrimSpace(c.ControlReadToken)
+	readPublic := c.ControlReadPublic != nil && *c.ControlReadPublic
+
+	admin := vrfserver.CallerPolicy{UIDs: c.ControlAllowedUIDs}
+	read := vrfserver.CallerPolicy{
+		Public: readPublic,
+		UIDs:   append(append([]uint32(nil), c.ControlAllowedUIDs...), c.ControlReadAllowedUIDs...),
+	}
+	if adminToken != "" {
+		admin.Tokens = []string{adminToken}
+		read.Tokens = append(read.Tokens, adminToken)
+	}
+	if readToken != "" {
+		read.Tokens = append(read.Tokens, readToken)
+	}
+
+	adminSet := len(admin.Tokens) > 0 || len(admin.UIDs) > 0
+	readSet := readPublic || readToken != "" || len(c.ControlReadAllowedUIDs) > 0
+	switch {
+	case !adminSet && !readSet:
+		own := vrfserver.CallerPolicy{UIDs: []uint32{uint32(os.Getuid())}}
+		return vrfserver.MethodAuth{
+			sidecarv1.DrandControl_Start_FullMethodName: own,
+			sidecarv1.DrandControl_Stop_FullMethodName:  own,
+		}, nil
+	case !adminSet:
+		return nil, errControlReadWithoutAdmin
+	}
+
+	return vrfserver.MethodAuth{
+		sidecarv1.DrandControl_Start_FullMethodName:  admin,
+		sidecarv1.DrandControl_Stop_FullMethodName:   admin,
+		sidecarv1.DrandControl_Status_FullMethodName: read,
+		sidecarv1.DrandControl_Logs_FullMethodName:   read,
+	}, nil
+}
+
 type drandControlServer struct {
 	sidecarv1.UnimplementedDrandControlServer
 
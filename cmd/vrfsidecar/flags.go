@@ -18,7 +18,9 @@ import (
 
 	"github.com/BurntSushi/toml"
 
+	"github.com/dgtlkitchen/vrf/sidecar/drand"
 	vrfserver "github.com/dgtlkitchen/vrf/sidecar/servers/vrf"
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
 )
 
 var (
@@ -42,6 +44,10 @@ type cliConfig struct {
 
 	DrandHTTP          string
 	DrandAllowNonLoop  bool
+	DrandRelays        []string
+	DrandCrossCheck    bool
+	DrandTransport     string
+	DrandGRPCAddr      string
 	DrandPublicAddr    string
 	DrandPrivateAddr   string
 	DrandControlAddr   string
@@ -71,11 +77,19 @@ type cliConfig struct {
 	ReshareEnabled      bool
 	DrandReshareTimeout time.Duration
 	DrandReshareArgs    []string
+
+	// BeaconStorePath is empty when the beacon store is disabled.
+	BeaconStorePath      string
+	BeaconStoreRetention uint64
 }
 
 type drandFileConfig struct {
 	HTTP                 string   `toml:"http"`
 	AllowNonLoopbackHTTP *bool    `toml:"allow_non_loopback_http"`
+	Transport            string   `toml:"transport"`
+	GRPCAddr             string   `toml:"grpc_addr"`
+	Relays               []string `toml:"relays"`
+	CrossCheck           *bool    `toml:"cross_check"`
 	AllowPublicBind      *bool    `toml:"allow_public_bind"`
 	PublicAddr           string   `toml:"public_addr"`
 	PrivateAddr          string   `toml:"private_addr"`
@@ -95,6 +109,21 @@ type drandFileConfig struct {
 	DKGJoinerAddrs       []string `toml:"dkg_joiner_addrs"`
 	GroupSourceAddr      string   `toml:"group_source_addr"`
 	GroupSourceToken     string   `toml:"group_source_token"`
+
+	BeaconStoreEnabled   *bool   `toml:"beacon_store_enabled"`
+	BeaconStorePath      string  `toml:"beacon_store_path"`
+	BeaconStoreRetention *uint64 `toml:"beacon_store_retention_rounds"`
+
+	TLSEnabled      *bool  `toml:"tls_enabled"`
+	TLSCertFile     string `toml:"tls_cert_file"`
+	TLSKeyFile      string `toml:"tls_key_file"`
+	TLSClientCAFile string `toml:"tls_client_ca_file"`
+
+	ControlToken           string   `toml:"control_token"`
+	ControlAllowedUIDs     []uint32 `toml:"control_allowed_uids"`
+	ControlReadToken       string   `toml:"control_read_token"`
+	ControlReadAllowedUIDs []uint32 `toml:"control_read_allowed_uids"`
+	ControlReadPublic      *bool    `toml:"control_read_public"`
 }
 
 func parseFlags(args []string) (cliConfig, error) {
@@ -192,47 +221,58 @@ func parseFlags(args []string) (cliConfig, error) {
 		MaxConcurrentStreams:         uint32(*grpcMaxConcurrentStreams),
 		MaxRecvMsgSize:               *grpcMaxRecvMsgSize,
 		MaxSendMsgSize:               *grpcMaxSendMsgSize,
+		TLS:                          fileCfg.serverTLS(configDir(configPath)),
+	}
+	grpcCfg.Auth, err = fileCfg.drandControlAuth()
+	if err != nil {
+		return cliConfig{}, err
 	}
 
 	return cliConfig{
-		ListenAddr:          *listenAddr,
-		AllowPublicBind:     allowPublic,
-		GRPC:                grpcCfg,
-		MetricsEnabled:      *metricsEnabled,
-		MetricsAddr:         *metricsAddr,
-		ChainID:             *chainID,
-		DebugHTTPEnabled:    *debugHTTPEnabled,
-		DebugHTTPAddr:       *debugHTTPAddr,
-		DrandHTTP:           *drandHTTP,
-		DrandAllowNonLoop:   *drandAllowNonLoop,
-		DrandPublicAddr:     *drandPublic,
-		DrandPrivateAddr:    *drandPrivate,
-		DrandControlAddr:    *drandControl,
-		DrandDataDir:        *drandDataDir,
-		DrandID:             *drandID,
-		DrandBinary:         *drandBinary,
-		DrandNoRestart:      *drandNoRestart,
-		DrandRestartMin:     *drandRestartMin,
-		DrandRestartMax:     *drandRestartMax,
-		DrandChainHashHex:   *chainHashHex,
-		DrandPublicKeyB64:   *publicKeyB64,
-		DrandPeriodSeconds:  *periodSeconds,
-		DrandGenesisUnix:    *genesisUnix,
-		DKGBeaconID:         fileCfg.dkgBeaconID(),
-		DKGThreshold:        fileCfg.DKGThreshold,
-		DKGPeriodSeconds:    fileCfg.DKGPeriodSeconds,
-		DKGGenesisUnix:      fileCfg.DKGGenesisUnix,
-		DKGTimeout:          dkgTimeout,
-		DKGCatchupSeconds:   fileCfg.DKGCatchupSeconds,
-		DKGJoinerAddrs:      fileCfg.dkgJoinerAddrs(),
-		GroupSourceAddr:     fileCfg.groupSourceAddr(),
-		GroupSourceToken:    fileCfg.groupSourceToken(),
-		ChainGRPCAddr:       chainGRPCAddr,
-		ChainRPCAddr:        chainRPCAddr,
-		ChainTimeoutCommit:  chainTimeoutCommit,
-		ReshareEnabled:      *reshareEnabled,
-		DrandReshareTimeout: *reshareTimeout,
-		DrandReshareArgs:    []string(reshareArgs),
+		ListenAddr:           *listenAddr,
+		AllowPublicBind:      allowPublic,
+		GRPC:                 grpcCfg,
+		MetricsEnabled:       *metricsEnabled,
+		MetricsAddr:          *metricsAddr,
+		ChainID:              *chainID,
+		DebugHTTPEnabled:     *debugHTTPEnabled,
+		DebugHTTPAddr:        *debugHTTPAddr,
+		DrandHTTP:            *drandHTTP,
+		DrandAllowNonLoop:    *drandAllowNonLoop,
+		DrandRelays:          fileCfg.relays(),
+		DrandTransport:       fileCfg.transportOr(string(drand.DrandTransportHTTP)),
+		DrandGRPCAddr:        strings.TrimSpace(fileCfg.GRPCAddr),
+		DrandCrossCheck:      fileCfg.crossCheckOr(false),
+		DrandPublicAddr:      *drandPublic,
+		DrandPrivateAddr:     *drandPrivate,
+		DrandControlAddr:     *drandControl,
+		DrandDataDir:         *drandDataDir,
+		DrandID:              *drandID,
+		DrandBinary:          *drandBinary,
+		DrandNoRestart:       *drandNoRestart,
+		DrandRestartMin:      *drandRestartMin,
+		DrandRestartMax:      *drandRestartMax,
+		DrandChainHashHex:    *chainHashHex,
+		DrandPublicKeyB64:    *publicKeyB64,
+		DrandPeriodSeconds:   *periodSeconds,
+		DrandGenesisUnix:     *genesisUnix,
+		DKGBeaconID:          fileCfg.dkgBeaconID(),
+		DKGThreshold:         fileCfg.DKGThreshold,
+		DKGPeriodSeconds:     fileCfg.DKGPeriodSeconds,
+		DKGGenesisUnix:       fileCfg.DKGGenesisUnix,
+		DKGTimeout:           dkgTimeout,
+		DKGCatchupSeconds:    fileCfg.DKGCatchupSeconds,
+		DKGJoinerAddrs:       fileCfg.dkgJoinerAddrs(),
+		GroupSourceAddr:      fileCfg.groupSourceAddr(),
+		GroupSourceToken:     fileCfg.groupSourceToken(),
+		ChainGRPCAddr:        chainGRPCAddr,
+		ChainRPCAddr:         chainRPCAddr,
+		ChainTimeoutCommit:   chainTimeoutCommit,
+		ReshareEnabled:       *reshareEnabled,
+		DrandReshareTimeout:  *reshareTimeout,
+		DrandReshareArgs:     []string(reshareArgs),
+		BeaconStorePath:      fileCfg.beaconStorePath(*drandDataDir),
+		BeaconStoreRetention: fileCfg.beaconStoreRetentionOr(drand.DefaultBeaconStoreRetention),
 	}, nil
 }
 
@@ -771,6 +811,35 @@ func (c drandFileConfig) dkgJoinerAddrs() []string {
 	return out
 }
 
+func (c drandFileConfig) transportOr(v string) string {
+	if strings.TrimSpace(c.Transport) == "" {
+		return v
+	}
+	return strings.TrimSpace(c.Transport)
+}
+
+func (c drandFileConfig) relays() []string {
+	if len(c.Relays) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(c.Relays))
+	for _, relay := range c.Relays {
+		relay = strings.TrimSpace(relay)
+		if relay == "" {
+			continue
+		}
+		out = append(out, relay)
+	}
+	return out
+}
+
+func (c drandFileConfig) crossCheckOr(v bool) bool {
+	if c.CrossCheck == nil {
+		return v
+	}
+	return *c.CrossCheck
+}
+
 func (c drandFileConfig) groupSourceAddr() string {
 	return strings.TrimSpace(c.GroupSourceAddr)
 }
@@ -779,6 +848,47 @@ func (c drandFileConfig) groupSourceToken() string {
 	return strings.TrimSpace(c.GroupSourceToken)
 }
 
+// beaconStorePath returns where the beacon store lives, or "" when it is
+// disabled. It defaults to a file next to the other sidecar state in the
+// drand data dir.
+func (c drandFileConfig) beaconStorePath(drandDataDir string) string {
+	if c.BeaconStoreEnabled != nil && !*c.BeaconStoreEnabled {
+		return ""
+	}
+	if path := strings.TrimSpace(c.BeaconStorePath); path != "" {
+		return path
+	}
+	if strings.TrimSpace(drandDataDir) == "" {
+		return ""
+	}
+	return filepath.Join(drandDataDir, "sidecar_beacons.db")
+}
+
+// serverTLS returns the gRPC server TLS files, with relative paths resolved
+// against dir, the directory holding vrf.toml.
+func (c drandFileConfig) serverTLS(dir string) tlsconfig.ServerFiles {
+	return tlsconfig.ServerFiles{
+		Enabled:      c.TLSEnabled != nil && *c.TLSEnabled,
+		CertFile:     strings.TrimSpace(c.TLSCertFile),
+		KeyFile:      strings.TrimSpace(c.TLSKeyFile),
+		ClientCAFile: strings.TrimSpace(c.TLSClientCAFile),
+	}.ResolvePaths(dir)
+}
+
+func configDir(configPath string) string {
+	if strings.TrimSpace(configPath) == "" {
+		return ""
+	}
+	return filepath.Dir(configPath)
+}
+
+func (c drandFileConfig) beaconStoreRetentionOr(v uint64) uint64 {
+	if c.BeaconStoreRetention == nil {
+		return v
+	}
+	return *c.BeaconStoreRetention
+}
+
 func validateBindConfig(cfg cliConfig) error {
 	if !cfg.AllowPublicBind && !isLoopbackAddr(cfg.ListenAddr) {
 		return fmt.Errorf("%w (addr=%s)", errSidecarNonLoopbackBind, cfg.ListenAddr)
@@ -1,10 +1,18 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	"github.com/dgtlkitchen/vrf/sidecar/drand"
+	vrfserver "github.com/dgtlkitchen/vrf/sidecar/servers/vrf"
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
 )
 
 func writeTestChainConfig(t *testing.T) string {
@@ -247,3 +255,180 @@ grpc-addr = "127.0.0.1:9090"
 		t.Fatal("expected error for invalid restart_backoff_min")
 	}
 }
+
+func TestParseFlags_BeaconStoreFromVrfToml(t *testing.T) {
+	home := writeTestChainConfig(t)
+	t.Setenv("CHAIN_HOME", home)
+	cfgDir := filepath.Join(home, "config")
+	dataDir := filepath.Join(home, "drand")
+
+	for _, tc := range []struct {
+		name          string
+		toml          string
+		wantPath      string
+		wantRetention uint64
+	}{
+		{
+			name:          "defaults",
+			toml:          ``,
+			wantPath:      filepath.Join(dataDir, "sidecar_beacons.db"),
+			wantRetention: drand.DefaultBeaconStoreRetention,
+		},
+		{
+			name: "custom",
+			toml: `
+beacon_store_path = "/var/lib/vrf/beacons.db"
+beacon_store_retention_rounds = 50
+`,
+			wantPath:      "/var/lib/vrf/beacons.db",
+			wantRetention: 50,
+		},
+		{
+			name: "disabled",
+			toml: `
+beacon_store_enabled = false
+beacon_store_path = "/var/lib/vrf/beacons.db"
+`,
+			wantPath:      "",
+			wantRetention: drand.DefaultBeaconStoreRetention,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vrfToml := `data_dir = "` + filepath.ToSlash(dataDir) + `"` + "\n" + tc.toml
+			if err := os.WriteFile(filepath.Join(cfgDir, "vrf.toml"), []byte(vrfToml), 0o644); err != nil {
+				t.Fatalf("failed to write vrf.toml: %v", err)
+			}
+
+			cfg, err := parseFlags([]string{"--drand-config", filepath.Join(cfgDir, "vrf.toml")})
+			if err != nil {
+				t.Fatalf("parseFlags returned error: %v", err)
+			}
+			if cfg.BeaconStorePath != tc.wantPath {
+				t.Fatalf("expected BeaconStorePath=%q, got %q", tc.wantPath, cfg.BeaconStorePath)
+			}
+			if cfg.BeaconStoreRetention != tc.wantRetention {
+				t.Fatalf("expected BeaconStoreRetention=%d, got %d", tc.wantRetention, cfg.BeaconStoreRetention)
+			}
+		})
+	}
+}
+
+func TestParseFlags_RelaysFromVrfToml(t *testing.T) {
+	home := writeTestChainConfig(t)
+	t.Setenv("CHAIN_HOME", home)
+	cfgDir := filepath.Join(home, "config")
+
+	vrfToml := `
+relays = ["https://api.drand.sh", " ", "https://drand.cloudflare.com/ "]
+cross_check = true
+`
+	if err := os.WriteFile(filepath.Join(cfgDir, "vrf.toml"), []byte(vrfToml), 0o644); err != nil {
+		t.Fatalf("failed to write vrf.toml: %v", err)
+	}
+
+	cfg, err := parseFlags([]string{"--drand-config", filepath.Join(cfgDir, "vrf.toml")})
+	if err != nil {
+		t.Fatalf("parseFlags returned error: %v", err)
+	}
+	want := []string{"https://api.drand.sh", "https://drand.cloudflare.com/"}
+	if !reflect.DeepEqual(cfg.DrandRelays, want) {
+		t.Fatalf("expected DrandRelays=%v, got %v", want, cfg.DrandRelays)
+	}
+	if !cfg.DrandCrossCheck {
+		t.Fatalf("expected DrandCrossCheck=true")
+	}
+}
+
+func TestParseFlags_TLSFromVrfToml(t *testing.T) {
+	home := writeTestChainConfig(t)
+	t.Setenv("CHAIN_HOME", home)
+	cfgDir := filepath.Join(home, "config")
+
+	vrfToml := `
+tls_enabled = true
+tls_cert_file = "tls/sidecar.pem"
+tls_key_file = "/etc/vrf/sidecar-key.pem"
+tls_client_ca_file = "tls/ca.pem"
+`
+	if err := os.WriteFile(filepath.Join(cfgDir, "vrf.toml"), []byte(vrfToml), 0o644); err != nil {
+		t.Fatalf("failed to write vrf.toml: %v", err)
+	}
+
+	cfg, err := parseFlags([]string{"--drand-config", filepath.Join(cfgDir, "vrf.toml")})
+	if err != nil {
+		t.Fatalf("parseFlags returned error: %v", err)
+	}
+	want := tlsconfig.ServerFiles{
+		Enabled:      true,
+		CertFile:     filepath.Join(cfgDir, "tls", "sidecar.pem"),
+		KeyFile:      "/etc/vrf/sidecar-key.pem",
+		ClientCAFile: filepath.Join(cfgDir, "tls", "ca.pem"),
+	}
+	if cfg.GRPC.TLS != want {
+		t.Fatalf("expected GRPC.TLS=%+v, got %+v", want, cfg.GRPC.TLS)
+	}
+	if err := validateVrfTomlBytes([]byte("tls_cert_file = \"tls/sidecar.pem\"\n")); err == nil {
+		t.Fatalf("expected TLS files without tls_enabled to fail validation")
+	}
+}
+
+func TestDrandControlAuthFromVrfToml(t *testing.T) {
+	auth, err := drandFileConfig{}.drandControlAuth()
+	if err != nil {
+		t.Fatalf("drandControlAuth returned error: %v", err)
+	}
+	own := vrfserver.CallerPolicy{UIDs: []uint32{uint32(os.Getuid())}}
+	want := vrfserver.MethodAuth{
+		sidecarv1.DrandControl_Start_FullMethodName: own,
+		sidecarv1.DrandControl_Stop_FullMethodName:  own,
+	}
+	if !reflect.DeepEqual(auth, want) {
+		t.Fatalf("expected Start and Stop limited to the own UID without control settings, got %+v", auth)
+	}
+
+	readPublic := true
+	if _, err := (drandFileConfig{ControlReadPublic: &readPublic}).drandControlAuth(); !errors.Is(err, errControlReadWithoutAdmin) {
+		t.Fatalf("expected errControlReadWithoutAdmin, got %v", err)
+	}
+
+	auth, err = drandFileConfig{
+		ControlToken:           " admin ",
+		ControlAllowedUIDs:     []uint32{1000},
+		ControlReadToken:       "read",
+		ControlReadAllowedUIDs: []uint32{1001},
+	}.drandControlAuth()
+	if err != nil {
+		t.Fatalf("drandControlAuth returned error: %v", err)
+	}
+	if err := auth.Validate(); err != nil {
+		t.Fatalf("invalid auth: %v", err)
+	}
+
+	stop := auth[sidecarv1.DrandControl_Stop_FullMethodName]
+	if !reflect.DeepEqual(stop.Tokens, []string{"admin"}) || !reflect.DeepEqual(stop.UIDs, []uint32{1000}) {
+		t.Fatalf("unexpected Stop policy: %+v", stop)
+	}
+	logs := auth[sidecarv1.DrandControl_Logs_FullMethodName]
+	if !reflect.DeepEqual(logs.Tokens, []string{"admin", "read"}) || !reflect.DeepEqual(logs.UIDs, []uint32{1000, 1001}) {
+		t.Fatalf("unexpected Logs policy: %+v", logs)
+	}
+}
+
+func TestDialSidecarRefusesPlaintextToken(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if _, err := dialSidecar(ctx, "10.0.0.1:8090", tlsconfig.ClientFiles{}, "secret"); !errors.Is(err, errPlaintextToken) {
+		t.Fatalf("expected errPlaintextToken, got %v", err)
+	}
+
+	// Loopback and unix sockets may carry the token without TLS.
+	for _, addr := range []string{"127.0.0.1:1", "unix:///nonexistent/sidecar.sock"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		_, err := dialSidecar(ctx, addr, tlsconfig.ClientFiles{}, "secret")
+		cancel()
+		if errors.Is(err, errPlaintextToken) {
+			t.Fatalf("expected %s to be allowed without TLS", addr)
+		}
+	}
+}
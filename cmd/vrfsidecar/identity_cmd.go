@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	drandcommon "github.com/drand/drand/v2/common"
+	drandkey "github.com/drand/drand/v2/common/key"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+var errIdentityShareMissing = errors.New("drand key share is missing; run the DKG first")
+
+func newIdentityProofCmd() *cobra.Command {
+	v := viper.New()
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+
+	cmd := &cobra.Command{
+		Use:   "identity-proof <chain-id> <validator-address>",
+		Short: "Print the MsgRegisterVrfIdentity flags proving possession of the local drand key share",
+		Long: `Loads the drand key share from the drand data dir and signs the identity
+proof for the given chain id and validator operator address. The output are
+the flags of "tx vrf register-identity".`,
+		Args:          cobra.ExactArgs(2),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataDir := strings.TrimSpace(v.GetString("data-dir"))
+			beaconID := strings.TrimSpace(v.GetString("id"))
+			if dataDir == "" || beaconID == "" {
+				cfgPath, err := resolveVrfConfigPath(v)
+				if err != nil {
+					return err
+				}
+				fileCfg, err := loadDrandFileConfig(cfgPath, false)
+				if err != nil {
+					return err
+				}
+				if dataDir == "" {
+					dataDir = fileCfg.dataDirOr("")
+				}
+				if beaconID == "" {
+					beaconID = fileCfg.idOr(drandcommon.DefaultBeaconID)
+				}
+			}
+			if dataDir == "" {
+				return fmt.Errorf("drand data dir is empty (set --data-dir or data_dir in vrf.toml)")
+			}
+
+			flags, err := identityProofFlags(dataDir, beaconID, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), flags)
+			return nil
+		},
+	}
+
+	cmd.Flags().String("file", "", "path to vrf.toml (overrides defaults when set)")
+	cmd.Flags().String("data-dir", "", "drand data dir (defaults to data_dir in vrf.toml)")
+	cmd.Flags().String("id", "", "drand beacon id (defaults to id in vrf.toml)")
+
+	_ = v.BindPFlag("file", cmd.Flags().Lookup("file"))
+	_ = v.BindEnv("file", "VRF_CONFIG")
+	_ = v.BindPFlag("data-dir", cmd.Flags().Lookup("data-dir"))
+	_ = v.BindPFlag("id", cmd.Flags().Lookup("id"))
+
+	return cmd
+}
+
+// identityProofFlags signs the identity proof of validatorAddr on chainID
+// with the drand key share stored under dataDir and returns the matching
+// register-identity flags.
+func identityProofFlags(dataDir, beaconID, chainID, validatorAddr string) (string, error) {
+	store := drandkey.NewFileStore(filepath.Join(dataDir, drandcommon.MultiBeaconFolder), beaconID)
+	share, err := store.LoadShare()
+	if err != nil {
+		return "", fmt.Errorf("loading drand key share: %w", err)
+	}
+	if share == nil || share.PrivateShare() == nil || share.Scheme == nil {
+		return "", errIdentityShareMissing
+	}
+
+	priv := share.PrivateShare()
+	pubKey, err := share.Scheme.KeyGroup.Point().Mul(priv.V, nil).MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("encoding drand public share: %w", err)
+	}
+
+	shareIndex := uint32(priv.I)
+	proof, err := vrftypes.SignIdentityProof(share.Scheme, priv.V, chainID, validatorAddr, shareIndex)
+	if err != nil {
+		return "", fmt.Errorf("signing identity proof: %w", err)
+	}
+
+	return fmt.Sprintf("--drand-bls-public-key %s --share-index %d --drand-bls-proof %s",
+		hex.EncodeToString(pubKey), shareIndex, hex.EncodeToString(proof)), nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	drandcommon "github.com/drand/drand/v2/common"
+	drandkey "github.com/drand/drand/v2/common/key"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+func TestIdentityProofFlags(t *testing.T) {
+	scheme := crypto.NewPedersenBLSUnchained()
+	priPoly := share.NewPriPoly(scheme.KeyGroup, 2, nil, random.New())
+	_, commits := priPoly.Commit(nil).Info()
+	priShare := priPoly.Shares(3)[2]
+
+	dataDir := t.TempDir()
+	if _, err := identityProofFlags(dataDir, drandcommon.DefaultBeaconID, "chain", "valoper"); err == nil {
+		t.Fatal("expected an error without a share on disk")
+	}
+
+	store := drandkey.NewFileStore(filepath.Join(dataDir, drandcommon.MultiBeaconFolder), drandcommon.DefaultBeaconID)
+	if err := store.SaveShare(&drandkey.Share{
+		DistKeyShare: dkg.DistKeyShare{Commits: commits, Share: priShare},
+		Scheme:       scheme,
+	}); err != nil {
+		t.Fatalf("saving share: %v", err)
+	}
+
+	out, err := identityProofFlags(dataDir, drandcommon.DefaultBeaconID, "chain", "valoper")
+	if err != nil {
+		t.Fatalf("identityProofFlags returned error: %v", err)
+	}
+
+	var pubHex, proofHex string
+	var shareIndex uint32
+	if _, err := fmt.Sscanf(out, "--drand-bls-public-key %s --share-index %d --drand-bls-proof %s", &pubHex, &shareIndex, &proofHex); err != nil {
+		t.Fatalf("unexpected output %q: %v", out, err)
+	}
+	if shareIndex != uint32(priShare.I) {
+		t.Fatalf("expected share index %d, got %d", priShare.I, shareIndex)
+	}
+
+	pubKey, _ := hex.DecodeString(pubHex)
+	proof, _ := hex.DecodeString(proofHex)
+	if err := vrftypes.VerifyIdentityProof(scheme, pubKey, "chain", "valoper", shareIndex, proof); err != nil {
+		t.Fatalf("proof does not verify: %v", err)
+	}
+	if err := vrftypes.VerifyIdentityProof(scheme, pubKey, "chain", "other", shareIndex, proof); err == nil {
+		t.Fatal("expected the proof to be bound to the validator address")
+	}
+}
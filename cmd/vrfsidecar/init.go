@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+
+	"github.com/dgtlkitchen/vrf/sidecar/drand"
 )
 
 func runInit(args []string) int {
@@ -110,7 +112,12 @@ func runInit(args []string) int {
 
 	allowNonLoop := *drandAllowNonLoop
 	noRestart := *drandNoRestart
+	crossCheck := false
 	allowPublicBind := false
+	beaconStoreEnabled := true
+	tlsEnabled := false
+	controlReadPublic := false
+	beaconStoreRetention := uint64(drand.DefaultBeaconStoreRetention)
 	dkgBeaconID := strings.TrimSpace(*drandID)
 	if dkgBeaconID == "" {
 		dkgBeaconID = "default"
@@ -118,6 +125,10 @@ func runInit(args []string) int {
 	cfg := drandFileConfig{
 		HTTP:                 strings.TrimSpace(*drandHTTP),
 		AllowNonLoopbackHTTP: &allowNonLoop,
+		Transport:            string(drand.DrandTransportHTTP),
+		GRPCAddr:             "",
+		Relays:               []string{},
+		CrossCheck:           &crossCheck,
 		AllowPublicBind:      &allowPublicBind,
 		PublicAddr:           strings.TrimSpace(*drandPublic),
 		PrivateAddr:          strings.TrimSpace(*drandPrivate),
@@ -137,6 +148,12 @@ func runInit(args []string) int {
 		DKGJoinerAddrs:       []string{},
 		GroupSourceAddr:      "",
 		GroupSourceToken:     "",
+		BeaconStoreEnabled:   &beaconStoreEnabled,
+		BeaconStorePath:      "",
+		BeaconStoreRetention: &beaconStoreRetention,
+		TLSEnabled:           &tlsEnabled,
+		ControlAllowedUIDs:   []uint32{},
+		ControlReadPublic:    &controlReadPublic,
 	}
 
 	var buf bytes.Buffer
@@ -21,6 +21,8 @@ func newRootCmd() *cobra.Command {
 		newVersionCmd(),
 		newConfigCmd(),
 		newDrandCmd(),
+		newBeaconsCmd(),
+		newIdentityProofCmd(),
 	)
 
 	return cmd
@@ -73,6 +73,10 @@ func runStart(parent context.Context, args []string) int {
 		logger.Error("invalid gRPC server config", zap.Error(err))
 		return 1
 	}
+	if !cfg.GRPC.TLS.Enabled && !isLoopbackAddr(cfg.ListenAddr) {
+		logger.Warn("sidecar gRPC is served in plaintext on a non-loopback address; set tls_enabled in vrf.toml",
+			zap.String("addr", cfg.ListenAddr))
+	}
 
 	metrics, err := sidecarmetrics.NewFromConfig(cfg.MetricsEnabled, cfg.ChainID)
 	if err != nil {
@@ -176,6 +180,10 @@ func runStart(parent context.Context, args []string) int {
 	drandCfg := drand.Config{
 		DrandHTTP:                 strings.TrimSpace(cfg.DrandHTTP),
 		DrandAllowNonLoopbackHTTP: cfg.DrandAllowNonLoop,
+		DrandTransport:            drand.DrandTransport(cfg.DrandTransport),
+		DrandGRPC:                 cfg.DrandGRPCAddr,
+		DrandRelays:               cfg.DrandRelays,
+		DrandCrossCheck:           cfg.DrandCrossCheck,
 		BinaryPath:                cfg.DrandBinary,
 		DrandVersionCheck:         versionMode,
 		DrandDataDir:              cfg.DrandDataDir,
@@ -255,6 +263,21 @@ func runStart(parent context.Context, args []string) int {
 		return 1
 	}
 
+	if cfg.BeaconStorePath != "" {
+		store, err := drand.OpenBeaconStore(cfg.BeaconStorePath, cfg.BeaconStoreRetention)
+		if err != nil {
+			logger.Error("failed to open beacon store", zap.Error(err))
+			return 1
+		}
+		defer func() { _ = store.Close() }()
+		drandCfg.BeaconStore = store
+		logger.Info(
+			"beacon store opened",
+			zap.String("path", cfg.BeaconStorePath),
+			zap.Uint64("retention_rounds", cfg.BeaconStoreRetention),
+		)
+	}
+
 	drandCtl = newDrandController(drand.DrandProcessConfig{
 		BinaryPath:        drandCfg.BinaryPath,
 		DataDir:           drandCfg.DrandDataDir,
@@ -283,11 +306,13 @@ func runStart(parent context.Context, args []string) int {
 		logger.Warn("debug HTTP server disabled; DKG identity/group endpoints will be unavailable")
 	}
 
+	chainHealth := newChainWatchHealth(ws)
 	if strings.TrimSpace(cfg.ChainGRPCAddr) != "" {
 		go runChainWatcherWithRetry(ctx, logger, metrics, &drandCfg, cfg.ChainGRPCAddr, chainWatchConfig{
 			reshareExtraArgs: cfg.DrandReshareArgs,
 			reshareEnabled:   cfg.ReshareEnabled,
 			reshareTimeout:   cfg.DrandReshareTimeout,
+			health:           chainHealth,
 		}, dyn, drandCtl, dkgMgr.Load(), initialDKGEvents, paramsUpdatedEvents, reshareEvents)
 	} else {
 		logger.Error("chain gRPC address is required; ensure config/client.toml or config/app.toml is present in the chain home")
@@ -303,6 +328,8 @@ func runStart(parent context.Context, args []string) int {
 		logger.Error("failed to configure gRPC server options", zap.Error(err))
 		return 1
 	}
+	server.SetDrandHealthCheck(drandCtl.CheckHealth)
+	server.SetChainHealthCheck(chainHealth.CheckHealth)
 	server.RegisterServices(func(reg grpc.ServiceRegistrar) {
 		sidecarv1.RegisterDrandControlServer(reg, newDrandControlServer(drandCtl))
 	})
@@ -199,6 +199,7 @@ func TestVRFEndToEnd(t *testing.T) {
 		Authority: govAddr,
 		Address:   valAddr,
 		Label:     "validator",
+		Roles:     []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP}},
 	}
 	committeeProp, err := chain.BuildProposal(
 		[]cosmos.ProtoMessage{committeeMsg},
@@ -221,6 +222,7 @@ func TestVRFEndToEnd(t *testing.T) {
 		"--public-key", base64.StdEncoding.EncodeToString(fixture.PublicKey),
 		"--period-seconds", strconv.FormatUint(fixture.PeriodSeconds, 10),
 		"--genesis-unix-sec", strconv.FormatInt(fixture.GenesisUnixSec, 10),
+		"--genesis-seed", base64.StdEncoding.EncodeToString(fixture.GenesisSeed),
 	)
 	require.NoError(t, err)
 
@@ -267,6 +269,7 @@ type drandFixture struct {
 	DataDir              string
 	ChainHash            []byte
 	PublicKey            []byte
+	GenesisSeed          []byte
 	PeriodSeconds        uint64
 	GenesisUnixSec       int64
 	CatchupPeriodSeconds uint64
@@ -338,6 +341,7 @@ func generateDrandFixture(t *testing.T, period time.Duration) drandFixture {
 		DataDir:              drandDir,
 		ChainHash:            info.Hash(),
 		PublicKey:            publicKey,
+		GenesisSeed:          info.GenesisSeed,
 		PeriodSeconds:        uint64(period.Seconds()),
 		GenesisUnixSec:       genesisUnix,
 		CatchupPeriodSeconds: uint64(group.CatchupPeriod.Seconds()),
@@ -220,6 +220,7 @@ chaind genesis collect-gentxs --home "$NODE1_HOME" >/dev/null 2>&1
 
 CHAIN_HASH_B64=$(jq -r '.chain_hash_b64' "$META")
 PUBLIC_KEY_B64=$(jq -r '.public_key_b64' "$META")
+GENESIS_SEED_B64=$(jq -r '.genesis_seed_b64' "$META")
 PERIOD_SECONDS=$(jq -r '.period_seconds' "$META")
 GENESIS_UNIX=$(jq -r '.genesis_unix_sec' "$META")
 SAFETY_MARGIN=$(jq -r '.safety_margin_seconds' "$META")
@@ -246,6 +247,7 @@ TMP_GENESIS="$GENESIS.tmp"
 jq \
   --arg chain_hash "$CHAIN_HASH_B64" \
   --arg public_key "$PUBLIC_KEY_B64" \
+  --arg genesis_seed "$GENESIS_SEED_B64" \
   --arg period "$PERIOD_SECONDS" \
   --arg genesis_unix "$GENESIS_UNIX" \
   --arg safety_margin "$SAFETY_MARGIN" \
@@ -262,15 +264,21 @@ jq \
   .app_state.vrf.params //= {} |
   .app_state.vrf.params.chain_hash = $chain_hash |
   .app_state.vrf.params.public_key = $public_key |
+  .app_state.vrf.params.genesis_seed = $genesis_seed |
   .app_state.vrf.params.period_seconds = $period |
   .app_state.vrf.params.genesis_unix_sec = $genesis_unix |
   .app_state.vrf.params.safety_margin_seconds = $safety_margin |
   .app_state.vrf.params.enabled = true |
   .app_state.vrf.params.reshare_epoch = "0" |
   .app_state.vrf.params.slashing_grace_blocks = "0" |
+  ([
+    "VRF_COMMITTEE_ROLE_EMERGENCY",
+    "VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP",
+    "VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER"
+  ] | map({role: ., expiry_height: "0"})) as $committee_roles |
   .app_state.vrf.committee = [
-    {address: $addr1, label: "validator-1"},
-    {address: $addr2, label: "validator-2"}
+    {address: $addr1, label: "validator-1", roles: $committee_roles},
+    {address: $addr2, label: "validator-2", roles: $committee_roles}
   ] |
   .app_state.vrf.identities = [
     {validator_address: $valoper1, drand_bls_public_key: $share1, chain_hash: $chain_hash, signal_unix_sec: "0", signal_reshare_epoch: "0"},
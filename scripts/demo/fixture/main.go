@@ -34,6 +34,7 @@ type nodeMeta struct {
 type metadata struct {
 	ChainHashB64         string     `json:"chain_hash_b64"`
 	PublicKeyB64         string     `json:"public_key_b64"`
+	GenesisSeedB64       string     `json:"genesis_seed_b64"`
 	PeriodSeconds        uint64     `json:"period_seconds"`
 	GenesisUnixSec       int64      `json:"genesis_unix_sec"`
 	CatchupPeriodSeconds uint64     `json:"catchup_period_seconds"`
@@ -151,6 +152,7 @@ func main() {
 	meta := metadata{
 		ChainHashB64:         base64.StdEncoding.EncodeToString(info.Hash()),
 		PublicKeyB64:         base64.StdEncoding.EncodeToString(publicKeyBytes),
+		GenesisSeedB64:       base64.StdEncoding.EncodeToString(info.GenesisSeed),
 		PeriodSeconds:        periodSeconds,
 		GenesisUnixSec:       genesisUnix,
 		CatchupPeriodSeconds: catchupSeconds,
@@ -32,7 +32,10 @@ const (
 	msgVrfEmergencyDisableTypeURL = "/digitalkitchen.vrf.v1.MsgVrfEmergencyDisable"
 )
 
-var errCometRPCAddrRequired = errors.New("comet RPC address is required")
+var (
+	errCometRPCAddrRequired = errors.New("comet RPC address is required")
+	errNotSubscribed        = errors.New("chain websocket is not subscribed")
+)
 
 type InitialDKGEvent struct {
 	Height         int64
@@ -135,6 +138,21 @@ func (p *Pipeline) Close() {
 	})
 }
 
+// CheckHealth reports whether the websocket is connected and subscribed to
+// the chain events.
+func (p *Pipeline) CheckHealth(context.Context) error {
+	if p == nil {
+		return errNotSubscribed
+	}
+
+	p.clientMu.Lock()
+	defer p.clientMu.Unlock()
+	if p.client == nil {
+		return errNotSubscribed
+	}
+	return nil
+}
+
 func (p *Pipeline) OnInitialDKG(fn func(context.Context, InitialDKGEvent)) {
 	if p == nil || fn == nil {
 		return
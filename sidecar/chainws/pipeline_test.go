@@ -2,6 +2,8 @@ package chainws
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -193,3 +195,23 @@ func TestParseEmergencyDisableEvent_ExtractsFields(t *testing.T) {
 		t.Fatalf("expected reason=oops, got %q", info.Reason)
 	}
 }
+
+func TestPipelineCheckHealth_NotSubscribed(t *testing.T) {
+	t.Parallel()
+
+	var nilPipeline *Pipeline
+	if err := nilPipeline.CheckHealth(context.Background()); !errors.Is(err, errNotSubscribed) {
+		t.Fatalf("expected errNotSubscribed for nil pipeline, got %v", err)
+	}
+
+	// Nothing listens on port 1, so the websocket never subscribes.
+	p, err := NewPipeline(context.Background(), "tcp://127.0.0.1:1", nil)
+	if err != nil {
+		t.Fatalf("new pipeline: %v", err)
+	}
+	defer p.Close()
+
+	if err := p.CheckHealth(context.Background()); !errors.Is(err, errNotSubscribed) {
+		t.Fatalf("expected errNotSubscribed, got %v", err)
+	}
+}
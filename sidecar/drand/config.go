@@ -2,18 +2,40 @@ package drand
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 )
 
+var errDrandCrossCheckNeedsRelay = errors.New("drand cross-check requires at least one relay")
+
 // Config contains the static configuration for a single canonical drand chain
 // as seen by a sidecar instance. All fields in this struct are expected to be
 // consistent with the on-chain VrfParams for the network.
 type Config struct {
 	// DrandHTTP is the base URL of the local drand HTTP endpoint, e.g.
-	// "http://127.0.0.1:8081". Only this statically configured endpoint is
-	// ever used for randomness / info fetches.
+	// "http://127.0.0.1:8081". It is always the first upstream tried for
+	// randomness / info fetches.
 	DrandHTTP string
 
+	// DrandRelays are base URLs of remote drand HTTP relays, tried in order
+	// after DrandHTTP when it times out, errors or lacks a round. Relays may
+	// be non-loopback: every beacon is verified against PublicKey, so a relay
+	// can withhold rounds but cannot forge them.
+	DrandRelays []string
+
+	// DrandCrossCheck requires every fetched beacon to be served identically
+	// by a second upstream before it is accepted. It needs at least one relay.
+	DrandCrossCheck bool
+
+	// DrandTransport selects how the local daemon is queried. It defaults to
+	// DrandTransportHTTP; relays are always queried over HTTP.
+	DrandTransport DrandTransport
+
+	// DrandGRPC is the address of the local daemon's gRPC Public service,
+	// used with DrandTransportGRPC. drand serves it on its private listen
+	// address, so it defaults to loopback on the DrandPrivateListen port.
+	DrandGRPC string
+
 	// DrandAllowNonLoopbackHTTP permits non-loopback drand HTTP endpoints.
 	// This is unsafe for production but useful for containerized dev setups
 	// where drand runs in a separate service on an isolated Docker network.
@@ -53,6 +75,10 @@ type Config struct {
 
 	// GenesisUnixSec is the drand genesis time (UNIX seconds) for round 1.
 	GenesisUnixSec int64
+
+	// BeaconStore, if set, persists verified beacons across drand restarts.
+	// It is shared by every DrandService built from this config.
+	BeaconStore *BeaconStore
 }
 
 // ValidateBasic validates the config for sidecar runtime wiring.
@@ -64,6 +90,20 @@ func (c Config) ValidateBasic() error {
 		return err
 	}
 
+	transport, err := ParseDrandTransport(string(c.DrandTransport))
+	if err != nil {
+		return err
+	}
+	if transport == DrandTransportGRPC {
+		addr, err := c.grpcAddr()
+		if err != nil {
+			return err
+		}
+		if err := enforceLoopbackGRPC(addr); err != nil {
+			return err
+		}
+	}
+
 	httpEndpoint := strings.TrimSpace(c.DrandHTTP)
 	if httpEndpoint == "" {
 		publicListen := strings.TrimSpace(c.DrandPublicListen)
@@ -79,6 +119,15 @@ func (c Config) ValidateBasic() error {
 		return err
 	}
 
+	for _, relay := range c.DrandRelays {
+		if err := validateDrandHTTPEndpoint(relay, true); err != nil {
+			return fmt.Errorf("drand relay %q: %w", relay, err)
+		}
+	}
+	if c.DrandCrossCheck && len(c.DrandRelays) == 0 {
+		return errDrandCrossCheckNeedsRelay
+	}
+
 	if strings.TrimSpace(c.DrandDataDir) == "" {
 		return errDrandDataDirRequired
 	}
@@ -0,0 +1,39 @@
+package drand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+// healthMaxRoundLag is how many rounds the latest verified round may trail
+// the current one before the service is reported unhealthy. The prefetcher
+// normally verifies each round within its period, so a lag of one is routine.
+const healthMaxRoundLag = 2
+
+// CheckHealth reports whether s can serve fresh randomness: a round has been
+// verified, and the latest one is at most healthMaxRoundLag rounds behind the
+// current round. It fails when every upstream has been unreachable for a few
+// periods.
+func (s *DrandService) CheckHealth(context.Context) error {
+	return s.healthAt(time.Now())
+}
+
+func (s *DrandService) healthAt(now time.Time) error {
+	latest := s.latestSeenRound()
+	if latest == 0 {
+		return fmt.Errorf("%w: no round verified yet", scerror.ErrStaleRandomness)
+	}
+
+	current := s.currentRound(now)
+	if latest+healthMaxRoundLag < current {
+		return fmt.Errorf("%w: latest verified round %d, current round %d", scerror.ErrStaleRandomness, latest, current)
+	}
+	return nil
+}
+
+func (offlineService) CheckHealth(context.Context) error {
+	return fmt.Errorf("%w: drand is offline for a reshare", scerror.ErrServiceUnavailable)
+}
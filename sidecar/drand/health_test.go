@@ -0,0 +1,40 @@
+package drand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/dgtlkitchen/vrf/sidecar"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+func TestDrandService_CheckHealth(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+
+	if svc.latestSeenRound() == 0 {
+		require.ErrorIs(t, svc.healthAt(svc.roundTime(2)), scerror.ErrStaleRandomness)
+	}
+
+	_, err = svc.Randomness(ctx, 2)
+	require.NoError(t, err)
+	require.NoError(t, svc.healthAt(svc.roundTime(4)))
+	require.ErrorIs(t, svc.healthAt(svc.roundTime(5)), scerror.ErrStaleRandomness)
+
+	offline, ok := svc.Offline().(sidecar.HealthChecker)
+	require.True(t, ok)
+	require.ErrorIs(t, offline.CheckHealth(ctx), scerror.ErrServiceUnavailable)
+}
@@ -0,0 +1,92 @@
+package drand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/key"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+var (
+	errPartialSignatureChained   = errors.New("partial signatures require an unchained drand scheme")
+	errPartialSignatureZeroRound = errors.New("partial signature round must be > 0")
+	errPartialSignatureFuture    = errors.New("partial signature round is not due yet")
+	errDrandShareMissing         = errors.New("drand key share is missing")
+)
+
+// maxPartialSignatureLead is how many rounds past the current drand round
+// PartialSignature still signs, to absorb clock skew between nodes. Partials
+// for later rounds would let t callers recover a beacon before it is due.
+const maxPartialSignatureLead = 1
+
+// PartialSignature signs the digest of the given round with the local drand
+// key share. The share is reloaded from the drand key store on every call so
+// that a completed reshare is picked up without restarting the sidecar.
+// Rounds more than maxPartialSignatureLead past the current round are
+// rejected.
+func (s *DrandService) PartialSignature(
+	_ context.Context,
+	round uint64,
+) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	if round == 0 {
+		return nil, fmt.Errorf("%w: %w", scerror.ErrWrongRound, errPartialSignatureZeroRound)
+	}
+
+	if s.scheme == nil {
+		return nil, errDrandVerificationNotInitialized
+	}
+
+	if strings.HasSuffix(s.scheme.Name, "-chained") {
+		return nil, fmt.Errorf("%w: %w", scerror.ErrServiceUnavailable, errPartialSignatureChained)
+	}
+
+	if current := s.currentRound(time.Now()); round > current+maxPartialSignatureLead {
+		return nil, fmt.Errorf("%w: %w: round %d, current round %d", scerror.ErrWrongRound, errPartialSignatureFuture, round, current)
+	}
+
+	share, err := s.loadShare()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", scerror.ErrServiceUnavailable, err)
+	}
+
+	msg := s.scheme.DigestBeacon(&common.Beacon{Round: round})
+
+	partial, err := s.scheme.ThresholdScheme.Sign(share.PrivateShare(), msg)
+	if err != nil {
+		return nil, fmt.Errorf("signing partial for round %d: %w", round, err)
+	}
+
+	return &sidecarv1.QueryPartialSignatureResponse{
+		DrandRound:       round,
+		PartialSignature: partial,
+	}, nil
+}
+
+func (s *DrandService) loadShare() (*key.Share, error) {
+	beaconID := strings.TrimSpace(s.cfg.DrandID)
+	if beaconID == "" {
+		beaconID = common.DefaultBeaconID
+	}
+
+	base := filepath.Join(strings.TrimSpace(s.cfg.DrandDataDir), common.MultiBeaconFolder)
+	store := key.NewFileStore(base, beaconID)
+
+	share, err := store.LoadShare()
+	if err != nil {
+		return nil, fmt.Errorf("loading drand key share: %w", err)
+	}
+
+	if share == nil || share.PrivateShare() == nil {
+		return nil, errDrandShareMissing
+	}
+
+	return share, nil
+}
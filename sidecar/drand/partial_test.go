@@ -0,0 +1,74 @@
+package drand
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/key"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/share/dkg"
+	"github.com/drand/kyber/util/random"
+	"github.com/stretchr/testify/require"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+func TestDrandService_PartialSignature(t *testing.T) {
+	scheme := crypto.NewPedersenBLSUnchained()
+	priPoly := share.NewPriPoly(scheme.KeyGroup, 2, nil, random.New())
+	pubPoly := priPoly.Commit(nil)
+	priShare := priPoly.Shares(3)[1]
+
+	dataDir := t.TempDir()
+	svc := &DrandService{
+		cfg: Config{
+			DrandDataDir:   dataDir,
+			PeriodSeconds:  3,
+			GenesisUnixSec: time.Now().Add(-time.Minute).Unix(),
+		},
+		scheme: scheme,
+	}
+
+	// Without a share on disk the sidecar cannot sign.
+	_, err := svc.PartialSignature(context.Background(), 7)
+	require.ErrorIs(t, err, scerror.ErrServiceUnavailable)
+
+	_, commits := pubPoly.Info()
+	store := key.NewFileStore(filepath.Join(dataDir, common.MultiBeaconFolder), common.DefaultBeaconID)
+	require.NoError(t, store.SaveShare(&key.Share{
+		DistKeyShare: dkg.DistKeyShare{Commits: commits, Share: priShare},
+		Scheme:       scheme,
+	}))
+
+	res, err := svc.PartialSignature(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), res.DrandRound)
+
+	msg := scheme.DigestBeacon(&common.Beacon{Round: 7})
+	require.NoError(t, scheme.ThresholdScheme.VerifyPartial(pubPoly, msg, res.PartialSignature))
+
+	index, err := scheme.ThresholdScheme.IndexOf(res.PartialSignature)
+	require.NoError(t, err)
+	require.Equal(t, priShare.I, index)
+
+	_, err = svc.PartialSignature(context.Background(), 0)
+	require.ErrorIs(t, err, scerror.ErrWrongRound)
+
+	// The next round may be signed, later rounds are not due yet.
+	current := svc.currentRound(time.Now())
+	_, err = svc.PartialSignature(context.Background(), current+1)
+	require.NoError(t, err)
+	_, err = svc.PartialSignature(context.Background(), current+10)
+	require.ErrorIs(t, err, scerror.ErrWrongRound)
+	require.ErrorIs(t, err, errPartialSignatureFuture)
+
+	// Chained digests depend on the previous signature, which a partial
+	// signer does not have.
+	svc.scheme = crypto.NewPedersenBLSChained()
+	_, err = svc.PartialSignature(context.Background(), 7)
+	require.ErrorIs(t, err, scerror.ErrServiceUnavailable)
+}
@@ -0,0 +1,74 @@
+package drand
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+// defaultPrefetchRetry is how often RunPrefetch asks again for a round that
+// is due but not yet served. drand publishes a round shortly after its
+// nominal time, so this is kept well below any beacon period.
+const defaultPrefetchRetry = 250 * time.Millisecond
+
+// RunPrefetch fetches and verifies each round at its publication time, so
+// that Randomness serves it from the round cache afterwards. It starts with
+// the current round and returns when ctx is done.
+//
+// A round that is still missing when the next one is due is given up; a later
+// Randomness call for it fetches it on demand.
+//
+// With the gRPC transport, rounds are received from drand's PublicRandStream
+// instead, and polling only covers the time the stream is down.
+func (s *DrandService) RunPrefetch(ctx context.Context) {
+	go s.runRoundStream(ctx)
+
+	round := s.currentRound(time.Now())
+	for {
+		if round > 0 && !s.streaming.Load() {
+			s.prefetchRound(ctx, round)
+		}
+
+		round = max(round+1, s.currentRound(time.Now()))
+		if err := waitOrNotify(ctx, nil, time.Until(s.roundTime(round))); err != nil {
+			return
+		}
+	}
+}
+
+func (s *DrandService) prefetchRound(ctx context.Context, round uint64) {
+	ctx, cancel := context.WithDeadline(ctx, s.roundTime(round+1))
+	defer cancel()
+
+	for {
+		if _, ok := s.cachedRound(round); ok {
+			return
+		}
+
+		_, err := s.Randomness(ctx, round)
+		switch {
+		case err == nil, ctx.Err() != nil:
+			return
+		case errors.Is(err, scerror.ErrBadSignature),
+			errors.Is(err, scerror.ErrHashMismatch),
+			errors.Is(err, scerror.ErrWrongRound):
+			s.logger.Warn("drand prefetch rejected beacon", zap.Uint64("round", round), zap.Error(err))
+			return
+		}
+
+		if waitOrNotify(ctx, nil, s.prefetchRetryInterval()) != nil {
+			return
+		}
+	}
+}
+
+func (s *DrandService) prefetchRetryInterval() time.Duration {
+	if s.prefetchRetry > 0 {
+		return s.prefetchRetry
+	}
+	return defaultPrefetchRetry
+}
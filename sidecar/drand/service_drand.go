@@ -19,6 +19,7 @@ import (
 	"github.com/drand/drand/v2/common/chain"
 	"github.com/drand/drand/v2/crypto"
 	"github.com/drand/kyber"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
 
@@ -43,21 +44,29 @@ var (
 	errDrandVerificationNotInitialized = errors.New("drand verification not initialized")
 	errNilDrandChainInfo               = errors.New("nil drand chain info")
 	errEmptyHexString                  = errors.New("empty hex string")
+	errDrandNoCrossCheckUpstream       = errors.New("no other drand upstream to cross-check against")
 )
 
+// defaultRoundCacheSize is how many verified rounds DrandService keeps in
+// memory. Vote extensions ask for rounds close to the latest one, so a few
+// minutes' worth of rounds covers them.
+const defaultRoundCacheSize = 256
+
 var newHTTPClient = func() *http.Client {
 	return &http.Client{Timeout: 5 * time.Second}
 }
 
-// DrandService implements Service by talking to a local drand HTTP endpoint
-// using only statically configured URLs. It can also be used alongside a
-// supervised drand subprocess (see StartDrandProcess).
+// DrandService implements Service by talking to a local drand HTTP endpoint,
+// failing over to statically configured relays, and never to discovered URLs.
+// It can also be used alongside a supervised drand subprocess (see
+// StartDrandProcess).
 type DrandService struct {
 	cfg     Config
 	logger  *zap.Logger
 	metrics sidecarmetrics.Metrics
 
 	httpClient *http.Client
+	upstreams  []*upstream
 
 	sf       singleflight.Group
 	fetchSem chan struct{}
@@ -72,6 +81,21 @@ type DrandService struct {
 	cacheMu      sync.RWMutex
 	cachedLatest *sidecarv1.QueryRandomnessResponse
 	cachedAt     time.Time
+
+	// rounds holds verified beacons by round. Verified beacons never change,
+	// so entries are only evicted for space.
+	rounds        *lru.Cache[uint64, *sidecarv1.QueryRandomnessResponse]
+	prefetchRetry time.Duration
+	// streaming is set while the gRPC round stream delivers rounds, so that
+	// RunPrefetch does not poll for them as well.
+	streaming atomic.Bool
+
+	// roundMu guards the newest verified round and the channel that
+	// WatchRandomness callers wait on for the next one.
+	roundMu     sync.Mutex
+	latestRound uint64
+	roundCh     chan struct{}
+	watchRetry  time.Duration
 }
 
 // NewDrandService constructs a new DrandService, checking the configured drand
@@ -90,16 +114,33 @@ func NewDrandService(
 		m = sidecarmetrics.NewNop()
 	}
 
-	if strings.TrimSpace(cfg.DrandHTTP) == "" {
-		return nil, errDrandHTTPEndpointRequired
+	transport, err := ParseDrandTransport(string(cfg.DrandTransport))
+	if err != nil {
+		return nil, err
 	}
+	cfg.DrandTransport = transport
 
-	if !cfg.DrandAllowNonLoopbackHTTP {
-		if err := enforceLoopbackHTTP(cfg.DrandHTTP); err != nil {
-			return nil, err
+	if transport == DrandTransportHTTP {
+		if strings.TrimSpace(cfg.DrandHTTP) == "" {
+			return nil, errDrandHTTPEndpointRequired
+		}
+
+		if !cfg.DrandAllowNonLoopbackHTTP {
+			if err := enforceLoopbackHTTP(cfg.DrandHTTP); err != nil {
+				return nil, err
+			}
 		}
 	}
 
+	for _, relay := range cfg.DrandRelays {
+		if err := validateDrandHTTPEndpoint(relay, true); err != nil {
+			return nil, fmt.Errorf("drand relay %q: %w", relay, err)
+		}
+	}
+	if cfg.DrandCrossCheck && len(cfg.DrandRelays) == 0 {
+		return nil, errDrandCrossCheckNeedsRelay
+	}
+
 	if len(cfg.ChainHash) == 0 || len(cfg.PublicKey) == 0 || cfg.PeriodSeconds == 0 || cfg.GenesisUnixSec == 0 {
 		return nil, errDrandChainConfigIncomplete
 	}
@@ -108,6 +149,16 @@ func NewDrandService(
 		return nil, err
 	}
 
+	rounds, err := lru.New[uint64, *sidecarv1.QueryRandomnessResponse](defaultRoundCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("creating drand round cache: %w", err)
+	}
+
+	upstreams, err := newUpstreams(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &DrandService{
 		cfg: cfg,
 		logger: logger.With(
@@ -117,23 +168,35 @@ func NewDrandService(
 		fetchSem:   make(chan struct{}, 1),
 		httpClient: newHTTPClient(),
 		cacheTTL:   1 * time.Second,
+		rounds:     rounds,
+		upstreams:  upstreams,
 	}
 	if s.httpClient == nil {
 		s.httpClient = &http.Client{Timeout: 5 * time.Second}
 	}
 
+	if err := s.init(ctx); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// init discovers and validates the chain info and loads the verification key.
+func (s *DrandService) init(ctx context.Context) error {
 	info, err := s.fetchChainInfo(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	infoRes, err := queryInfoResponseFromChainInfo(info)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := ValidateDrandChainInfo(infoRes, cfg); err != nil {
-		return nil, err
+	if err := ValidateDrandChainInfo(infoRes, s.cfg); err != nil {
+		return err
 	}
 
 	s.chainInfo = infoRes
@@ -145,15 +208,27 @@ func NewDrandService(
 
 	s.scheme, err = crypto.SchemeFromName(schemeName)
 	if err != nil {
-		return nil, fmt.Errorf("loading drand scheme %q: %w", schemeName, err)
+		return fmt.Errorf("loading drand scheme %q: %w", schemeName, err)
 	}
 
 	s.pubKey = s.scheme.KeyGroup.Point()
-	if err := s.pubKey.UnmarshalBinary(cfg.PublicKey); err != nil {
-		return nil, fmt.Errorf("decoding drand public key: %w", err)
+	if err := s.pubKey.UnmarshalBinary(s.cfg.PublicKey); err != nil {
+		return fmt.Errorf("decoding drand public key: %w", err)
 	}
 
-	return s, nil
+	return nil
+}
+
+// Close releases the connections to gRPC upstreams. Beacons that were already
+// verified can still be served afterwards, e.g. through Offline.
+func (s *DrandService) Close() error {
+	var errs []error
+	for _, up := range s.upstreams {
+		if up.conn != nil {
+			errs = append(errs, up.conn.Close())
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // ValidateDrandChainInfo enforces that the discovered drand chain info matches
@@ -183,18 +258,26 @@ func ValidateDrandChainInfo(info *sidecarv1.QueryInfoResponse, cfg Config) error
 }
 
 // Randomness fetches a beacon for the given round from the configured drand
-// HTTP endpoint. A round of zero requests the latest beacon. Fetches are
+// HTTP upstreams. A round of zero requests the latest beacon. Rounds that were
+// already verified, e.g. by RunPrefetch, are served from memory, and then
+// from the beacon store if one is configured. Fetches are
 // serialized so that at most one upstream drand HTTP request is in-flight.
 func (s *DrandService) Randomness(
 	ctx context.Context,
 	round uint64,
 ) (*sidecarv1.QueryRandomnessResponse, error) {
+	now := time.Now()
 	if round == 0 {
-		now := time.Now()
 		if beacon, ok := s.cachedLatestBeacon(now); ok {
 			s.observeTimeSinceLastSuccess(now)
 			return beacon, nil
 		}
+	} else if beacon, ok := s.cachedRound(round); ok {
+		s.observeTimeSinceLastSuccess(now)
+		return beacon, nil
+	} else if beacon, ok := s.storedRound(round); ok {
+		s.observeTimeSinceLastSuccess(now)
+		return beacon, nil
 	}
 
 	key := fmt.Sprintf("round-%d", round)
@@ -269,6 +352,24 @@ func (s *DrandService) cachedLatestBeacon(now time.Time) (*sidecarv1.QueryRandom
 	return cloneRandomnessResponse(s.cachedLatest), true
 }
 
+func (s *DrandService) cacheRound(beacon *sidecarv1.QueryRandomnessResponse) {
+	if s.rounds == nil {
+		return
+	}
+	s.rounds.Add(beacon.DrandRound, cloneRandomnessResponse(beacon))
+}
+
+func (s *DrandService) cachedRound(round uint64) (*sidecarv1.QueryRandomnessResponse, bool) {
+	if s.rounds == nil {
+		return nil, false
+	}
+	beacon, ok := s.rounds.Get(round)
+	if !ok {
+		return nil, false
+	}
+	return cloneRandomnessResponse(beacon), true
+}
+
 func (s *DrandService) observeTimeSinceLastSuccess(now time.Time) {
 	lastNanos := s.lastSuccessUnixNano.Load()
 	if lastNanos == 0 {
@@ -288,160 +389,221 @@ type drandHTTPBeacon struct {
 	PreviousSignature string `json:"previous_signature"`
 }
 
+// fetchBeacon fetches and verifies a beacon from the first upstream that
+// serves it, trying them in upstreamOrder. With DrandCrossCheck set, the
+// beacon is only accepted once a second upstream serves it identically. If
+// every upstream fails, the error of the first one tried is returned.
 func (s *DrandService) fetchBeacon(ctx context.Context, round uint64) (*sidecarv1.QueryRandomnessResponse, error) {
-	chainHashHex := fmt.Sprintf("%x", s.cfg.ChainHash)
-	requestedRound := round
-	var servedRound uint64
 	result := sidecarmetrics.FetchOther
-	var retErr error
+	defer func() { s.metrics.AddDrandFetch(result) }()
 
-	defer func() {
-		s.metrics.AddDrandFetch(result)
-
-		fields := []zap.Field{
-			zap.Uint64("round", requestedRound),
-			zap.Uint64("served_round", servedRound),
-			zap.String("chain_hash", chainHashHex),
-			zap.String("result", string(result)),
-		}
-		if retErr != nil {
-			fields = append(fields, zap.Error(retErr))
+	var firstErr error
+	upstreams := s.upstreamOrder(time.Now())
+	for i, up := range upstreams {
+		out, res, err := s.fetchFrom(ctx, up, round)
+		if err != nil {
+			if firstErr == nil {
+				result, firstErr = res, err
+			}
+			if ctx.Err() != nil {
+				break
+			}
+			continue
 		}
 
-		switch result {
-		case sidecarmetrics.FetchSuccess, sidecarmetrics.FetchNotFound:
-			s.logger.Info("drand fetch attempt", fields...)
-		case sidecarmetrics.FetchTimeout,
-			sidecarmetrics.FetchHTTPError,
-			sidecarmetrics.FetchDecodeError,
-			sidecarmetrics.FetchHashMismatch,
-			sidecarmetrics.FetchBadSignature,
-			sidecarmetrics.FetchWrongRound,
-			sidecarmetrics.FetchOther:
-			s.logger.Warn("drand fetch attempt", fields...)
-		default:
-			s.logger.Warn("drand fetch attempt (unknown result)", fields...)
+		if s.cfg.DrandCrossCheck {
+			others := append(append([]*upstream{}, upstreams[i+1:]...), upstreams[:i]...)
+			if res, err := s.crossCheck(ctx, out, up, others); err != nil {
+				result = res
+				return nil, err
+			}
 		}
-	}()
+
+		result = sidecarmetrics.FetchSuccess
+		s.acceptBeacon(out, round == 0)
+		return out, nil
+	}
+
+	return nil, firstErr
+}
+
+// acceptBeacon records a verified (and, if configured, cross-checked) beacon:
+// it is cached, persisted and announced to watchers. latest marks a beacon
+// that was fetched as the latest one.
+func (s *DrandService) acceptBeacon(beacon *sidecarv1.QueryRandomnessResponse, latest bool) {
+	s.metrics.SetDrandLatestRound(beacon.DrandRound)
+	s.lastSuccessUnixNano.Store(time.Now().UnixNano())
+
+	if latest {
+		s.cacheLatest(time.Now(), beacon)
+	}
+	s.cacheRound(beacon)
+	s.storeRound(beacon)
+	s.noteVerifiedRound(beacon.DrandRound)
+}
+
+// rawBeacon is a beacon as an upstream served it, before verification.
+type rawBeacon struct {
+	round             uint64
+	signature         []byte
+	previousSignature []byte
+	// randomness is empty when the upstream did not send it.
+	randomness []byte
+}
+
+// fetchFrom fetches and verifies a beacon from a single upstream, and
+// updates that upstream's health score and metrics.
+func (s *DrandService) fetchFrom(
+	ctx context.Context,
+	up *upstream,
+	round uint64,
+) (*sidecarv1.QueryRandomnessResponse, sidecarmetrics.FetchResult, error) {
+	var (
+		raw    rawBeacon
+		result sidecarmetrics.FetchResult
+		err    error
+	)
+	if up.public != nil {
+		raw, result, err = s.fetchRawGRPC(ctx, up, round)
+	} else {
+		raw, result, err = s.fetchRawHTTP(ctx, up, round)
+	}
+
+	var out *sidecarv1.QueryRandomnessResponse
+	if err == nil {
+		out, result, err = s.verifyRawBeacon(round, raw)
+	}
+
+	s.recordUpstreamFetch(up, round, raw.round, result, err)
+	return out, result, err
+}
+
+func (s *DrandService) recordUpstreamFetch(
+	up *upstream,
+	requestedRound, servedRound uint64,
+	result sidecarmetrics.FetchResult,
+	err error,
+) {
+	s.metrics.AddDrandUpstreamFetch(up.label, result)
+	s.metrics.SetDrandUpstreamHealthy(up.label, up.record(result, time.Now()))
+
+	fields := []zap.Field{
+		zap.String("upstream", up.label),
+		zap.Uint64("round", requestedRound),
+		zap.Uint64("served_round", servedRound),
+		zap.String("chain_hash", fmt.Sprintf("%x", s.cfg.ChainHash)),
+		zap.String("result", string(result)),
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	switch result {
+	case sidecarmetrics.FetchSuccess, sidecarmetrics.FetchNotFound:
+		s.logger.Info("drand fetch attempt", fields...)
+	case sidecarmetrics.FetchTimeout,
+		sidecarmetrics.FetchHTTPError,
+		sidecarmetrics.FetchDecodeError,
+		sidecarmetrics.FetchHashMismatch,
+		sidecarmetrics.FetchBadSignature,
+		sidecarmetrics.FetchWrongRound,
+		sidecarmetrics.FetchOther:
+		s.logger.Warn("drand fetch attempt", fields...)
+	default:
+		s.logger.Warn("drand fetch attempt (unknown result)", fields...)
+	}
+}
+
+func (s *DrandService) fetchRawHTTP(
+	ctx context.Context,
+	up *upstream,
+	round uint64,
+) (rawBeacon, sidecarmetrics.FetchResult, error) {
+	chainHashHex := fmt.Sprintf("%x", s.cfg.ChainHash)
 
 	path := fmt.Sprintf("/%s/public/latest", chainHashHex)
 	if round > 0 {
 		path = fmt.Sprintf("/%s/public/%d", chainHashHex, round)
 	}
 
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		strings.TrimRight(s.cfg.DrandHTTP, "/")+path,
-		nil,
-	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, up.baseURL+path, nil)
 	if err != nil {
-		retErr = fmt.Errorf("creating drand request: %w", err)
-		result = sidecarmetrics.FetchOther
-		return nil, retErr
+		return rawBeacon{}, sidecarmetrics.FetchOther, fmt.Errorf("creating drand request: %w", err)
 	}
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		retErr = fmt.Errorf("querying drand: %w", err)
-
 		var netErr net.Error
 		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
-			result = sidecarmetrics.FetchTimeout
-		} else {
-			result = sidecarmetrics.FetchHTTPError
+			return rawBeacon{}, sidecarmetrics.FetchTimeout, fmt.Errorf("querying drand: %w", err)
 		}
-
-		return nil, retErr
+		return rawBeacon{}, sidecarmetrics.FetchHTTPError, fmt.Errorf("querying drand: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode == http.StatusNotFound {
-		retErr = scerror.ErrRoundNotAvailable
-		result = sidecarmetrics.FetchNotFound
-		return nil, retErr
+		return rawBeacon{}, sidecarmetrics.FetchNotFound, scerror.ErrRoundNotAvailable
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		retErr = fmt.Errorf("%w: %s", errDrandReturnedNon200, resp.Status)
-		result = sidecarmetrics.FetchHTTPError
-		return nil, retErr
+		return rawBeacon{}, sidecarmetrics.FetchHTTPError, fmt.Errorf("%w: %s", errDrandReturnedNon200, resp.Status)
 	}
 
 	var hb drandHTTPBeacon
 	if err := json.NewDecoder(resp.Body).Decode(&hb); err != nil {
-		retErr = fmt.Errorf("decoding drand response: %w", err)
-		result = sidecarmetrics.FetchDecodeError
-		return nil, retErr
-	}
-	servedRound = hb.Round
-
-	if round > 0 && hb.Round != round {
-		retErr = fmt.Errorf("%w: drand returned round %d for requested round %d", scerror.ErrWrongRound, hb.Round, round)
-		result = sidecarmetrics.FetchWrongRound
-		return nil, retErr
+		return rawBeacon{}, sidecarmetrics.FetchDecodeError, fmt.Errorf("decoding drand response: %w", err)
 	}
+	raw := rawBeacon{round: hb.Round}
 
-	sig, err := decodeHexBytes(hb.Signature)
-	if err != nil {
-		retErr = fmt.Errorf("decoding signature: %w", err)
-		result = sidecarmetrics.FetchDecodeError
-		return nil, retErr
+	if raw.signature, err = decodeHexBytes(hb.Signature); err != nil {
+		return raw, sidecarmetrics.FetchDecodeError, fmt.Errorf("decoding signature: %w", err)
 	}
 
-	var prevSig []byte
 	if strings.TrimSpace(hb.PreviousSignature) != "" {
-		prevSig, err = decodeHexBytes(hb.PreviousSignature)
-		if err != nil {
-			retErr = fmt.Errorf("decoding previous signature: %w", err)
-			result = sidecarmetrics.FetchDecodeError
-			return nil, retErr
+		if raw.previousSignature, err = decodeHexBytes(hb.PreviousSignature); err != nil {
+			return raw, sidecarmetrics.FetchDecodeError, fmt.Errorf("decoding previous signature: %w", err)
 		}
 	}
 
-	randomness := crypto.RandomnessFromSignature(sig)
-
-	// If the endpoint returned randomness, verify it matches the local derivation.
 	if strings.TrimSpace(hb.Randomness) != "" {
-		gotRand, err := decodeHexBytes(hb.Randomness)
-		if err != nil {
-			retErr = fmt.Errorf("decoding randomness: %w", err)
-			result = sidecarmetrics.FetchDecodeError
-			return nil, retErr
-		}
-		if !bytes.Equal(gotRand, randomness) {
-			retErr = fmt.Errorf("%w: drand randomness mismatch", scerror.ErrHashMismatch)
-			result = sidecarmetrics.FetchHashMismatch
-			return nil, retErr
+		if raw.randomness, err = decodeHexBytes(hb.Randomness); err != nil {
+			return raw, sidecarmetrics.FetchDecodeError, fmt.Errorf("decoding randomness: %w", err)
 		}
 	}
 
-	if err := s.verifyBeacon(hb.Round, sig, prevSig); err != nil {
-		retErr = err
-		if errors.Is(err, scerror.ErrBadSignature) {
-			result = sidecarmetrics.FetchBadSignature
-		} else {
-			result = sidecarmetrics.FetchOther
-		}
-		return nil, retErr
+	return raw, sidecarmetrics.FetchSuccess, nil
+}
+
+// verifyRawBeacon checks that raw is the requested round (any round when
+// round is zero), derives its randomness and verifies its signature.
+func (s *DrandService) verifyRawBeacon(
+	round uint64,
+	raw rawBeacon,
+) (*sidecarv1.QueryRandomnessResponse, sidecarmetrics.FetchResult, error) {
+	if round > 0 && raw.round != round {
+		return nil, sidecarmetrics.FetchWrongRound, fmt.Errorf("%w: drand returned round %d for requested round %d", scerror.ErrWrongRound, raw.round, round)
 	}
 
-	result = sidecarmetrics.FetchSuccess
-	s.metrics.SetDrandLatestRound(hb.Round)
-	s.lastSuccessUnixNano.Store(time.Now().UnixNano())
+	randomness := crypto.RandomnessFromSignature(raw.signature)
 
-	out := &sidecarv1.QueryRandomnessResponse{
-		DrandRound:        hb.Round,
-		Randomness:        randomness,
-		Signature:         sig,
-		PreviousSignature: prevSig,
+	// If the endpoint returned randomness, verify it matches the local derivation.
+	if len(raw.randomness) > 0 && !bytes.Equal(raw.randomness, randomness) {
+		return nil, sidecarmetrics.FetchHashMismatch, fmt.Errorf("%w: drand randomness mismatch", scerror.ErrHashMismatch)
 	}
 
-	if round == 0 {
-		s.cacheLatest(time.Now(), out)
+	if err := s.verifyBeacon(raw.round, raw.signature, raw.previousSignature); err != nil {
+		if errors.Is(err, scerror.ErrBadSignature) {
+			return nil, sidecarmetrics.FetchBadSignature, err
+		}
+		return nil, sidecarmetrics.FetchOther, err
 	}
 
-	return out, nil
+	return &sidecarv1.QueryRandomnessResponse{
+		DrandRound:        raw.round,
+		Randomness:        randomness,
+		Signature:         raw.signature,
+		PreviousSignature: raw.previousSignature,
+	}, sidecarmetrics.FetchSuccess, nil
 }
 
 func (s *DrandService) verifyBeacon(round uint64, sig, prevSig []byte) error {
@@ -466,13 +628,36 @@ func (s *DrandService) verifyBeacon(round uint64, sig, prevSig []byte) error {
 	return nil
 }
 
+// fetchChainInfo returns /info from the first upstream that serves it.
 func (s *DrandService) fetchChainInfo(ctx context.Context) (*chain.Info, error) {
+	var firstErr error
+	for _, up := range s.upstreamOrder(time.Now()) {
+		info, err := s.fetchChainInfoFrom(ctx, up)
+		if err == nil {
+			return info, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		s.logger.Warn("drand /info fetch failed", zap.String("upstream", up.label), zap.Error(err))
+	}
+	return nil, firstErr
+}
+
+func (s *DrandService) fetchChainInfoFrom(ctx context.Context, up *upstream) (*chain.Info, error) {
+	if up.public != nil {
+		return s.fetchChainInfoGRPC(ctx, up)
+	}
+
 	chainHashHex := fmt.Sprintf("%x", s.cfg.ChainHash)
 
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
-		strings.TrimRight(s.cfg.DrandHTTP, "/")+fmt.Sprintf("/%s/info", chainHashHex),
+		up.baseURL+fmt.Sprintf("/%s/info", chainHashHex),
 		nil,
 	)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
 	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
 	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
 )
@@ -354,7 +355,8 @@ func TestDrandService_RandomnessRequestUsesStaticBaseAndCanonicalPath(t *testing
 	})
 
 	t.Run("explicit_round_gt_0", func(t *testing.T) {
-		const round uint64 = 2
+		// Round 2 was cached by the latest fetch above.
+		const round uint64 = 1
 
 		var captured url.URL
 		haveCaptured := make(chan struct{}, 1)
@@ -386,3 +388,191 @@ func TestDrandService_RandomnessRequestUsesStaticBaseAndCanonicalPath(t *testing
 		require.Empty(t, captured.Fragment)
 	})
 }
+
+func TestDrandService_WatchRandomnessResumesFromRound(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+
+	errStop := errors.New("stop")
+	var rounds []uint64
+	err = svc.WatchRandomness(ctx, 1, func(res *sidecarv1.QueryRandomnessResponse) error {
+		rounds = append(rounds, res.DrandRound)
+		if res.DrandRound == 2 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []uint64{1, 2}, rounds)
+}
+
+func TestDrandService_WatchRandomnessRetriesUntilCancelled(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	svc.watchRetry = 10 * time.Millisecond
+
+	// Round 3 is past its publication time but never served.
+	watchCtx, watchCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer watchCancel()
+
+	var rounds []uint64
+	err = svc.WatchRandomness(watchCtx, 0, func(res *sidecarv1.QueryRandomnessResponse) error {
+		rounds = append(rounds, res.DrandRound)
+		return nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, []uint64{2}, rounds)
+}
+
+func TestDrandService_WatchRandomnessWakesOnNewRound(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	prevSig, err := hex.DecodeString(fx.beacons[2].Signature)
+	require.NoError(t, err)
+	sig3 := mustMakeRecoveredSig(t, fx.scheme, fx.pubPoly, fx.priShare, &common.Beacon{
+		Round:       3,
+		PreviousSig: prevSig,
+	})
+	fx.beacons[3] = drandHTTPBeacon{
+		Round:             3,
+		Signature:         hex.EncodeToString(sig3),
+		PreviousSignature: fx.beacons[2].Signature,
+	}
+
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	// Move genesis so that round 3 is not due for another minute.
+	svc.cfg.GenesisUnixSec = time.Now().Add(time.Minute).Unix()
+
+	errStop := errors.New("stop")
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchRandomness(ctx, 0, func(res *sidecarv1.QueryRandomnessResponse) error {
+			if res.DrandRound == 3 {
+				return errStop
+			}
+			return nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return svc.latestSeenRound() == 2 }, 2*time.Second, 10*time.Millisecond)
+	svc.noteVerifiedRound(3)
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, errStop)
+	case <-time.After(2 * time.Second):
+		t.Fatal("watcher did not wake up for the new round")
+	}
+}
+
+func TestDrandService_WatchRandomnessRejectsOldResume(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	svc.cfg.GenesisUnixSec = time.Now().Add(-24 * time.Hour).Unix()
+
+	err = svc.WatchRandomness(ctx, 1, func(*sidecarv1.QueryRandomnessResponse) error {
+		t.Fatal("unexpected beacon")
+		return nil
+	})
+	require.ErrorIs(t, err, scerror.ErrRoundTooOld)
+}
+
+func TestDrandService_ServesVerifiedRoundsFromCache(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+
+	var roundCalls atomic.Int64
+	base := handlerRoundTripper(handler)
+	withHTTPRoundTripper(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/public/1") {
+			roundCalls.Add(1)
+		}
+		return base.RoundTrip(req)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+
+	res1, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+	res2, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+
+	require.Equal(t, res1, res2)
+	require.Equal(t, int64(1), roundCalls.Load())
+
+	// Callers get a copy they may modify.
+	res2.Randomness[0] ^= 0xff
+	res3, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, res1, res3)
+}
+
+func TestDrandService_PrefetchesCurrentRound(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	handler, _ := newTestDrandHandler(t, fx)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	withHTTPRoundTripper(t, handlerRoundTripper(handler))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	// Move genesis so that round 2 is the current round.
+	svc.cfg.GenesisUnixSec = time.Now().Add(-time.Duration(svc.cfg.PeriodSeconds) * time.Second).Unix()
+
+	prefetchCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		svc.RunPrefetch(prefetchCtx)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, ok := svc.cachedRound(2)
+		return ok
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stop()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("prefetcher did not stop")
+	}
+}
@@ -0,0 +1,345 @@
+package drand
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/drand/drand/v2/crypto"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	"github.com/dgtlkitchen/vrf/sidecar"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+// DefaultBeaconStoreRetention is how many rounds behind the newest stored one
+// a BeaconStore keeps: about three and a half days at a 3s period.
+const DefaultBeaconStoreRetention = 100_000
+
+const beaconStoreOpenTimeout = time.Second
+
+var (
+	errBeaconStorePathRequired = errors.New("beacon store path must be provided")
+	errBeaconStoreChainHash    = errors.New("beacon store: chain hash must be provided")
+	errBeaconStoreNilBeacon    = errors.New("beacon store: nil beacon")
+	errBeaconStoreZeroRound    = errors.New("beacon store: beacon round must be > 0")
+)
+
+// BeaconStore persists verified beacons on disk, keyed by chain hash and
+// round, so that the sidecar can serve rounds it verified before while the
+// local drand daemon is down.
+//
+// Entries are not trusted on the way back: DrandService verifies every beacon
+// it reads from the store, which also covers beacons seeded with Import.
+type BeaconStore struct {
+	db        *bolt.DB
+	retention uint64
+}
+
+// OpenBeaconStore opens or creates the store at path. Rounds more than
+// retention rounds behind the newest stored round of a chain are pruned on
+// write; a retention of zero keeps every round. The file is locked while
+// open, so a second process fails instead of waiting.
+func OpenBeaconStore(path string, retention uint64) (*BeaconStore, error) {
+	if path == "" {
+		return nil, errBeaconStorePathRequired
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating beacon store dir: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: beaconStoreOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("opening beacon store %q: %w", path, err)
+	}
+
+	return &BeaconStore{db: db, retention: retention}, nil
+}
+
+func (s *BeaconStore) Close() error {
+	return s.db.Close()
+}
+
+// Get returns the stored beacon of the given chain and round, if any.
+func (s *BeaconStore) Get(chainHash []byte, round uint64) (*sidecarv1.QueryRandomnessResponse, bool, error) {
+	var out *sidecarv1.QueryRandomnessResponse
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(chainHash)
+		if b == nil {
+			return nil
+		}
+		bz := b.Get(roundKey(round))
+		if bz == nil {
+			return nil
+		}
+		out = &sidecarv1.QueryRandomnessResponse{}
+		return proto.Unmarshal(bz, out)
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("reading round %d from beacon store: %w", round, err)
+	}
+
+	return out, out != nil, nil
+}
+
+// Put stores beacon under the given chain and prunes the rounds that fell out
+// of retention.
+func (s *BeaconStore) Put(chainHash []byte, beacon *sidecarv1.QueryRandomnessResponse) error {
+	return s.putAll(chainHash, []*sidecarv1.QueryRandomnessResponse{beacon})
+}
+
+func (s *BeaconStore) putAll(chainHash []byte, beacons []*sidecarv1.QueryRandomnessResponse) error {
+	if len(chainHash) == 0 {
+		return errBeaconStoreChainHash
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(chainHash)
+		if err != nil {
+			return err
+		}
+
+		for _, beacon := range beacons {
+			switch {
+			case beacon == nil:
+				return errBeaconStoreNilBeacon
+			case beacon.DrandRound == 0:
+				return errBeaconStoreZeroRound
+			}
+
+			bz, err := proto.Marshal(beacon)
+			if err != nil {
+				return err
+			}
+			if err := b.Put(roundKey(beacon.DrandRound), bz); err != nil {
+				return err
+			}
+		}
+
+		return s.prune(b)
+	})
+}
+
+// prune deletes the rounds of b that are more than s.retention rounds behind
+// its newest round. Keys are big-endian rounds, so they sort by round.
+func (s *BeaconStore) prune(b *bolt.Bucket) error {
+	if s.retention == 0 {
+		return nil
+	}
+
+	c := b.Cursor()
+	last, _ := c.Last()
+	if last == nil || binary.BigEndian.Uint64(last) <= s.retention {
+		return nil
+	}
+	cutoff := roundKey(binary.BigEndian.Uint64(last) - s.retention)
+
+	// Restart from the first key after each delete; moving a bbolt cursor
+	// past a deleted key can skip the next one.
+	for k, _ := c.First(); k != nil && bytes.Compare(k, cutoff) < 0; k, _ = c.First() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportedBeacon is one line of a beacon export. The byte fields are hex, as
+// in drand's HTTP API.
+type exportedBeacon struct {
+	ChainHash         string `json:"chain_hash"`
+	Round             uint64 `json:"round"`
+	Randomness        string `json:"randomness"`
+	Signature         string `json:"signature"`
+	PreviousSignature string `json:"previous_signature,omitempty"`
+}
+
+// Export writes every stored beacon of every chain to w as JSON lines, in
+// chain and round order, and returns how many it wrote.
+func (s *BeaconStore) Export(w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	n := 0
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(chainHash []byte, b *bolt.Bucket) error {
+			return b.ForEach(func(_, v []byte) error {
+				var beacon sidecarv1.QueryRandomnessResponse
+				if err := proto.Unmarshal(v, &beacon); err != nil {
+					return err
+				}
+				n++
+				return enc.Encode(exportedBeacon{
+					ChainHash:         hex.EncodeToString(chainHash),
+					Round:             beacon.DrandRound,
+					Randomness:        hex.EncodeToString(beacon.Randomness),
+					Signature:         hex.EncodeToString(beacon.Signature),
+					PreviousSignature: hex.EncodeToString(beacon.PreviousSignature),
+				})
+			})
+		})
+	})
+
+	return n, err
+}
+
+// Import reads beacons written by Export from r and stores them, overwriting
+// stored rounds. It returns how many it imported. Nothing is verified here;
+// DrandService verifies each beacon when it is first served.
+func (s *BeaconStore) Import(r io.Reader) (int, error) {
+	byChain := make(map[string][]*sidecarv1.QueryRandomnessResponse)
+	var order []string
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+
+		var eb exportedBeacon
+		if err := json.Unmarshal(sc.Bytes(), &eb); err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+		chainHash, beacon, err := eb.decode()
+		if err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		key := string(chainHash)
+		if _, ok := byChain[key]; !ok {
+			order = append(order, key)
+		}
+		byChain[key] = append(byChain[key], beacon)
+	}
+	if err := sc.Err(); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, key := range order {
+		if err := s.putAll([]byte(key), byChain[key]); err != nil {
+			return n, err
+		}
+		n += len(byChain[key])
+	}
+
+	return n, nil
+}
+
+func (eb exportedBeacon) decode() ([]byte, *sidecarv1.QueryRandomnessResponse, error) {
+	chainHash, err := decodeHexBytes(eb.ChainHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding chain hash: %w", err)
+	}
+
+	beacon := &sidecarv1.QueryRandomnessResponse{DrandRound: eb.Round}
+	if beacon.Randomness, err = decodeHexBytes(eb.Randomness); err != nil {
+		return nil, nil, fmt.Errorf("decoding randomness: %w", err)
+	}
+	if beacon.Signature, err = decodeHexBytes(eb.Signature); err != nil {
+		return nil, nil, fmt.Errorf("decoding signature: %w", err)
+	}
+	if eb.PreviousSignature != "" {
+		if beacon.PreviousSignature, err = decodeHexBytes(eb.PreviousSignature); err != nil {
+			return nil, nil, fmt.Errorf("decoding previous signature: %w", err)
+		}
+	}
+
+	return chainHash, beacon, nil
+}
+
+func roundKey(round uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, round)
+}
+
+// storedRound returns the given round from the beacon store after verifying
+// it. A beacon that fails verification is logged and treated as missing, so
+// that the round is fetched from drand instead.
+func (s *DrandService) storedRound(round uint64) (*sidecarv1.QueryRandomnessResponse, bool) {
+	store := s.cfg.BeaconStore
+	if store == nil {
+		return nil, false
+	}
+
+	beacon, ok, err := store.Get(s.cfg.ChainHash, round)
+	if err != nil {
+		s.logger.Warn("failed to read beacon store", zap.Uint64("round", round), zap.Error(err))
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+
+	if beacon.DrandRound != round || !bytes.Equal(beacon.Randomness, crypto.RandomnessFromSignature(beacon.Signature)) {
+		s.logger.Warn("ignoring malformed stored beacon", zap.Uint64("round", round))
+		return nil, false
+	}
+	if err := s.verifyBeacon(round, beacon.Signature, beacon.PreviousSignature); err != nil {
+		s.logger.Warn("ignoring stored beacon that fails verification", zap.Uint64("round", round), zap.Error(err))
+		return nil, false
+	}
+
+	s.cacheRound(beacon)
+	return beacon, true
+}
+
+func (s *DrandService) storeRound(beacon *sidecarv1.QueryRandomnessResponse) {
+	store := s.cfg.BeaconStore
+	if store == nil {
+		return
+	}
+
+	if err := store.Put(s.cfg.ChainHash, beacon); err != nil {
+		s.logger.Warn("failed to persist beacon", zap.Uint64("round", beacon.DrandRound), zap.Error(err))
+	}
+}
+
+// Offline returns a Service that serves only the rounds s has already
+// verified, from memory or from the beacon store. The sidecar switches to it
+// while the drand daemon is taken over for a reshare.
+func (s *DrandService) Offline() sidecar.Service {
+	return offlineService{s: s}
+}
+
+type offlineService struct {
+	s *DrandService
+}
+
+func (o offlineService) Randomness(_ context.Context, round uint64) (*sidecarv1.QueryRandomnessResponse, error) {
+	if round == 0 {
+		return nil, scerror.ErrServiceUnavailable
+	}
+	if beacon, ok := o.s.cachedRound(round); ok {
+		return beacon, nil
+	}
+	if beacon, ok := o.s.storedRound(round); ok {
+		return beacon, nil
+	}
+	return nil, scerror.ErrServiceUnavailable
+}
+
+func (o offlineService) Info(ctx context.Context) (*sidecarv1.QueryInfoResponse, error) {
+	return o.s.Info(ctx)
+}
+
+func (offlineService) PartialSignature(context.Context, uint64) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	return nil, scerror.ErrServiceUnavailable
+}
+
+func (offlineService) WatchRandomness(context.Context, uint64, func(*sidecarv1.QueryRandomnessResponse) error) error {
+	return scerror.ErrServiceUnavailable
+}
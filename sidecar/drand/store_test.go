@@ -0,0 +1,189 @@
+package drand
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+func openTestBeaconStore(t *testing.T, retention uint64) *BeaconStore {
+	t.Helper()
+
+	store, err := OpenBeaconStore(filepath.Join(t.TempDir(), "beacons.db"), retention)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func testBeacon(round uint64) *sidecarv1.QueryRandomnessResponse {
+	return &sidecarv1.QueryRandomnessResponse{
+		DrandRound: round,
+		Randomness: []byte{byte(round), 1},
+		Signature:  []byte{byte(round), 2},
+	}
+}
+
+func TestBeaconStore_PutGetPrune(t *testing.T) {
+	store := openTestBeaconStore(t, 3)
+	chainA, chainB := []byte("chain-a"), []byte("chain-b")
+
+	for round := uint64(1); round <= 5; round++ {
+		require.NoError(t, store.Put(chainA, testBeacon(round)))
+	}
+	require.NoError(t, store.Put(chainB, testBeacon(1)))
+
+	for round := uint64(1); round <= 5; round++ {
+		got, ok, err := store.Get(chainA, round)
+		require.NoError(t, err)
+		// Rounds more than 3 behind round 5 are pruned.
+		require.Equal(t, round >= 2, ok, "round %d", round)
+		if ok {
+			require.Equal(t, testBeacon(round).Signature, got.Signature)
+		}
+	}
+
+	// Pruning is per chain.
+	_, ok, err := store.Get(chainB, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, ok, err = store.Get([]byte("unknown"), 2)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.Error(t, store.Put(nil, testBeacon(1)))
+	require.Error(t, store.Put(chainA, testBeacon(0)))
+}
+
+func TestBeaconStore_ExportImport(t *testing.T) {
+	src := openTestBeaconStore(t, 0)
+	for round := uint64(1); round <= 3; round++ {
+		require.NoError(t, src.Put([]byte("chain-a"), testBeacon(round)))
+	}
+	require.NoError(t, src.Put([]byte("chain-b"), testBeacon(7)))
+
+	var buf bytes.Buffer
+	n, err := src.Export(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	dst := openTestBeaconStore(t, 0)
+	n, err = dst.Import(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+
+	got, ok, err := dst.Get([]byte("chain-b"), 7)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, testBeacon(7).Randomness, got.Randomness)
+
+	_, err = dst.Import(bytes.NewReader([]byte("{\"chain_hash\":\"zz\"}\n")))
+	require.Error(t, err)
+}
+
+func TestBeaconStore_SecondOpenFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacons.db")
+	store, err := OpenBeaconStore(path, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	_, err = OpenBeaconStore(path, 0)
+	require.Error(t, err)
+}
+
+// newStoreBackedService returns a DrandService over fx that persists to store.
+// Requests fail with a transport error once drandDown is closed.
+func newStoreBackedService(t *testing.T, fx *testDrandFixture, store *BeaconStore, drandDown <-chan struct{}) *DrandService {
+	t.Helper()
+
+	handler, _ := newTestDrandHandler(t, fx)
+	base := handlerRoundTripper(handler)
+	withHTTPRoundTripper(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-drandDown:
+			return nil, errors.New("connection refused")
+		default:
+			return base.RoundTrip(req)
+		}
+	}))
+
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	fx.cfg.BeaconStore = store
+
+	svc, err := NewDrandService(context.Background(), fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	return svc
+}
+
+func TestDrandService_ServesStoredRoundsWhileDrandIsDown(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	store := openTestBeaconStore(t, 0)
+	drandDown := make(chan struct{})
+
+	svc := newStoreBackedService(t, fx, store, drandDown)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	want, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+
+	// A new service, e.g. after a sidecar restart, starts with an empty
+	// memory cache.
+	restarted := newStoreBackedService(t, fx, store, drandDown)
+	close(drandDown)
+
+	got, err := restarted.Randomness(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, got))
+
+	_, err = restarted.Randomness(ctx, 2)
+	require.Error(t, err)
+
+	offline := restarted.Offline()
+	got, err = offline.Randomness(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, got))
+	_, err = offline.Randomness(ctx, 2)
+	require.ErrorIs(t, err, scerror.ErrServiceUnavailable)
+	_, err = offline.PartialSignature(ctx, 1)
+	require.ErrorIs(t, err, scerror.ErrServiceUnavailable)
+}
+
+func TestDrandService_IgnoresTamperedStoredBeacon(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	store := openTestBeaconStore(t, 0)
+
+	svc := newStoreBackedService(t, fx, store, make(chan struct{}))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	beacon, err := svc.Randomness(ctx, 2)
+	require.NoError(t, err)
+
+	// The signature of round 2 is valid, but not for round 1.
+	forged := cloneRandomnessResponse(beacon)
+	forged.DrandRound = 1
+	forged.PreviousSignature = nil
+	require.NoError(t, store.Put(fx.cfg.ChainHash, forged))
+
+	drandDown := make(chan struct{})
+	restarted := newStoreBackedService(t, fx, store, drandDown)
+	close(drandDown)
+
+	_, err = restarted.Randomness(ctx, 1)
+	require.Error(t, err)
+	_, err = restarted.Offline().Randomness(ctx, 1)
+	require.ErrorIs(t, err, scerror.ErrServiceUnavailable)
+}
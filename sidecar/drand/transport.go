@@ -0,0 +1,277 @@
+package drand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/drand/drand/v2/common/chain"
+	drandpb "github.com/drand/drand/v2/protobuf/drand"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+// DrandTransport selects how DrandService queries the local drand daemon.
+type DrandTransport string
+
+const (
+	// DrandTransportHTTP polls the daemon's HTTP API at DrandHTTP.
+	DrandTransportHTTP DrandTransport = "http"
+	// DrandTransportGRPC uses the daemon's gRPC Public service at DrandGRPC:
+	// PublicRand for specific rounds and PublicRandStream for new ones.
+	DrandTransportGRPC DrandTransport = "grpc"
+)
+
+// drandGRPCTimeout bounds a single PublicRand or ChainInfo call, matching the
+// HTTP client timeout.
+const drandGRPCTimeout = 5 * time.Second
+
+var (
+	errInvalidDrandTransport    = errors.New("invalid drand transport (expected http|grpc)")
+	errDrandGRPCAddrRequired    = errors.New("drand gRPC address must be provided (set grpc_addr or private_addr)")
+	errDrandGRPCAddrNotLoopback = errors.New("drand gRPC address must be loopback-only")
+	errDrandGRPCNilChainInfo    = errors.New("drand gRPC returned nil chain info")
+)
+
+func ParseDrandTransport(s string) (DrandTransport, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", string(DrandTransportHTTP):
+		return DrandTransportHTTP, nil
+	case string(DrandTransportGRPC):
+		return DrandTransportGRPC, nil
+	default:
+		return "", fmt.Errorf("%w: %q", errInvalidDrandTransport, s)
+	}
+}
+
+var dialDrandGRPC = func(addr string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// grpcAddr returns DrandGRPC, or else the loopback address on the port of
+// DrandPrivateListen, where drand serves its gRPC Public service.
+func (c Config) grpcAddr() (string, error) {
+	if addr := strings.TrimSpace(c.DrandGRPC); addr != "" {
+		return addr, nil
+	}
+
+	listen := strings.TrimSpace(c.DrandPrivateListen)
+	if listen == "" {
+		return "", errDrandGRPCAddrRequired
+	}
+	_, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return "", fmt.Errorf("deriving drand gRPC address from %q: %w", listen, err)
+	}
+	return net.JoinHostPort("127.0.0.1", port), nil
+}
+
+// enforceLoopbackGRPC rejects non-loopback gRPC addresses. The connection is
+// plaintext, as drand serves it, so it must not leave the host.
+func enforceLoopbackGRPC(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid drand gRPC address: %w", err)
+	}
+	if strings.EqualFold(host, "localhost") {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%w: got host %q", errDrandGRPCAddrNotLoopback, host)
+	}
+	return nil
+}
+
+func newGRPCUpstream(cfg Config) (*upstream, error) {
+	addr, err := cfg.grpcAddr()
+	if err != nil {
+		return nil, err
+	}
+	if err := enforceLoopbackGRPC(addr); err != nil {
+		return nil, err
+	}
+
+	conn, err := dialDrandGRPC(addr)
+	if err != nil {
+		return nil, fmt.Errorf("dialing drand gRPC %q: %w", addr, err)
+	}
+
+	return &upstream{
+		label:  "grpc://" + addr,
+		public: drandpb.NewPublicClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// drandMetadata routes a request to the configured chain; drand prefers the
+// chain hash over the beacon ID.
+func (s *DrandService) drandMetadata() *drandpb.Metadata {
+	return &drandpb.Metadata{
+		BeaconID:  s.cfg.DrandID,
+		ChainHash: s.cfg.ChainHash,
+	}
+}
+
+func (s *DrandService) fetchRawGRPC(
+	ctx context.Context,
+	up *upstream,
+	round uint64,
+) (rawBeacon, sidecarmetrics.FetchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, drandGRPCTimeout)
+	defer cancel()
+
+	resp, err := up.public.PublicRand(ctx, &drandpb.PublicRandRequest{
+		Round:    round,
+		Metadata: s.drandMetadata(),
+	})
+	if err != nil {
+		result, err := classifyGRPCError(err)
+		return rawBeacon{}, result, err
+	}
+
+	// The randomness field is deprecated in drand's API; it is always derived
+	// locally from the signature.
+	return rawBeacon{
+		round:             resp.GetRound(),
+		signature:         resp.GetSignature(),
+		previousSignature: resp.GetPreviousSignature(),
+	}, sidecarmetrics.FetchSuccess, nil
+}
+
+// classifyGRPCError maps a PublicRand error to the fetch result an HTTP fetch
+// would report. drand answers a missing round with an untyped error, so the
+// message is matched too.
+func classifyGRPCError(err error) (sidecarmetrics.FetchResult, error) {
+	st, _ := status.FromError(err)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), st.Code() == codes.DeadlineExceeded:
+		return sidecarmetrics.FetchTimeout, fmt.Errorf("querying drand gRPC: %w", err)
+	case st.Code() == codes.NotFound,
+		strings.Contains(st.Message(), "can't retrieve beacon"),
+		strings.Contains(st.Message(), "waited too long for next beacon"):
+		return sidecarmetrics.FetchNotFound, fmt.Errorf("%w: %s", scerror.ErrRoundNotAvailable, st.Message())
+	default:
+		return sidecarmetrics.FetchHTTPError, fmt.Errorf("querying drand gRPC: %w", err)
+	}
+}
+
+func (s *DrandService) fetchChainInfoGRPC(ctx context.Context, up *upstream) (*chain.Info, error) {
+	ctx, cancel := context.WithTimeout(ctx, drandGRPCTimeout)
+	defer cancel()
+
+	pkt, err := up.public.ChainInfo(ctx, &drandpb.ChainInfoRequest{Metadata: s.drandMetadata()})
+	if err != nil {
+		return nil, fmt.Errorf("querying drand gRPC chain info: %w", err)
+	}
+	if pkt == nil {
+		return nil, errDrandGRPCNilChainInfo
+	}
+
+	info, err := chain.InfoFromProto(pkt)
+	if err != nil {
+		return nil, fmt.Errorf("decoding drand gRPC chain info: %w", err)
+	}
+	return info, nil
+}
+
+// streamUpstream returns the gRPC upstream, if the local daemon is queried
+// over gRPC.
+func (s *DrandService) streamUpstream() *upstream {
+	for _, up := range s.upstreams {
+		if up.public != nil {
+			return up
+		}
+	}
+	return nil
+}
+
+// runRoundStream receives every new round from the gRPC upstream's
+// PublicRandStream and accepts it as if it had been fetched, so that
+// Randomness serves it from memory and watchers wake up without polling.
+// A round that fails verification or the cross-check is fetched again by
+// prefetchRound instead. The stream is reopened after a receive error until
+// ctx is done.
+func (s *DrandService) runRoundStream(ctx context.Context) {
+	up := s.streamUpstream()
+	if up == nil {
+		return
+	}
+
+	for {
+		err := s.streamRounds(ctx, up)
+		s.streaming.Store(false)
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.logger.Warn("drand round stream ended; polling until it is reopened",
+			zap.String("upstream", up.label), zap.Error(err))
+		if waitOrNotify(ctx, nil, s.watchRetryInterval()) != nil {
+			return
+		}
+	}
+}
+
+func (s *DrandService) streamRounds(ctx context.Context, up *upstream) error {
+	// Round zero streams only rounds produced from now on.
+	stream, err := up.public.PublicRandStream(ctx, &drandpb.PublicRandRequest{Metadata: s.drandMetadata()})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		s.streaming.Store(true)
+
+		raw := rawBeacon{
+			round:             resp.GetRound(),
+			signature:         resp.GetSignature(),
+			previousSignature: resp.GetPreviousSignature(),
+		}
+		if err := s.acceptStreamedBeacon(ctx, up, raw); err != nil {
+			// A relay may not have the round yet: retry it like a polled
+			// round and keep receiving.
+			s.logger.Warn("drand streamed round rejected; fetching it instead",
+				zap.String("upstream", up.label), zap.Uint64("round", raw.round), zap.Error(err))
+			go s.prefetchRound(ctx, raw.round)
+		}
+	}
+}
+
+// acceptStreamedBeacon verifies (and, if configured, cross-checks) a beacon
+// received on the round stream, with the same metrics as a fetched one.
+func (s *DrandService) acceptStreamedBeacon(ctx context.Context, up *upstream, raw rawBeacon) error {
+	beacon, result, err := s.verifyRawBeacon(0, raw)
+	s.recordUpstreamFetch(up, 0, raw.round, result, err)
+
+	if err == nil && s.cfg.DrandCrossCheck {
+		var others []*upstream
+		for _, other := range s.upstreamOrder(time.Now()) {
+			if other != up {
+				others = append(others, other)
+			}
+		}
+		result, err = s.crossCheck(ctx, beacon, up, others)
+	}
+
+	s.metrics.AddDrandFetch(result)
+	if err != nil {
+		return err
+	}
+
+	s.acceptBeacon(beacon, true)
+	return nil
+}
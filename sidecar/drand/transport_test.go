@@ -0,0 +1,284 @@
+package drand
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	drandpb "github.com/drand/drand/v2/protobuf/drand"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+// fakePublicServer serves the fixture's beacons over drand's gRPC Public
+// service, answering missing rounds the way drand does. Beacons sent on
+// stream are forwarded to PublicRandStream subscribers, and opened counts the
+// subscriptions.
+type fakePublicServer struct {
+	drandpb.UnimplementedPublicServer
+
+	t      *testing.T
+	fx     *testDrandFixture
+	stream chan *drandpb.PublicRandResponse
+	opened atomic.Int32
+}
+
+func (f *fakePublicServer) response(round uint64) (*drandpb.PublicRandResponse, bool) {
+	b, ok := f.fx.beacons[round]
+	if !ok {
+		return nil, false
+	}
+	sig, err := hex.DecodeString(b.Signature)
+	require.NoError(f.t, err)
+	out := &drandpb.PublicRandResponse{Round: b.Round, Signature: sig}
+	if b.PreviousSignature != "" {
+		out.PreviousSignature, err = hex.DecodeString(b.PreviousSignature)
+		require.NoError(f.t, err)
+	}
+	return out, true
+}
+
+func (f *fakePublicServer) PublicRand(_ context.Context, req *drandpb.PublicRandRequest) (*drandpb.PublicRandResponse, error) {
+	round := req.GetRound()
+	if round == 0 {
+		round = 2
+	}
+	resp, ok := f.response(round)
+	if !ok {
+		return nil, fmt.Errorf("can't retrieve beacon %d: beacon not found in database", round)
+	}
+	return resp, nil
+}
+
+func (f *fakePublicServer) PublicRandStream(_ *drandpb.PublicRandRequest, stream drandpb.Public_PublicRandStreamServer) error {
+	f.opened.Add(1)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case resp := <-f.stream:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (f *fakePublicServer) ChainInfo(context.Context, *drandpb.ChainInfoRequest) (*drandpb.ChainInfoPacket, error) {
+	return f.fx.info.ToProto(nil), nil
+}
+
+// withFakePublicServer points the gRPC transport at an in-memory drand and
+// makes any HTTP request fail.
+func withFakePublicServer(t *testing.T, fx *testDrandFixture) *fakePublicServer {
+	t.Helper()
+
+	srv := &fakePublicServer{t: t, fx: fx, stream: make(chan *drandpb.PublicRandResponse)}
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	drandpb.RegisterPublicServer(gs, srv)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	old := dialDrandGRPC
+	t.Cleanup(func() { dialDrandGRPC = old })
+	dialDrandGRPC = func(string) (*grpc.ClientConn, error) {
+		return grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+	}
+
+	withHTTPRoundTripper(t, roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("unexpected HTTP request")
+	}))
+
+	return srv
+}
+
+func newGRPCTestService(t *testing.T, fx *testDrandFixture) *DrandService {
+	t.Helper()
+
+	fx.cfg.DrandTransport = DrandTransportGRPC
+	fx.cfg.DrandPrivateListen = "0.0.0.0:4444"
+
+	svc, err := NewDrandService(context.Background(), fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = svc.Close() })
+	require.Equal(t, "grpc://127.0.0.1:4444", svc.upstreams[0].label)
+	return svc
+}
+
+func TestDrandService_GRPCTransport(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	withFakePublicServer(t, fx)
+	svc := newGRPCTestService(t, fx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	res, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.DrandRound)
+
+	latest, err := svc.Randomness(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest.DrandRound)
+
+	_, err = svc.Randomness(ctx, 5)
+	require.ErrorIs(t, err, scerror.ErrRoundNotAvailable)
+
+	info, err := svc.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, fx.cfg.ChainHash, info.ChainHash)
+}
+
+func TestDrandService_GRPCRoundStream(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	srv := withFakePublicServer(t, fx)
+	svc := newGRPCTestService(t, fx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	go svc.RunPrefetch(ctx)
+
+	sig2, err := hex.DecodeString(fx.beacons[2].Signature)
+	require.NoError(t, err)
+	sig3 := mustMakeRecoveredSig(t, fx.scheme, fx.pubPoly, fx.priShare, &common.Beacon{
+		Round:       3,
+		PreviousSig: sig2,
+	})
+
+	select {
+	case srv.stream <- &drandpb.PublicRandResponse{Round: 3, Signature: sig3, PreviousSignature: sig2}:
+	case <-ctx.Done():
+		t.Fatal("round stream was never opened")
+	}
+
+	require.Eventually(t, func() bool {
+		_, ok := svc.cachedRound(3)
+		return ok
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Eventually(t, svc.streaming.Load, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(3), svc.latestSeenRound())
+
+	// A beacon that fails verification is skipped; the stream stays open.
+	sendRound(t, ctx, srv, &drandpb.PublicRandResponse{Round: 4, Signature: sig3, PreviousSignature: sig3})
+	sendRound(t, ctx, srv, &drandpb.PublicRandResponse{Round: 3, Signature: sig3, PreviousSignature: sig2})
+	_, ok := svc.cachedRound(4)
+	require.False(t, ok)
+	require.True(t, svc.streaming.Load())
+	require.Equal(t, int32(1), srv.opened.Load())
+}
+
+func TestDrandService_GRPCRoundStreamCrossCheck(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	srv := withFakePublicServer(t, fx)
+
+	sig2, err := hex.DecodeString(fx.beacons[2].Signature)
+	require.NoError(t, err)
+	sig3 := mustMakeRecoveredSig(t, fx.scheme, fx.pubPoly, fx.priShare, &common.Beacon{
+		Round:       3,
+		PreviousSig: sig2,
+	})
+
+	// The relay serves rounds 1 and 2 until it catches up with round 3.
+	caughtUp := *fx
+	caughtUp.beacons = map[uint64]drandHTTPBeacon{
+		1: fx.beacons[1],
+		2: fx.beacons[2],
+		3: {
+			Round:             3,
+			Signature:         hex.EncodeToString(sig3),
+			PreviousSignature: fx.beacons[2].Signature,
+			Randomness:        hex.EncodeToString(crypto.RandomnessFromSignature(sig3)),
+		},
+	}
+	laggingHandler, _ := newTestDrandHandler(t, fx)
+	caughtUpHandler, _ := newTestDrandHandler(t, &caughtUp)
+	var relayCaughtUp atomic.Bool
+	withUpstreamHandlers(t, map[string]http.Handler{
+		"relay.example": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if relayCaughtUp.Load() {
+				caughtUpHandler.ServeHTTP(w, r)
+				return
+			}
+			laggingHandler.ServeHTTP(w, r)
+		}),
+	})
+
+	fx.cfg.DrandRelays = []string{"https://relay.example"}
+	fx.cfg.DrandCrossCheck = true
+	svc := newGRPCTestService(t, fx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	go svc.RunPrefetch(ctx)
+
+	// The relay does not confirm round 3 yet: the round is skipped, and the
+	// stream keeps receiving.
+	round3 := &drandpb.PublicRandResponse{Round: 3, Signature: sig3, PreviousSignature: sig2}
+	sendRound(t, ctx, srv, round3)
+	require.Eventually(t, svc.streaming.Load, 2*time.Second, 10*time.Millisecond)
+	_, ok := svc.cachedRound(3)
+	require.False(t, ok)
+
+	relayCaughtUp.Store(true)
+	sendRound(t, ctx, srv, round3)
+	require.Eventually(t, func() bool {
+		_, ok := svc.cachedRound(3)
+		return ok
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(1), srv.opened.Load())
+}
+
+// sendRound delivers resp to the open round stream.
+func sendRound(t *testing.T, ctx context.Context, srv *fakePublicServer, resp *drandpb.PublicRandResponse) {
+	t.Helper()
+
+	select {
+	case srv.stream <- resp:
+	case <-ctx.Done():
+		t.Fatal("round stream is not open")
+	}
+}
+
+func TestConfigValidateBasic_GRPCTransport(t *testing.T) {
+	cfg := Config{
+		DrandVersionCheck:  DrandVersionCheckStrict,
+		DrandHTTP:          "http://127.0.0.1:8081",
+		DrandTransport:     DrandTransportGRPC,
+		DrandDataDir:       t.TempDir(),
+		DrandPrivateListen: "0.0.0.0:4444",
+		DrandPublicListen:  "127.0.0.1:8081",
+		DrandControlListen: "127.0.0.1:8888",
+	}
+	require.NoError(t, cfg.ValidateBasic())
+
+	addr, err := cfg.grpcAddr()
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:4444", addr)
+
+	cfg.DrandGRPC = "10.0.0.1:4444"
+	require.ErrorIs(t, cfg.ValidateBasic(), errDrandGRPCAddrNotLoopback)
+
+	cfg.DrandTransport = "quic"
+	require.ErrorIs(t, cfg.ValidateBasic(), errInvalidDrandTransport)
+}
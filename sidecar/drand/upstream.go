@@ -0,0 +1,181 @@
+package drand
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	drandpb "github.com/drand/drand/v2/protobuf/drand"
+	"go.uber.org/zap"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+const (
+	upstreamBackoffBase = time.Second
+	upstreamBackoffMax  = 30 * time.Second
+)
+
+// upstream is one drand endpoint together with its health score: the
+// number of consecutive failed fetches, and the time before which it is only
+// tried after every healthy upstream.
+type upstream struct {
+	baseURL string
+	label   string
+
+	// public is set for the local daemon when it is queried over gRPC; the
+	// upstream then has no baseURL.
+	public drandpb.PublicClient
+	conn   io.Closer
+
+	mu       sync.Mutex
+	failures int
+	retryAt  time.Time
+}
+
+func newUpstreams(cfg Config) ([]*upstream, error) {
+	out := make([]*upstream, 0, 1+len(cfg.DrandRelays))
+
+	if cfg.DrandTransport == DrandTransportGRPC {
+		local, err := newGRPCUpstream(cfg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, local)
+	} else {
+		out = append(out, newHTTPUpstream(cfg.DrandHTTP))
+	}
+
+	for _, relay := range cfg.DrandRelays {
+		out = append(out, newHTTPUpstream(relay))
+	}
+	return out, nil
+}
+
+func newHTTPUpstream(endpoint string) *upstream {
+	endpoint = strings.TrimRight(strings.TrimSpace(endpoint), "/")
+	return &upstream{baseURL: endpoint, label: upstreamLabel(endpoint)}
+}
+
+// upstreamLabel identifies an upstream in logs and metrics without any path
+// or credentials it may carry.
+func upstreamLabel(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// record updates the health score after a fetch and reports whether the
+// upstream is healthy afterwards. A missing round says nothing about the
+// upstream, since the round may simply not be published yet.
+func (u *upstream) record(result sidecarmetrics.FetchResult, now time.Time) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	switch result {
+	case sidecarmetrics.FetchSuccess:
+		u.failures = 0
+		u.retryAt = time.Time{}
+	case sidecarmetrics.FetchNotFound:
+	default:
+		u.failures++
+		backoff := upstreamBackoffMax
+		if u.failures <= 5 {
+			backoff = min(upstreamBackoffBase<<(u.failures-1), upstreamBackoffMax)
+		}
+		u.retryAt = now.Add(backoff)
+	}
+
+	return u.failures == 0
+}
+
+func (u *upstream) backedOffUntil() time.Time {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.retryAt
+}
+
+// upstreamOrder returns the upstreams in the order to try them: those not
+// backed off in configured order, then the backed-off ones by the time their
+// backoff ends. Backed-off upstreams are still tried as a last resort.
+func (s *DrandService) upstreamOrder(now time.Time) []*upstream {
+	type entry struct {
+		u       *upstream
+		retryAt time.Time
+	}
+
+	entries := make([]entry, len(s.upstreams))
+	for i, u := range s.upstreams {
+		entries[i] = entry{u: u, retryAt: u.backedOffUntil()}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		iReady, jReady := !entries[i].retryAt.After(now), !entries[j].retryAt.After(now)
+		if iReady != jReady {
+			return iReady
+		}
+		return !iReady && entries[i].retryAt.Before(entries[j].retryAt)
+	})
+
+	out := make([]*upstream, len(entries))
+	for i, e := range entries {
+		out[i] = e.u
+	}
+	return out
+}
+
+// crossCheck fetches beacon's round from the upstreams in others, in order,
+// until one of them serves a verified beacon, and requires it to be identical
+// to beacon. Upstreams that fail are skipped; if none serves the round, the
+// first failure is returned.
+func (s *DrandService) crossCheck(
+	ctx context.Context,
+	beacon *sidecarv1.QueryRandomnessResponse,
+	from *upstream,
+	others []*upstream,
+) (sidecarmetrics.FetchResult, error) {
+	result := sidecarmetrics.FetchOther
+	var firstErr error
+
+	for _, up := range others {
+		other, res, err := s.fetchFrom(ctx, up, beacon.DrandRound)
+		if err != nil {
+			if firstErr == nil {
+				result, firstErr = res, err
+			}
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		if !bytes.Equal(other.Signature, beacon.Signature) || !bytes.Equal(other.PreviousSignature, beacon.PreviousSignature) {
+			s.logger.Error("drand upstreams disagree",
+				zap.Uint64("round", beacon.DrandRound),
+				zap.String("upstream", from.label),
+				zap.String("cross_check_upstream", up.label),
+			)
+			return sidecarmetrics.FetchHashMismatch, fmt.Errorf(
+				"%w: upstreams %s and %s returned different beacons for round %d",
+				scerror.ErrHashMismatch, from.label, up.label, beacon.DrandRound,
+			)
+		}
+
+		return sidecarmetrics.FetchSuccess, nil
+	}
+
+	if firstErr == nil {
+		return result, fmt.Errorf("cross-checking round %d: %w", beacon.DrandRound, errDrandNoCrossCheckUpstream)
+	}
+	return result, fmt.Errorf("cross-checking round %d: %w", beacon.DrandRound, firstErr)
+}
@@ -0,0 +1,180 @@
+package drand
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+	sidecarmetrics "github.com/dgtlkitchen/vrf/sidecar/servers/metrics"
+)
+
+// withUpstreamHandlers routes each request to the handler of its host. A host
+// without a handler fails with a transport error.
+func withUpstreamHandlers(t *testing.T, handlers map[string]http.Handler) {
+	t.Helper()
+
+	withHTTPRoundTripper(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		h, ok := handlers[req.URL.Host]
+		if !ok {
+			return nil, errors.New("connection refused")
+		}
+		return handlerRoundTripper(h).RoundTrip(req)
+	}))
+}
+
+func TestDrandService_FailsOverToRelay(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	relayHandler, _ := newTestDrandHandler(t, fx)
+
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	fx.cfg.DrandRelays = []string{"https://relay.example"}
+	withUpstreamHandlers(t, map[string]http.Handler{"relay.example": relayHandler})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+
+	res, err := svc.Randomness(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.DrandRound)
+
+	// The local daemon is backed off, so the relay is tried first.
+	order := svc.upstreamOrder(time.Now())
+	require.Equal(t, "https://relay.example", order[0].label)
+	require.Equal(t, "http://127.0.0.1", order[1].label)
+}
+
+func TestDrandService_FailsOverOnMissingRound(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	relayHandler, _ := newTestDrandHandler(t, fx)
+
+	lagging := *fx
+	lagging.beacons = map[uint64]drandHTTPBeacon{1: fx.beacons[1]}
+	localHandler, _ := newTestDrandHandler(t, &lagging)
+
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	fx.cfg.DrandRelays = []string{"https://relay.example"}
+	withUpstreamHandlers(t, map[string]http.Handler{
+		"127.0.0.1":     localHandler,
+		"relay.example": relayHandler,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.NoError(t, err)
+
+	res, err := svc.Randomness(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.DrandRound)
+
+	// A missing round does not count against the local daemon.
+	require.Equal(t, "http://127.0.0.1", svc.upstreamOrder(time.Now())[0].label)
+}
+
+func TestDrandService_CrossCheck(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	fx.cfg.DrandRelays = []string{"https://relay.example"}
+	fx.cfg.DrandCrossCheck = true
+
+	// forked serves a round 2 that verifies on its own but chains from a
+	// different previous signature.
+	forgedPrev := []byte(strings.Repeat("x", 96))
+	forkedSig := mustMakeRecoveredSig(t, fx.scheme, fx.pubPoly, fx.priShare, &common.Beacon{
+		Round:       2,
+		PreviousSig: forgedPrev,
+	})
+	forked := *fx
+	forked.beacons = map[uint64]drandHTTPBeacon{
+		1: fx.beacons[1],
+		2: {
+			Round:             2,
+			Signature:         hex.EncodeToString(forkedSig),
+			PreviousSignature: hex.EncodeToString(forgedPrev),
+			Randomness:        hex.EncodeToString(crypto.RandomnessFromSignature(forkedSig)),
+		},
+	}
+
+	lagging := *fx
+	lagging.beacons = map[uint64]drandHTTPBeacon{1: fx.beacons[1]}
+
+	localHandler, _ := newTestDrandHandler(t, fx)
+	forkedHandler, _ := newTestDrandHandler(t, &forked)
+	laggingHandler, _ := newTestDrandHandler(t, &lagging)
+
+	for _, tc := range []struct {
+		name    string
+		relay   http.Handler
+		round   uint64
+		wantErr error
+	}{
+		{name: "agree", relay: localHandler, round: 2},
+		{name: "disagree", relay: forkedHandler, round: 2, wantErr: scerror.ErrHashMismatch},
+		{name: "unconfirmed", relay: laggingHandler, round: 2, wantErr: scerror.ErrRoundNotAvailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			withUpstreamHandlers(t, map[string]http.Handler{
+				"127.0.0.1":     localHandler,
+				"relay.example": tc.relay,
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			svc, err := NewDrandService(ctx, fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+			require.NoError(t, err)
+
+			res, err := svc.Randomness(ctx, tc.round)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				_, cached := svc.cachedRound(tc.round)
+				require.False(t, cached)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.round, res.DrandRound)
+		})
+	}
+}
+
+func TestDrandService_CrossCheckRequiresRelay(t *testing.T) {
+	fx := newTestDrandFixture(t)
+	fx.cfg.DrandHTTP = "http://127.0.0.1"
+	fx.cfg.DrandCrossCheck = true
+
+	_, err := NewDrandService(context.Background(), fx.cfg, zap.NewNop(), sidecarmetrics.NewNop())
+	require.ErrorIs(t, err, errDrandCrossCheckNeedsRelay)
+}
+
+func TestUpstreamRecordBacksOff(t *testing.T) {
+	now := time.Now()
+	u := &upstream{}
+
+	require.True(t, u.record(sidecarmetrics.FetchNotFound, now))
+	require.False(t, u.record(sidecarmetrics.FetchTimeout, now))
+	require.Equal(t, now.Add(upstreamBackoffBase), u.backedOffUntil())
+	require.False(t, u.record(sidecarmetrics.FetchHTTPError, now))
+	require.Equal(t, now.Add(2*upstreamBackoffBase), u.backedOffUntil())
+
+	for range 10 {
+		u.record(sidecarmetrics.FetchTimeout, now)
+	}
+	require.Equal(t, now.Add(upstreamBackoffMax), u.backedOffUntil())
+
+	require.True(t, u.record(sidecarmetrics.FetchSuccess, now))
+	require.True(t, u.backedOffUntil().IsZero())
+}
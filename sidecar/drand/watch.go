@@ -0,0 +1,147 @@
+package drand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
+)
+
+const (
+	// MaxWatchBacklog is how many rounds behind the current one a watcher may
+	// resume from. Older rounds are still available through Randomness, but
+	// replaying them on a stream would tie up the fetch path.
+	MaxWatchBacklog = 1024
+
+	defaultWatchRetry = time.Second
+)
+
+// WatchRandomness calls fn with every verified beacon from fromRound onwards,
+// in round order, until ctx is done or fn returns an error. A fromRound of
+// zero starts at the latest beacon.
+//
+// Rounds are fetched through Randomness, so concurrent watchers share one
+// upstream request per round. A watcher waits for the round's publication
+// time, and wakes up early whenever any fetch verifies a newer round. Fetch
+// failures other than verification failures are retried.
+func (s *DrandService) WatchRandomness(
+	ctx context.Context,
+	fromRound uint64,
+	fn func(*sidecarv1.QueryRandomnessResponse) error,
+) error {
+	next := fromRound
+	if next == 0 {
+		latest, err := s.Randomness(ctx, 0)
+		if err != nil {
+			return err
+		}
+		if err := fn(latest); err != nil {
+			return err
+		}
+		next = latest.DrandRound + 1
+	} else if current := s.currentRound(time.Now()); current > MaxWatchBacklog && next < current-MaxWatchBacklog {
+		return fmt.Errorf("%w: round %d is more than %d rounds behind round %d", scerror.ErrRoundTooOld, next, MaxWatchBacklog, current)
+	}
+
+	for {
+		notify := s.roundNotify()
+
+		if wait := time.Until(s.roundTime(next)); wait > 0 && s.latestSeenRound() < next {
+			if err := waitOrNotify(ctx, notify, wait); err != nil {
+				return err
+			}
+			continue
+		}
+
+		beacon, err := s.Randomness(ctx, next)
+		switch {
+		case err == nil:
+			if err := fn(beacon); err != nil {
+				return err
+			}
+			next++
+			continue
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, scerror.ErrBadSignature),
+			errors.Is(err, scerror.ErrHashMismatch),
+			errors.Is(err, scerror.ErrWrongRound):
+			return err
+		}
+
+		if err := waitOrNotify(ctx, notify, s.watchRetryInterval()); err != nil {
+			return err
+		}
+	}
+}
+
+// noteVerifiedRound records that round was verified and wakes up watchers if
+// it is newer than any round seen before.
+func (s *DrandService) noteVerifiedRound(round uint64) {
+	s.roundMu.Lock()
+	defer s.roundMu.Unlock()
+
+	if round <= s.latestRound {
+		return
+	}
+	s.latestRound = round
+	if s.roundCh != nil {
+		close(s.roundCh)
+		s.roundCh = nil
+	}
+}
+
+// roundNotify returns a channel that is closed when a newer round than the
+// latest seen one is verified.
+func (s *DrandService) roundNotify() <-chan struct{} {
+	s.roundMu.Lock()
+	defer s.roundMu.Unlock()
+
+	if s.roundCh == nil {
+		s.roundCh = make(chan struct{})
+	}
+	return s.roundCh
+}
+
+func (s *DrandService) latestSeenRound() uint64 {
+	s.roundMu.Lock()
+	defer s.roundMu.Unlock()
+	return s.latestRound
+}
+
+func (s *DrandService) roundTime(round uint64) time.Time {
+	return time.Unix(common.TimeOfRound(s.period(), s.cfg.GenesisUnixSec, round), 0)
+}
+
+func (s *DrandService) currentRound(now time.Time) uint64 {
+	return common.CurrentRound(now.Unix(), s.period(), s.cfg.GenesisUnixSec)
+}
+
+func (s *DrandService) period() time.Duration {
+	return time.Duration(s.cfg.PeriodSeconds) * time.Second
+}
+
+func (s *DrandService) watchRetryInterval() time.Duration {
+	if s.watchRetry > 0 {
+		return s.watchRetry
+	}
+	return defaultWatchRetry
+}
+
+func waitOrNotify(ctx context.Context, notify <-chan struct{}, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-notify:
+	case <-timer.C:
+	}
+	return nil
+}
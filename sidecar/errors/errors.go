@@ -8,6 +8,9 @@ var (
 	ErrHashMismatch                      = errors.New("sidecar: hash mismatch")
 	ErrBadSignature                      = errors.New("sidecar: bad signature")
 	ErrServiceUnavailable                = errors.New("sidecar: service unavailable")
+	ErrRoundTooOld                       = errors.New("sidecar: round too old to watch from")
+	ErrChainParamsNotLoaded              = errors.New("sidecar: on-chain vrf params not loaded")
+	ErrStaleRandomness                   = errors.New("sidecar: latest verified round is stale")
 	ErrVrfServiceNil                     = errors.New("vrf service is nil")
 	ErrVrfUnixListenerPathEmpty          = errors.New("vrf unix listener path cannot be empty")
 	ErrVrfDebugHTTPUnixListenerPathEmpty = errors.New("vrf debug http unix listener path cannot be empty")
@@ -26,6 +26,9 @@ type Metrics interface {
 	SetDrandProcessHealthy(healthy bool)
 	ObserveTimeSinceLastSuccess(seconds float64)
 
+	AddDrandUpstreamFetch(upstream string, result FetchResult)
+	SetDrandUpstreamHealthy(upstream string, healthy bool)
+
 	AddGRPCRateLimitRejected(method string)
 	ObserveGRPCConcurrencyWait(method string, seconds float64)
 }
@@ -38,7 +41,10 @@ func (nopMetrics) SetDrandLatestRound(uint64)          {}
 func (nopMetrics) AddDrandFetch(FetchResult)           {}
 func (nopMetrics) SetDrandProcessHealthy(bool)         {}
 func (nopMetrics) ObserveTimeSinceLastSuccess(float64) {}
-func (nopMetrics) AddGRPCRateLimitRejected(string)     {}
+func (nopMetrics) AddDrandUpstreamFetch(string, FetchResult) {
+}
+func (nopMetrics) SetDrandUpstreamHealthy(string, bool) {}
+func (nopMetrics) AddGRPCRateLimitRejected(string)      {}
 func (nopMetrics) ObserveGRPCConcurrencyWait(string, float64) {
 }
 
@@ -61,6 +67,8 @@ type promMetrics struct {
 	drandFetchCounter         *prometheus.CounterVec
 	drandProcessHealthy       *prometheus.GaugeVec
 	drandTimeSinceLastSuccess *prometheus.GaugeVec
+	drandUpstreamFetch        *prometheus.CounterVec
+	drandUpstreamHealthy      *prometheus.GaugeVec
 
 	grpcRateLimitRejected *prometheus.CounterVec
 	grpcConcurrencyWait   *prometheus.HistogramVec
@@ -89,6 +97,16 @@ func newPromMetrics(chainID string) *promMetrics {
 			Name:      "vrf_drand_time_since_last_successful_fetch_seconds",
 			Help:      "Seconds since last successful drand fetch",
 		}, []string{"chain_id"}),
+		drandUpstreamFetch: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "app",
+			Name:      "vrf_drand_upstream_fetch_total",
+			Help:      "Count of drand fetch attempts grouped by upstream and result",
+		}, []string{"chain_id", "upstream", "result"}),
+		drandUpstreamHealthy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "app",
+			Name:      "vrf_drand_upstream_healthy",
+			Help:      "Health flag for each drand upstream (1 healthy, 0 backed off after failures)",
+		}, []string{"chain_id", "upstream"}),
 		grpcRateLimitRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "app",
 			Name:      "vrf_grpc_rate_limit_rejected_total",
@@ -132,6 +150,25 @@ func (m *promMetrics) ObserveTimeSinceLastSuccess(seconds float64) {
 	}).Set(seconds)
 }
 
+func (m *promMetrics) AddDrandUpstreamFetch(upstream string, result FetchResult) {
+	m.drandUpstreamFetch.With(prometheus.Labels{
+		"chain_id": m.chainID,
+		"upstream": upstream,
+		"result":   string(result),
+	}).Inc()
+}
+
+func (m *promMetrics) SetDrandUpstreamHealthy(upstream string, healthy bool) {
+	val := 0.0
+	if healthy {
+		val = 1.0
+	}
+	m.drandUpstreamHealthy.With(prometheus.Labels{
+		"chain_id": m.chainID,
+		"upstream": upstream,
+	}).Set(val)
+}
+
 func (m *promMetrics) AddGRPCRateLimitRejected(method string) {
 	m.grpcRateLimitRejected.With(prometheus.Labels{
 		"chain_id": m.chainID,
@@ -152,6 +189,8 @@ func (m *promMetrics) register() error {
 		m.drandFetchCounter,
 		m.drandProcessHealthy,
 		m.drandTimeSinceLastSuccess,
+		m.drandUpstreamFetch,
+		m.drandUpstreamHealthy,
 		m.grpcRateLimitRejected,
 		m.grpcConcurrencyWait,
 	} {
@@ -0,0 +1,173 @@
+package vrf
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errCallerPolicyEmpty  = errors.New("grpc caller policy allows no caller (set public, tokens or uids)")
+	errCallerPolicyMethod = errors.New("grpc caller policy method must be a full method name (/package.Service/Method)")
+)
+
+// CallerPolicy decides who may call a gRPC method. A caller is allowed if the
+// method is Public, if it sends one of Tokens as "authorization: Bearer
+// <token>" metadata, or if it is connected over the unix socket as one of
+// UIDs.
+type CallerPolicy struct {
+	Public bool
+	Tokens []string
+	UIDs   []uint32
+}
+
+// MethodAuth maps full gRPC method names, e.g.
+// "/digitalkitchen.sidecar.v1.DrandControl/Stop", to their caller policy.
+// Methods without an entry are not checked.
+type MethodAuth map[string]CallerPolicy
+
+func (a MethodAuth) Validate() error {
+	for method, policy := range a {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return fmt.Errorf("%w: %q", errCallerPolicyMethod, method)
+		}
+		if !policy.Public && len(policy.Tokens) == 0 && len(policy.UIDs) == 0 {
+			return fmt.Errorf("%w: %s", errCallerPolicyEmpty, method)
+		}
+	}
+	return nil
+}
+
+// authorize returns nil if the caller in ctx may call method. Callers that
+// present no credential get Unauthenticated, and callers whose credential is
+// not accepted get PermissionDenied.
+func (a MethodAuth) authorize(ctx context.Context, method string) error {
+	policy, ok := a[method]
+	if !ok || policy.Public {
+		return nil
+	}
+
+	token, hasToken := bearerToken(ctx)
+	if hasToken && policy.allowsToken(token) {
+		return nil
+	}
+
+	uid, hasUID := peerUID(ctx)
+	if hasUID && slices.Contains(policy.UIDs, uid) {
+		return nil
+	}
+
+	if hasToken || (hasUID && len(policy.UIDs) > 0) {
+		return status.Error(codes.PermissionDenied, "vrf: caller is not allowed to call "+method)
+	}
+	return status.Error(codes.Unauthenticated, "vrf: "+method+" requires a bearer token or an allowed unix peer")
+}
+
+func (p CallerPolicy) allowsToken(token string) bool {
+	allowed := false
+	for _, want := range p.Tokens {
+		// Check every token so the time taken does not reveal which matched.
+		if subtle.ConstantTimeCompare([]byte(token), []byte(want)) == 1 {
+			allowed = true
+		}
+	}
+	return allowed
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	const prefix = "bearer "
+	for _, v := range md.Get("authorization") {
+		if len(v) > len(prefix) && strings.EqualFold(v[:len(prefix)], prefix) {
+			return strings.TrimSpace(v[len(prefix):]), true
+		}
+	}
+	return "", false
+}
+
+func peerUID(ctx context.Context) (uint32, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p == nil {
+		return 0, false
+	}
+	addr, ok := p.Addr.(interface{ PeerUID() (uint32, bool) })
+	if !ok {
+		return 0, false
+	}
+	return addr.PeerUID()
+}
+
+// requireLocalCaller returns nil if the caller in ctx is connected over the
+// unix socket or loopback, or presented a verified TLS client certificate.
+// Other callers get PermissionDenied.
+func requireLocalCaller(ctx context.Context, method string) error {
+	p, ok := peer.FromContext(ctx)
+	if ok && p != nil && p.Addr != nil {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			return nil
+		}
+		if p.Addr.Network() == "unix" {
+			return nil
+		}
+		if addr, ok := p.Addr.(*net.TCPAddr); ok && addr.IP.IsLoopback() {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "vrf: "+method+" is only served to local or mutual TLS callers")
+}
+
+func (a MethodAuth) unaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := a.check(ctx, info.FullMethod, logger); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a MethodAuth) streamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := a.check(ss.Context(), info.FullMethod, logger); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (a MethodAuth) check(ctx context.Context, method string, logger *zap.Logger) error {
+	err := a.authorize(ctx, method)
+	if err != nil {
+		logger.Warn("rejected unauthorized gRPC call",
+			zap.String("method", method),
+			zap.String("client", clientKeyFromContext(ctx)),
+			zap.String("code", status.Code(err).String()),
+		)
+	}
+	return err
+}
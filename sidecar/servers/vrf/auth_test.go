@@ -0,0 +1,137 @@
+//go:build linux || darwin
+
+package vrf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+)
+
+type stubDrandControl struct {
+	sidecarv1.UnimplementedDrandControlServer
+}
+
+func (stubDrandControl) Status(context.Context, *sidecarv1.DrandStatusRequest) (*sidecarv1.DrandStatusResponse, error) {
+	return &sidecarv1.DrandStatusResponse{Running: true}, nil
+}
+
+func (stubDrandControl) Start(context.Context, *sidecarv1.DrandStartRequest) (*sidecarv1.DrandStartResponse, error) {
+	return &sidecarv1.DrandStartResponse{Running: true}, nil
+}
+
+func (stubDrandControl) Stop(context.Context, *sidecarv1.DrandStopRequest) (*sidecarv1.DrandStopResponse, error) {
+	return &sidecarv1.DrandStopResponse{}, nil
+}
+
+func (stubDrandControl) Logs(context.Context, *sidecarv1.DrandLogsRequest) (*sidecarv1.DrandLogsResponse, error) {
+	return &sidecarv1.DrandLogsResponse{}, nil
+}
+
+// newAuthTestClient serves a stub DrandControl with auth over a unix socket,
+// so that the caller's UID is known to the server.
+func newAuthTestClient(t *testing.T, auth MethodAuth) sidecarv1.DrandControlClient {
+	t.Helper()
+
+	opts, err := GRPCServerConfig{Auth: auth}.serverOptions(zap.NewNop())
+	require.NoError(t, err)
+
+	dir, err := os.MkdirTemp("", "vrfauth")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "s.sock")
+
+	ln, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(opts...)
+	sidecarv1.RegisterDrandControlServer(srv, stubDrandControl{})
+	go func() { _ = srv.Serve(withPerClientUDSPeerIdentity(ln, zap.NewNop())) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return sidecarv1.NewDrandControlClient(conn)
+}
+
+func withBearer(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestMethodAuth(t *testing.T) {
+	uid := uint32(os.Getuid())
+
+	client := newAuthTestClient(t, MethodAuth{
+		sidecarv1.DrandControl_Start_FullMethodName:  {UIDs: []uint32{uid + 1}},
+		sidecarv1.DrandControl_Stop_FullMethodName:   {Tokens: []string{"admin"}},
+		sidecarv1.DrandControl_Status_FullMethodName: {Tokens: []string{"admin", "read"}, UIDs: []uint32{uid}},
+		sidecarv1.DrandControl_Logs_FullMethodName:   {Public: true},
+	})
+	ctx := context.Background()
+
+	_, err := client.Status(ctx, &sidecarv1.DrandStatusRequest{})
+	require.NoError(t, err, "allowed UID")
+
+	_, err = client.Logs(ctx, &sidecarv1.DrandLogsRequest{})
+	require.NoError(t, err, "public method")
+
+	_, err = client.Stop(ctx, &sidecarv1.DrandStopRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.Stop(withBearer("read"), &sidecarv1.DrandStopRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.Stop(withBearer("admin"), &sidecarv1.DrandStopRequest{})
+	require.NoError(t, err)
+
+	_, err = client.Start(ctx, &sidecarv1.DrandStartRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "UID not in the allowlist")
+}
+
+func TestMethodAuthValidate(t *testing.T) {
+	require.NoError(t, MethodAuth(nil).Validate())
+	require.NoError(t, MethodAuth{"/pkg.Svc/Method": {Public: true}}.Validate())
+	require.ErrorIs(t, MethodAuth{"/pkg.Svc/Method": {}}.Validate(), errCallerPolicyEmpty)
+	require.ErrorIs(t, MethodAuth{"Method": {Public: true}}.Validate(), errCallerPolicyMethod)
+}
+
+func TestRequireLocalCaller(t *testing.T) {
+	withPeer := func(p *peer.Peer) context.Context {
+		return peer.NewContext(context.Background(), p)
+	}
+	remote := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9000}
+
+	require.NoError(t, requireLocalCaller(withPeer(&peer.Peer{Addr: &net.TCPAddr{IP: net.IPv6loopback, Port: 9000}}), "m"))
+	require.NoError(t, requireLocalCaller(withPeer(&peer.Peer{Addr: &net.UnixAddr{Name: "s.sock", Net: "unix"}}), "m"))
+	require.NoError(t, requireLocalCaller(withPeer(&peer.Peer{
+		Addr:     remote,
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{}}}},
+	}), "m"), "verified client certificate")
+
+	err := requireLocalCaller(withPeer(&peer.Peer{Addr: remote}), "m")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = requireLocalCaller(withPeer(&peer.Peer{Addr: remote, AuthInfo: credentials.TLSInfo{}}), "m")
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "TLS without a client certificate")
+
+	err = requireLocalCaller(context.Background(), "m")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
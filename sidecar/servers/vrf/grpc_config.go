@@ -4,8 +4,12 @@ import (
 	"errors"
 	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
 )
 
 const (
@@ -45,6 +49,12 @@ type GRPCServerConfig struct {
 	MaxConcurrentStreams uint32
 	MaxRecvMsgSize       int
 	MaxSendMsgSize       int
+
+	// TLS serves gRPC over TLS, or mutual TLS if a client CA is set.
+	TLS tlsconfig.ServerFiles
+
+	// Auth restricts who may call the listed methods.
+	Auth MethodAuth
 }
 
 func (c GRPCServerConfig) Validate() error {
@@ -72,7 +82,10 @@ func (c GRPCServerConfig) Validate() error {
 	if c.MaxSendMsgSize < 0 {
 		return errGRPCMaxSendMsgSizeNegative
 	}
-	return nil
+	if err := c.TLS.Validate(); err != nil {
+		return err
+	}
+	return c.Auth.Validate()
 }
 
 func (c GRPCServerConfig) keepaliveParams() (keepalive.ServerParameters, bool) {
@@ -120,12 +133,25 @@ func (c GRPCServerConfig) enforcementPolicy() (keepalive.EnforcementPolicy, bool
 	}, true
 }
 
-func (c GRPCServerConfig) serverOptions() ([]grpc.ServerOption, error) {
+func (c GRPCServerConfig) serverOptions(logger *zap.Logger) ([]grpc.ServerOption, error) {
 	if err := c.Validate(); err != nil {
 		return nil, err
 	}
 
 	var opts []grpc.ServerOption
+	if c.TLS.Enabled {
+		tlsCfg, err := tlsconfig.NewServerConfig(c.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+	if len(c.Auth) > 0 {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(c.Auth.unaryInterceptor(logger)),
+			grpc.ChainStreamInterceptor(c.Auth.streamInterceptor(logger)),
+		)
+	}
 	if kp, ok := c.keepaliveParams(); ok {
 		opts = append(opts, grpc.KeepaliveParams(kp))
 	}
@@ -0,0 +1,142 @@
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/dgtlkitchen/vrf/sidecar"
+)
+
+const (
+	// HealthServiceVrf is the grpc.health.v1 service name of the Vrf service.
+	// It is also reported as the overall ("") status of the sidecar.
+	HealthServiceVrf = "digitalkitchen.sidecar.v1.Vrf"
+	// HealthServiceDrand is the grpc.health.v1 service name of the
+	// supervised drand subprocess.
+	HealthServiceDrand = "drand"
+	// HealthServiceChain is the grpc.health.v1 service name of the chain
+	// watcher's connections to the node.
+	HealthServiceChain = "chain"
+
+	defaultHealthInterval = 2 * time.Second
+)
+
+// HealthCheck reports whether a component is healthy. A nil error means
+// healthy; the error explains why not.
+type HealthCheck func(ctx context.Context) error
+
+type componentHealth struct {
+	service string
+	err     error
+}
+
+// SetDrandHealthCheck sets the check behind the HealthServiceDrand status.
+// Without one, the drand status is not reported.
+func (s *Server) SetDrandHealthCheck(check HealthCheck) {
+	s.drandHealth = check
+}
+
+// SetChainHealthCheck sets the check behind the HealthServiceChain status.
+// Without one, the chain status is not reported.
+func (s *Server) SetChainHealthCheck(check HealthCheck) {
+	s.chainHealth = check
+}
+
+// checkHealth runs the health checks: the Vrf service's, if the service
+// implements sidecar.HealthChecker, and then the drand and chain ones.
+func (s *Server) checkHealth(ctx context.Context) []componentHealth {
+	vrf := componentHealth{service: HealthServiceVrf}
+	if hc, ok := s.svc.(sidecar.HealthChecker); ok {
+		vrf.err = hc.CheckHealth(ctx)
+	}
+	out := []componentHealth{vrf}
+
+	if s.drandHealth != nil {
+		out = append(out, componentHealth{service: HealthServiceDrand, err: s.drandHealth(ctx)})
+	}
+	if s.chainHealth != nil {
+		out = append(out, componentHealth{service: HealthServiceChain, err: s.chainHealth(ctx)})
+	}
+	return out
+}
+
+// updateHealth publishes the current health checks on hs, logging changes.
+func (s *Server) updateHealth(ctx context.Context, hs *health.Server, last map[string]bool) {
+	for _, c := range s.checkHealth(ctx) {
+		serving := c.err == nil
+		if prev, ok := last[c.service]; !ok || prev != serving {
+			if serving {
+				s.logger.Info("sidecar component is healthy", zap.String("service", c.service))
+			} else {
+				s.logger.Warn("sidecar component is unhealthy", zap.String("service", c.service), zap.Error(c.err))
+			}
+			last[c.service] = serving
+		}
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if !serving {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		hs.SetServingStatus(c.service, status)
+		if c.service == HealthServiceVrf {
+			hs.SetServingStatus("", status)
+		}
+	}
+}
+
+// runHealth keeps hs up to date until ctx is done.
+func (s *Server) runHealth(ctx context.Context, hs *health.Server) {
+	interval := s.healthInterval
+	if interval <= 0 {
+		interval = defaultHealthInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := make(map[string]bool)
+	for {
+		s.updateHealth(ctx, hs, last)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleHealthz answers 200 while every component is healthy, and 503 with
+// the failing components otherwise.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, s.checkHealth(r.Context()))
+}
+
+// handleReadyz answers 200 while the Vrf service can serve fresh randomness,
+// and 503 otherwise.
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, s.checkHealth(r.Context())[:1])
+}
+
+func writeHealth(w http.ResponseWriter, components []componentHealth) {
+	var failing []string
+	for _, c := range components {
+		if c.err != nil {
+			failing = append(failing, fmt.Sprintf("%s: %v", c.service, c.err))
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(failing) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strings.Join(failing, "\n") + "\n"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,138 @@
+package vrf
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	"github.com/dgtlkitchen/vrf/sidecar"
+)
+
+type healthStubService struct {
+	sidecar.Service
+
+	mu  sync.Mutex
+	err error
+}
+
+func (s *healthStubService) CheckHealth(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *healthStubService) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+func TestHealthServiceNames(t *testing.T) {
+	require.Equal(t, sidecarv1.Vrf_ServiceDesc.ServiceName, HealthServiceVrf)
+}
+
+func TestGRPCHealth(t *testing.T) {
+	svc := &healthStubService{err: errors.New("latest verified round is stale")}
+	srv := NewServer(svc, zap.NewNop(), nil)
+	srv.healthInterval = 10 * time.Millisecond
+	srv.SetDrandHealthCheck(func(context.Context) error { return nil })
+	var chainErr error = errors.New("chain websocket is not subscribed")
+	var chainMu sync.Mutex
+	srv.SetChainHealthCheck(func(context.Context) error {
+		chainMu.Lock()
+		defer chainMu.Unlock()
+		return chainErr
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.StartWithListener(ctx, ln) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	conn, err := grpc.NewClient(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := healthpb.NewHealthClient(conn)
+
+	statusOf := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return resp.GetStatus()
+	}
+
+	require.Eventually(t, func() bool {
+		return statusOf(HealthServiceVrf) == healthpb.HealthCheckResponse_NOT_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, statusOf(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, statusOf(HealthServiceDrand))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, statusOf(HealthServiceChain))
+
+	svc.setErr(nil)
+	chainMu.Lock()
+	chainErr = nil
+	chainMu.Unlock()
+	require.Eventually(t, func() bool {
+		return statusOf(HealthServiceVrf) == healthpb.HealthCheckResponse_SERVING &&
+			statusOf("") == healthpb.HealthCheckResponse_SERVING &&
+			statusOf(HealthServiceChain) == healthpb.HealthCheckResponse_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHealthzAndReadyz(t *testing.T) {
+	svc := &healthStubService{}
+	srv := NewServer(svc, zap.NewNop(), nil)
+	drandErr := errors.New("drand subprocess is not running")
+	srv.SetDrandHealthCheck(func(context.Context) error { return drandErr })
+
+	get := func(h http.HandlerFunc) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rec
+	}
+
+	// drand being down fails liveness but not readiness, which only
+	// depends on the Vrf service.
+	rec := get(srv.handleHealthz)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), "drand: drand subprocess is not running")
+	require.Equal(t, http.StatusOK, get(srv.handleReadyz).Code)
+
+	svc.setErr(errors.New("on-chain vrf params not loaded"))
+	rec = get(srv.handleReadyz)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), HealthServiceVrf+": on-chain vrf params not loaded")
+
+	// Nor does a chain watcher disconnected from the node.
+	svc.setErr(nil)
+	srv.SetDrandHealthCheck(nil)
+	srv.SetChainHealthCheck(func(context.Context) error { return errors.New("chain websocket is not subscribed") })
+	rec = get(srv.handleHealthz)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), "chain: chain websocket is not subscribed")
+	require.Equal(t, http.StatusOK, get(srv.handleReadyz).Code)
+
+	srv.SetChainHealthCheck(nil)
+	rec = get(srv.handleHealthz)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "ok", rec.Body.String())
+}
@@ -1,6 +1,7 @@
 package vrf
 
 import (
+	"fmt"
 	"net"
 	"syscall"
 
@@ -9,14 +10,40 @@ import (
 	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
 )
 
+// udsPeerCred is what the kernel reports about the process at the other end
+// of a unix socket.
+type udsPeerCred struct {
+	pid    int32
+	uid    uint32
+	hasUID bool
+}
+
+// token identifies the peer for per-client rate limiting: by process if
+// known, else by user.
+func (c udsPeerCred) token() string {
+	switch {
+	case c.pid > 0:
+		return fmt.Sprintf("pid=%d", c.pid)
+	case c.hasUID:
+		return fmt.Sprintf("uid=%d", c.uid)
+	default:
+		return ""
+	}
+}
+
 type peerIdentityAddr struct {
 	network string
 	key     string
+	uid     uint32
+	hasUID  bool
 }
 
 func (a peerIdentityAddr) Network() string { return a.network }
 func (a peerIdentityAddr) String() string  { return a.key }
 
+// PeerUID returns the user ID of the peer process, if the kernel reported it.
+func (a peerIdentityAddr) PeerUID() (uint32, bool) { return a.uid, a.hasUID }
+
 func (a peerIdentityAddr) ClientKey() string {
 	switch a.network {
 	case "unix":
@@ -52,18 +79,19 @@ func (l *peerIdentityListener) Accept() (net.Conn, error) {
 		return nil, err
 	}
 
-	key, err := udsPeerToken(conn)
+	cred, err := udsPeerCredentials(conn)
 	if err != nil {
 		l.logger.Debug("failed to determine UDS peer identity", zap.Error(err))
 		return conn, nil
 	}
+	key := cred.token()
 	if key == "" {
 		return conn, nil
 	}
 
 	return &peerIdentityConn{
 		Conn:   conn,
-		remote: peerIdentityAddr{network: "unix", key: key},
+		remote: peerIdentityAddr{network: "unix", key: key, uid: cred.uid, hasUID: cred.hasUID},
 	}, nil
 }
 
@@ -86,33 +114,33 @@ func withPerClientUDSPeerIdentity(ln net.Listener, logger *zap.Logger) net.Liste
 	}
 }
 
-func udsPeerToken(conn net.Conn) (string, error) {
+func udsPeerCredentials(conn net.Conn) (udsPeerCred, error) {
 	if conn == nil {
-		return "", scerror.ErrNilConn
+		return udsPeerCred{}, scerror.ErrNilConn
 	}
 
 	sysConn, ok := conn.(syscall.Conn)
 	if !ok {
-		return "", scerror.ErrConnNoRawFD
+		return udsPeerCred{}, scerror.ErrConnNoRawFD
 	}
 
 	rawConn, err := sysConn.SyscallConn()
 	if err != nil {
-		return "", err
+		return udsPeerCred{}, err
 	}
 
 	var (
-		key        string
+		cred       udsPeerCred
 		controlErr error
 	)
 	if err := rawConn.Control(func(fd uintptr) {
-		key, controlErr = udsPeerTokenFromFD(int(fd))
+		cred, controlErr = udsPeerCredFromFD(int(fd))
 	}); err != nil {
-		return "", err
+		return udsPeerCred{}, err
 	}
 	if controlErr != nil {
-		return "", controlErr
+		return udsPeerCred{}, controlErr
 	}
 
-	return key, nil
+	return cred, nil
 }
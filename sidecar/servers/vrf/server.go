@@ -18,6 +18,8 @@ import (
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -34,6 +36,11 @@ const (
 	defaultRatePerSecond = 100
 	defaultRateBurst     = 200
 
+	// defaultMaxWatchers bounds concurrent WatchRandomness streams. Streams
+	// are long-lived, so they get their own slots instead of holding one of
+	// the unary concurrency slots for their whole lifetime.
+	defaultMaxWatchers = 16
+
 	// defaultPerClientLimiterCacheSize bounds memory usage for per-client limiters.
 	// It should be comfortably above the expected number of distinct peers.
 	defaultPerClientLimiterCacheSize = 1024
@@ -53,13 +60,18 @@ type Server struct {
 	extraRegistrations []func(grpc.ServiceRegistrar)
 	debugRegistrations []func(*http.ServeMux)
 
-	sem     chan struct{}
-	limiter *rate.Limiter
+	sem      chan struct{}
+	watchSem chan struct{}
+	limiter  *rate.Limiter
 
 	perClientMu       sync.Mutex
 	perClientLimiters *lru.Cache[string, *rate.Limiter]
 	perClientRate     rate.Limit
 	perClientBurst    int
+
+	drandHealth    HealthCheck
+	chainHealth    HealthCheck
+	healthInterval time.Duration
 }
 
 func NewServer(svc sidecar.Service, logger *zap.Logger, m sidecarmetrics.Metrics) *Server {
@@ -80,11 +92,12 @@ func NewServer(svc sidecar.Service, logger *zap.Logger, m sidecarmetrics.Metrics
 	}
 
 	return &Server{
-		svc:     svc,
-		logger:  logger.With(zap.String("server", "vrf")),
-		metrics: m,
-		newGRPC: grpc.NewServer,
-		sem:     make(chan struct{}, defaultMaxConcurrent),
+		svc:      svc,
+		logger:   logger.With(zap.String("server", "vrf")),
+		metrics:  m,
+		newGRPC:  grpc.NewServer,
+		sem:      make(chan struct{}, defaultMaxConcurrent),
+		watchSem: make(chan struct{}, defaultMaxWatchers),
 		limiter: rate.NewLimiter(
 			defaultRatePerSecond,
 			defaultRateBurst,
@@ -96,7 +109,7 @@ func NewServer(svc sidecar.Service, logger *zap.Logger, m sidecarmetrics.Metrics
 }
 
 func (s *Server) SetGRPCConfig(cfg GRPCServerConfig) error {
-	grpcOpts, err := cfg.serverOptions()
+	grpcOpts, err := cfg.serverOptions(s.logger)
 	if err != nil {
 		return err
 	}
@@ -131,6 +144,8 @@ func (s *Server) StartWithListener(ctx context.Context, ln net.Listener) error {
 
 	s.grpcSrv = newGRPC(s.grpcOpts...)
 	sidecarv1.RegisterVrfServer(s.grpcSrv, s)
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(s.grpcSrv, hs)
 	for _, fn := range s.extraRegistrations {
 		if fn == nil {
 			continue
@@ -140,9 +155,15 @@ func (s *Server) StartWithListener(ctx context.Context, ln net.Listener) error {
 
 	eg, ctx := errgroup.WithContext(ctx)
 
+	eg.Go(func() error {
+		s.runHealth(ctx, hs)
+		return nil
+	})
+
 	eg.Go(func() error {
 		<-ctx.Done()
 		s.logger.Info("context cancelled, stopping vrf gRPC server")
+		hs.Shutdown()
 		s.grpcSrv.GracefulStop()
 		_ = ln.Close()
 		return nil
@@ -231,6 +252,63 @@ func (s *Server) Info(
 	return info, nil
 }
 
+func (s *Server) PartialSignature(
+	ctx context.Context,
+	req *sidecarv1.QueryPartialSignatureRequest,
+) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil QueryPartialSignatureRequest")
+	}
+
+	// Partials for the current round are as good as the beacon once t of
+	// them are collected, so only the local node may ask for them.
+	if err := requireLocalCaller(ctx, "PartialSignature"); err != nil {
+		return nil, err
+	}
+
+	if err := s.allow(ctx, "PartialSignature"); err != nil {
+		return nil, err
+	}
+
+	release := s.acquire("PartialSignature")
+	defer release()
+
+	res, err := s.svc.PartialSignature(ctx, req.Round)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) WatchRandomness(
+	req *sidecarv1.WatchRandomnessRequest,
+	stream sidecarv1.Vrf_WatchRandomnessServer,
+) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "nil WatchRandomnessRequest")
+	}
+
+	ctx := stream.Context()
+	if err := s.allow(ctx, "WatchRandomness"); err != nil {
+		return err
+	}
+
+	select {
+	case s.watchSem <- struct{}{}:
+		defer func() { <-s.watchSem }()
+	default:
+		s.metrics.AddGRPCRateLimitRejected("WatchRandomness")
+		return status.Error(codes.ResourceExhausted, "vrf: too many randomness watchers")
+	}
+
+	err := s.svc.WatchRandomness(ctx, req.StartRound, stream.Send)
+	if err == nil || ctx.Err() != nil {
+		return nil
+	}
+	return mapServiceError(err)
+}
+
 func mapServiceError(err error) error {
 	switch {
 	case errors.Is(err, scerror.ErrServiceUnavailable):
@@ -239,6 +317,8 @@ func mapServiceError(err error) error {
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, scerror.ErrWrongRound):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, scerror.ErrRoundTooOld):
+		return status.Error(codes.OutOfRange, err.Error())
 	case errors.Is(err, scerror.ErrBadSignature), errors.Is(err, scerror.ErrHashMismatch):
 		return status.Error(codes.DataLoss, err.Error())
 	default:
@@ -352,10 +432,8 @@ func (s *Server) StartDebugHTTP(ctx context.Context, addr string) error {
 	}
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("ok"))
-	})
+	mux.HandleFunc("/healthz", s.handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
 	mux.HandleFunc("/vrf/v1/info", s.handleDebugInfo)
 	mux.HandleFunc("/vrf/v1/randomness", s.handleDebugRandomness)
 	for _, fn := range s.debugRegistrations {
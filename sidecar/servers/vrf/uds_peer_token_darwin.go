@@ -3,30 +3,33 @@
 package vrf
 
 import (
-	"fmt"
-
 	"golang.org/x/sys/unix"
 
 	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
 )
 
-func udsPeerTokenFromFD(fd int) (string, error) {
+func udsPeerCredFromFD(fd int) (udsPeerCred, error) {
+	var out udsPeerCred
+
 	pid, pidErr := unix.GetsockoptInt(fd, unix.SOL_LOCAL, unix.LOCAL_PEERPID)
 	if pidErr == nil && pid > 0 {
-		return fmt.Sprintf("pid=%d", pid), nil
+		out.pid = int32(pid)
 	}
 
 	cred, credErr := unix.GetsockoptXucred(fd, unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
 	if credErr == nil && cred != nil {
-		return fmt.Sprintf("uid=%d", cred.Uid), nil
+		out.uid, out.hasUID = cred.Uid, true
 	}
 
+	if out.pid > 0 || out.hasUID {
+		return out, nil
+	}
 	if pidErr != nil {
-		return "", pidErr
+		return udsPeerCred{}, pidErr
 	}
 	if credErr != nil {
-		return "", credErr
+		return udsPeerCred{}, credErr
 	}
 
-	return "", scerror.ErrUnableToDeterminePeerCredentials
+	return udsPeerCred{}, scerror.ErrUnableToDeterminePeerCredentials
 }
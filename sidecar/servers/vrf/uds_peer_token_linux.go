@@ -8,19 +8,15 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-func udsPeerTokenFromFD(fd int) (string, error) {
+func udsPeerCredFromFD(fd int) (udsPeerCred, error) {
 	cred, err := unix.GetsockoptUcred(fd, unix.SOL_SOCKET, unix.SO_PEERCRED)
 	if err != nil {
-		return "", err
+		return udsPeerCred{}, err
 	}
 
 	if cred == nil {
-		return "", fmt.Errorf("missing ucred")
+		return udsPeerCred{}, fmt.Errorf("missing ucred")
 	}
 
-	if cred.Pid > 0 {
-		return fmt.Sprintf("pid=%d", cred.Pid), nil
-	}
-
-	return fmt.Sprintf("uid=%d", cred.Uid), nil
+	return udsPeerCred{pid: cred.Pid, uid: cred.Uid, hasUID: true}, nil
 }
@@ -4,6 +4,6 @@ package vrf
 
 import "fmt"
 
-func udsPeerTokenFromFD(int) (string, error) {
-	return "", fmt.Errorf("uds peer credentials unsupported on this platform")
+func udsPeerCredFromFD(int) (udsPeerCred, error) {
+	return udsPeerCred{}, fmt.Errorf("uds peer credentials unsupported on this platform")
 }
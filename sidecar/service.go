@@ -8,13 +8,25 @@ import (
 	scerror "github.com/dgtlkitchen/vrf/sidecar/errors"
 )
 
-// Service provides the sidecar's core VRF API (drand beacons + chain info).
+// Service provides the sidecar's core VRF API (drand beacons, chain info and
+// partial signatures from the local key share).
 //
 // Implementations may be swapped at runtime (e.g. when drand is started/stopped
 // based on on-chain state).
 type Service interface {
 	Randomness(ctx context.Context, round uint64) (*sidecarv1.QueryRandomnessResponse, error)
 	Info(ctx context.Context) (*sidecarv1.QueryInfoResponse, error)
+	PartialSignature(ctx context.Context, round uint64) (*sidecarv1.QueryPartialSignatureResponse, error)
+	// WatchRandomness calls fn with every verified beacon from fromRound
+	// onwards, in round order, until ctx is done or fn returns an error. A
+	// fromRound of zero starts at the latest beacon.
+	WatchRandomness(ctx context.Context, fromRound uint64, fn func(*sidecarv1.QueryRandomnessResponse) error) error
+}
+
+// HealthChecker is implemented by services that can tell whether they are
+// able to serve fresh, verified randomness. A nil error means healthy.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
 }
 
 // DynamicService is a thin wrapper that allows swapping the underlying Service
@@ -54,6 +66,34 @@ func (s *DynamicService) Randomness(ctx context.Context, round uint64) (*sidecar
 	return svc.Randomness(ctx, round)
 }
 
+func (s *DynamicService) PartialSignature(ctx context.Context, round uint64) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	s.mu.RLock()
+	svc := s.svc
+	s.mu.RUnlock()
+
+	if svc == nil {
+		return nil, scerror.ErrServiceUnavailable
+	}
+
+	return svc.PartialSignature(ctx, round)
+}
+
+func (s *DynamicService) WatchRandomness(
+	ctx context.Context,
+	fromRound uint64,
+	fn func(*sidecarv1.QueryRandomnessResponse) error,
+) error {
+	s.mu.RLock()
+	svc := s.svc
+	s.mu.RUnlock()
+
+	if svc == nil {
+		return scerror.ErrServiceUnavailable
+	}
+
+	return svc.WatchRandomness(ctx, fromRound, fn)
+}
+
 func (s *DynamicService) Info(ctx context.Context) (*sidecarv1.QueryInfoResponse, error) {
 	s.mu.RLock()
 	info := cloneInfoResponse(s.info)
@@ -71,6 +111,32 @@ func (s *DynamicService) Info(ctx context.Context) (*sidecarv1.QueryInfoResponse
 	return info, nil
 }
 
+// CheckHealth reports whether the on-chain params are loaded and the current
+// service is healthy. A service that does not implement HealthChecker is
+// assumed to be healthy.
+func (s *DynamicService) CheckHealth(ctx context.Context) error {
+	s.mu.RLock()
+	info := s.info
+	svc := s.svc
+	s.mu.RUnlock()
+
+	if info == nil ||
+		len(info.ChainHash) == 0 ||
+		len(info.PublicKey) == 0 ||
+		info.PeriodSeconds == 0 ||
+		info.GenesisUnixSec == 0 {
+		return scerror.ErrChainParamsNotLoaded
+	}
+	if svc == nil {
+		return scerror.ErrServiceUnavailable
+	}
+
+	if hc, ok := svc.(HealthChecker); ok {
+		return hc.CheckHealth(ctx)
+	}
+	return nil
+}
+
 func cloneInfoResponse(info *sidecarv1.QueryInfoResponse) *sidecarv1.QueryInfoResponse {
 	if info == nil {
 		return nil
@@ -0,0 +1,117 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileStamp identifies the version of a file on disk.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func stampOf(path string) (fileStamp, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{modTime: fi.ModTime(), size: fi.Size()}, nil
+}
+
+// reloader caches a value parsed from a set of files and parses them again
+// when any of them changes. If the new files do not parse, e.g. because a
+// rotation has replaced the certificate but not yet the key, the previous
+// value is kept until they do.
+type reloader[T any] struct {
+	paths []string
+	parse func() (T, error)
+
+	mu     sync.Mutex
+	stamps []fileStamp
+	value  T
+}
+
+func newReloader[T any](parse func() (T, error), paths ...string) (*reloader[T], error) {
+	r := &reloader[T]{paths: paths, parse: parse}
+
+	stamps, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	value, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	r.stamps, r.value = stamps, value
+	return r, nil
+}
+
+func (r *reloader[T]) stat() ([]fileStamp, error) {
+	stamps := make([]fileStamp, len(r.paths))
+	for i, path := range r.paths {
+		stamp, err := stampOf(path)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		stamps[i] = stamp
+	}
+	return stamps, nil
+}
+
+func (r *reloader[T]) get() (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stamps, err := r.stat()
+	if err != nil || equalStamps(stamps, r.stamps) {
+		return r.value, nil
+	}
+
+	value, err := r.parse()
+	if err != nil {
+		return r.value, nil
+	}
+	r.stamps, r.value = stamps, value
+	return value, nil
+}
+
+func equalStamps(a, b []fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
+
+func newKeyPairReloader(certFile, keyFile string) (*reloader[*tls.Certificate], error) {
+	return newReloader(func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls: loading key pair %s, %s: %w", certFile, keyFile, err)
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+}
+
+func newCAReloader(caFile string) (*reloader[*x509.CertPool], error) {
+	return newReloader(func() (*x509.CertPool, error) {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls: reading CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: %s", errNoCACertificates, caFile)
+		}
+		return pool, nil
+	}, caFile)
+}
@@ -0,0 +1,266 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for name, usable by a server and
+// a client alike.
+func (ca *testCA) issue(t *testing.T, serial int64, name string) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFile writes data to dir/name and moves its mtime forward by age, so
+// that successive writes are seen as changes whatever the clock resolution.
+func writeFile(t *testing.T, dir, name string, data []byte, age time.Duration) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	mtime := time.Now().Add(age)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+	return path
+}
+
+// handshake runs a TLS handshake over loopback TCP and returns the serial
+// number of the server certificate the client accepted.
+func handshake(t *testing.T, server, client *tls.Config) (*big.Int, error) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	cc, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	sc, err := ln.Accept()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sc.Close(); _ = cc.Close() })
+
+	srv := tls.Server(sc, server)
+	srvErr := make(chan error, 1)
+	go func() {
+		err := srv.Handshake()
+		if err != nil {
+			_ = sc.Close()
+		}
+		srvErr <- err
+	}()
+
+	cli := tls.Client(cc, client)
+	if err := cli.Handshake(); err != nil {
+		_ = cc.Close()
+		<-srvErr
+		return nil, err
+	}
+	// With TLS 1.3 the server verifies the client certificate after the
+	// client considers the handshake done; a read surfaces its verdict.
+	go func() { _, _ = cli.Read(make([]byte, 1)) }()
+	if err := <-srvErr; err != nil {
+		return nil, err
+	}
+	return cli.ConnectionState().PeerCertificates[0].SerialNumber, nil
+}
+
+type testPKI struct {
+	dir                   string
+	server                ServerFiles
+	clientFiles           ClientFiles
+	serverCert, serverKey string
+	ca                    *testCA
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := writeFile(t, dir, "ca.pem", ca.pem, 0)
+
+	srvCert, srvKey := ca.issue(t, 10, "vrf-sidecar")
+	cliCert, cliKey := ca.issue(t, 20, "vrf-app")
+
+	p := &testPKI{
+		dir:        dir,
+		ca:         ca,
+		serverCert: writeFile(t, dir, "server.pem", srvCert, 0),
+		serverKey:  writeFile(t, dir, "server-key.pem", srvKey, 0),
+	}
+	p.server = ServerFiles{Enabled: true, CertFile: p.serverCert, KeyFile: p.serverKey, ClientCAFile: caFile}
+	p.clientFiles = ClientFiles{
+		Enabled:    true,
+		CAFile:     caFile,
+		CertFile:   writeFile(t, dir, "client.pem", cliCert, 0),
+		KeyFile:    writeFile(t, dir, "client-key.pem", cliKey, 0),
+		ServerName: "vrf-sidecar",
+	}
+	return p
+}
+
+func TestMutualTLS(t *testing.T) {
+	p := newTestPKI(t)
+
+	serverCfg, err := NewServerConfig(p.server)
+	require.NoError(t, err)
+	clientCfg, err := NewClientConfig(p.clientFiles)
+	require.NoError(t, err)
+
+	serial, err := handshake(t, serverCfg, clientCfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), serial.Int64())
+
+	// Without a client certificate the server rejects the connection.
+	noCert := p.clientFiles
+	noCert.CertFile, noCert.KeyFile = "", ""
+	clientCfg, err = NewClientConfig(noCert)
+	require.NoError(t, err)
+	_, err = handshake(t, serverCfg, clientCfg)
+	require.Error(t, err)
+
+	// Without a client CA the server accepts it.
+	oneWay := p.server
+	oneWay.ClientCAFile = ""
+	serverCfg, err = NewServerConfig(oneWay)
+	require.NoError(t, err)
+	_, err = handshake(t, serverCfg, clientCfg)
+	require.NoError(t, err)
+}
+
+func TestClientPinsServerName(t *testing.T) {
+	p := newTestPKI(t)
+
+	serverCfg, err := NewServerConfig(p.server)
+	require.NoError(t, err)
+
+	pinned := p.clientFiles
+	pinned.ServerName = "other-sidecar"
+	clientCfg, err := NewClientConfig(pinned)
+	require.NoError(t, err)
+
+	_, err = handshake(t, serverCfg, clientCfg)
+	require.ErrorContains(t, err, "other-sidecar")
+
+	// A certificate from another CA is rejected even with the right name.
+	rogue := newTestCA(t)
+	cert, key := rogue.issue(t, 30, "vrf-sidecar")
+	rogueServer := p.server
+	rogueServer.CertFile = writeFile(t, p.dir, "rogue.pem", cert, 0)
+	rogueServer.KeyFile = writeFile(t, p.dir, "rogue-key.pem", key, 0)
+	serverCfg, err = NewServerConfig(rogueServer)
+	require.NoError(t, err)
+	clientCfg, err = NewClientConfig(p.clientFiles)
+	require.NoError(t, err)
+
+	_, err = handshake(t, serverCfg, clientCfg)
+	require.Error(t, err)
+}
+
+func TestServerCertificateReload(t *testing.T) {
+	p := newTestPKI(t)
+
+	serverCfg, err := NewServerConfig(p.server)
+	require.NoError(t, err)
+	clientCfg, err := NewClientConfig(p.clientFiles)
+	require.NoError(t, err)
+
+	// Rotating only the certificate leaves a mismatched pair; the previous
+	// one is served until the key is rotated too.
+	cert, key := p.ca.issue(t, 11, "vrf-sidecar")
+	writeFile(t, p.dir, "server.pem", cert, time.Minute)
+
+	serial, err := handshake(t, serverCfg, clientCfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), serial.Int64())
+
+	writeFile(t, p.dir, "server-key.pem", key, time.Minute)
+
+	serial, err = handshake(t, serverCfg, clientCfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), serial.Int64())
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, ServerFiles{}.Validate())
+	require.ErrorIs(t, ServerFiles{CertFile: "a"}.Validate(), errTLSConfigIncomplete)
+	require.ErrorIs(t, ServerFiles{Enabled: true, CertFile: "a"}.Validate(), errServerCertRequired)
+
+	require.NoError(t, ClientFiles{}.Validate())
+	require.ErrorIs(t, ClientFiles{CAFile: "a"}.Validate(), errTLSConfigIncomplete)
+	require.ErrorIs(t, ClientFiles{Enabled: true, ServerName: "s"}.Validate(), errClientCARequired)
+	require.ErrorIs(t, ClientFiles{Enabled: true, CAFile: "a"}.Validate(), errServerNameRequired)
+	require.ErrorIs(t, ClientFiles{Enabled: true, CAFile: "a", ServerName: "s", CertFile: "c"}.Validate(), errClientKeyPairPair)
+
+	f := ClientFiles{CAFile: "tls/ca.pem", CertFile: "/etc/client.pem"}.ResolvePaths("/home")
+	require.Equal(t, "/home/tls/ca.pem", f.CAFile)
+	require.Equal(t, "/etc/client.pem", f.CertFile)
+}
+
+func TestNewConfigDisabled(t *testing.T) {
+	cfg, err := NewServerConfig(ServerFiles{})
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	_, err = NewClientConfig(ClientFiles{Enabled: true, CAFile: filepath.Join(t.TempDir(), "missing.pem"), ServerName: "s"})
+	require.Error(t, err)
+}
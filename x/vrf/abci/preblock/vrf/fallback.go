@@ -0,0 +1,64 @@
+package vrf
+
+import (
+	cometabci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// ecvrfTally accumulates the outputs of the valid ECVRF fallback proofs at a
+// single height, in commit order.
+type ecvrfTally struct {
+	alpha   []byte
+	outputs [][]byte
+	power   int64
+}
+
+func newECVRFTally(height int64, seed []byte) *ecvrfTally {
+	return &ecvrfTally{alpha: vrftypes.ECVRFFallbackInput(height, seed)}
+}
+
+// selectBeacon combines the outputs into a fallback beacon once the proofs
+// are backed by requiredVP.
+func (t *ecvrfTally) selectBeacon(requiredVP int64) *vrftypes.VrfBeacon {
+	if t.power < requiredVP {
+		return nil
+	}
+
+	return &vrftypes.VrfBeacon{
+		Randomness: vrftypes.CombineECVRFOutputs(t.alpha, t.outputs),
+		Source:     vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF,
+	}
+}
+
+// addECVRFProof verifies the fallback proof of a vote against the validator's
+// registered ECVRF key and records its output in tally. Invalid proofs are
+// logged and ignored.
+func (h *PreBlockHandler) addECVRFProof(
+	ctx sdk.Context,
+	tally *ecvrfTally,
+	vote cometabci.ExtendedVoteInfo,
+	proof []byte,
+) {
+	if len(proof) == 0 {
+		return
+	}
+
+	consAddr := sdk.ConsAddress(vote.Validator.Address)
+	key, err := h.keeper.GetEcvrfKeyByConsAddr(ctx, consAddr)
+	if err != nil {
+		h.logger.Error("vrf: ECVRF proof from validator without a registered ECVRF key", "height", ctx.BlockHeight(), "validator", consAddr.String(), "err", err)
+		return
+	}
+
+	beta, err := vrftypes.ECVRFVerify(key, tally.alpha, proof)
+	if err != nil {
+		h.logger.Error("vrf: ECVRF proof verification failed", "height", ctx.BlockHeight(), "validator", consAddr.String(), "err", err)
+		return
+	}
+
+	tally.outputs = append(tally.outputs, beta)
+	tally.power += vote.Validator.Power
+}
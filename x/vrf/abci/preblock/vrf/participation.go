@@ -0,0 +1,96 @@
+package vrf
+
+import (
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// failureRank orders failure reasons so that the most informative one is
+// recorded when a vote carries several failing beacons.
+var failureRank = map[vrftypes.VrfParticipationReason]int{
+	vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED:   0,
+	vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND:   1,
+	vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_HASH_MISMATCH: 2,
+	vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED:    3,
+	vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE: 4,
+}
+
+// voteParticipation tracks the outcome of a single vote while PreBlock
+// processes the injected commit.
+type voteParticipation struct {
+	reason vrftypes.VrfParticipationReason
+	rounds map[uint64]struct{}
+}
+
+func newVoteParticipation(vote cometabci.ExtendedVoteInfo) voteParticipation {
+	p := voteParticipation{rounds: make(map[uint64]struct{})}
+
+	switch {
+	case vote.BlockIdFlag != cmtproto.BlockIDFlagCommit && len(vote.VoteExtension) == 0:
+		p.reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_ABSENT
+	case len(vote.VoteExtension) == 0:
+		p.reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY
+	}
+
+	return p
+}
+
+// fail records a failing beacon unless a more informative failure was already
+// recorded.
+func (p *voteParticipation) fail(reason vrftypes.VrfParticipationReason) {
+	if failureRank[reason] > failureRank[p.reason] {
+		p.reason = reason
+	}
+}
+
+// verified records a beacon that passed every check.
+func (p *voteParticipation) verified(round uint64) {
+	p.rounds[round] = struct{}{}
+}
+
+func (p *voteParticipation) counted(round uint64) bool {
+	_, ok := p.rounds[round]
+	return ok
+}
+
+// finalize returns the reason recorded for the vote once the finalized round
+// is known. A zero round means no beacon was finalized.
+func (p *voteParticipation) finalize(round uint64) vrftypes.VrfParticipationReason {
+	if round != 0 && p.counted(round) {
+		return vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED
+	}
+	if len(p.rounds) > 0 {
+		return vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_OTHER_ROUND
+	}
+	return p.reason
+}
+
+// buildParticipation assembles the participation record for a height.
+func buildParticipation(
+	height int64,
+	targetRound uint64,
+	round uint64,
+	votes []cometabci.ExtendedVoteInfo,
+	parts []voteParticipation,
+) vrftypes.VrfParticipation {
+	record := vrftypes.VrfParticipation{
+		Height:      height,
+		TargetRound: targetRound,
+		DrandRound:  round,
+		Validators:  make([][]byte, len(votes)),
+		Contributed: make([]byte, (len(votes)+7)/8),
+		Reasons:     make([]vrftypes.VrfParticipationReason, len(votes)),
+	}
+
+	for i, vote := range votes {
+		record.Validators[i] = vote.Validator.Address
+		record.Reasons[i] = parts[i].finalize(round)
+		if record.Reasons[i] == vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED {
+			record.Contributed[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return record
+}
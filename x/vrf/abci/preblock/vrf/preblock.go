@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/drand/drand/v2/common"
-	"github.com/drand/drand/v2/crypto"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 
@@ -40,6 +39,7 @@ type PreBlockHandler struct {
 	logger          log.Logger
 	keeper          *vrfkeeper.Keeper
 	accountKeeper   authkeeper.AccountKeeper
+	authzKeeper     emergency.AuthzKeeper
 	signModeHandler *txsigning.HandlerMap
 	txDecoder       sdk.TxDecoder
 }
@@ -48,6 +48,7 @@ func NewPreBlockHandler(
 	logger log.Logger,
 	keeper *vrfkeeper.Keeper,
 	accountKeeper authkeeper.AccountKeeper,
+	authzKeeper emergency.AuthzKeeper,
 	signModeHandler *txsigning.HandlerMap,
 	txDecoder sdk.TxDecoder,
 ) *PreBlockHandler {
@@ -55,6 +56,7 @@ func NewPreBlockHandler(
 		logger:          logger.With("component", "vrf-preblock"),
 		keeper:          keeper,
 		accountKeeper:   accountKeeper,
+		authzKeeper:     authzKeeper,
 		signModeHandler: signModeHandler,
 		txDecoder:       txDecoder,
 	}
@@ -74,11 +76,28 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return resp, err
 		}
 
+		// Params scheduled for this height take effect before anything reads
+		// them. The extensions aggregated below were built under them.
+		if activated, err := h.keeper.ActivatePendingParams(ctx); err != nil {
+			return resp, fmt.Errorf("vrf: failed to activate pending params: %w", err)
+		} else if activated {
+			h.logger.Info("vrf: activated pending params", "height", ctx.BlockHeight())
+		}
+
+		// Record the ECVRF fallback seed on every height, so that it is
+		// available as soon as ecvrf_fallback is enabled.
+		fallbackSeed, fallbackSeedOK, err := h.keeper.RotateFallbackSeed(ctx)
+		if err != nil {
+			return resp, fmt.Errorf("vrf: failed to rotate ECVRF fallback seed: %w", err)
+		}
+
 		// ------------------------------------------------------------------
 		// Emergency disable handling
 		// ------------------------------------------------------------------
 
-		// Scan all transactions for an authorized MsgVrfEmergencyDisable
+		// Scan all transactions for an authorized MsgVrfEmergencyDisable,
+		// within the per-block limits on emergency txs.
+		blockTxs := emergency.NewBlockTxs(h.keeper)
 		for i, txBytes := range req.Txs {
 			if len(txBytes) == 0 {
 				continue
@@ -101,10 +120,22 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 				continue
 			}
 
+			if !emergency.IsEmergencyTx(tx) {
+				continue
+			}
+
+			if err := blockTxs.Add(ctx, tx, txBytes); err != nil {
+				// ProcessProposal rejects such blocks; ignore the tx in case
+				// one was accepted anyway.
+				h.logger.Error("vrf: emergency tx exceeds the block limits in PreBlock", "err", err)
+				continue
+			}
+
 			found, authorized, reason, verErr := emergency.VerifyEmergencyMsg(
 				ctx,
 				tx,
 				h.accountKeeper,
+				h.authzKeeper,
 				h.keeper,
 				h.signModeHandler,
 			)
@@ -136,6 +167,20 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 				}
 			}
 
+			// A scheduled change must not re-enable VRF behind the disable.
+			pending, ok, pendingErr := h.keeper.GetPendingParams(ctx)
+			if pendingErr != nil {
+				return resp, fmt.Errorf("vrf: failed to load pending params during emergency disable: %w", pendingErr)
+			}
+			if ok && pending.Params.Enabled {
+				pending.Params.Enabled = false
+				if err := h.keeper.SetPendingParams(ctx, pending); err != nil {
+					return resp, fmt.Errorf("vrf: failed to persist emergency disable pending params: %w", err)
+				}
+			}
+
+			h.refundRequests(ctx)
+
 			if err := h.keeper.SetLastBlockTime(ctx, ctx.BlockTime().Unix()); err != nil {
 				return resp, fmt.Errorf("vrf: failed to update last block time after emergency disable: %w", err)
 			}
@@ -157,27 +202,11 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 		}
 
 		if !params.Enabled {
+			h.refundRequests(ctx)
 			_ = h.keeper.SetLastBlockTime(ctx, ctx.BlockTime().Unix())
 			return resp, nil
 		}
 
-		if updatedHeight, err := h.keeper.GetParamsUpdatedHeight(ctx); err != nil {
-			h.logger.Error("vrf: failed to load params update height; continuing", "err", err)
-		} else {
-			// PreBlock runs before txs are applied for the current height, so any
-			// params update will only be visible starting the next block. Skip VRF
-			// enforcement for one height after an update to allow vote extensions
-			// to be generated with the new params.
-			if updatedHeight > 0 && updatedHeight >= ctx.BlockHeight()-1 {
-				h.logger.Info("vrf: params updated recently; skipping VRF enforcement",
-					"height", ctx.BlockHeight(),
-					"updated_height", updatedHeight,
-				)
-				_ = h.keeper.SetLastBlockTime(ctx, ctx.BlockTime().Unix())
-				return resp, nil
-			}
-		}
-
 		lastTS, err := h.keeper.GetPrevBlockTime(ctx)
 		if err != nil {
 			return resp, fmt.Errorf("vrf: failed to load previous block time in PreBlock: %w", err)
@@ -198,7 +227,10 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 		}
 
 		// Prepare scheme and public key for BLS verification
-		scheme := crypto.NewPedersenBLSChained()
+		scheme, err := params.Scheme()
+		if err != nil {
+			return resp, fmt.Errorf("vrf: %w", err)
+		}
 		pubKey := scheme.KeyGroup.Point()
 		if err := pubKey.UnmarshalBinary(params.PublicKey); err != nil {
 			return resp, fmt.Errorf("vrf: failed to unmarshal drand public key: %w", err)
@@ -210,11 +242,31 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 		}
 
 		totalVP := int64(0)
-		validVP := int64(0)
-		var chosen *vrftypes.VrfBeacon
+		rounds := vrftypes.EligibleRounds(params, targetRound)
+		// Never finalize a round below the last drand beacon, even after ECVRF
+		// fallback beacons, or consumers would see randomness move backward. A
+		// last round above targetRound belongs to a drand chain the params
+		// moved away from.
+		lastRound, err := h.keeper.GetLastDrandRound(ctx)
+		if err != nil {
+			return resp, fmt.Errorf("vrf: failed to load last drand round in PreBlock: %w", err)
+		}
+		if lastRound <= targetRound {
+			rounds = roundsFrom(rounds, lastRound)
+		}
+		tally := newRoundTally(rounds)
+		partials := newPartialTally(rounds)
+		var fallback *ecvrfTally
+		if params.EcvrfFallback && fallbackSeedOK {
+			// The extensions were made at the previous height.
+			fallback = newECVRFTally(ctx.BlockHeight()-1, fallbackSeed)
+		}
+		verified := make(map[string]bool)
+		parts := make([]voteParticipation, len(extendedCommitInfo.Votes))
 
-		for _, voteInfo := range extendedCommitInfo.Votes {
+		for i, voteInfo := range extendedCommitInfo.Votes {
 			totalVP += voteInfo.Validator.Power
+			parts[i] = newVoteParticipation(voteInfo)
 
 			if len(voteInfo.VoteExtension) == 0 {
 				continue
@@ -232,49 +284,95 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 					"len", len(voteInfo.VoteExtension),
 					"prefix", hex.EncodeToString(voteInfo.VoteExtension[:prefixLen]),
 				)
+				parts[i].reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED
 				continue
 			}
 
-			if veExt.DrandRound != targetRound {
-				h.logger.Info(
-					"vrf: vote extension round mismatch",
-					"height", ctx.BlockHeight(),
-					"target_round", targetRound,
-					"extension_round", veExt.DrandRound,
-				)
+			proofOnly, err := ve.ValidateExtensionECVRF(params, veExt)
+			if err != nil {
+				h.logger.Error("vrf: invalid ECVRF proof in vote extension; treating as invalid", "height", req.Height, "err", err)
+				parts[i].reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED
 				continue
 			}
-
-			hash := sha256.Sum256(veExt.Signature)
-			if !bytes.Equal(hash[:], veExt.Randomness) {
-				h.logger.Error("vrf: hash mismatch in PreBlock", "height", req.Height)
+			if fallback != nil {
+				h.addECVRFProof(ctx, fallback, voteInfo, veExt.EcvrfProof)
+			}
+			if proofOnly {
+				parts[i].reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_ECVRF_ONLY
 				continue
 			}
 
-			beacon := &common.Beacon{
-				PreviousSig: veExt.PreviousSignature,
-				Round:       veExt.DrandRound,
-				Signature:   veExt.Signature,
-			}
-			if err := scheme.VerifyBeacon(beacon, pubKey); err != nil {
-				h.logger.Error("vrf: BLS verification failed", "height", req.Height, "err", err)
+			if params.ThresholdMode() {
+				if err := ve.ValidateExtensionPartials(params, veExt); err != nil {
+					h.logger.Error("vrf: unsupported vote extension; treating as invalid", "height", req.Height, "err", err)
+					parts[i].reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED
+					continue
+				}
+
+				consAddr := sdk.ConsAddress(voteInfo.Validator.Address)
+				h.addPartials(ctx, scheme, partials, consAddr, voteInfo.Validator.Power, veExt.Partials, &parts[i])
 				continue
 			}
 
-			b := vrftypes.VrfBeacon{
-				DrandRound:        veExt.DrandRound,
-				Randomness:        veExt.Randomness,
-				Signature:         veExt.Signature,
-				PreviousSignature: veExt.PreviousSignature,
+			beacons, err := ve.ExtensionBeacons(veExt)
+			if err != nil {
+				h.logger.Error("vrf: unsupported vote extension; treating as invalid", "height", req.Height, "err", err)
+				parts[i].reason = vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED
+				continue
 			}
 
-			if chosen == nil {
-				chosen = &b
-			} else if !equalBeacon(*chosen, b) {
-				return resp, fmt.Errorf("%w at height %d", errInconsistentBeaconsInValidSet, ctx.BlockHeight())
-			}
+			for _, b := range beacons {
+				// Each validator backs a given round at most once.
+				if parts[i].counted(b.DrandRound) {
+					continue
+				}
+
+				if !tally.eligible(b.DrandRound) {
+					h.logger.Info(
+						"vrf: vote extension round outside tolerance",
+						"height", ctx.BlockHeight(),
+						"target_round", targetRound,
+						"round_tolerance", params.RoundTolerance,
+						"extension_round", b.DrandRound,
+					)
+					parts[i].fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND)
+					continue
+				}
 
-			validVP += voteInfo.Validator.Power
+				hash := sha256.Sum256(b.Signature)
+				if !bytes.Equal(hash[:], b.Randomness) {
+					h.logger.Error("vrf: hash mismatch in PreBlock", "height", req.Height, "round", b.DrandRound)
+					parts[i].fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_HASH_MISMATCH)
+					continue
+				}
+
+				// Honest validators carry identical beacons, so verify each
+				// distinct (round, signature, previous signature) once.
+				key := fmt.Sprintf("%d/%x/%x", b.DrandRound, b.Signature, b.PreviousSignature)
+				ok, seen := verified[key]
+				if !seen {
+					beacon := &common.Beacon{
+						PreviousSig: b.PreviousSignature,
+						Round:       b.DrandRound,
+						Signature:   b.Signature,
+					}
+					if err := scheme.VerifyBeacon(beacon, pubKey); err != nil {
+						h.logger.Error("vrf: BLS verification failed", "height", req.Height, "round", b.DrandRound, "err", err)
+					} else {
+						ok = true
+					}
+					verified[key] = ok
+				}
+				if !ok {
+					parts[i].fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED)
+					continue
+				}
+
+				if err := tally.add(b, voteInfo.Validator.Power); err != nil {
+					return resp, fmt.Errorf("%w at height %d", err, ctx.BlockHeight())
+				}
+				parts[i].verified(b.DrandRound)
+			}
 		}
 
 		if totalVP <= 0 {
@@ -282,12 +380,49 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return resp, nil
 		}
 
+		// Deterministically pick the highest eligible round backed by >2/3 of
+		// the voting power.
 		requiredVP := (totalVP*2)/3 + 1
-		if validVP < requiredVP || chosen == nil {
+		var (
+			chosen  *vrftypes.VrfBeacon
+			validVP int64
+		)
+		if params.ThresholdMode() {
+			var recoverErr error
+			chosen, validVP, recoverErr = partials.selectBeacon(scheme, pubKey, requiredVP, int(params.PartialThreshold))
+			if recoverErr != nil {
+				h.logger.Error("vrf: failed to recover threshold signature", "height", ctx.BlockHeight(), "err", recoverErr)
+			}
+		} else {
+			chosen, validVP = tally.selectBeacon(requiredVP)
+		}
+
+		if chosen == nil && fallback != nil {
+			chosen = fallback.selectBeacon(requiredVP)
+			if chosen != nil {
+				h.logger.Warn(
+					"vrf: no drand round reached quorum; using ECVRF fallback randomness",
+					"height", ctx.BlockHeight(),
+					"target_round", targetRound,
+					"drand_power", validVP,
+					"ecvrf_power", fallback.power,
+				)
+			}
+		}
+
+		finalizedRound := uint64(0)
+		if chosen != nil {
+			finalizedRound = chosen.DrandRound
+		}
+		participation := buildParticipation(ctx.BlockHeight(), targetRound, finalizedRound, extendedCommitInfo.Votes, parts)
+		abcitypes.RecordParticipation(participation)
+
+		if chosen == nil {
 			h.logger.Warn(
 				"vrf: did not receive enough VRF commits; rejecting block",
 				"height", ctx.BlockHeight(),
 				"target_round", targetRound,
+				"round_tolerance", params.RoundTolerance,
 				"got_power", validVP,
 				"required_power", requiredVP,
 				"total_power", totalVP,
@@ -295,10 +430,39 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return resp, fmt.Errorf("%w at height %d: got=%d required>=%d", errInsufficientVotingPowerForValidBeacons, ctx.BlockHeight(), validVP, requiredVP)
 		}
 
+		if chosen.Source == vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND && chosen.DrandRound != targetRound {
+			h.logger.Info(
+				"vrf: finalized beacon below target round",
+				"height", ctx.BlockHeight(),
+				"target_round", targetRound,
+				"round", chosen.DrandRound,
+			)
+		}
+
 		if err := h.keeper.SetLatestBeacon(ctx, *chosen); err != nil {
 			return resp, fmt.Errorf("vrf: failed to store latest beacon: %w", err)
 		}
 
+		if params.ParticipationRetentionBlocks > 0 {
+			if err := h.keeper.SetParticipation(ctx, participation); err != nil {
+				return resp, fmt.Errorf("vrf: failed to store participation record: %w", err)
+			}
+
+			minHeight := ctx.BlockHeight() - int64(params.ParticipationRetentionBlocks) + 1
+			if err := h.keeper.PruneParticipation(ctx, minHeight); err != nil {
+				return resp, fmt.Errorf("vrf: failed to prune participation records: %w", err)
+			}
+		}
+
+		// Fallback randomness pays no rewards and is not bound to a drand
+		// round, so it neither fulfills requests nor decrypts anything.
+		if chosen.Source == vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND {
+			contributors := beaconContributors(extendedCommitInfo.Votes, participation)
+			h.payRewards(ctx, params, contributors)
+			h.fulfillRequests(ctx, *chosen, contributors)
+			h.decryptTimelocked(ctx, *chosen)
+		}
+
 		if err := h.keeper.SetLastBlockTime(ctx, ctx.BlockTime().Unix()); err != nil {
 			return resp, fmt.Errorf("vrf: failed to update last block time: %w", err)
 		}
@@ -307,6 +471,121 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 	}
 }
 
+// payRewards funds the reward pool from fees and pays the validators that
+// contributed to the finalized beacon. Reward failures never reject a block:
+// they run in a cached context that is discarded on error.
+func (h *PreBlockHandler) payRewards(
+	ctx sdk.Context,
+	params vrftypes.VrfParams,
+	contributors []vrfkeeper.Contributor,
+) {
+	if params.RewardPerBlock.IsZero() && params.RewardFeeShareBps == 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := h.keeper.FundRewardPool(cacheCtx, params); err != nil {
+		h.logger.Error("vrf: failed to fund reward pool; skipping rewards", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	paid, err := h.keeper.DistributeRewards(cacheCtx, params, contributors)
+	if err != nil {
+		h.logger.Error("vrf: failed to distribute rewards; skipping rewards", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	write()
+
+	if !paid.IsZero() {
+		h.logger.Debug("vrf: paid beacon rewards", "height", ctx.BlockHeight(), "amount", paid.String(), "recipients", len(contributors))
+	}
+}
+
+// fulfillRequests fulfills the randomness requests that are due at the
+// finalized beacon's round. Like rewards, failures never reject a block; the
+// requests stay pending and are retried with the next beacon.
+func (h *PreBlockHandler) fulfillRequests(
+	ctx sdk.Context,
+	beacon vrftypes.VrfBeacon,
+	contributors []vrfkeeper.Contributor,
+) {
+	cacheCtx, write := ctx.CacheContext()
+	n, err := h.keeper.FulfillRandomnessRequests(cacheCtx, beacon, contributors)
+	if err != nil {
+		h.logger.Error("vrf: failed to fulfill randomness requests; keeping them pending", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	write()
+
+	if n > 0 {
+		h.logger.Debug("vrf: fulfilled randomness requests", "height", ctx.BlockHeight(), "round", beacon.DrandRound, "requests", n)
+	}
+}
+
+// decryptTimelocked decrypts the ciphertexts encrypted to the finalized round
+// and hands each plaintext to the consumer hooks. A failing hook only discards
+// its own state changes.
+func (h *PreBlockHandler) decryptTimelocked(ctx sdk.Context, beacon vrftypes.VrfBeacon) {
+	cacheCtx, write := ctx.CacheContext()
+	decrypted, err := h.keeper.DecryptTimelocked(cacheCtx, beacon)
+	if err != nil {
+		h.logger.Error("vrf: failed to decrypt timelocked messages; keeping them pending", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	write()
+
+	for _, msg := range decrypted {
+		hookCtx, writeHook := ctx.CacheContext()
+		if err := h.keeper.AfterTimelockDecrypted(hookCtx, msg); err != nil {
+			h.logger.Error("vrf: timelock hook failed", "height", ctx.BlockHeight(), "id", msg.Id, "err", err)
+			continue
+		}
+		writeHook()
+	}
+
+	if len(decrypted) > 0 {
+		h.logger.Debug("vrf: decrypted timelocked messages", "height", ctx.BlockHeight(), "round", beacon.DrandRound, "messages", len(decrypted))
+	}
+}
+
+// refundRequests returns the escrowed fees of every pending randomness
+// request while VRF is disabled.
+func (h *PreBlockHandler) refundRequests(ctx sdk.Context) {
+	cacheCtx, write := ctx.CacheContext()
+	n, err := h.keeper.RefundPendingRequests(cacheCtx)
+	if err != nil {
+		h.logger.Error("vrf: failed to refund randomness requests", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	write()
+
+	if n > 0 {
+		h.logger.Info("vrf: refunded randomness requests", "height", ctx.BlockHeight(), "requests", n)
+	}
+}
+
+// beaconContributors returns the validators whose extensions counted towards
+// the finalized beacon.
+func beaconContributors(
+	votes []cometabci.ExtendedVoteInfo,
+	participation vrftypes.VrfParticipation,
+) []vrfkeeper.Contributor {
+	contributors := make([]vrfkeeper.Contributor, 0, len(votes))
+	for i, vote := range votes {
+		if participation.Reasons[i] == vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED {
+			contributors = append(contributors, vrfkeeper.Contributor{
+				ConsAddress: vote.Validator.Address,
+				Power:       vote.Validator.Power,
+			})
+		}
+	}
+	return contributors
+}
+
 func equalBeacon(a, b vrftypes.VrfBeacon) bool {
 	return a.DrandRound == b.DrandRound &&
 		bytes.Equal(a.Randomness, b.Randomness) &&
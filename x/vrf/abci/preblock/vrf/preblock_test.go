@@ -1,22 +1,36 @@
 package vrf
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
 	"errors"
 	"testing"
 	"time"
 
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/drand/drand/v2/common"
 	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abcicodec "github.com/dgtlkitchen/vrf/x/vrf/abci/codec"
+	"github.com/dgtlkitchen/vrf/x/vrf/abci/ve"
+	vetypes "github.com/dgtlkitchen/vrf/x/vrf/abci/ve/types"
+	"github.com/dgtlkitchen/vrf/x/vrf/emergency"
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
@@ -27,6 +41,11 @@ type PreBlockSuite struct {
 
 	keeper  vrfkeeper.Keeper
 	handler *PreBlockHandler
+	staking testStakingKeeper
+	authz   *vrftestutil.AuthzKeeper
+
+	scheme *crypto.Scheme
+	secret kyber.Scalar
 }
 
 func TestPreBlockSuite(t *testing.T) {
@@ -36,11 +55,14 @@ func TestPreBlockSuite(t *testing.T) {
 func (s *PreBlockSuite) SetupTest() {
 	s.VrfTestSuite.SetupTest()
 
-	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority)
+	s.staking = testStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	s.authz = vrftestutil.NewAuthzKeeper()
+	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, s.staking, nil)
 
 	// Minimal params required for the PreBlock BLS/public-key verification setup.
-	scheme := crypto.NewPedersenBLSChained()
-	pubKey := scheme.KeyGroup.Point().Mul(scheme.KeyGroup.Scalar().SetInt64(1), nil)
+	s.scheme = crypto.NewPedersenBLSChained()
+	s.secret = s.scheme.KeyGroup.Scalar().SetInt64(1)
+	pubKey := s.scheme.KeyGroup.Point().Mul(s.secret, nil)
 	pubKeyBz, err := pubKey.MarshalBinary()
 	s.Require().NoError(err)
 
@@ -50,6 +72,8 @@ func (s *PreBlockSuite) SetupTest() {
 	params.PeriodSeconds = 2
 	params.SafetyMarginSeconds = 2
 	params.PublicKey = pubKeyBz
+	params.ChainHash, err = params.DrandChainHash()
+	s.Require().NoError(err)
 	s.Require().NoError(k.SetParams(s.Ctx, params))
 
 	s.keeper = k
@@ -58,6 +82,7 @@ func (s *PreBlockSuite) SetupTest() {
 		log.NewNopLogger(),
 		&s.keeper,
 		s.AccountKeeper,
+		s.authz,
 		s.SignModeHandler,
 		s.EncCfg.TxConfig.TxDecoder(),
 	)
@@ -116,3 +141,569 @@ func (s *PreBlockSuite) TestWrappedPreBlocker_RejectsBlockWhenInsufficientVoting
 	s.Require().NoError(err)
 	s.Require().Equal(int64(1700000000), last)
 }
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_SelectsHighestRoundWithQuorum() {
+	ctx := s.preBlockCtx()
+
+	// Every validator carries target and target-1; the target round wins.
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 334, rounds: []uint64{50, 49}},
+		{power: 166, rounds: []uint64{50, 49}},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	beacon, err := s.keeper.GetLatestBeacon(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), beacon.DrandRound)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_FallsBackToPreviousRoundWithinTolerance() {
+	ctx := s.preBlockCtx()
+
+	// Validators with lagging clocks only carry target-1, so only round 49
+	// reaches quorum.
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 200, rounds: []uint64{50, 49}},
+		{power: 300, rounds: []uint64{49}},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	beacon, err := s.keeper.GetLatestBeacon(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(49), beacon.DrandRound)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_NeverFinalizesRoundBelowLatest() {
+	ctx := s.preBlockCtx()
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, s.finalizeBlockRequest(ctx, []testVote{
+		{power: 334, rounds: []uint64{50, 49}},
+		{power: 166, rounds: []uint64{50, 49}},
+	}))
+	s.Require().NoError(err)
+
+	// The next height has the same target round, but only target-1 reaches
+	// quorum. Finalizing it would move the latest beacon backward.
+	next := s.preBlockCtx().WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(next, s.finalizeBlockRequest(next, []testVote{
+		{power: 200, rounds: []uint64{50, 49}},
+		{power: 300, rounds: []uint64{49}},
+	}))
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+
+	beacon, err := s.keeper.GetLatestBeacon(next)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), beacon.DrandRound)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_NeverFinalizesRoundBelowLastDrandAfterFallback() {
+	ctx := s.preBlockCtx()
+
+	drand := s.signBeacon(50)
+	s.Require().NoError(s.keeper.SetLatestBeacon(ctx, vrftypes.VrfBeacon{
+		DrandRound: drand.DrandRound,
+		Randomness: drand.Randomness,
+		Signature:  drand.Signature,
+	}))
+	s.Require().NoError(s.keeper.SetLatestBeacon(ctx, vrftypes.VrfBeacon{
+		Randomness: []byte("fallback randomness"),
+		Source:     vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF,
+	}))
+
+	// The fallback beacon carries no round, but round 50 was finalized
+	// before it.
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, s.finalizeBlockRequest(ctx, []testVote{
+		{power: 500, rounds: []uint64{49}},
+	}))
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+
+	round, err := s.keeper.GetLastDrandRound(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), round)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_IgnoresRoundsOutsideTolerance() {
+	ctx := s.preBlockCtx()
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.RoundTolerance = 0
+	s.Require().NoError(s.keeper.SetParams(ctx, params))
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 200, rounds: []uint64{50, 49}},
+		{power: 300, rounds: []uint64{49}},
+	})
+
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+
+	_, err = s.keeper.GetLatestBeacon(ctx)
+	s.Require().True(errors.Is(err, collections.ErrNotFound))
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_ActivatesPendingParams() {
+	ctx := s.preBlockCtx()
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.RoundTolerance = 0
+	s.Require().NoError(s.keeper.SetPendingParams(ctx, vrftypes.VrfPendingParams{
+		Params:           params,
+		ActivationHeight: ctx.BlockHeight() + 1,
+	}))
+
+	votes := []testVote{
+		{power: 200, rounds: []uint64{50, 49}},
+		{power: 300, rounds: []uint64{49}},
+	}
+
+	// Before the activation height the current round_tolerance still applies.
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(ctx, s.finalizeBlockRequest(ctx, votes))
+	s.Require().NoError(err)
+
+	// At the activation height the scheduled params are enforced right away.
+	next := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(next, s.finalizeBlockRequest(next, votes))
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+
+	current, err := s.keeper.GetParams(next)
+	s.Require().NoError(err)
+	s.Require().Equal(params, current)
+
+	_, ok, err := s.keeper.GetPendingParams(next)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_RecordsParticipation() {
+	ctx := s.preBlockCtx()
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 400, rounds: []uint64{50, 49}},
+		{power: 40, rounds: []uint64{48}},
+		{power: 30},
+		{power: 30, absent: true},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	record, err := s.keeper.GetParticipation(ctx, ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), record.TargetRound)
+	s.Require().Equal(uint64(50), record.DrandRound)
+	s.Require().Len(record.Validators, 4)
+	s.Require().Equal([]byte{0b0001}, record.Contributed)
+	s.Require().Equal([]vrftypes.VrfParticipationReason{
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_ABSENT,
+	}, record.Reasons)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_DecryptsTimelockedOnUnchainedScheme() {
+	ctx := s.preBlockCtx()
+
+	s.scheme = crypto.NewPedersenBLSUnchained()
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.SchemeId = crypto.UnchainedSchemeID
+	s.Require().NoError(s.keeper.SetParams(ctx, params))
+
+	ct, err := vrftypes.TimelockEncrypt(params.PublicKey, 50, []byte("sealed bid"))
+	s.Require().NoError(err)
+	submitted, err := s.keeper.SubmitTimelocked(ctx.WithBlockTime(time.Unix(1699999950, 0).UTC()), s.Authority, 50, ct)
+	s.Require().NoError(err)
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 334, rounds: []uint64{50, 49}},
+		{power: 166, rounds: []uint64{50, 49}},
+	})
+
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	msg, err := s.keeper.GetTimelocked(ctx, submitted.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.TimelockStatus_TIMELOCK_STATUS_DECRYPTED, msg.Status)
+	s.Require().Equal([]byte("sealed bid"), msg.Plaintext)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_RecoversBeaconFromPartials() {
+	ctx := s.preBlockCtx()
+	shares := s.setupThresholdMode(ctx, 2, 4)
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 400, partials: s.signPartials(shares[0], 50, 49)},
+		{power: 400, partials: s.signPartials(shares[1], 50)},
+		{power: 100},
+		// Replays the partial of a share registered to another validator.
+		{power: 100, partials: s.signPartials(shares[2], 50)},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	beacon, err := s.keeper.GetLatestBeacon(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(50), beacon.DrandRound)
+
+	expected, err := s.scheme.AuthScheme.Sign(s.secret, s.scheme.DigestBeacon(&common.Beacon{Round: 50}))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, beacon.Signature)
+	randomness := sha256.Sum256(expected)
+	s.Require().Equal(randomness[:], beacon.Randomness)
+
+	record, err := s.keeper.GetParticipation(ctx, ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Equal([]vrftypes.VrfParticipationReason{
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE,
+	}, record.Reasons)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_RejectsBlockBelowPartialThreshold() {
+	ctx := s.preBlockCtx()
+	shares := s.setupThresholdMode(ctx, 3, 3)
+
+	// Two thirds of the power is reached, but only two of three shares.
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 450, partials: s.signPartials(shares[0], 50)},
+		{power: 450, partials: s.signPartials(shares[1], 50)},
+		{power: 100},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+
+	_, err = s.keeper.GetLatestBeacon(ctx)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_FallsBackToECVRF() {
+	ctx := s.preBlockCtx()
+	keys := s.setupECVRFFallback(ctx, 3)
+	alpha := vrftypes.ECVRFFallbackInput(ctx.BlockHeight()-1, []byte("previous randomness"))
+
+	proof0, beta0 := s.proveECVRF(keys[0], alpha)
+	proof1, beta1 := s.proveECVRF(keys[1], alpha)
+
+	// drand is down: no validator carries a beacon.
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 400, ecvrfProof: proof0},
+		{power: 400, ecvrfProof: proof1},
+		{power: 200},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	beacon, err := s.keeper.GetLatestBeacon(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF, beacon.Source)
+	s.Require().Zero(beacon.DrandRound)
+	s.Require().Empty(beacon.Signature)
+	s.Require().Equal(vrftypes.CombineECVRFOutputs(alpha, [][]byte{beta0, beta1}), beacon.Randomness)
+
+	record, err := s.keeper.GetParticipation(ctx, ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Equal([]vrftypes.VrfParticipationReason{
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_ECVRF_ONLY,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_ECVRF_ONLY,
+		vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY,
+	}, record.Reasons)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_PrefersDrandOverECVRF() {
+	ctx := s.preBlockCtx()
+	keys := s.setupECVRFFallback(ctx, 2)
+	alpha := vrftypes.ECVRFFallbackInput(ctx.BlockHeight()-1, []byte("previous randomness"))
+
+	proof0, _ := s.proveECVRF(keys[0], alpha)
+	proof1, _ := s.proveECVRF(keys[1], alpha)
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 334, rounds: []uint64{50}, ecvrfProof: proof0},
+		{power: 166, rounds: []uint64{50}, ecvrfProof: proof1},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	beacon, err := s.keeper.GetLatestBeacon(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND, beacon.Source)
+	s.Require().Equal(uint64(50), beacon.DrandRound)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_IgnoresInvalidECVRFProofs() {
+	ctx := s.preBlockCtx()
+	keys := s.setupECVRFFallback(ctx, 2)
+	alpha := vrftypes.ECVRFFallbackInput(ctx.BlockHeight()-1, []byte("previous randomness"))
+
+	proof0, _ := s.proveECVRF(keys[0], alpha)
+	// A proof over another input, such as a stale seed, does not count.
+	proof1, _ := s.proveECVRF(keys[1], vrftypes.ECVRFFallbackInput(ctx.BlockHeight()-1, nil))
+
+	req := s.finalizeBlockRequest(ctx, []testVote{
+		{power: 500, ecvrfProof: proof0},
+		{power: 500, ecvrfProof: proof1},
+	})
+
+	_, err := s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().ErrorIs(err, errInsufficientVotingPowerForValidBeacons)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_EmergencyDisableThroughAuthz() {
+	ctx := s.preBlockCtx()
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.keeper.SetCommitteeMember(ctx, vrftypes.AllowlistEntry{
+		Address: granter.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+	s.authz.Grant(s.Addr, granter, vrftypes.NewEmergencyDisableAuthorization())
+
+	exec := authz.NewMsgExec(s.Addr, []sdk.Msg{&vrftypes.MsgVrfEmergencyDisable{Authority: granter.String(), Reason: "halt"}})
+	tx, err := s.BuildSignedTx(&exec)
+	s.Require().NoError(err)
+	txBz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	// Without the disable, a block without extensions is rejected.
+	req := s.finalizeBlockRequest(ctx, nil)
+	req.Txs = append(req.Txs, txBz)
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().NoError(err)
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().False(params.Enabled)
+}
+
+func (s *PreBlockSuite) TestWrappedPreBlocker_IgnoresReplayedEmergencyDisable() {
+	ctx := s.preBlockCtx()
+
+	s.Require().NoError(s.keeper.SetCommitteeMember(ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+
+	tx, err := s.BuildSignedTx(&vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String(), Reason: "halt"})
+	s.Require().NoError(err)
+	txBz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.RecordEmergencyTx(ctx, emergency.TxHash(txBz), s.Addr, emergency.TimeoutHeight(tx)))
+
+	// The replayed disable is ignored, so the block still needs extensions.
+	req := s.finalizeBlockRequest(ctx, nil)
+	req.Txs = append(req.Txs, txBz)
+	_, err = s.handler.WrappedPreBlocker(module.NewManager())(ctx, req)
+	s.Require().Error(err)
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().True(params.Enabled)
+}
+
+// setupECVRFFallback enables ecvrf_fallback, records the fallback seed of the
+// previous height and registers ECVRF keys for the first n test validators.
+func (s *PreBlockSuite) setupECVRFFallback(ctx sdk.Context, n int) []ed25519.PrivateKey {
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.EcvrfFallback = true
+	s.Require().NoError(s.keeper.SetParams(ctx, params))
+
+	s.Require().NoError(s.keeper.SetLatestBeacon(ctx, vrftypes.VrfBeacon{Randomness: []byte("previous randomness")}))
+	_, _, err = s.keeper.RotateFallbackSeed(ctx)
+	s.Require().NoError(err)
+
+	keys := make([]ed25519.PrivateKey, n)
+	for i := range keys {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		s.Require().NoError(err)
+		operator := sdk.ValAddress(testValidatorAddress(i)).String()
+		s.staking.validators[sdk.ConsAddress(testValidatorAddress(i)).String()] = stakingtypes.Validator{OperatorAddress: operator}
+		s.Require().NoError(s.keeper.SetEcvrfKey(ctx, operator, pub))
+		keys[i] = priv
+	}
+
+	return keys
+}
+
+func (s *PreBlockSuite) proveECVRF(key ed25519.PrivateKey, alpha []byte) (proof, beta []byte) {
+	proof, err := vrftypes.ECVRFProve(key, alpha)
+	s.Require().NoError(err)
+	beta, err = vrftypes.ECVRFVerify(key.Public().(ed25519.PublicKey), alpha, proof)
+	s.Require().NoError(err)
+	return proof, beta
+}
+
+// setupThresholdMode switches the suite to an unchained group key shared
+// t-of-n among the first n test validators and returns their private shares.
+func (s *PreBlockSuite) setupThresholdMode(ctx sdk.Context, t, n int) []*share.PriShare {
+	s.scheme = crypto.NewPedersenBLSUnchained()
+	poly := share.NewPriPoly(s.scheme.KeyGroup, t, s.secret, random.New())
+	pubPoly := poly.Commit(nil)
+
+	pubKeyBz, err := pubPoly.Commit().MarshalBinary()
+	s.Require().NoError(err)
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.SchemeId = crypto.UnchainedSchemeID
+	params.PublicKey = pubKeyBz
+	params.PartialThreshold = uint32(t)
+	params.ChainHash, err = params.DrandChainHash()
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.SetParams(ctx, params))
+
+	shares := poly.Shares(n)
+	for i, sh := range shares {
+		operator := sdk.ValAddress(testValidatorAddress(i)).String()
+		s.staking.validators[sdk.ConsAddress(testValidatorAddress(i)).String()] = stakingtypes.Validator{OperatorAddress: operator}
+
+		sharePubBz, err := pubPoly.Eval(sh.I).V.MarshalBinary()
+		s.Require().NoError(err)
+		s.Require().NoError(s.keeper.SetVrfIdentity(ctx, vrftypes.VrfIdentity{
+			ValidatorAddress:  operator,
+			DrandBlsPublicKey: sharePubBz,
+			ChainHash:         params.ChainHash,
+			ShareIndex:        uint32(sh.I),
+		}))
+	}
+
+	return shares
+}
+
+func (s *PreBlockSuite) signPartials(sh *share.PriShare, rounds ...uint64) []vetypes.VrfVoteExtensionPartial {
+	out := make([]vetypes.VrfVoteExtensionPartial, 0, len(rounds))
+	for _, round := range rounds {
+		sig, err := s.scheme.ThresholdScheme.Sign(sh, s.scheme.DigestBeacon(&common.Beacon{Round: round}))
+		s.Require().NoError(err)
+		out = append(out, vetypes.VrfVoteExtensionPartial{DrandRound: round, PartialSignature: sig})
+	}
+	return out
+}
+
+// preBlockCtx returns a context whose target round is 50 under the suite
+// params.
+func (s *PreBlockSuite) preBlockCtx() sdk.Context {
+	ctx := s.Ctx.
+		WithBlockHeight(10).
+		WithBlockTime(time.Unix(1700000010, 0).UTC()).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+		})
+	s.Require().NoError(s.keeper.SetLastBlockTime(ctx, 1700000000))
+
+	return ctx
+}
+
+// testVote is a committed vote carrying signed beacons for rounds, or the
+// given partial signatures, and optionally an ECVRF proof. Votes without any
+// of them carry no extension; absent votes are not committed.
+type testVote struct {
+	power      int64
+	rounds     []uint64
+	partials   []vetypes.VrfVoteExtensionPartial
+	ecvrfProof []byte
+	absent     bool
+}
+
+// finalizeBlockRequest injects one vote per entry. The i-th validator has
+// the consensus address testValidatorAddress(i).
+func (s *PreBlockSuite) finalizeBlockRequest(ctx sdk.Context, votes []testVote) *cmtabci.RequestFinalizeBlock {
+	extCommit := cmtabci.ExtendedCommitInfo{}
+	for i, vote := range votes {
+		var bz []byte
+		switch {
+		case len(vote.partials) > 0:
+			ext := vetypes.VrfVoteExtension{Version: ve.VoteExtensionVersionPartial, Partials: vote.partials}
+			var err error
+			bz, err = ve.EncodeVrfVoteExtension(ext)
+			s.Require().NoError(err)
+		case len(vote.rounds) > 0 || len(vote.ecvrfProof) > 0:
+			ext := vetypes.VrfVoteExtension{Version: ve.VoteExtensionVersionMulti, EcvrfProof: vote.ecvrfProof}
+			for _, round := range vote.rounds {
+				ext.Beacons = append(ext.Beacons, s.signBeacon(round))
+			}
+			var err error
+			bz, err = ve.EncodeVrfVoteExtension(ext)
+			s.Require().NoError(err)
+		}
+
+		flag := cmtproto.BlockIDFlagCommit
+		if vote.absent {
+			flag = cmtproto.BlockIDFlagAbsent
+		}
+
+		extCommit.Votes = append(extCommit.Votes, cmtabci.ExtendedVoteInfo{
+			Validator:     cmtabci.Validator{Address: testValidatorAddress(i), Power: vote.power},
+			BlockIdFlag:   flag,
+			VoteExtension: bz,
+		})
+	}
+
+	extCommitCodec := abcicodec.NewCompressionExtendedCommitCodec(
+		abcicodec.NewDefaultExtendedCommitCodec(),
+		abcicodec.NewZStdCompressor(),
+	)
+	extCommitBz, err := extCommitCodec.Encode(extCommit)
+	s.Require().NoError(err)
+
+	return &cmtabci.RequestFinalizeBlock{
+		Height: ctx.BlockHeight(),
+		Txs:    [][]byte{extCommitBz},
+	}
+}
+
+func testValidatorAddress(i int) []byte {
+	addr := make([]byte, 20)
+	addr[19] = byte(i + 1)
+	return addr
+}
+
+type testStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (k testStakingKeeper) Validator(_ context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+	for _, val := range k.validators {
+		if val.OperatorAddress == addr.String() {
+			return val, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (k testStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	val, ok := k.validators[consAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (s *PreBlockSuite) signBeacon(round uint64) vetypes.VrfVoteExtensionBeacon {
+	prev := []byte("previous-signature")
+	msg := s.scheme.DigestBeacon(&common.Beacon{Round: round, PreviousSig: prev})
+	sig, err := s.scheme.AuthScheme.Sign(s.secret, msg)
+	s.Require().NoError(err)
+
+	randomness := sha256.Sum256(sig)
+	return vetypes.VrfVoteExtensionBeacon{
+		DrandRound:        round,
+		Randomness:        randomness[:],
+		Signature:         sig,
+		PreviousSignature: prev,
+	}
+}
@@ -0,0 +1,83 @@
+package vrf
+
+import (
+	"fmt"
+
+	vetypes "github.com/dgtlkitchen/vrf/x/vrf/abci/ve/types"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// roundTally accumulates the voting power backing each eligible drand round
+// at a single height.
+type roundTally struct {
+	rounds  []uint64
+	beacons map[uint64]vrftypes.VrfBeacon
+	power   map[uint64]int64
+}
+
+func newRoundTally(rounds []uint64) *roundTally {
+	return &roundTally{
+		rounds:  rounds,
+		beacons: make(map[uint64]vrftypes.VrfBeacon, len(rounds)),
+		power:   make(map[uint64]int64, len(rounds)),
+	}
+}
+
+// roundsFrom returns the rounds at or above floor, keeping their order.
+func roundsFrom(rounds []uint64, floor uint64) []uint64 {
+	kept := make([]uint64, 0, len(rounds))
+	for _, r := range rounds {
+		if r >= floor {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+func (t *roundTally) eligible(round uint64) bool {
+	for _, r := range t.rounds {
+		if r == round {
+			return true
+		}
+	}
+	return false
+}
+
+// add records power for a verified beacon. All valid beacons for the same
+// round must be identical.
+func (t *roundTally) add(b vetypes.VrfVoteExtensionBeacon, power int64) error {
+	beacon := vrftypes.VrfBeacon{
+		DrandRound:        b.DrandRound,
+		Randomness:        b.Randomness,
+		Signature:         b.Signature,
+		PreviousSignature: b.PreviousSignature,
+	}
+
+	if existing, ok := t.beacons[b.DrandRound]; ok {
+		if !equalBeacon(existing, beacon) {
+			return fmt.Errorf("%w for round %d", errInconsistentBeaconsInValidSet, b.DrandRound)
+		}
+	} else {
+		t.beacons[b.DrandRound] = beacon
+	}
+
+	t.power[b.DrandRound] += power
+	return nil
+}
+
+// selectBeacon returns the highest eligible round whose voting power reaches
+// requiredVP, together with the best power seen across all rounds.
+func (t *roundTally) selectBeacon(requiredVP int64) (*vrftypes.VrfBeacon, int64) {
+	best := int64(0)
+	for _, r := range t.rounds {
+		power := t.power[r]
+		if power >= requiredVP {
+			b := t.beacons[r]
+			return &b, power
+		}
+		if power > best {
+			best = power
+		}
+	}
+	return nil, best
+}
@@ -0,0 +1,193 @@
+package vrf
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/sign/tbls"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vetypes "github.com/dgtlkitchen/vrf/x/vrf/abci/ve/types"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+var errRecoveredBeaconInvalid = errors.New("vrf: recovered threshold signature does not verify")
+
+// partialTally accumulates the verified partial signatures and the voting
+// power behind them for each eligible drand round at a single height.
+type partialTally struct {
+	rounds []uint64
+	shares map[uint64][]*share.PubShare
+	power  map[uint64]int64
+}
+
+func newPartialTally(rounds []uint64) *partialTally {
+	return &partialTally{
+		rounds: rounds,
+		shares: make(map[uint64][]*share.PubShare, len(rounds)),
+		power:  make(map[uint64]int64, len(rounds)),
+	}
+}
+
+func (t *partialTally) eligible(round uint64) bool {
+	for _, r := range t.rounds {
+		if r == round {
+			return true
+		}
+	}
+	return false
+}
+
+// add records a verified partial signature for round. A share index counts
+// once per round, so validators registered with the same share cannot stack
+// their voting power.
+func (t *partialTally) add(round uint64, index int, sig kyber.Point, power int64) bool {
+	for _, s := range t.shares[round] {
+		if s.I == index {
+			return false
+		}
+	}
+
+	t.shares[round] = append(t.shares[round], &share.PubShare{I: index, V: sig})
+	t.power[round] += power
+	return true
+}
+
+// selectBeacon recovers the beacon of the highest eligible round whose
+// partials reach both requiredVP and threshold, together with the best power
+// seen across all rounds. Recovery only fails if the registered shares do not
+// belong to the group key, in which case lower rounds are tried.
+func (t *partialTally) selectBeacon(
+	scheme *crypto.Scheme,
+	pubKey kyber.Point,
+	requiredVP int64,
+	threshold int,
+) (*vrftypes.VrfBeacon, int64, error) {
+	best := int64(0)
+	var recoverErr error
+	for _, r := range t.rounds {
+		power := t.power[r]
+		if power > best {
+			best = power
+		}
+		if power < requiredVP || len(t.shares[r]) < threshold {
+			continue
+		}
+
+		beacon, err := recoverBeacon(scheme, pubKey, r, t.shares[r], threshold)
+		if err != nil {
+			recoverErr = errors.Join(recoverErr, err)
+			continue
+		}
+		return beacon, power, nil
+	}
+	return nil, best, recoverErr
+}
+
+// recoverBeacon interpolates the group signature for round from threshold
+// partials and checks it against the group public key.
+func recoverBeacon(
+	scheme *crypto.Scheme,
+	pubKey kyber.Point,
+	round uint64,
+	shares []*share.PubShare,
+	threshold int,
+) (*vrftypes.VrfBeacon, error) {
+	sigPoint, err := share.RecoverCommit(scheme.SigGroup, shares, threshold, len(shares))
+	if err != nil {
+		return nil, fmt.Errorf("vrf: recovering round %d: %w", round, err)
+	}
+
+	sig, err := sigPoint.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("vrf: encoding recovered signature for round %d: %w", round, err)
+	}
+
+	if err := scheme.VerifyBeacon(&common.Beacon{Round: round, Signature: sig}, pubKey); err != nil {
+		return nil, fmt.Errorf("%w for round %d: %w", errRecoveredBeaconInvalid, round, err)
+	}
+
+	randomness := sha256.Sum256(sig)
+	return &vrftypes.VrfBeacon{
+		DrandRound: round,
+		Randomness: randomness[:],
+		Signature:  sig,
+	}, nil
+}
+
+// addPartials verifies the partial signatures of a single vote against the
+// validator's registered public share and records the valid ones in tally.
+func (h *PreBlockHandler) addPartials(
+	ctx sdk.Context,
+	scheme *crypto.Scheme,
+	tally *partialTally,
+	consAddr sdk.ConsAddress,
+	power int64,
+	partials []vetypes.VrfVoteExtensionPartial,
+	part *voteParticipation,
+) {
+	identity, err := h.keeper.GetVrfIdentityByConsAddr(ctx, consAddr)
+	if err != nil {
+		h.logger.Error("vrf: no registered drand share for validator", "height", ctx.BlockHeight(), "validator", consAddr.String(), "err", err)
+		part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE)
+		return
+	}
+
+	sharePub := scheme.KeyGroup.Point()
+	if err := sharePub.UnmarshalBinary(identity.DrandBlsPublicKey); err != nil {
+		h.logger.Error("vrf: invalid registered drand share", "height", ctx.BlockHeight(), "validator", identity.ValidatorAddress, "err", err)
+		part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE)
+		return
+	}
+
+	for _, p := range partials {
+		if part.counted(p.DrandRound) {
+			continue
+		}
+
+		if !tally.eligible(p.DrandRound) {
+			part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND)
+			continue
+		}
+
+		sigShare := tbls.SigShare(p.PartialSignature)
+		index, err := sigShare.Index()
+		if err != nil || index != int(identity.ShareIndex) {
+			h.logger.Error("vrf: partial signature share index mismatch",
+				"height", ctx.BlockHeight(),
+				"validator", identity.ValidatorAddress,
+				"round", p.DrandRound,
+				"index", index,
+				"registered_index", identity.ShareIndex,
+			)
+			part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE)
+			continue
+		}
+
+		msg := scheme.DigestBeacon(&common.Beacon{Round: p.DrandRound})
+		if err := scheme.ThresholdScheme.VerifyRecovered(sharePub, msg, sigShare.Value()); err != nil {
+			h.logger.Error("vrf: partial signature verification failed", "height", ctx.BlockHeight(), "validator", identity.ValidatorAddress, "round", p.DrandRound, "err", err)
+			part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED)
+			continue
+		}
+
+		sigPoint := scheme.SigGroup.Point()
+		if err := sigPoint.UnmarshalBinary(sigShare.Value()); err != nil {
+			part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED)
+			continue
+		}
+
+		if !tally.add(p.DrandRound, index, sigPoint, power) {
+			h.logger.Error("vrf: duplicate partial signature share index", "height", ctx.BlockHeight(), "validator", identity.ValidatorAddress, "round", p.DrandRound, "index", index)
+			part.fail(vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE)
+			continue
+		}
+		part.verified(p.DrandRound)
+	}
+}
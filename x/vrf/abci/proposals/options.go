@@ -14,3 +14,20 @@ func WithRetainInjectedCommitInfo() Option {
 		p.retainInjectedCommitInfoInWrappedHandler = true
 	}
 }
+
+// EmergencyLane holds the emergency disable txs the node admitted to its
+// mempool. ante.EmergencyLane implements it.
+type EmergencyLane interface {
+	// Txs returns the pending txs a block at height may include.
+	Txs(height int64) [][]byte
+}
+
+// WithEmergencyLane configures the ProposalHandler to propose the emergency
+// disable txs of lane ahead of the txs reaped from the mempool. Without it,
+// PrepareProposal only finds the emergency disables that the reap returned,
+// which a full mempool can keep out.
+func WithEmergencyLane(lane EmergencyLane) Option {
+	return func(p *ProposalHandler) {
+		p.emergencyLane = lane
+	}
+}
@@ -39,6 +39,7 @@ type ProposalHandler struct {
 	// vrf keepers/deps (used for emergency-disable exemption and enablement)
 	vrfKeeper       *vrfkeeper.Keeper
 	accountKeeper   authkeeper.AccountKeeper
+	authzKeeper     emergency.AuthzKeeper
 	signModeHandler *txsigning.HandlerMap
 	txDecoder       sdk.TxDecoder
 
@@ -50,6 +51,7 @@ type ProposalHandler struct {
 
 	// options
 	retainInjectedCommitInfoInWrappedHandler bool
+	emergencyLane                            EmergencyLane
 }
 
 func NewProposalHandler(
@@ -58,6 +60,7 @@ func NewProposalHandler(
 	processProposalHandler sdk.ProcessProposalHandler,
 	vrfKeeper *vrfkeeper.Keeper,
 	accountKeeper authkeeper.AccountKeeper,
+	authzKeeper emergency.AuthzKeeper,
 	signModeHandler *txsigning.HandlerMap,
 	txDecoder sdk.TxDecoder,
 	validateVoteExtensionsFn ve.ValidateVoteExtensionsFn,
@@ -70,6 +73,7 @@ func NewProposalHandler(
 		processProposalHandler:   processProposalHandler,
 		vrfKeeper:                vrfKeeper,
 		accountKeeper:            accountKeeper,
+		authzKeeper:              authzKeeper,
 		signModeHandler:          signModeHandler,
 		txDecoder:                txDecoder,
 		validateVoteExtensionsFn: validateVoteExtensionsFn,
@@ -137,10 +141,24 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 				return &cometabci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
 			}
 
-			// Optionally pass the injected bytes through to wrapped handler.
-			if h.retainInjectedCommitInfoInWrappedHandler {
-				req.Txs = append([][]byte{extInfoBz}, req.Txs...)
-			}
+		}
+
+		// Authorized emergency disables skip the queue: their bytes are
+		// reserved before the wrapped handler fills the rest of the block.
+		// The emergency lane comes first, since the mempool reap in req.Txs
+		// is first come first served.
+		var pending [][]byte
+		if h.emergencyLane != nil {
+			pending = h.emergencyLane.Txs(ctx.BlockHeight())
+		}
+		blockTxs := emergency.NewBlockTxs(h.vrfKeeper)
+		laneTxs, laneBytes, appTxs := h.priorityLane(ctx, blockTxs, pending, req.Txs, req.MaxTxBytes)
+		req.Txs = appTxs
+		req.MaxTxBytes -= laneBytes
+
+		// Optionally pass the injected bytes through to wrapped handler.
+		if len(extInfoBz) != 0 && h.retainInjectedCommitInfoInWrappedHandler {
+			req.Txs = append([][]byte{extInfoBz}, req.Txs...)
 		}
 
 		wrappedStart := time.Now()
@@ -151,9 +169,14 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			return &cometabci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
 		}
 
-		// Inject our tx (if extInfoBz is non-empty), and resize response to
-		// respect the original max bytes (including the injected tx).
-		resp.Txs = h.injectAndResize(resp.Txs, extInfoBz, req.MaxTxBytes+int64(len(extInfoBz)))
+		// Leave out the emergency disable txs ProcessProposal would reject,
+		// including copies of the priority lane.
+		resp.Txs = h.filterEmergencyTxs(ctx, blockTxs, resp.Txs)
+
+		// Inject our tx (if extInfoBz is non-empty) followed by the priority
+		// lane, and resize response to respect the original max bytes
+		// (including the injected tx and the lane).
+		resp.Txs = h.injectAndResize(resp.Txs, extInfoBz, laneTxs, req.MaxTxBytes+int64(len(extInfoBz))+laneBytes)
 
 		return resp, nil
 	}
@@ -178,7 +201,11 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		// and VRF is enabled and not bypassed by an authorized emergency disable.
 		voteExtensionsEnabled := ve.VoteExtensionsEnabled(ctx)
 		vrfEnabled := h.isVrfEnabled(ctx)
-		emergencyDisabled := h.hasAuthorizedEmergencyDisable(ctx, req.Txs)
+		emergencyDisabled, emergencyErr := h.hasAuthorizedEmergencyDisable(ctx, req.Txs)
+		if emergencyErr != nil {
+			h.logger.Warn("vrf: invalid emergency disable txs in ProcessProposal; rejecting proposal", "err", emergencyErr)
+			return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT}, nil
+		}
 
 		// Save injected tx so we can re-add it if a wrapped handler mutates txs.
 		var injectedTx []byte
@@ -224,14 +251,19 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	}
 }
 
-func (*ProposalHandler) injectAndResize(appTxs [][]byte, injectTx []byte, maxSizeBytes int64) [][]byte {
+func (*ProposalHandler) injectAndResize(appTxs [][]byte, injectTx []byte, laneTxs [][]byte, maxSizeBytes int64) [][]byte {
 	var (
-		returnedTxs   = make([][]byte, 0, len(appTxs)+1)
+		returnedTxs   = make([][]byte, 0, len(appTxs)+len(laneTxs)+1)
 		consumedBytes int64
 	)
 
-	// If our injected tx isn't already present, inject it.
-	if len(injectTx) != 0 && (len(appTxs) < 1 || !bytes.Equal(appTxs[0], injectTx)) {
+	// The wrapped handler may have kept our injected tx in front; it still has
+	// to come before the priority lane.
+	if len(injectTx) != 0 && len(appTxs) > 0 && bytes.Equal(appTxs[0], injectTx) {
+		appTxs = appTxs[1:]
+	}
+
+	if len(injectTx) != 0 {
 		injectBytes := int64(len(injectTx))
 		if injectBytes <= maxSizeBytes {
 			consumedBytes += injectBytes
@@ -239,6 +271,14 @@ func (*ProposalHandler) injectAndResize(appTxs [][]byte, injectTx []byte, maxSiz
 		}
 	}
 
+	for _, tx := range laneTxs {
+		if consumedBytes+int64(len(tx)) > maxSizeBytes {
+			break
+		}
+		consumedBytes += int64(len(tx))
+		returnedTxs = append(returnedTxs, tx)
+	}
+
 	for _, tx := range appTxs {
 		consumedBytes += int64(len(tx))
 		if consumedBytes > maxSizeBytes {
@@ -255,7 +295,8 @@ func (h *ProposalHandler) isVrfEnabled(ctx sdk.Context) bool {
 		return false
 	}
 
-	params, err := h.vrfKeeper.GetParams(ctx)
+	// PreBlock at this height activates any params scheduled for it.
+	params, err := h.vrfKeeper.ParamsAt(ctx, ctx.BlockHeight())
 	if err != nil {
 		h.logger.Error("vrf: failed to load params in ProcessProposal; treating as disabled", "err", err)
 		return false
@@ -264,11 +305,16 @@ func (h *ProposalHandler) isVrfEnabled(ctx sdk.Context) bool {
 	return params.Enabled
 }
 
-func (h *ProposalHandler) hasAuthorizedEmergencyDisable(ctx sdk.Context, txs [][]byte) bool {
+// hasAuthorizedEmergencyDisable reports whether txs contain an authorized
+// emergency disable. It fails if the emergency disable txs break the
+// per-block limits of emergency.BlockTxs.
+func (h *ProposalHandler) hasAuthorizedEmergencyDisable(ctx sdk.Context, txs [][]byte) (bool, error) {
 	if h.txDecoder == nil || h.signModeHandler == nil || h.vrfKeeper == nil {
-		return false
+		return false, nil
 	}
 
+	blockTxs := emergency.NewBlockTxs(h.vrfKeeper)
+	authorizedFound := false
 	for i, txBytes := range txs {
 		if len(txBytes) == 0 {
 			continue
@@ -280,14 +326,25 @@ func (h *ProposalHandler) hasAuthorizedEmergencyDisable(ctx sdk.Context, txs [][
 		}
 
 		tx, decErr := h.txDecoder(txBytes)
-		if decErr != nil {
+		if decErr != nil || !emergency.IsEmergencyTx(tx) {
+			continue
+		}
+
+		if err := blockTxs.Add(ctx, tx, txBytes); err != nil {
+			return false, err
+		}
+
+		// Every emergency tx is admitted above, but one authorized tx is
+		// enough, so the rest are not verified.
+		if authorizedFound {
 			continue
 		}
 
-		found, authorized, _, verErr := emergency.VerifyEmergencyMsg(
+		_, authorized, _, verErr := emergency.VerifyEmergencyMsg(
 			ctx,
 			tx,
 			h.accountKeeper,
+			h.authzKeeper,
 			h.vrfKeeper,
 			h.signModeHandler,
 		)
@@ -296,12 +353,96 @@ func (h *ProposalHandler) hasAuthorizedEmergencyDisable(ctx sdk.Context, txs [][
 			continue
 		}
 
-		if found && authorized {
-			return true
+		authorizedFound = authorized
+	}
+
+	return authorizedFound, nil
+}
+
+// priorityLane selects, in order, the authorized emergency disable txs of
+// pending and then of txs, as long as blockTxs admits them and they fit in
+// maxBytes. It returns them, their size and the txs it did not take out of
+// txs.
+func (h *ProposalHandler) priorityLane(
+	ctx sdk.Context,
+	blockTxs *emergency.BlockTxs,
+	pending [][]byte,
+	txs [][]byte,
+	maxBytes int64,
+) (laneTxs [][]byte, laneBytes int64, rest [][]byte) {
+	if h.txDecoder == nil || h.signModeHandler == nil || h.vrfKeeper == nil {
+		return nil, 0, txs
+	}
+
+	take := func(txBytes []byte) bool {
+		if len(laneTxs) >= emergency.MaxTxsPerBlock || laneBytes+int64(len(txBytes)) > maxBytes {
+			return false
+		}
+
+		tx, err := h.txDecoder(txBytes)
+		if err != nil || !h.isAuthorizedEmergencyTx(ctx, tx) || blockTxs.Add(ctx, tx, txBytes) != nil {
+			return false
+		}
+
+		laneTxs = append(laneTxs, txBytes)
+		laneBytes += int64(len(txBytes))
+		return true
+	}
+
+	for _, txBytes := range pending {
+		take(txBytes)
+	}
+
+	rest = make([][]byte, 0, len(txs))
+	for _, txBytes := range txs {
+		if !take(txBytes) {
+			rest = append(rest, txBytes)
+		}
+	}
+
+	return laneTxs, laneBytes, rest
+}
+
+// isAuthorizedEmergencyTx reports whether tx is an emergency disable tx that
+// passes VerifyEmergencyMsg.
+func (h *ProposalHandler) isAuthorizedEmergencyTx(ctx sdk.Context, tx sdk.Tx) bool {
+	if !emergency.IsEmergencyTx(tx) {
+		return false
+	}
+
+	_, authorized, _, err := emergency.VerifyEmergencyMsg(
+		ctx,
+		tx,
+		h.accountKeeper,
+		h.authzKeeper,
+		h.vrfKeeper,
+		h.signModeHandler,
+	)
+
+	return err == nil && authorized
+}
+
+// filterEmergencyTxs drops the emergency disable txs that blockTxs does not
+// admit, keeping every other tx in order.
+func (h *ProposalHandler) filterEmergencyTxs(ctx sdk.Context, blockTxs *emergency.BlockTxs, txs [][]byte) [][]byte {
+	if h.txDecoder == nil || h.vrfKeeper == nil {
+		return txs
+	}
+
+	filtered := make([][]byte, 0, len(txs))
+	for _, txBytes := range txs {
+		tx, decErr := h.txDecoder(txBytes)
+		if decErr == nil && emergency.IsEmergencyTx(tx) {
+			if err := blockTxs.Add(ctx, tx, txBytes); err != nil {
+				h.logger.Info("vrf: leaving emergency disable tx out of proposal", "err", err)
+				continue
+			}
 		}
+
+		filtered = append(filtered, txBytes)
 	}
 
-	return false
+	return filtered
 }
 
 var zstdFrameMagic = [...]byte{0x28, 0xB5, 0x2F, 0xFD}
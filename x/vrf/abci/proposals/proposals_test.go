@@ -0,0 +1,275 @@
+package proposals
+
+import (
+	"bytes"
+	"testing"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/abci/codec"
+	vrfante "github.com/dgtlkitchen/vrf/x/vrf/ante"
+	"github.com/dgtlkitchen/vrf/x/vrf/emergency"
+	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+const fillerTxSize = 100
+
+type ProposalsSuite struct {
+	vrftestutil.VrfTestSuite
+
+	keeper *vrfkeeper.Keeper
+}
+
+func TestProposalsSuite(t *testing.T) {
+	suite.Run(t, new(ProposalsSuite))
+}
+
+func (s *ProposalsSuite) SetupTest() {
+	s.VrfTestSuite.SetupTest()
+
+	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	s.keeper = &k
+	s.Require().NoError(s.keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+}
+
+// newHandler wraps prepare, and a process handler that accepts everything.
+func (s *ProposalsSuite) newHandler(prepare sdk.PrepareProposalHandler, opts ...Option) *ProposalHandler {
+	return NewProposalHandler(
+		log.NewNopLogger(),
+		prepare,
+		func(sdk.Context, *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+			return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
+		},
+		s.keeper,
+		s.AccountKeeper,
+		nil,
+		s.SignModeHandler,
+		s.EncCfg.TxConfig.TxDecoder(),
+		nil,
+		codec.NewCompressionExtendedCommitCodec(codec.NewDefaultExtendedCommitCodec(), codec.NewZStdCompressor()),
+		opts...,
+	)
+}
+
+// reapTxs stands in for the default handler over a no-op mempool: it keeps
+// req.Txs in order until MaxTxBytes is reached.
+func reapTxs(_ sdk.Context, req *cometabci.RequestPrepareProposal) (*cometabci.ResponsePrepareProposal, error) {
+	var (
+		txs  [][]byte
+		size int64
+	)
+	for _, tx := range req.Txs {
+		size += int64(len(tx))
+		if size > req.MaxTxBytes {
+			break
+		}
+		txs = append(txs, tx)
+	}
+
+	return &cometabci.ResponsePrepareProposal{Txs: txs}, nil
+}
+
+// emergencyTx returns an emergency disable tx of the suite account.
+func (s *ProposalsSuite) emergencyTx(reason string) []byte {
+	return s.emergencyTxAs(s.Priv, reason)
+}
+
+// emergencyTxAs returns an emergency disable tx of the account of priv.
+func (s *ProposalsSuite) emergencyTxAs(priv cryptotypes.PrivKey, reason string) []byte {
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	tx, err := s.BuildSignedTxAs(priv, uint64(s.Ctx.BlockHeight())+20, &vrftypes.MsgVrfEmergencyDisable{Authority: addr.String(), Reason: reason})
+	s.Require().NoError(err)
+	bz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	return bz
+}
+
+// addEmergencyMember adds an account holding the emergency committee role.
+func (s *ProposalsSuite) addEmergencyMember() cryptotypes.PrivKey {
+	priv, addr := s.AddAccount()
+	s.Require().NoError(s.keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+
+	return priv
+}
+
+// saturatedTxs returns n filler txs followed by tail.
+func saturatedTxs(n int, tail ...[]byte) [][]byte {
+	txs := make([][]byte, 0, n+len(tail))
+	for i := range n {
+		txs = append(txs, bytes.Repeat([]byte{byte(i)}, fillerTxSize))
+	}
+
+	return append(txs, tail...)
+}
+
+func totalSize(txs [][]byte) int64 {
+	var size int64
+	for _, tx := range txs {
+		size += int64(len(tx))
+	}
+
+	return size
+}
+
+func (s *ProposalsSuite) TestPrepareProposalSaturatedMempool() {
+	emergencyTx := s.emergencyTx("halt")
+	const maxTxBytes = 10 * fillerTxSize
+
+	resp, err := s.newHandler(reapTxs).PrepareProposalHandler()(s.Ctx, &cometabci.RequestPrepareProposal{
+		Txs:        saturatedTxs(50, emergencyTx),
+		MaxTxBytes: maxTxBytes,
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(emergencyTx, resp.Txs[0])
+	s.Require().LessOrEqual(totalSize(resp.Txs), int64(maxTxBytes))
+	s.Require().Len(resp.Txs, 1+(maxTxBytes-len(emergencyTx))/fillerTxSize)
+}
+
+func (s *ProposalsSuite) TestPrepareProposalSaturatedMempoolWithCommitInfo() {
+	ctx := s.Ctx.
+		WithBlockHeight(3).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+		})
+	emergencyTx := s.emergencyTx("halt")
+	const maxTxBytes = 10 * fillerTxSize
+
+	resp, err := s.newHandler(reapTxs).PrepareProposalHandler()(ctx, &cometabci.RequestPrepareProposal{
+		Txs:             saturatedTxs(50, emergencyTx),
+		MaxTxBytes:      maxTxBytes,
+		LocalLastCommit: cometabci.ExtendedCommitInfo{Round: 1},
+	})
+	s.Require().NoError(err)
+
+	s.Require().True(looksLikeInjectedCommitInfo(resp.Txs[0]))
+	s.Require().Equal(emergencyTx, resp.Txs[1])
+	s.Require().LessOrEqual(totalSize(resp.Txs), int64(maxTxBytes))
+	s.Require().Greater(len(resp.Txs), 2)
+}
+
+func (s *ProposalsSuite) TestPrepareProposalEmergencyLane() {
+	lane := vrfante.NewEmergencyLane()
+	decorator := vrfante.NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.keeper, s.SignModeHandler, lane)
+	emergencyTx := s.emergencyTx("halt")
+	tx, err := s.EncCfg.TxConfig.TxDecoder()(emergencyTx)
+	s.Require().NoError(err)
+	_, err = decorator.AnteHandle(s.Ctx.WithIsCheckTx(true).WithTxBytes(emergencyTx), tx, false, nil)
+	s.Require().NoError(err)
+
+	// The mempool is full of earlier txs, so the reap does not reach the
+	// emergency disable.
+	const maxTxBytes = 10 * fillerTxSize
+	prepare := s.newHandler(reapTxs, WithEmergencyLane(lane)).PrepareProposalHandler()
+	resp, err := prepare(s.Ctx, &cometabci.RequestPrepareProposal{
+		Txs:        saturatedTxs(10),
+		MaxTxBytes: maxTxBytes,
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(emergencyTx, resp.Txs[0])
+	s.Require().Equal(saturatedTxs((maxTxBytes-len(emergencyTx))/fillerTxSize), resp.Txs[1:])
+
+	// Once included, the tx leaves the lane.
+	_, err = decorator.AnteHandle(s.Ctx.WithExecMode(sdk.ExecModeFinalize).WithTxBytes(emergencyTx), tx, false, nil)
+	s.Require().NoError(err)
+	resp, err = prepare(s.Ctx, &cometabci.RequestPrepareProposal{
+		Txs:        saturatedTxs(10),
+		MaxTxBytes: maxTxBytes,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(saturatedTxs(10), resp.Txs)
+}
+
+func (s *ProposalsSuite) TestPrepareProposalPriorityLaneLimits() {
+	// Each signer may have emergency.MaxTxsPerSigner txs in the block.
+	members := []cryptotypes.PrivKey{s.Priv, s.addEmergencyMember()}
+	lane := make([][]byte, emergency.MaxTxsPerBlock)
+	for i := range lane {
+		lane[i] = s.emergencyTxAs(members[i%len(members)], string(rune('a'+i)))
+	}
+	overCap := s.emergencyTxAs(s.addEmergencyMember(), "over cap")
+	replayed := s.emergencyTx("replayed")
+	s.Require().NoError(s.keeper.RecordEmergencyTx(s.Ctx, emergency.TxHash(replayed), nil, 20))
+
+	// An app-side mempool hands the lane txs to the wrapped handler as well.
+	var wrappedReq *cometabci.RequestPrepareProposal
+	prepare := func(_ sdk.Context, req *cometabci.RequestPrepareProposal) (*cometabci.ResponsePrepareProposal, error) {
+		wrappedReq = req
+		return &cometabci.ResponsePrepareProposal{Txs: append([][]byte{lane[0], overCap}, req.Txs...)}, nil
+	}
+
+	txs := append(saturatedTxs(2, replayed), lane...)
+	txs = append(txs, overCap)
+	resp, err := s.newHandler(prepare).PrepareProposalHandler()(s.Ctx, &cometabci.RequestPrepareProposal{
+		Txs:        txs,
+		MaxTxBytes: 100 * fillerTxSize,
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(lane, resp.Txs[:len(lane)])
+	s.Require().Equal(saturatedTxs(2), resp.Txs[len(lane):])
+	s.Require().Equal(int64(100*fillerTxSize)-totalSize(lane), wrappedReq.MaxTxBytes)
+}
+
+func (s *ProposalsSuite) TestPrepareProposalUnauthorizedNotPrioritized() {
+	s.Require().NoError(s.keeper.RemoveCommitteeMember(s.Ctx, s.Addr.String()))
+	emergencyTx := s.emergencyTx("halt")
+
+	resp, err := s.newHandler(reapTxs).PrepareProposalHandler()(s.Ctx, &cometabci.RequestPrepareProposal{
+		Txs:        saturatedTxs(50, emergencyTx),
+		MaxTxBytes: 10 * fillerTxSize,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(saturatedTxs(10), resp.Txs)
+}
+
+func (s *ProposalsSuite) TestProcessProposalEmergencyLimits() {
+	process := s.newHandler(reapTxs).ProcessProposalHandler()
+
+	members := []cryptotypes.PrivKey{s.Priv, s.addEmergencyMember()}
+	txs := make([][]byte, emergency.MaxTxsPerBlock)
+	for i := range txs {
+		txs[i] = s.emergencyTxAs(members[i%len(members)], string(rune('a'+i)))
+	}
+	resp, err := process(s.Ctx, &cometabci.RequestProcessProposal{Txs: txs})
+	s.Require().NoError(err)
+	s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+	for _, tc := range []struct {
+		name string
+		txs  [][]byte
+	}{
+		{name: "over cap", txs: append(append([][]byte{}, txs...), s.emergencyTx("over cap"))},
+		{name: "duplicate", txs: [][]byte{txs[0], txs[0]}},
+		{name: "replayed", txs: [][]byte{s.recorded(txs[1])}},
+		{name: "signer cap", txs: [][]byte{txs[0], txs[2], s.emergencyTx("third")}},
+	} {
+		resp, err := process(s.Ctx, &cometabci.RequestProcessProposal{Txs: tc.txs})
+		s.Require().NoError(err, tc.name)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status, tc.name)
+	}
+}
+
+// recorded records txBytes as included and returns it.
+func (s *ProposalsSuite) recorded(txBytes []byte) []byte {
+	s.Require().NoError(s.keeper.RecordEmergencyTx(s.Ctx, emergency.TxHash(txBytes), nil, 20))
+	return txBytes
+}
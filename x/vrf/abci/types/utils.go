@@ -6,6 +6,7 @@ import (
 	metrics "github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
 )
@@ -65,3 +66,46 @@ func RecordMessageSize(msgType MessageType, size int) {
 		},
 	)
 }
+
+// RecordParticipation emits per-reason and per-validator vote extension
+// participation counters, and the share of validators that contributed to the
+// finalized beacon.
+func RecordParticipation(p vrftypes.VrfParticipation) {
+	if !telemetry.IsTelemetryEnabled() || len(p.Validators) == 0 {
+		return
+	}
+
+	contributed := 0
+	for i, addr := range p.Validators {
+		if i >= len(p.Reasons) {
+			break
+		}
+
+		reason := p.Reasons[i].String()
+		if p.Reasons[i] == vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED {
+			contributed++
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{vrftypes.ModuleName, "participation", "votes_total"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("reason", reason),
+			},
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{vrftypes.ModuleName, "participation", "validator_votes_total"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("validator", sdk.ConsAddress(addr).String()),
+				telemetry.NewLabel("reason", reason),
+			},
+		)
+	}
+
+	telemetry.SetGauge(
+		float32(contributed)/float32(len(p.Validators)),
+		vrftypes.ModuleName, "participation", "contributed_ratio",
+	)
+}
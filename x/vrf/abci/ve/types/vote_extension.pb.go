@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,13 +23,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// VrfVoteExtension carries a single drand beacon for a specific round.
+// VrfVoteExtension carries drand beacons for the rounds a validator considers
+// eligible at a given height.
+//
+// Version 0 extensions carry a single beacon in the top-level fields. Version 1
+// extensions carry up to round_tolerance+1 beacons in `beacons`, ordered from the
+// highest round down, and leave the top-level beacon fields empty. Version 2
+// extensions carry partial signatures in `partials` with the same ordering and
+// are used when VrfParams.partial_threshold is set.
 type VrfVoteExtension struct {
 	DrandRound        uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
 	Randomness        []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
 	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
 	ChainHash         []byte `protobuf:"bytes,5,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// version tags the layout of the extension.
+	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// beacons contains the beacons carried by a version 1 extension.
+	Beacons []VrfVoteExtensionBeacon `protobuf:"bytes,7,rep,name=beacons,proto3" json:"beacons"`
+	// partials contains the partial signatures carried by a version 2 extension.
+	Partials []VrfVoteExtensionPartial `protobuf:"bytes,8,rep,name=partials,proto3" json:"partials"`
+	// ecvrf_proof is the validator's ECVRF-EDWARDS25519-SHA512-TAI proof over
+	// the fallback input of the height, set when VrfParams.ecvrf_fallback is
+	// enabled. It may be the only content of the extension when drand is down.
+	EcvrfProof []byte `protobuf:"bytes,9,opt,name=ecvrf_proof,json=ecvrfProof,proto3" json:"ecvrf_proof,omitempty"`
 }
 
 func (m *VrfVoteExtension) Reset()         { *m = VrfVoteExtension{} }
@@ -99,8 +117,162 @@ func (m *VrfVoteExtension) GetChainHash() []byte {
 	return nil
 }
 
+func (m *VrfVoteExtension) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VrfVoteExtension) GetBeacons() []VrfVoteExtensionBeacon {
+	if m != nil {
+		return m.Beacons
+	}
+	return nil
+}
+
+func (m *VrfVoteExtension) GetPartials() []VrfVoteExtensionPartial {
+	if m != nil {
+		return m.Partials
+	}
+	return nil
+}
+
+func (m *VrfVoteExtension) GetEcvrfProof() []byte {
+	if m != nil {
+		return m.EcvrfProof
+	}
+	return nil
+}
+
+// VrfVoteExtensionBeacon is a single drand beacon carried in a vote extension.
+type VrfVoteExtensionBeacon struct {
+	DrandRound        uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	Randomness        []byte `protobuf:"bytes,2,opt,name=randomness,proto3" json:"randomness,omitempty"`
+	Signature         []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+}
+
+func (m *VrfVoteExtensionBeacon) Reset()         { *m = VrfVoteExtensionBeacon{} }
+func (m *VrfVoteExtensionBeacon) String() string { return proto.CompactTextString(m) }
+func (*VrfVoteExtensionBeacon) ProtoMessage()    {}
+func (*VrfVoteExtensionBeacon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_197daafdbb041096, []int{1}
+}
+func (m *VrfVoteExtensionBeacon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfVoteExtensionBeacon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfVoteExtensionBeacon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VrfVoteExtensionBeacon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfVoteExtensionBeacon.Merge(m, src)
+}
+func (m *VrfVoteExtensionBeacon) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfVoteExtensionBeacon) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfVoteExtensionBeacon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfVoteExtensionBeacon proto.InternalMessageInfo
+
+func (m *VrfVoteExtensionBeacon) GetDrandRound() uint64 {
+	if m != nil {
+		return m.DrandRound
+	}
+	return 0
+}
+
+func (m *VrfVoteExtensionBeacon) GetRandomness() []byte {
+	if m != nil {
+		return m.Randomness
+	}
+	return nil
+}
+
+func (m *VrfVoteExtensionBeacon) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *VrfVoteExtensionBeacon) GetPreviousSignature() []byte {
+	if m != nil {
+		return m.PreviousSignature
+	}
+	return nil
+}
+
+// VrfVoteExtensionPartial is a validator's threshold-BLS signature share for a
+// drand round.
+type VrfVoteExtensionPartial struct {
+	DrandRound uint64 `protobuf:"varint,1,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// partial_signature is prefixed with the big-endian uint16 share index.
+	PartialSignature []byte `protobuf:"bytes,2,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (m *VrfVoteExtensionPartial) Reset()         { *m = VrfVoteExtensionPartial{} }
+func (m *VrfVoteExtensionPartial) String() string { return proto.CompactTextString(m) }
+func (*VrfVoteExtensionPartial) ProtoMessage()    {}
+func (*VrfVoteExtensionPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_197daafdbb041096, []int{2}
+}
+func (m *VrfVoteExtensionPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfVoteExtensionPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfVoteExtensionPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VrfVoteExtensionPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfVoteExtensionPartial.Merge(m, src)
+}
+func (m *VrfVoteExtensionPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfVoteExtensionPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfVoteExtensionPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfVoteExtensionPartial proto.InternalMessageInfo
+
+func (m *VrfVoteExtensionPartial) GetDrandRound() uint64 {
+	if m != nil {
+		return m.DrandRound
+	}
+	return 0
+}
+
+func (m *VrfVoteExtensionPartial) GetPartialSignature() []byte {
+	if m != nil {
+		return m.PartialSignature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VrfVoteExtension)(nil), "digitalkitchen.vrf.abci.v1.VrfVoteExtension")
+	proto.RegisterType((*VrfVoteExtensionBeacon)(nil), "digitalkitchen.vrf.abci.v1.VrfVoteExtensionBeacon")
+	proto.RegisterType((*VrfVoteExtensionPartial)(nil), "digitalkitchen.vrf.abci.v1.VrfVoteExtensionPartial")
 }
 
 func init() {
@@ -108,24 +280,33 @@ func init() {
 }
 
 var fileDescriptor_197daafdbb041096 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0xff, 0x2f, 0x48, 0x18, 0x06, 0xf0, 0x14, 0x21, 0x30, 0x15, 0x53, 0x17, 0x6c,
-	0x55, 0xbc, 0x01, 0x12, 0x52, 0xe7, 0x20, 0x75, 0x60, 0x89, 0x9c, 0xc4, 0x89, 0x2d, 0xa8, 0x1d,
-	0xd9, 0x37, 0x56, 0x79, 0x0b, 0xde, 0x88, 0x95, 0xb1, 0x23, 0x23, 0x4a, 0x5e, 0x04, 0xc5, 0xa8,
-	0xb4, 0x1d, 0xef, 0x77, 0xce, 0xb9, 0xc3, 0x87, 0x79, 0xa5, 0x1b, 0x0d, 0xe2, 0xf5, 0x45, 0x43,
-	0xa9, 0xa4, 0xe1, 0xc1, 0xd5, 0x5c, 0x14, 0xa5, 0xe6, 0x61, 0xce, 0x83, 0x05, 0x99, 0xcb, 0x35,
-	0x48, 0xe3, 0xb5, 0x35, 0xac, 0x75, 0x16, 0x2c, 0xb9, 0x3c, 0x1c, 0xb0, 0xe0, 0x6a, 0x36, 0x0e,
-	0x58, 0x98, 0xdf, 0x7e, 0x20, 0x7c, 0xbe, 0x74, 0xf5, 0xd2, 0x82, 0x7c, 0xdc, 0xce, 0xc8, 0x0d,
-	0x3e, 0xad, 0x9c, 0x30, 0x55, 0xee, 0x6c, 0x67, 0xaa, 0x14, 0x4d, 0xd1, 0x6c, 0x92, 0xe1, 0x88,
-	0xb2, 0x91, 0x10, 0x8a, 0xf1, 0x78, 0xd8, 0x95, 0x91, 0xde, 0xa7, 0xff, 0xa6, 0x68, 0x76, 0x96,
-	0xed, 0x11, 0x72, 0x85, 0x4f, 0xbc, 0x6e, 0x8c, 0x80, 0xce, 0xc9, 0xf4, 0x7f, 0x8c, 0x77, 0x80,
-	0xdc, 0x61, 0xd2, 0x3a, 0x19, 0xb4, 0xed, 0x7c, 0xbe, 0xab, 0x4d, 0x62, 0xed, 0x62, 0x9b, 0x3c,
-	0xfd, 0xd5, 0xaf, 0x31, 0x2e, 0x95, 0xd0, 0x26, 0x57, 0xc2, 0xab, 0xf4, 0xe8, 0xf7, 0x5b, 0x24,
-	0x0b, 0xe1, 0xd5, 0xc3, 0xe2, 0xb3, 0xa7, 0x68, 0xd3, 0x53, 0xf4, 0xdd, 0x53, 0xf4, 0x3e, 0xd0,
-	0x64, 0x33, 0xd0, 0xe4, 0x6b, 0xa0, 0xc9, 0x33, 0x6b, 0x34, 0xa8, 0xae, 0x60, 0xa5, 0x5d, 0xf1,
-	0xaa, 0x81, 0x03, 0x61, 0xeb, 0x3d, 0x6d, 0x92, 0xc3, 0x5b, 0x2b, 0x7d, 0x71, 0x1c, 0x75, 0xdd,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xef, 0x9f, 0x93, 0xde, 0x61, 0x01, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0x69, 0x59, 0xb7, 0x6f, 0x20, 0x6d, 0x16, 0x02, 0x6b, 0x82, 0xac, 0xea, 0xa9, 0x12,
+	0xc2, 0xd6, 0xb6, 0x7f, 0x50, 0x09, 0x69, 0xc7, 0x29, 0x88, 0x1d, 0xb8, 0x44, 0x4e, 0xe2, 0x24,
+	0x16, 0x9b, 0x1d, 0xd9, 0x8e, 0x35, 0xfe, 0x05, 0x7f, 0x02, 0x7e, 0xcb, 0x8e, 0x3b, 0x72, 0x42,
+	0xa8, 0xfd, 0x23, 0x28, 0x4e, 0xd3, 0x75, 0x88, 0x0a, 0x71, 0xdb, 0x2d, 0x7e, 0xdf, 0x7b, 0xcf,
+	0x2f, 0xcf, 0xfa, 0x80, 0xe5, 0xb2, 0x94, 0x8e, 0x5f, 0x7d, 0x96, 0x2e, 0xab, 0x84, 0x62, 0xde,
+	0x14, 0x8c, 0xa7, 0x99, 0x64, 0xfe, 0x84, 0x79, 0xed, 0x44, 0x22, 0x6e, 0x9c, 0x50, 0x56, 0x6a,
+	0x45, 0x6b, 0xa3, 0x9d, 0xc6, 0x47, 0x0f, 0x05, 0xd4, 0x9b, 0x82, 0xb6, 0x02, 0xea, 0x4f, 0x8e,
+	0x5e, 0x94, 0xba, 0xd4, 0x81, 0xc6, 0xda, 0xaf, 0x4e, 0x31, 0xfd, 0x36, 0x84, 0x83, 0x4b, 0x53,
+	0x5c, 0x6a, 0x27, 0xde, 0xf7, 0x66, 0xf8, 0x18, 0xf6, 0x73, 0xc3, 0x55, 0x9e, 0x18, 0xdd, 0xa8,
+	0x9c, 0xa0, 0x09, 0x9a, 0x8d, 0x62, 0x08, 0x50, 0xdc, 0x22, 0x38, 0x02, 0x68, 0x0f, 0xfa, 0x5a,
+	0x09, 0x6b, 0xc9, 0x93, 0x09, 0x9a, 0x3d, 0x8b, 0x37, 0x10, 0xfc, 0x1a, 0xf6, 0xac, 0x2c, 0x15,
+	0x77, 0x8d, 0x11, 0x64, 0x18, 0xc6, 0xf7, 0x00, 0x7e, 0x07, 0xb8, 0x36, 0xc2, 0x4b, 0xdd, 0xd8,
+	0xe4, 0x9e, 0x36, 0x0a, 0xb4, 0xc3, 0x7e, 0xf2, 0x61, 0x4d, 0x7f, 0x03, 0x90, 0x55, 0x5c, 0xaa,
+	0xa4, 0xe2, 0xb6, 0x22, 0x4f, 0x3b, 0xb7, 0x80, 0x9c, 0x73, 0x5b, 0x61, 0x02, 0x63, 0x2f, 0x4c,
+	0x9b, 0x9b, 0xec, 0x4c, 0xd0, 0xec, 0x79, 0xdc, 0x1f, 0x71, 0x0c, 0xe3, 0x54, 0xf0, 0x4c, 0x2b,
+	0x4b, 0xc6, 0x93, 0xe1, 0x6c, 0xff, 0xf4, 0x94, 0x6e, 0xef, 0x87, 0xfe, 0xd9, 0xc2, 0x3c, 0x48,
+	0xe7, 0xa3, 0xdb, 0x9f, 0xc7, 0x83, 0xb8, 0x37, 0xc2, 0x1f, 0x61, 0xb7, 0xe6, 0xc6, 0x49, 0x7e,
+	0x65, 0xc9, 0x6e, 0x30, 0x3d, 0xfb, 0x1f, 0xd3, 0x8b, 0x4e, 0xbb, 0x72, 0x5d, 0x5b, 0xb5, 0x8d,
+	0x8b, 0xcc, 0x9b, 0x22, 0xa9, 0x8d, 0xd6, 0x05, 0xd9, 0xeb, 0x1a, 0x0d, 0xd0, 0x45, 0x8b, 0x4c,
+	0xbf, 0x23, 0x78, 0xf9, 0xf7, 0x84, 0x8f, 0xeb, 0xb5, 0xa6, 0x25, 0xbc, 0xda, 0xf2, 0xd3, 0xff,
+	0x0e, 0xfa, 0x16, 0x0e, 0x57, 0x8d, 0x6c, 0xdc, 0xd4, 0xe5, 0x3d, 0x58, 0x0d, 0xd6, 0x17, 0xcd,
+	0xcf, 0x6f, 0x17, 0x11, 0xba, 0x5b, 0x44, 0xe8, 0xd7, 0x22, 0x42, 0x5f, 0x97, 0xd1, 0xe0, 0x6e,
+	0x19, 0x0d, 0x7e, 0x2c, 0xa3, 0xc1, 0x27, 0x5a, 0x4a, 0x57, 0x35, 0x29, 0xcd, 0xf4, 0x35, 0xcb,
+	0x4b, 0xf7, 0x60, 0x7d, 0x6e, 0x36, 0x96, 0x48, 0x30, 0xf7, 0xa5, 0x16, 0x36, 0xdd, 0x09, 0xab,
+	0x70, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x2a, 0x54, 0xd9, 0x6f, 0x03, 0x00, 0x00,
 }
 
 func (m *VrfVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -148,6 +329,46 @@ func (m *VrfVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EcvrfProof) > 0 {
+		i -= len(m.EcvrfProof)
+		copy(dAtA[i:], m.EcvrfProof)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.EcvrfProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Partials) > 0 {
+		for iNdEx := len(m.Partials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Beacons) > 0 {
+		for iNdEx := len(m.Beacons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Beacons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ChainHash) > 0 {
 		i -= len(m.ChainHash)
 		copy(dAtA[i:], m.ChainHash)
@@ -184,6 +405,90 @@ func (m *VrfVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VrfVoteExtensionBeacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfVoteExtensionBeacon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfVoteExtensionBeacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousSignature) > 0 {
+		i -= len(m.PreviousSignature)
+		copy(dAtA[i:], m.PreviousSignature)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.PreviousSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Randomness) > 0 {
+		i -= len(m.Randomness)
+		copy(dAtA[i:], m.Randomness)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Randomness)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfVoteExtensionPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfVoteExtensionPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfVoteExtensionPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PartialSignature) > 0 {
+		i -= len(m.PartialSignature)
+		copy(dAtA[i:], m.PartialSignature)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.PartialSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoteExtension(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoteExtension(v)
 	base := offset
@@ -220,6 +525,65 @@ func (m *VrfVoteExtension) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVoteExtension(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Version))
+	}
+	if len(m.Beacons) > 0 {
+		for _, e := range m.Beacons {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	if len(m.Partials) > 0 {
+		for _, e := range m.Partials {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	l = len(m.EcvrfProof)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *VrfVoteExtensionBeacon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrandRound != 0 {
+		n += 1 + sovVoteExtension(uint64(m.DrandRound))
+	}
+	l = len(m.Randomness)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	l = len(m.PreviousSignature)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *VrfVoteExtensionPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrandRound != 0 {
+		n += 1 + sovVoteExtension(uint64(m.DrandRound))
+	}
+	l = len(m.PartialSignature)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
 	return n
 }
 
@@ -413,6 +777,401 @@ func (m *VrfVoteExtension) Unmarshal(dAtA []byte) error {
 				m.ChainHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beacons = append(m.Beacons, VrfVoteExtensionBeacon{})
+			if err := m.Beacons[len(m.Beacons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partials = append(m.Partials, VrfVoteExtensionPartial{})
+			if err := m.Partials[len(m.Partials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcvrfProof = append(m.EcvrfProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.EcvrfProof == nil {
+				m.EcvrfProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfVoteExtensionBeacon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfVoteExtensionBeacon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfVoteExtensionBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randomness = append(m.Randomness[:0], dAtA[iNdEx:postIndex]...)
+			if m.Randomness == nil {
+				m.Randomness = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousSignature = append(m.PreviousSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousSignature == nil {
+				m.PreviousSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfVoteExtensionPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfVoteExtensionPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfVoteExtensionPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialSignature = append(m.PartialSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartialSignature == nil {
+				m.PartialSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtension(dAtA[iNdEx:])
@@ -1,9 +1,12 @@
 package ve
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"errors"
+	"fmt"
 	"time"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
@@ -22,9 +25,33 @@ import (
 )
 
 var (
-	errInvalidVoteExtensionFields     = errors.New("vrf: invalid vote extension fields")
-	errVoteExtensionChainHashMismatch = errors.New("vrf: chain hash mismatch in vote extension")
-	errVoteExtensionHashMismatch      = errors.New("vrf: randomness != SHA256(signature)")
+	errInvalidVoteExtensionFields      = errors.New("vrf: invalid vote extension fields")
+	errVoteExtensionChainHashMismatch  = errors.New("vrf: chain hash mismatch in vote extension")
+	errVoteExtensionHashMismatch       = errors.New("vrf: randomness != SHA256(signature)")
+	errUnsupportedVoteExtensionVersion = errors.New("vrf: unsupported vote extension version")
+	errTooManyVoteExtensionBeacons     = errors.New("vrf: too many beacons in vote extension")
+	errVoteExtensionRoundsNotOrdered   = errors.New("vrf: vote extension beacons must have strictly decreasing rounds")
+	errVoteExtensionModeMismatch       = errors.New("vrf: vote extension version does not match the beacon mode")
+	errInvalidPartialSignatureLength   = errors.New("vrf: invalid partial signature length")
+	errInvalidECVRFProofLength         = errors.New("vrf: invalid ECVRF proof length")
+	errUnexpectedECVRFProof            = errors.New("vrf: ECVRF proof present but ecvrf_fallback is disabled")
+)
+
+const (
+	// VoteExtensionVersionSingle extensions carry one beacon in the top-level
+	// VrfVoteExtension fields.
+	VoteExtensionVersionSingle uint32 = 0
+	// VoteExtensionVersionMulti extensions carry beacons for every eligible
+	// round in VrfVoteExtension.Beacons, ordered from the highest round down.
+	VoteExtensionVersionMulti uint32 = 1
+	// VoteExtensionVersionPartial extensions carry the validator's partial
+	// signatures for every eligible round in VrfVoteExtension.Partials,
+	// ordered from the highest round down.
+	VoteExtensionVersionPartial uint32 = 2
+
+	// partialIndexLength is the size of the share index prefix of a partial
+	// signature.
+	partialIndexLength = 2
 )
 
 func EncodeVrfVoteExtension(ve vetypes.VrfVoteExtension) ([]byte, error) {
@@ -40,6 +67,157 @@ func DecodeVrfVoteExtension(bz []byte) (vetypes.VrfVoteExtension, error) {
 	return ve, proto.Unmarshal(bz, &ve)
 }
 
+// ExtensionBeacons returns the beacons carried by ve regardless of its version.
+func ExtensionBeacons(ve vetypes.VrfVoteExtension) ([]vetypes.VrfVoteExtensionBeacon, error) {
+	switch ve.Version {
+	case VoteExtensionVersionSingle:
+		return []vetypes.VrfVoteExtensionBeacon{{
+			DrandRound:        ve.DrandRound,
+			Randomness:        ve.Randomness,
+			Signature:         ve.Signature,
+			PreviousSignature: ve.PreviousSignature,
+		}}, nil
+	case VoteExtensionVersionMulti:
+		return ve.Beacons, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnsupportedVoteExtensionVersion, ve.Version)
+	}
+}
+
+// ValidateExtensionBeacons performs the stateless checks on the beacons of a
+// vote extension: field presence, strictly decreasing rounds, at most
+// round_tolerance+1 entries and randomness == SHA256(signature).
+func ValidateExtensionBeacons(params vrftypes.VrfParams, ve vetypes.VrfVoteExtension) error {
+	beacons, err := ExtensionBeacons(ve)
+	if err != nil {
+		return err
+	}
+
+	if len(beacons) == 0 {
+		return errInvalidVoteExtensionFields
+	}
+
+	if uint64(len(beacons)) > params.RoundTolerance+1 {
+		return fmt.Errorf("%w: got %d, max %d", errTooManyVoteExtensionBeacons, len(beacons), params.RoundTolerance+1)
+	}
+
+	for i, b := range beacons {
+		if b.DrandRound == 0 || len(b.Randomness) == 0 || len(b.Signature) == 0 {
+			return errInvalidVoteExtensionFields
+		}
+
+		if i > 0 && b.DrandRound >= beacons[i-1].DrandRound {
+			return errVoteExtensionRoundsNotOrdered
+		}
+
+		sigHash := sha256.Sum256(b.Signature)
+		if !bytes.Equal(sigHash[:], b.Randomness) {
+			return fmt.Errorf("%w: round %d", errVoteExtensionHashMismatch, b.DrandRound)
+		}
+	}
+
+	return nil
+}
+
+// ValidateExtensionPartials performs the stateless checks on the partial
+// signatures of a version 2 vote extension: field presence, signature length,
+// strictly decreasing rounds and at most round_tolerance+1 entries.
+func ValidateExtensionPartials(params vrftypes.VrfParams, ve vetypes.VrfVoteExtension) error {
+	if ve.Version != VoteExtensionVersionPartial {
+		return fmt.Errorf("%w: got version %d", errVoteExtensionModeMismatch, ve.Version)
+	}
+
+	if len(ve.Partials) == 0 {
+		return errInvalidVoteExtensionFields
+	}
+
+	if uint64(len(ve.Partials)) > params.RoundTolerance+1 {
+		return fmt.Errorf("%w: got %d, max %d", errTooManyVoteExtensionBeacons, len(ve.Partials), params.RoundTolerance+1)
+	}
+
+	scheme, err := params.Scheme()
+	if err != nil {
+		return err
+	}
+	sigLen := partialIndexLength + scheme.SigGroup.PointLen()
+
+	for i, p := range ve.Partials {
+		if p.DrandRound == 0 {
+			return errInvalidVoteExtensionFields
+		}
+
+		if len(p.PartialSignature) != sigLen {
+			return fmt.Errorf("%w: round %d: got %d, expected %d", errInvalidPartialSignatureLength, p.DrandRound, len(p.PartialSignature), sigLen)
+		}
+
+		if i > 0 && p.DrandRound >= ve.Partials[i-1].DrandRound {
+			return errVoteExtensionRoundsNotOrdered
+		}
+	}
+
+	return nil
+}
+
+// ValidateExtensionECVRF checks the presence and length of the ECVRF
+// fallback proof. It reports whether the extension carries only that proof,
+// which validators send when drand is unavailable.
+func ValidateExtensionECVRF(params vrftypes.VrfParams, ve vetypes.VrfVoteExtension) (proofOnly bool, err error) {
+	if len(ve.EcvrfProof) == 0 {
+		return false, nil
+	}
+
+	if !params.EcvrfFallback {
+		return false, errUnexpectedECVRFProof
+	}
+
+	if len(ve.EcvrfProof) != vrftypes.ECVRFProofLength {
+		return false, fmt.Errorf("%w: got %d, expected %d", errInvalidECVRFProofLength, len(ve.EcvrfProof), vrftypes.ECVRFProofLength)
+	}
+
+	proofOnly = len(ve.Beacons) == 0 && len(ve.Partials) == 0 && ve.DrandRound == 0
+	return proofOnly, nil
+}
+
+// validateExtension dispatches to the checks of the beacon mode selected by
+// params.
+func validateExtension(params vrftypes.VrfParams, ve vetypes.VrfVoteExtension) error {
+	if ve.Version > VoteExtensionVersionPartial {
+		return fmt.Errorf("%w: %d", errUnsupportedVoteExtensionVersion, ve.Version)
+	}
+
+	if params.ThresholdMode() != (ve.Version == VoteExtensionVersionPartial) {
+		return fmt.Errorf("%w: got version %d", errVoteExtensionModeMismatch, ve.Version)
+	}
+
+	proofOnly, err := ValidateExtensionECVRF(params, ve)
+	if err != nil || proofOnly {
+		return err
+	}
+
+	if params.ThresholdMode() {
+		return ValidateExtensionPartials(params, ve)
+	}
+
+	return ValidateExtensionBeacons(params, ve)
+}
+
+// highestRound returns the highest round carried by a validated extension, or
+// zero for an extension carrying only an ECVRF proof.
+func highestRound(ve vetypes.VrfVoteExtension) uint64 {
+	if ve.Version == VoteExtensionVersionPartial {
+		if len(ve.Partials) == 0 {
+			return 0
+		}
+		return ve.Partials[0].DrandRound
+	}
+
+	beacons, _ := ExtensionBeacons(ve)
+	if len(beacons) == 0 {
+		return 0
+	}
+	return beacons[0].DrandRound
+}
+
 // Handler wires the sidecar client and x/vrf keeper into ABCI++ vote extension
 // handlers.
 type Handler struct {
@@ -47,6 +225,10 @@ type Handler struct {
 	client  vrfclient.Client
 	keeper  *vrfkeeper.Keeper
 	timeout time.Duration
+
+	// fallbackKey is the validator's ed25519 ECVRF key, used to add ECVRF
+	// fallback proofs to vote extensions. Nil disables the proofs.
+	fallbackKey ed25519.PrivateKey
 }
 
 func NewHandler(
@@ -63,8 +245,16 @@ func NewHandler(
 	}
 }
 
-// ExtendVoteHandler implements the logic for fetching a drand
-// beacon for target_round(H) from the sidecar and including it in the vote extension.
+// SetFallbackKey sets the ECVRF key used to sign ECVRF fallback proofs
+// when VrfParams.ecvrf_fallback is enabled.
+func (h *Handler) SetFallbackKey(key ed25519.PrivateKey) {
+	h.fallbackKey = key
+}
+
+// ExtendVoteHandler implements the logic for fetching the drand beacons for
+// target_round(H) and the rounds below it allowed by round_tolerance from the
+// sidecar and including them in the vote extension. In threshold mode the
+// extension carries the local partial signatures for those rounds instead.
 func (h *Handler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 	return func(ctx sdk.Context, req *cometabci.RequestExtendVote) (resp *cometabci.ResponseExtendVote, err error) {
 		if req == nil {
@@ -72,7 +262,9 @@ func (h *Handler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
 		}
 
-		params, err := h.keeper.GetParams(ctx)
+		// The extension is aggregated by PreBlock at req.Height+1, so build it
+		// under the params that govern that height.
+		params, err := h.keeper.ParamsAt(ctx, req.Height+1)
 		if err != nil {
 			h.logger.Error("vrf: failed to load params; returning empty extension", "err", err)
 			return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
@@ -106,39 +298,117 @@ func (h *Handler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
 		}
 
-		// Call the sidecar for the specific round.
-		reqCtx, cancel := context.WithTimeout(ctx, h.timeout)
-		defer cancel()
+		// Call the sidecar for every eligible round, highest first, so PreBlock
+		// can still reach quorum on target-1 when validators disagree about the
+		// target round near a period boundary. Each call gets its own timeout,
+		// so a slow target round does not starve the rounds below it.
+		ve := vetypes.VrfVoteExtension{
+			ChainHash: params.ChainHash,
+			Version:   VoteExtensionVersionMulti,
+		}
+		rounds := vrftypes.EligibleRounds(params, targetRound)
+		if params.ThresholdMode() {
+			ve.Version = VoteExtensionVersionPartial
+			h.appendPartials(ctx, req.Height, targetRound, rounds, &ve)
+		} else {
+			h.appendBeacons(ctx, req.Height, targetRound, rounds, &ve)
+		}
 
-		res, err := h.client.Randomness(
-			ctx.WithContext(reqCtx),
-			&sidecarv1.QueryRandomnessRequest{Round: targetRound},
-		)
-		if err != nil || res == nil {
-			h.logger.Warn("vrf: failed to fetch randomness; returning empty vote extension",
+		if params.EcvrfFallback && h.fallbackKey != nil {
+			h.appendECVRFProof(ctx, req.Height, &ve)
+		}
+
+		if len(ve.Beacons) == 0 && len(ve.Partials) == 0 {
+			if len(ve.EcvrfProof) == 0 {
+				h.logger.Warn("vrf: no eligible round available; returning empty vote extension",
+					"height", req.Height,
+					"target_round", targetRound,
+				)
+				return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
+			}
+
+			h.logger.Warn("vrf: no eligible round available; sending only the ECVRF fallback proof",
 				"height", req.Height,
-				"round", targetRound,
-				"err", err,
+				"target_round", targetRound,
 			)
+		}
+
+		bz, err := EncodeVrfVoteExtension(ve)
+		if err != nil {
+			h.logger.Error("vrf: failed to encode vote extension; returning empty", "err", err)
 			return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
 		}
 
-		ve := vetypes.VrfVoteExtension{
+		return &cometabci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// appendBeacons adds the full drand beacon of every round the sidecar can
+// serve to ve.
+func (h *Handler) appendBeacons(ctx sdk.Context, height int64, targetRound uint64, rounds []uint64, ve *vetypes.VrfVoteExtension) {
+	for _, round := range rounds {
+		callCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		res, err := h.client.Randomness(callCtx, &sidecarv1.QueryRandomnessRequest{Round: round})
+		cancel()
+		if err != nil || res == nil {
+			h.logger.Warn("vrf: failed to fetch randomness; omitting round from vote extension",
+				"height", height,
+				"round", round,
+				"target_round", targetRound,
+				"err", err,
+			)
+			continue
+		}
+
+		ve.Beacons = append(ve.Beacons, vetypes.VrfVoteExtensionBeacon{
 			DrandRound:        res.DrandRound,
 			Randomness:        res.Randomness,
 			Signature:         res.Signature,
 			PreviousSignature: res.PreviousSignature,
-			ChainHash:         params.ChainHash,
-		}
+		})
+	}
+}
 
-		bz, err := EncodeVrfVoteExtension(ve)
-		if err != nil {
-			h.logger.Error("vrf: failed to encode vote extension; returning empty", "err", err)
-			return &cometabci.ResponseExtendVote{VoteExtension: nil}, nil
+// appendPartials adds the local partial signature of every round the sidecar
+// can sign to ve.
+func (h *Handler) appendPartials(ctx sdk.Context, height int64, targetRound uint64, rounds []uint64, ve *vetypes.VrfVoteExtension) {
+	for _, round := range rounds {
+		callCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		res, err := h.client.PartialSignature(callCtx, &sidecarv1.QueryPartialSignatureRequest{Round: round})
+		cancel()
+		if err != nil || res == nil {
+			h.logger.Warn("vrf: failed to fetch partial signature; omitting round from vote extension",
+				"height", height,
+				"round", round,
+				"target_round", targetRound,
+				"err", err,
+			)
+			continue
 		}
 
-		return &cometabci.ResponseExtendVote{VoteExtension: bz}, nil
+		ve.Partials = append(ve.Partials, vetypes.VrfVoteExtensionPartial{
+			DrandRound:       res.DrandRound,
+			PartialSignature: res.PartialSignature,
+		})
+	}
+}
+
+// appendECVRFProof adds the ECVRF fallback proof for height to ve. Failures
+// only omit the proof.
+func (h *Handler) appendECVRFProof(ctx sdk.Context, height int64, ve *vetypes.VrfVoteExtension) {
+	seed, err := h.keeper.FallbackSeed(ctx)
+	if err != nil {
+		h.logger.Error("vrf: failed to load ECVRF fallback seed; omitting proof", "height", height, "err", err)
+		return
+	}
+
+	proof, err := vrftypes.ECVRFProve(h.fallbackKey, vrftypes.ECVRFFallbackInput(height, seed))
+	if err != nil {
+		h.logger.Error("vrf: failed to compute ECVRF fallback proof; omitting proof", "height", height, "err", err)
+		return
 	}
+
+	ve.EcvrfProof = proof
 }
 
 // VerifyVoteExtensionHandler implements the deterministic checks:
@@ -151,7 +421,7 @@ func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, nil
 		}
 
-		params, err := h.keeper.GetParams(ctx)
+		params, err := h.keeper.ParamsAt(ctx, req.Height+1)
 		if err != nil {
 			h.logger.Error("vrf: failed to load params in VerifyVoteExtension; rejecting", "err", err)
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, nil
@@ -182,13 +452,6 @@ func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, nil
 		}
 
-		// Basic validity.
-		if ve.DrandRound == 0 || len(ve.Randomness) == 0 || len(ve.Signature) == 0 {
-			err = errInvalidVoteExtensionFields
-			h.logger.Error("vrf: invalid vote extension fields", "height", req.Height)
-			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, nil
-		}
-
 		// Chain hash check, if present.
 		if len(ve.ChainHash) > 0 && len(params.ChainHash) > 0 {
 			if string(ve.ChainHash) != string(params.ChainHash) {
@@ -198,18 +461,17 @@ func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 			}
 		}
 
-		// Cheap SHA256(signature) == randomness filter.
-		sigHash := sha256.Sum256(ve.Signature)
-		if string(sigHash[:]) != string(ve.Randomness) {
-			err = errVoteExtensionHashMismatch
-			h.logger.Error("vrf: hash mismatch in vote extension", "height", req.Height)
+		// Basic validity and, for full beacons, the cheap
+		// SHA256(signature) == randomness filter for every carried beacon.
+		if err := validateExtension(params, ve); err != nil {
+			h.logger.Error("vrf: invalid vote extension", "height", req.Height, "err", err)
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, nil
 		}
 
 		// Deterministic sanity check: compare the provided round against the
 		// target round derived from the last finalized block time. Do not
 		// reject here to avoid liveness regressions; PreBlock enforces the
-		// strict target-round policy.
+		// round_tolerance window.
 		if lastTS, tsErr := h.keeper.GetPrevBlockTime(ctx); tsErr == nil {
 			if lastTS == 0 {
 				lastTS, _ = h.keeper.GetLastBlockTime(ctx)
@@ -218,12 +480,12 @@ func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 			teff := tref.Add(-time.Duration(params.SafetyMarginSeconds) * time.Second)
 			targetRound := vrftypes.RoundAt(params, teff)
 
-			if targetRound > 0 && ve.DrandRound != targetRound {
+			if round := highestRound(ve); targetRound > 0 && round != targetRound {
 				h.logger.Info(
 					"vrf: vote extension round does not match target round",
 					"height", req.Height,
 					"target_round", targetRound,
-					"extension_round", ve.DrandRound,
+					"extension_round", round,
 				)
 			}
 		}
@@ -1,16 +1,22 @@
 package ve
 
 import (
+	"context"
+	"crypto/sha256"
 	"testing"
 	"time"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/drand/drand/v2/crypto"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
+	sidecarv1 "github.com/dgtlkitchen/vrf/api/digitalkitchen/sidecar/v1"
+	vetypes "github.com/dgtlkitchen/vrf/x/vrf/abci/ve/types"
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 	vrfclient "github.com/dgtlkitchen/vrf/x/vrf/sidecar"
 	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
@@ -28,16 +34,59 @@ func TestVoteExtensionHandlerSuite(t *testing.T) {
 	suite.Run(t, new(VoteExtensionHandlerSuite))
 }
 
+// slowRoundClient serves every round but slowRound, on which it blocks until
+// the call's deadline.
+type slowRoundClient struct {
+	vrfclient.NoOpClient
+	slowRound uint64
+}
+
+func (c slowRoundClient) Randomness(
+	ctx context.Context,
+	in *sidecarv1.QueryRandomnessRequest,
+	_ ...grpc.CallOption,
+) (*sidecarv1.QueryRandomnessResponse, error) {
+	if in.Round == c.slowRound {
+		<-ctx.Done()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sig := []byte{byte(in.Round)}
+	randomness := sha256.Sum256(sig)
+	return &sidecarv1.QueryRandomnessResponse{DrandRound: in.Round, Randomness: randomness[:], Signature: sig}, nil
+}
+
 func (s *VoteExtensionHandlerSuite) SetupTest() {
 	s.VrfTestSuite.SetupTest()
 
-	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority)
+	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
 	s.Require().NoError(k.SetParams(s.Ctx, vrftypes.DefaultParams()))
 
 	s.keeper = k
 	s.handler = NewHandler(log.NewNopLogger(), vrfclient.NoOpClient{}, &s.keeper, time.Second)
 }
 
+func (s *VoteExtensionHandlerSuite) TestExtendVote_SlowRoundDoesNotStarveLowerRounds() {
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	params.RoundTolerance = 1
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, params))
+
+	// Round 33 is the target at 1000s after the drand genesis.
+	ctx := s.Ctx.WithBlockTime(time.Unix(1000, 0))
+	handler := NewHandler(log.NewNopLogger(), slowRoundClient{slowRound: 33}, &s.keeper, 50*time.Millisecond)
+
+	resp, err := handler.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{Height: 10})
+	s.Require().NoError(err)
+
+	ext, err := DecodeVrfVoteExtension(resp.VoteExtension)
+	s.Require().NoError(err)
+	s.Require().Len(ext.Beacons, 1)
+	s.Require().Equal(uint64(32), ext.Beacons[0].DrandRound)
+}
+
 func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_EmptyAcceptedWhenDisabled() {
 	resp, err := s.handler.VerifyVoteExtensionHandler()(s.Ctx, &cometabci.RequestVerifyVoteExtension{
 		Height:        10,
@@ -59,3 +108,163 @@ func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_EmptyRejectedWhenEna
 	s.Require().NoError(err)
 	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, resp.Status)
 }
+
+func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_UsesParamsOfNextHeight() {
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.keeper.SetPendingParams(s.Ctx, vrftypes.VrfPendingParams{Params: params, ActivationHeight: 11}))
+
+	verify := func(height int64) cometabci.ResponseVerifyVoteExtension_VerifyStatus {
+		resp, err := s.handler.VerifyVoteExtensionHandler()(s.Ctx, &cometabci.RequestVerifyVoteExtension{
+			Height:        height,
+			VoteExtension: nil,
+		})
+		s.Require().NoError(err)
+		return resp.Status
+	}
+
+	// Extensions of height 10 are aggregated at 11, where VRF is enabled.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(9))
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(10))
+}
+
+func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_MultiBeacon() {
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	params.RoundTolerance = 1
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, params))
+
+	beacon := func(round uint64) vetypes.VrfVoteExtensionBeacon {
+		sig := []byte{byte(round)}
+		randomness := sha256.Sum256(sig)
+		return vetypes.VrfVoteExtensionBeacon{DrandRound: round, Randomness: randomness[:], Signature: sig}
+	}
+
+	verify := func(ext vetypes.VrfVoteExtension) cometabci.ResponseVerifyVoteExtension_VerifyStatus {
+		bz, err := EncodeVrfVoteExtension(ext)
+		s.Require().NoError(err)
+
+		resp, err := s.handler.VerifyVoteExtensionHandler()(s.Ctx, &cometabci.RequestVerifyVoteExtension{
+			Height:        10,
+			VoteExtension: bz,
+		})
+		s.Require().NoError(err)
+		return resp.Status
+	}
+
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(vetypes.VrfVoteExtension{
+		Version: VoteExtensionVersionMulti,
+		Beacons: []vetypes.VrfVoteExtensionBeacon{beacon(5), beacon(4)},
+	}))
+
+	// Rounds must be strictly decreasing.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version: VoteExtensionVersionMulti,
+		Beacons: []vetypes.VrfVoteExtensionBeacon{beacon(4), beacon(5)},
+	}))
+
+	// At most round_tolerance+1 beacons.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version: VoteExtensionVersionMulti,
+		Beacons: []vetypes.VrfVoteExtensionBeacon{beacon(5), beacon(4), beacon(3)},
+	}))
+
+	// Unknown versions are rejected.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version: VoteExtensionVersionPartial + 1,
+		Beacons: []vetypes.VrfVoteExtensionBeacon{beacon(5)},
+	}))
+
+	// Partial signatures are only accepted in threshold mode.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version:  VoteExtensionVersionPartial,
+		Partials: []vetypes.VrfVoteExtensionPartial{{DrandRound: 5, PartialSignature: make([]byte, 98)}},
+	}))
+}
+
+func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_Partials() {
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	params.RoundTolerance = 1
+	params.SchemeId = crypto.UnchainedSchemeID
+	params.PartialThreshold = 2
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, params))
+
+	partial := func(round uint64) vetypes.VrfVoteExtensionPartial {
+		return vetypes.VrfVoteExtensionPartial{DrandRound: round, PartialSignature: make([]byte, 98)}
+	}
+
+	verify := func(ext vetypes.VrfVoteExtension) cometabci.ResponseVerifyVoteExtension_VerifyStatus {
+		bz, err := EncodeVrfVoteExtension(ext)
+		s.Require().NoError(err)
+
+		resp, err := s.handler.VerifyVoteExtensionHandler()(s.Ctx, &cometabci.RequestVerifyVoteExtension{
+			Height:        10,
+			VoteExtension: bz,
+		})
+		s.Require().NoError(err)
+		return resp.Status
+	}
+
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(vetypes.VrfVoteExtension{
+		Version:  VoteExtensionVersionPartial,
+		Partials: []vetypes.VrfVoteExtensionPartial{partial(5), partial(4)},
+	}))
+
+	// Rounds must be strictly decreasing.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version:  VoteExtensionVersionPartial,
+		Partials: []vetypes.VrfVoteExtensionPartial{partial(4), partial(5)},
+	}))
+
+	// Partials must be an index prefix followed by a signature group point.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version:  VoteExtensionVersionPartial,
+		Partials: []vetypes.VrfVoteExtensionPartial{{DrandRound: 5, PartialSignature: make([]byte, 96)}},
+	}))
+
+	// Full beacons are rejected in threshold mode.
+	sig := []byte{5}
+	randomness := sha256.Sum256(sig)
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version: VoteExtensionVersionMulti,
+		Beacons: []vetypes.VrfVoteExtensionBeacon{{DrandRound: 5, Randomness: randomness[:], Signature: sig}},
+	}))
+}
+
+func (s *VoteExtensionHandlerSuite) TestVerifyVoteExtension_ECVRFProof() {
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, params))
+
+	verify := func(ext vetypes.VrfVoteExtension) cometabci.ResponseVerifyVoteExtension_VerifyStatus {
+		bz, err := EncodeVrfVoteExtension(ext)
+		s.Require().NoError(err)
+
+		resp, err := s.handler.VerifyVoteExtensionHandler()(s.Ctx, &cometabci.RequestVerifyVoteExtension{
+			Height:        10,
+			VoteExtension: bz,
+		})
+		s.Require().NoError(err)
+		return resp.Status
+	}
+
+	proofOnly := vetypes.VrfVoteExtension{
+		Version:    VoteExtensionVersionMulti,
+		EcvrfProof: make([]byte, vrftypes.ECVRFProofLength),
+	}
+
+	// Proofs are rejected unless ecvrf_fallback is enabled.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(proofOnly))
+
+	params.EcvrfFallback = true
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, params))
+
+	// A proof alone is accepted while drand is unavailable.
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, verify(proofOnly))
+
+	s.Require().Equal(cometabci.ResponseVerifyVoteExtension_REJECT, verify(vetypes.VrfVoteExtension{
+		Version:    VoteExtensionVersionMulti,
+		EcvrfProof: make([]byte, vrftypes.ECVRFProofLength-1),
+	}))
+}
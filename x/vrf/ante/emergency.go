@@ -1,7 +1,11 @@
 package ante
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"sort"
+	"sync"
 
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -12,36 +16,66 @@ import (
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 )
 
-var errUnauthorizedMsgVrfEmergencyDisable = errors.New("vrf: unauthorized MsgVrfEmergencyDisable")
+var (
+	errUnauthorizedMsgVrfEmergencyDisable = errors.New("vrf: unauthorized MsgVrfEmergencyDisable")
+	errEmergencyTxReplayed                = errors.New("vrf: emergency disable tx was already included")
+	errEmergencyTxPending                 = errors.New("vrf: emergency disable tx is already pending")
+	errTooManyPendingEmergencyTxs         = errors.New("vrf: too many pending emergency disable txs")
+)
+
+const (
+	// maxPendingEmergencyTxs bounds the emergency txs CheckTx remembers.
+	maxPendingEmergencyTxs = 256
+	// maxPendingEmergencyTxsPerSigner bounds the emergency txs CheckTx admits
+	// from one signer until they are included or time out.
+	maxPendingEmergencyTxsPerSigner = 2
+)
 
 // EmergencyDisableDecorator is an AnteDecorator that recognizes
-// MsgVrfEmergencyDisable transactions, verifies their signatures and
-// allowlist authorization via VerifyEmergencyMsg, and:
+// MsgVrfEmergencyDisable transactions, sent directly or through an x/authz
+// MsgExec, verifies their signatures and allowlist authorization via
+// VerifyEmergencyMsg, and:
 //
 //   - If the tx contains no MsgVrfEmergencyDisable: passes it through.
 //   - If the tx contains at least one such message and is authorized:
 //     treats it as gasless and bypasses sequence/nonce checks by not
-//     performing any additional fee or sequence validation here.
+//     performing any additional fee or sequence validation here. CheckTx
+//     adds it to the EmergencyLane, from which PrepareProposal takes it
+//     ahead of the mempool reap.
 //   - If the tx contains at least one such message but is unauthorized:
 //     rejects the transaction.
 //
+// Since such txs pay no fee, the decorator keeps them cheap to reject: a tx
+// already included in a block is refused before its signatures are verified,
+// and CheckTx admits each tx once and at most maxPendingEmergencyTxsPerSigner
+// per signer until they are included or time out. DeliverTx records the
+// included tx and its signer in x/vrf state until its timeout height, which
+// VerifyEmergencyMsg uses to cap the txs of each signer.
+//
 // Actual toggling of VrfParams.Enabled is performed in PreBlock at the height
 // where an authorized tx is first included.
 type EmergencyDisableDecorator struct {
 	accountKeeper   authkeeper.AccountKeeper
+	authzKeeper     emergency.AuthzKeeper
 	vrfKeeper       *vrfkeeper.Keeper
 	signModeHandler *txsigning.HandlerMap
+
+	lane *EmergencyLane
 }
 
 func NewEmergencyDisableDecorator(
 	accountKeeper authkeeper.AccountKeeper,
+	authzKeeper emergency.AuthzKeeper,
 	vrfKeeper *vrfkeeper.Keeper,
 	signModeHandler *txsigning.HandlerMap,
+	lane *EmergencyLane,
 ) EmergencyDisableDecorator {
 	return EmergencyDisableDecorator{
 		accountKeeper:   accountKeeper,
+		authzKeeper:     authzKeeper,
 		vrfKeeper:       vrfKeeper,
 		signModeHandler: signModeHandler,
+		lane:            lane,
 	}
 }
 
@@ -57,10 +91,32 @@ func (d EmergencyDisableDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	if !emergency.IsEmergencyTx(tx) {
+		// Not an emergency disable tx; pass through to the rest of the ante
+		// chain as usual.
+		return next(ctx, tx, simulate)
+	}
+
+	// Reject copies of known txs before paying for signature verification.
+	hash := emergency.TxHash(ctx.TxBytes())
+	replayed, err := d.vrfKeeper.HasEmergencyTx(ctx, hash)
+	if err != nil {
+		return ctx, err
+	}
+	if replayed {
+		return ctx, fmt.Errorf("%w: %X", errEmergencyTxReplayed, hash)
+	}
+
+	newCheckTx := ctx.IsCheckTx() && !ctx.IsReCheckTx()
+	if newCheckTx && d.lane.has(hash) {
+		return ctx, fmt.Errorf("%w: %X", errEmergencyTxPending, hash)
+	}
+
 	found, authorized, _, err := emergency.VerifyEmergencyMsg(
 		ctx,
 		tx,
 		d.accountKeeper,
+		d.authzKeeper,
 		d.vrfKeeper,
 		d.signModeHandler,
 	)
@@ -72,8 +128,6 @@ func (d EmergencyDisableDecorator) AnteHandle(
 	}
 
 	if !found {
-		// Not an emergency disable tx; pass through to the rest of the ante
-		// chain as usual.
 		return next(ctx, tx, simulate)
 	}
 
@@ -81,9 +135,135 @@ func (d EmergencyDisableDecorator) AnteHandle(
 		return ctx, errUnauthorizedMsgVrfEmergencyDisable
 	}
 
+	timeout := emergency.TimeoutHeight(tx)
+	signer, err := emergency.Signer(tx)
+	if err != nil {
+		return ctx, err
+	}
+	switch {
+	case newCheckTx:
+		if err := d.lane.add(ctx.BlockHeight(), ctx.TxBytes(), signer, timeout); err != nil {
+			return ctx, err
+		}
+
+	case !ctx.IsCheckTx():
+		if err := d.vrfKeeper.RecordEmergencyTx(ctx, hash, signer, timeout); err != nil {
+			return ctx, err
+		}
+		// Proposal verification runs on state that is thrown away, so only
+		// FinalizeBlock settles the pending tx.
+		if ctx.ExecMode() == sdk.ExecModeFinalize {
+			d.lane.remove(hash)
+		}
+	}
+
 	// Transaction contains an authorized MsgVrfEmergencyDisable. The PRD
 	// specifies that it should be gasless and bypass sequence/nonce checks.
 	// We honor this by short-circuiting the ante chain after performing our
 	// own signature verification.
 	return ctx, nil
 }
+
+// EmergencyLane remembers the emergency txs CheckTx admitted to the mempool,
+// by hash and by first signer, until they are included or time out. It keeps
+// their bytes so that PrepareProposal can propose them whatever the mempool
+// reaps. It is local to the node and never affects consensus.
+type EmergencyLane struct {
+	mu        sync.Mutex
+	seq       uint64
+	txs       map[string]pendingEmergencyTx
+	perSigner map[string]int
+}
+
+type pendingEmergencyTx struct {
+	seq           uint64
+	txBytes       []byte
+	signer        string
+	timeoutHeight uint64
+}
+
+func NewEmergencyLane() *EmergencyLane {
+	return &EmergencyLane{
+		txs:       make(map[string]pendingEmergencyTx),
+		perSigner: make(map[string]int),
+	}
+}
+
+// Txs returns the pending txs that a block at the given height may include,
+// in the order CheckTx admitted them.
+func (p *EmergencyLane) Txs(height int64) [][]byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pending := make([]pendingEmergencyTx, 0, len(p.txs))
+	for _, tx := range p.txs {
+		if tx.timeoutHeight >= uint64(height) {
+			pending = append(pending, tx)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].seq < pending[j].seq })
+
+	txs := make([][]byte, len(pending))
+	for i, tx := range pending {
+		txs[i] = tx.txBytes
+	}
+	return txs
+}
+
+func (p *EmergencyLane) has(hash []byte) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.txs[string(hash)]
+	return ok
+}
+
+// add admits a tx at the given height after forgetting the timed out ones.
+func (p *EmergencyLane) add(height int64, txBytes, signer []byte, timeoutHeight uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, tx := range p.txs {
+		if tx.timeoutHeight < uint64(height) {
+			p.removeLocked(key)
+		}
+	}
+
+	if len(p.txs) >= maxPendingEmergencyTxs {
+		return fmt.Errorf("%w: max %d", errTooManyPendingEmergencyTxs, maxPendingEmergencyTxs)
+	}
+	if p.perSigner[string(signer)] >= maxPendingEmergencyTxsPerSigner {
+		return fmt.Errorf("%w: max %d for %s", errTooManyPendingEmergencyTxs, maxPendingEmergencyTxsPerSigner, sdk.AccAddress(signer))
+	}
+
+	p.seq++
+	p.txs[string(emergency.TxHash(txBytes))] = pendingEmergencyTx{
+		seq:           p.seq,
+		txBytes:       bytes.Clone(txBytes),
+		signer:        string(signer),
+		timeoutHeight: timeoutHeight,
+	}
+	p.perSigner[string(signer)]++
+
+	return nil
+}
+
+func (p *EmergencyLane) remove(hash []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.removeLocked(string(hash))
+}
+
+func (p *EmergencyLane) removeLocked(key string) {
+	tx, ok := p.txs[key]
+	if !ok {
+		return
+	}
+
+	delete(p.txs, key)
+	p.perSigner[tx.signer]--
+	if p.perSigner[tx.signer] <= 0 {
+		delete(p.perSigner, tx.signer)
+	}
+}
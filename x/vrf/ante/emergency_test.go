@@ -6,8 +6,11 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	"github.com/dgtlkitchen/vrf/x/vrf/emergency"
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
@@ -26,12 +29,12 @@ func TestEmergencyDecoratorSuite(t *testing.T) {
 func (s *EmergencyDecoratorSuite) SetupTest() {
 	s.VrfTestSuite.SetupTest()
 
-	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority)
+	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
 	s.Keeper = &k
 }
 
 func (s *EmergencyDecoratorSuite) TestSimulateBypass() {
-	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
 	msg := &vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String()}
 	txSigned, err := s.BuildSignedTx(msg)
 	s.Require().NoError(err)
@@ -48,7 +51,7 @@ func (s *EmergencyDecoratorSuite) TestSimulateBypass() {
 }
 
 func (s *EmergencyDecoratorSuite) TestNoEmergency() {
-	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
 	params := vrftypes.DefaultParams()
 	msg := &vrftypes.MsgUpdateParams{Authority: s.Addr.String(), Params: params}
 	txSigned, err := s.BuildSignedTx(msg)
@@ -66,7 +69,7 @@ func (s *EmergencyDecoratorSuite) TestNoEmergency() {
 }
 
 func (s *EmergencyDecoratorSuite) TestUnauthorized() {
-	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
 	msg := &vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String()}
 	txSigned, err := s.BuildSignedTx(msg)
 	s.Require().NoError(err)
@@ -83,12 +86,16 @@ func (s *EmergencyDecoratorSuite) TestUnauthorized() {
 }
 
 func (s *EmergencyDecoratorSuite) TestAuthorized() {
-	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
 	msg := &vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String()}
 	txSigned, err := s.BuildSignedTx(msg)
 	s.Require().NoError(err)
 
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, s.Addr.String(), "member"))
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Label:   "member",
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
 
 	called := false
 	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
@@ -100,3 +107,102 @@ func (s *EmergencyDecoratorSuite) TestAuthorized() {
 	s.Require().NoError(err)
 	s.Require().False(called)
 }
+
+func (s *EmergencyDecoratorSuite) TestAuthorizedAuthzExec() {
+	_, _, granter := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: granter.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+
+	azk := vrftestutil.NewAuthzKeeper()
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, azk, s.Keeper, s.SignModeHandler, NewEmergencyLane())
+	exec := authz.NewMsgExec(s.Addr, []sdk.Msg{&vrftypes.MsgVrfEmergencyDisable{Authority: granter.String()}})
+	txSigned, err := s.BuildSignedTx(&exec)
+	s.Require().NoError(err)
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		s.Fail("emergency tx reached the rest of the ante chain")
+		return ctx, nil
+	}
+
+	_, err = decorator.AnteHandle(s.Ctx, txSigned, false, next)
+	s.Require().ErrorIs(err, errUnauthorizedMsgVrfEmergencyDisable)
+
+	azk.Grant(s.Addr, granter, vrftypes.NewEmergencyDisableAuthorization())
+	_, err = decorator.AnteHandle(s.Ctx, txSigned, false, next)
+	s.Require().NoError(err)
+}
+
+// emergencyTx returns an authorized emergency disable tx and its bytes.
+func (s *EmergencyDecoratorSuite) emergencyTx(reason string) (sdk.Tx, []byte) {
+	txSigned, err := s.BuildSignedTx(&vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String(), Reason: reason})
+	s.Require().NoError(err)
+	txBz, err := s.EncCfg.TxConfig.TxEncoder()(txSigned)
+	s.Require().NoError(err)
+
+	return txSigned, txBz
+}
+
+func (s *EmergencyDecoratorSuite) TestReplay() {
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+	txSigned, txBz := s.emergencyTx("replay")
+
+	deliverCtx := s.Ctx.WithExecMode(sdk.ExecModeFinalize).WithTxBytes(txBz)
+	_, err := decorator.AnteHandle(deliverCtx, txSigned, false, nil)
+	s.Require().NoError(err)
+
+	_, err = decorator.AnteHandle(deliverCtx, txSigned, false, nil)
+	s.Require().ErrorIs(err, errEmergencyTxReplayed)
+
+	checkCtx := s.Ctx.WithIsCheckTx(true).WithTxBytes(txBz)
+	_, err = decorator.AnteHandle(checkCtx, txSigned, false, nil)
+	s.Require().ErrorIs(err, errEmergencyTxReplayed)
+}
+
+func (s *EmergencyDecoratorSuite) TestCheckTxPending() {
+	decorator := NewEmergencyDisableDecorator(s.AccountKeeper, nil, s.Keeper, s.SignModeHandler, NewEmergencyLane())
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+	checkCtx := s.Ctx.WithIsCheckTx(true)
+
+	txA, bzA := s.emergencyTx("a")
+	_, err := decorator.AnteHandle(checkCtx.WithTxBytes(bzA), txA, false, nil)
+	s.Require().NoError(err)
+
+	// A copy is refused, but the pending tx passes re-checking.
+	_, err = decorator.AnteHandle(checkCtx.WithTxBytes(bzA), txA, false, nil)
+	s.Require().ErrorIs(err, errEmergencyTxPending)
+	_, err = decorator.AnteHandle(checkCtx.WithIsReCheckTx(true).WithTxBytes(bzA), txA, false, nil)
+	s.Require().NoError(err)
+
+	// The signer may have maxPendingEmergencyTxsPerSigner txs pending.
+	txB, bzB := s.emergencyTx("b")
+	_, err = decorator.AnteHandle(checkCtx.WithTxBytes(bzB), txB, false, nil)
+	s.Require().NoError(err)
+	txC, bzC := s.emergencyTx("c")
+	_, err = decorator.AnteHandle(checkCtx.WithTxBytes(bzC), txC, false, nil)
+	s.Require().ErrorIs(err, errTooManyPendingEmergencyTxs)
+
+	// The lane hands the admitted txs to PrepareProposal in order.
+	s.Require().Equal([][]byte{bzA, bzB}, decorator.lane.Txs(s.Ctx.BlockHeight()+1))
+
+	// Including a pending tx frees its slot.
+	_, err = decorator.AnteHandle(s.Ctx.WithExecMode(sdk.ExecModeFinalize).WithTxBytes(bzA), txA, false, nil)
+	s.Require().NoError(err)
+	_, err = decorator.AnteHandle(checkCtx.WithTxBytes(bzC), txC, false, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{bzB, bzC}, decorator.lane.Txs(s.Ctx.BlockHeight()+1))
+	s.Require().Empty(decorator.lane.Txs(int64(emergency.TimeoutHeight(txB)) + 1))
+
+	// Timed out txs are forgotten when the next one is admitted.
+	height := emergency.TimeoutHeight(txB) + 1
+	s.Require().NoError(decorator.lane.add(int64(height), []byte("e"), s.Addr, height))
+	s.Require().Len(decorator.lane.txs, 1)
+}
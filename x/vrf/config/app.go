@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/cast"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/dgtlkitchen/vrf/sidecar/tlsconfig"
 )
 
 var (
@@ -19,6 +21,8 @@ var (
 	errVrfClientTimeoutNonPositive = errors.New("vrf client timeout must be greater than 0")
 	errVrfAddressNotString         = errors.New("vrf address must be a non-empty string")
 	errVrfClientTimeoutNotDuration = errors.New("vrf client timeout must be a positive duration")
+	errECVRFKeyFileNotString       = errors.New("vrf ecvrf key file must be a string")
+	errTLSSettingNotString         = errors.New("vrf tls file and server name settings must be strings")
 )
 
 const (
@@ -41,6 +45,24 @@ client_timeout = "{{ .Vrf.ClientTimeout }}"
 
 # metrics_enabled determines whether VRF client metrics are enabled.
 metrics_enabled = "{{ .Vrf.MetricsEnabled }}"
+
+# ecvrf_key_file is the ECVRF key file whose ed25519 key signs the ECVRF
+# fallback proofs added to vote extensions when the chain enables
+# ecvrf_fallback. Create it with "ecvrf-key init" and register it with
+# "tx vrf register-ecvrf-key"; it must not be the consensus key. Relative
+# paths are resolved against the node home.
+ecvrf_key_file = "{{ .Vrf.ECVRFKeyFile }}"
+
+# tls_enabled connects to the sidecar over TLS. The sidecar's certificate
+# must chain to tls_ca_file and be valid for tls_server_name, whatever
+# vrf_address is dialed. Set tls_cert_file and tls_key_file as well when the
+# sidecar requires mutual TLS. Relative paths are resolved against the node
+# home; the files are re-read when they change.
+tls_enabled = "{{ .Vrf.TLSEnabled }}"
+tls_ca_file = "{{ .Vrf.TLSCAFile }}"
+tls_cert_file = "{{ .Vrf.TLSCertFile }}"
+tls_key_file = "{{ .Vrf.TLSKeyFile }}"
+tls_server_name = "{{ .Vrf.TLSServerName }}"
 `
 )
 
@@ -49,6 +71,12 @@ const (
 	flagVrfAddress    = "vrf.vrf_address"
 	flagClientTimeout = "vrf.client_timeout"
 	flagMetrics       = "vrf.metrics_enabled"
+	flagECVRFKeyFile  = "vrf.ecvrf_key_file"
+	flagTLSEnabled    = "vrf.tls_enabled"
+	flagTLSCAFile     = "vrf.tls_ca_file"
+	flagTLSCertFile   = "vrf.tls_cert_file"
+	flagTLSKeyFile    = "vrf.tls_key_file"
+	flagTLSServerName = "vrf.tls_server_name"
 )
 
 // AppConfig contains the application-side VRF configuration loaded from
@@ -58,6 +86,12 @@ type AppConfig struct {
 	VrfAddress     string        `mapstructure:"vrf_address" toml:"vrf_address"`
 	ClientTimeout  time.Duration `mapstructure:"client_timeout" toml:"client_timeout"`
 	MetricsEnabled bool          `mapstructure:"metrics_enabled" toml:"metrics_enabled"`
+	ECVRFKeyFile   string        `mapstructure:"ecvrf_key_file" toml:"ecvrf_key_file"`
+	TLSEnabled     bool          `mapstructure:"tls_enabled" toml:"tls_enabled"`
+	TLSCAFile      string        `mapstructure:"tls_ca_file" toml:"tls_ca_file"`
+	TLSCertFile    string        `mapstructure:"tls_cert_file" toml:"tls_cert_file"`
+	TLSKeyFile     string        `mapstructure:"tls_key_file" toml:"tls_key_file"`
+	TLSServerName  string        `mapstructure:"tls_server_name" toml:"tls_server_name"`
 }
 
 func NewDefaultAppConfig() AppConfig {
@@ -82,7 +116,19 @@ func (c *AppConfig) ValidateBasic() error {
 		return errVrfClientTimeoutNonPositive
 	}
 
-	return nil
+	return c.TLSFiles("").Validate()
+}
+
+// TLSFiles returns the TLS settings of the sidecar connection, with relative
+// paths resolved against home.
+func (c *AppConfig) TLSFiles(home string) tlsconfig.ClientFiles {
+	return tlsconfig.ClientFiles{
+		Enabled:    c.TLSEnabled,
+		CAFile:     c.TLSCAFile,
+		CertFile:   c.TLSCertFile,
+		KeyFile:    c.TLSKeyFile,
+		ServerName: c.TLSServerName,
+	}.ResolvePaths(home)
 }
 
 // ReadConfigFromAppOpts reads the vrf config parameters from the AppOptions
@@ -99,6 +145,14 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// The fallback key is independent of the sidecar, which may be the very
+	// thing that is unavailable.
+	if v := opts.Get(flagECVRFKeyFile); v != nil {
+		if cfg.ECVRFKeyFile, err = cast.ToStringE(v); err != nil {
+			return cfg, errECVRFKeyFileNotString
+		}
+	}
+
 	if !cfg.Enabled {
 		return cfg, nil
 	}
@@ -129,6 +183,25 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	if v := opts.Get(flagTLSEnabled); v != nil {
+		if cfg.TLSEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	for flag, dst := range map[string]*string{
+		flagTLSCAFile:     &cfg.TLSCAFile,
+		flagTLSCertFile:   &cfg.TLSCertFile,
+		flagTLSKeyFile:    &cfg.TLSKeyFile,
+		flagTLSServerName: &cfg.TLSServerName,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *dst, err = cast.ToStringE(v); err != nil {
+				return cfg, errTLSSettingNotString
+			}
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
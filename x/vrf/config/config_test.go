@@ -1,9 +1,13 @@
 package config
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/privval"
 	"github.com/stretchr/testify/suite"
 
 	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
@@ -59,4 +63,70 @@ func (s *ConfigSuite) TestReadConfig() {
 
 	_, err = ReadConfigFromAppOpts(appOptions{flagEnabled: true, flagClientTimeout: "bad"})
 	s.Require().ErrorIs(err, errVrfClientTimeoutNotDuration)
+
+	// The ECVRF key file is read even while the sidecar is disabled.
+	cfg, err = ReadConfigFromAppOpts(appOptions{flagECVRFKeyFile: "config/ecvrf_key.json"})
+	s.Require().NoError(err)
+	s.Require().Equal("config/ecvrf_key.json", cfg.ECVRFKeyFile)
+
+	_, err = ReadConfigFromAppOpts(appOptions{flagECVRFKeyFile: struct{}{}})
+	s.Require().ErrorIs(err, errECVRFKeyFileNotString)
+}
+
+func (s *ConfigSuite) TestReadTLSConfig() {
+	opts := appOptions{
+		flagEnabled:       true,
+		flagTLSEnabled:    true,
+		flagTLSCAFile:     "config/sidecar-ca.pem",
+		flagTLSServerName: "vrf-sidecar",
+	}
+	cfg, err := ReadConfigFromAppOpts(opts)
+	s.Require().NoError(err)
+
+	files := cfg.TLSFiles("/home/node")
+	s.Require().True(files.Enabled)
+	s.Require().Equal("/home/node/config/sidecar-ca.pem", files.CAFile)
+	s.Require().Equal("vrf-sidecar", files.ServerName)
+
+	// The sidecar's identity must be pinned.
+	delete(opts, flagTLSServerName)
+	_, err = ReadConfigFromAppOpts(opts)
+	s.Require().Error(err)
+
+	_, err = ReadConfigFromAppOpts(appOptions{flagEnabled: true, flagTLSCAFile: struct{}{}})
+	s.Require().ErrorIs(err, errTLSSettingNotString)
+}
+
+func (s *ConfigSuite) TestLoadECVRFKey() {
+	home := s.T().TempDir()
+
+	generated, err := GenECVRFKey(home, "config/ecvrf_key.json")
+	s.Require().NoError(err)
+
+	key, err := LoadECVRFKey(home, "config/ecvrf_key.json")
+	s.Require().NoError(err)
+	s.Require().Equal(generated, key)
+
+	// An existing key is never overwritten.
+	_, err = GenECVRFKey(home, filepath.Join(home, "config/ecvrf_key.json"))
+	s.Require().ErrorIs(err, errECVRFKeyExists)
+
+	// The public key must match the private key.
+	bz, err := json.Marshal(ECVRFKeyFile{PubKey: make([]byte, 32), PrivKey: generated})
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(filepath.Join(home, "mismatched.json"), bz, 0o600))
+	_, err = LoadECVRFKey(home, "mismatched.json")
+	s.Require().ErrorIs(err, errECVRFKeyInvalid)
+
+	// A CometBFT priv_validator_key.json is not an ECVRF key file.
+	pv := privval.GenFilePV(
+		filepath.Join(home, "priv_validator_key.json"),
+		filepath.Join(home, "priv_validator_state.json"),
+	)
+	pv.Save()
+	_, err = LoadECVRFKey(home, "priv_validator_key.json")
+	s.Require().Error(err)
+
+	_, err = LoadECVRFKey(home, "missing.json")
+	s.Require().Error(err)
 }
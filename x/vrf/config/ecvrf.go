@@ -0,0 +1,91 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var (
+	errECVRFKeyInvalid = errors.New("vrf: ECVRF key file does not hold a valid ed25519 key")
+	errECVRFKeyExists  = errors.New("vrf: ECVRF key file already exists")
+)
+
+// ECVRFKeyFile is the JSON content of an ECVRF key file. The key signs the
+// ECVRF fallback proofs and is registered on chain with MsgRegisterEcvrfKey;
+// it is never the consensus key.
+type ECVRFKeyFile struct {
+	PubKey  []byte `json:"pub_key"`
+	PrivKey []byte `json:"priv_key"`
+}
+
+// LoadECVRFKey reads the ed25519 private key of an ECVRF key file. A relative
+// path is resolved against home.
+func LoadECVRFKey(home, path string) (ed25519.PrivateKey, error) {
+	path = resolvePath(home, path)
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading ECVRF key file: %w", err)
+	}
+
+	var file ECVRFKeyFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("decoding ECVRF key file %s: %w", path, err)
+	}
+
+	if len(file.PrivKey) != ed25519.PrivateKeySize {
+		return nil, errECVRFKeyInvalid
+	}
+	key := ed25519.PrivateKey(file.PrivKey)
+	if !key.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(file.PubKey)) {
+		return nil, errECVRFKeyInvalid
+	}
+
+	return key, nil
+}
+
+// GenECVRFKey creates an ECVRF key file with a new ed25519 key. It refuses to
+// overwrite an existing file. A relative path is resolved against home.
+func GenECVRFKey(home, path string) (ed25519.PrivateKey, error) {
+	path = resolvePath(home, path)
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.MarshalIndent(ECVRFKeyFile{PubKey: pub, PrivKey: priv}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating ECVRF key dir: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("%w: %s", errECVRFKeyExists, path)
+		}
+		return nil, fmt.Errorf("creating ECVRF key file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(bz); err != nil {
+		return nil, fmt.Errorf("writing ECVRF key file: %w", err)
+	}
+
+	return priv, nil
+}
+
+func resolvePath(home, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(home, path)
+}
@@ -0,0 +1,96 @@
+package emergency
+
+import (
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
+)
+
+const (
+	// MaxTxsPerBlock is the number of emergency disable txs a block may
+	// carry. A single authorized one is enough to disable VRF, so the rest
+	// only cost verification work in ProcessProposal and PreBlock.
+	MaxTxsPerBlock = 4
+
+	// MaxTxsPerSigner is the number of emergency disable txs of one signer
+	// that may be included and not timed out yet. Each tx pays no fee and
+	// differs from the others only by its bytes, so without a cap a single
+	// key could fill every block until its txs time out.
+	MaxTxsPerSigner = 2
+)
+
+var (
+	errTooManyEmergencyTxs       = errors.New("vrf: too many emergency disable txs in block")
+	errDuplicateEmergencyTx      = errors.New("vrf: duplicate emergency disable tx in block")
+	errEmergencyTxReplayed       = errors.New("vrf: emergency disable tx was already included")
+	errTooManySignerEmergencyTxs = errors.New("vrf: too many emergency disable txs of the signer")
+)
+
+// TxHash returns the hash CometBFT uses for txBytes, under which included
+// emergency disable txs are recorded.
+func TxHash(txBytes []byte) []byte {
+	return cmttypes.Tx(txBytes).Hash()
+}
+
+// BlockTxs admits the emergency disable txs of a block in block order. It
+// enforces MaxTxsPerBlock and MaxTxsPerSigner, counting the txs of the signer
+// recorded in earlier blocks, and rejects txs that appear twice in the block
+// or were already included in an earlier block. PrepareProposal,
+// ProcessProposal and PreBlock apply it identically, before any signature is
+// verified.
+type BlockTxs struct {
+	vk        *vrfkeeper.Keeper
+	hashes    map[string]struct{}
+	perSigner map[string]int
+}
+
+func NewBlockTxs(vk *vrfkeeper.Keeper) *BlockTxs {
+	return &BlockTxs{
+		vk:        vk,
+		hashes:    make(map[string]struct{}, MaxTxsPerBlock),
+		perSigner: make(map[string]int, MaxTxsPerBlock),
+	}
+}
+
+// Add admits the emergency disable tx with the given bytes, or returns why it
+// may not be part of the block.
+func (b *BlockTxs) Add(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error {
+	if len(b.hashes) >= MaxTxsPerBlock {
+		return fmt.Errorf("%w: max %d", errTooManyEmergencyTxs, MaxTxsPerBlock)
+	}
+
+	hash := TxHash(txBytes)
+	if _, ok := b.hashes[string(hash)]; ok {
+		return fmt.Errorf("%w: %X", errDuplicateEmergencyTx, hash)
+	}
+
+	replayed, err := b.vk.HasEmergencyTx(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if replayed {
+		return fmt.Errorf("%w: %X", errEmergencyTxReplayed, hash)
+	}
+
+	signer, err := Signer(tx)
+	if err != nil {
+		return err
+	}
+	included, err := b.vk.CountEmergencyTxs(ctx, signer)
+	if err != nil {
+		return err
+	}
+	if included+b.perSigner[string(signer)] >= MaxTxsPerSigner {
+		return fmt.Errorf("%w: max %d for %s", errTooManySignerEmergencyTxs, MaxTxsPerSigner, sdk.AccAddress(signer))
+	}
+
+	b.hashes[string(hash)] = struct{}{}
+	b.perSigner[string(signer)]++
+
+	return nil
+}
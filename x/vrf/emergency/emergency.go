@@ -1,8 +1,10 @@
 package emergency
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
@@ -19,13 +22,36 @@ import (
 
 var (
 	errNilSignModeHandler             = errors.New("vrf: nil sign mode handler")
-	errEmergencyDisableTxNotDedicated = errors.New("vrf: emergency disable tx must contain only MsgVrfEmergencyDisable messages")
+	errEmergencyDisableTxNotDedicated = errors.New("vrf: emergency disable tx must contain only MsgVrfEmergencyDisable messages, directly or through MsgExec")
 	errInvalidSignerCount             = errors.New("vrf: invalid number of signers")
 	errTxNotV2Adaptable               = errors.New("vrf: expected tx to implement V2AdaptableTx")
+	errTxNotSignable                  = errors.New("vrf: expected tx to implement authsigning.Tx")
 	errSignerAccountNotFound          = errors.New("vrf: signer account does not exist")
 	errSignerPublicKeyMissing         = errors.New("vrf: missing public key for signer")
+	errTimeoutHeightMissing           = errors.New("vrf: emergency disable tx must set timeout_height")
+	errTimeoutHeightTooFar            = errors.New("vrf: emergency disable tx timeout_height is too far ahead")
+	errTimeoutHeightPassed            = errors.New("vrf: emergency disable tx timed out")
 )
 
+// MaxTimeoutBlocks is how far ahead of the current height the timeout_height
+// of an emergency disable tx may be. It bounds how long the tx must be
+// remembered to reject replays.
+const MaxTimeoutBlocks = 100
+
+// AuthzKeeper is the x/authz functionality needed to resolve emergency
+// disables executed through MsgExec.
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+}
+
+// emergencyMsg is a MsgVrfEmergencyDisable of the tx. grantee is the MsgExec
+// grantee that submits it on behalf of the authority, or empty when the
+// authority signs the message itself.
+type emergencyMsg struct {
+	*vrftypes.MsgVrfEmergencyDisable
+	grantee string
+}
+
 // VerifyEmergencyMsg performs the shared, deterministic authorization check
 // for MsgVrfEmergencyDisable, as described in PRD §4.3.2.
 //
@@ -33,15 +59,25 @@ var (
 //   - In PreBlock (to decide whether to bypass VRF for the block), and
 //   - In the Ante/DeliverTx path (to accept or reject the transaction).
 //
+// The tx must set a timeout_height no more than MaxTimeoutBlocks ahead, so
+// that its inclusion only has to be remembered for a bounded time. Its signer
+// must have fewer than MaxTxsPerSigner included txs that have not timed out.
+//
+// A MsgVrfEmergencyDisable may be sent directly or wrapped in an x/authz
+// MsgExec. A wrapped message counts only if the grantee holds an
+// EmergencyDisableAuthorization from the message authority; az may be nil, in
+// which case wrapped messages are never authorized.
+//
 // The function returns:
 //   - found:      whether the tx contained at least one MsgVrfEmergencyDisable.
-//   - authorized: whether at least one such message was signed by an
-//     allow-listed authority.
+//   - authorized: whether at least one such message was sent by, or on behalf
+//     of, a committee member holding the emergency role.
 //   - reason:     the free-form reason string from the first authorized msg.
 func VerifyEmergencyMsg(
 	ctx sdk.Context,
 	tx sdk.Tx,
 	ak authkeeper.AccountKeeper,
+	az AuthzKeeper,
 	vk *vrfkeeper.Keeper,
 	signModeHandler *txsigning.HandlerMap,
 ) (found bool, authorized bool, reason string, err error) {
@@ -55,53 +91,196 @@ func VerifyEmergencyMsg(
 		return false, false, "", nil
 	}
 
-	msgs := sigTx.GetMsgs()
-	var emergencyMsgs []*vrftypes.MsgVrfEmergencyDisable
-	for _, msg := range msgs {
-		emergencyMsg, ok := msg.(*vrftypes.MsgVrfEmergencyDisable)
-		if ok {
-			emergencyMsgs = append(emergencyMsgs, emergencyMsg)
-		}
-	}
-
+	emergencyMsgs, dedicated := collectEmergencyMsgs(sigTx.GetMsgs())
 	if len(emergencyMsgs) == 0 {
 		return false, false, "", nil
 	}
 
 	// Emergency disable must be a dedicated transaction so that bypassing fees and
 	// sequence checks cannot inadvertently apply to non-emergency messages.
-	if len(emergencyMsgs) != len(msgs) {
+	if !dedicated {
 		return true, false, "", errEmergencyDisableTxNotDedicated
 	}
 
+	if err := checkTimeoutHeight(ctx, tx); err != nil {
+		return true, false, "", err
+	}
+
+	if err := checkSignerTxs(ctx, tx, vk); err != nil {
+		return true, false, "", err
+	}
+
 	// At this point we know the tx includes at least one MsgVrfEmergencyDisable.
 	// Perform full signature verification using the same primitives as the
 	// standard auth ante handlers, but without enforcing sequence-equality
-	// checks (sequence is taken from the signature itself).
+	// checks (sequence is taken from the signature itself). This covers the
+	// MsgExec grantees as well, since they are the signers of their MsgExec.
 	if err := verifySignatures(ctx, sigTx, ak, signModeHandler); err != nil {
 		return true, false, "", err
 	}
 
-	// Check allowlist: for each MsgVrfEmergencyDisable, ensure that the signer is
-	// present in x/vrf's committee allowlist.
+	// Check allowlist: for each MsgVrfEmergencyDisable, ensure that the
+	// authority holds the emergency role in x/vrf's committee allowlist and,
+	// for wrapped messages, that it granted the grantee emergency rights.
 	for _, m := range emergencyMsgs {
 		if err := m.ValidateBasic(); err != nil {
 			return true, false, "", err
 		}
 
-		ok, err := vk.IsCommitteeMember(ctx, m.Authority)
+		ok, err := vk.HasCommitteeRole(ctx, m.Authority, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY)
 		if err != nil {
 			return true, false, "", err
 		}
+		if !ok {
+			continue
+		}
 
-		if ok {
-			return true, true, m.Reason, nil
+		if m.grantee != "" && m.grantee != m.Authority {
+			ok, err = hasEmergencyGrant(ctx, az, m.grantee, m.Authority)
+			if err != nil {
+				return true, false, "", err
+			}
+			if !ok {
+				continue
+			}
 		}
+
+		return true, true, m.Reason, nil
 	}
 
 	return true, false, "", nil
 }
 
+// IsEmergencyTx reports whether tx contains at least one
+// MsgVrfEmergencyDisable, directly or through MsgExec. It does not verify
+// anything.
+func IsEmergencyTx(tx sdk.Tx) bool {
+	emergencyMsgs, _ := collectEmergencyMsgs(tx.GetMsgs())
+	return len(emergencyMsgs) > 0
+}
+
+// TimeoutHeight returns the timeout_height of tx, or 0 if it has none.
+func TimeoutHeight(tx sdk.Tx) uint64 {
+	timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight)
+	if !ok {
+		return 0
+	}
+
+	return timeoutTx.GetTimeoutHeight()
+}
+
+// Signer returns the first signer of tx, against whose cap the tx counts.
+func Signer(tx sdk.Tx) ([]byte, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, fmt.Errorf("%w; got %T", errTxNotSignable, tx)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("%w; expected at least 1, got 0", errInvalidSignerCount)
+	}
+
+	return signers[0], nil
+}
+
+// checkSignerTxs requires the signer of tx to have fewer than
+// MaxTxsPerSigner recorded emergency disable txs.
+func checkSignerTxs(ctx sdk.Context, tx sdk.Tx, vk *vrfkeeper.Keeper) error {
+	signer, err := Signer(tx)
+	if err != nil {
+		return err
+	}
+
+	included, err := vk.CountEmergencyTxs(ctx, signer)
+	if err != nil {
+		return err
+	}
+	if included >= MaxTxsPerSigner {
+		return fmt.Errorf("%w: max %d for %s", errTooManySignerEmergencyTxs, MaxTxsPerSigner, sdk.AccAddress(signer))
+	}
+
+	return nil
+}
+
+// checkTimeoutHeight requires tx to time out at or after the current height
+// and at most MaxTimeoutBlocks after it.
+func checkTimeoutHeight(ctx sdk.Context, tx sdk.Tx) error {
+	timeout := TimeoutHeight(tx)
+	if timeout == 0 {
+		return errTimeoutHeightMissing
+	}
+
+	height := uint64(ctx.BlockHeight())
+	switch {
+	case timeout < height:
+		return fmt.Errorf("%w: timeout_height %d, height %d", errTimeoutHeightPassed, timeout, height)
+	case timeout > height+MaxTimeoutBlocks:
+		return fmt.Errorf("%w: timeout_height %d, height %d, max %d blocks", errTimeoutHeightTooFar, timeout, height, MaxTimeoutBlocks)
+	}
+
+	return nil
+}
+
+// collectEmergencyMsgs returns the MsgVrfEmergencyDisable messages of msgs,
+// including those wrapped in MsgExec. dedicated reports whether msgs contain
+// nothing else.
+func collectEmergencyMsgs(msgs []sdk.Msg) (emergencyMsgs []emergencyMsg, dedicated bool) {
+	dedicated = true
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *vrftypes.MsgVrfEmergencyDisable:
+			emergencyMsgs = append(emergencyMsgs, emergencyMsg{MsgVrfEmergencyDisable: msg})
+
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil || len(inner) == 0 {
+				dedicated = false
+				continue
+			}
+
+			for _, innerMsg := range inner {
+				m, ok := innerMsg.(*vrftypes.MsgVrfEmergencyDisable)
+				if !ok {
+					dedicated = false
+					continue
+				}
+				emergencyMsgs = append(emergencyMsgs, emergencyMsg{MsgVrfEmergencyDisable: m, grantee: msg.Grantee})
+			}
+
+		default:
+			dedicated = false
+		}
+	}
+
+	return emergencyMsgs, dedicated
+}
+
+// hasEmergencyGrant reports whether granter gave grantee an unexpired
+// EmergencyDisableAuthorization. Other authorization types for
+// MsgVrfEmergencyDisable, such as a GenericAuthorization, do not count.
+func hasEmergencyGrant(ctx sdk.Context, az AuthzKeeper, grantee, granter string) (bool, error) {
+	if az == nil {
+		return false, nil
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return false, err
+	}
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return false, err
+	}
+
+	authorization, _ := az.GetAuthorization(ctx, granteeAddr, granterAddr, sdk.MsgTypeURL(&vrftypes.MsgVrfEmergencyDisable{}))
+	_, ok := authorization.(*vrftypes.EmergencyDisableAuthorization)
+	return ok, nil
+}
+
 // verifySignatures verifies all signatures on the transaction using the x/tx
 // HandlerMap. It is modeled after x/auth/ante's SigVerificationDecorator but
 // deliberately does not enforce sequence equality between signatures and
@@ -6,10 +6,15 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	vrfkeeper "github.com/dgtlkitchen/vrf/x/vrf/keeper"
 	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
@@ -20,6 +25,7 @@ type EmergencySuite struct {
 	vrftestutil.VrfTestSuite
 
 	Keeper *vrfkeeper.Keeper
+	Authz  *vrftestutil.AuthzKeeper
 }
 
 func TestEmergencySuite(t *testing.T) {
@@ -29,8 +35,17 @@ func TestEmergencySuite(t *testing.T) {
 func (s *EmergencySuite) SetupTest() {
 	s.VrfTestSuite.SetupTest()
 
-	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority)
+	k := vrfkeeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
 	s.Keeper = &k
+	s.Authz = vrftestutil.NewAuthzKeeper()
+}
+
+// setEmergencyMember gives addr the emergency committee role.
+func (s *EmergencySuite) setEmergencyMember(addr sdk.AccAddress) {
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: addr.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
 }
 
 func (s *EmergencySuite) TestVerifyEmergencyMsg() {
@@ -38,20 +53,24 @@ func (s *EmergencySuite) TestVerifyEmergencyMsg() {
 	txSigned, err := s.BuildSignedTx(msg)
 	s.Require().NoError(err)
 
-	found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, nil)
+	found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, nil)
 	s.Require().ErrorIs(err, errNilSignModeHandler)
 	s.Require().False(found)
 	s.Require().False(authorized)
 	s.Require().Empty(reason)
 
-	found, authorized, reason, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	found, authorized, reason, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
 	s.Require().NoError(err)
 	s.Require().True(found)
 	s.Require().False(authorized)
 	s.Require().Empty(reason)
 
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, s.Addr.String(), "member"))
-	found, authorized, reason, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: s.Addr.String(),
+		Label:   "member",
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
+	}))
+	found, authorized, reason, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
 	s.Require().NoError(err)
 	s.Require().True(found)
 	s.Require().True(authorized)
@@ -64,7 +83,7 @@ func (s *EmergencySuite) TestVerifyEmergencyMsgNoEmergency() {
 	txSigned, err := s.BuildSignedTx(msg)
 	s.Require().NoError(err)
 
-	found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
 	s.Require().NoError(err)
 	s.Require().False(found)
 	s.Require().False(authorized)
@@ -78,18 +97,121 @@ func (s *EmergencySuite) TestVerifyEmergencyMsgMixedMsgs() {
 	txSigned, err := s.BuildSignedTx(msg1, msg2)
 	s.Require().NoError(err)
 
-	found, authorized, _, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	found, authorized, _, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
 	s.Require().ErrorIs(err, errEmergencyDisableTxNotDedicated)
 	s.Require().True(found)
 	s.Require().False(authorized)
 }
 
+func (s *EmergencySuite) TestVerifyEmergencyMsgTimeoutHeight() {
+	s.setEmergencyMember(s.Addr)
+	msg := &vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String()}
+	ctx := s.Ctx.WithBlockHeight(50)
+
+	for _, tc := range []struct {
+		timeout uint64
+		err     error
+	}{
+		{timeout: 0, err: errTimeoutHeightMissing},
+		{timeout: 49, err: errTimeoutHeightPassed},
+		{timeout: 50},
+		{timeout: 50 + MaxTimeoutBlocks},
+		{timeout: 51 + MaxTimeoutBlocks, err: errTimeoutHeightTooFar},
+	} {
+		txSigned, err := s.BuildSignedTxWithTimeout(tc.timeout, msg)
+		s.Require().NoError(err)
+
+		found, authorized, _, err := VerifyEmergencyMsg(ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
+		s.Require().True(found)
+		if tc.err != nil {
+			s.Require().ErrorIs(err, tc.err, "timeout %d", tc.timeout)
+			s.Require().False(authorized)
+			continue
+		}
+		s.Require().NoError(err, "timeout %d", tc.timeout)
+		s.Require().True(authorized)
+	}
+}
+
+// emergencyTx returns an emergency disable tx signed by priv and its bytes.
+func (s *EmergencySuite) emergencyTx(priv cryptotypes.PrivKey, reason string) (sdk.Tx, []byte) {
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	tx, err := s.BuildSignedTxAs(priv, uint64(s.Ctx.BlockHeight())+20, &vrftypes.MsgVrfEmergencyDisable{Authority: addr.String(), Reason: reason})
+	s.Require().NoError(err)
+	bz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	return tx, bz
+}
+
+func (s *EmergencySuite) TestBlockTxs() {
+	privA, _ := s.AddAccount()
+	privB, _ := s.AddAccount()
+
+	blockTxs := NewBlockTxs(s.Keeper)
+	for i := range MaxTxsPerBlock {
+		priv := privA
+		if i%2 == 1 {
+			priv = privB
+		}
+		tx, bz := s.emergencyTx(priv, string(rune('a'+i)))
+		s.Require().NoError(blockTxs.Add(s.Ctx, tx, bz))
+	}
+	tx, bz := s.emergencyTx(s.Priv, "over cap")
+	s.Require().ErrorIs(blockTxs.Add(s.Ctx, tx, bz), errTooManyEmergencyTxs)
+
+	blockTxs = NewBlockTxs(s.Keeper)
+	tx, bz = s.emergencyTx(privA, "tx")
+	s.Require().NoError(blockTxs.Add(s.Ctx, tx, bz))
+	s.Require().ErrorIs(blockTxs.Add(s.Ctx, tx, bz), errDuplicateEmergencyTx)
+
+	tx, bz = s.emergencyTx(privA, "included")
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, TxHash(bz), nil, 10))
+	s.Require().ErrorIs(NewBlockTxs(s.Keeper).Add(s.Ctx, tx, bz), errEmergencyTxReplayed)
+}
+
+func (s *EmergencySuite) TestSignerCap() {
+	s.setEmergencyMember(s.Addr)
+	handler := s.EncCfg.TxConfig.SignModeHandler()
+
+	// A tx of the signer is already included; the block may carry one more.
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, []byte("included"), s.Addr, 20))
+	blockTxs := NewBlockTxs(s.Keeper)
+	txA, bzA := s.emergencyTx(s.Priv, "a")
+	s.Require().NoError(blockTxs.Add(s.Ctx, txA, bzA))
+	txB, bzB := s.emergencyTx(s.Priv, "b")
+	s.Require().ErrorIs(blockTxs.Add(s.Ctx, txB, bzB), errTooManySignerEmergencyTxs)
+
+	// Other signers are not affected.
+	privOther, _ := s.AddAccount()
+	txOther, bzOther := s.emergencyTx(privOther, "other")
+	s.Require().NoError(blockTxs.Add(s.Ctx, txOther, bzOther))
+
+	_, authorized, _, err := VerifyEmergencyMsg(s.Ctx, txB, s.AccountKeeper, nil, s.Keeper, handler)
+	s.Require().NoError(err)
+	s.Require().True(authorized)
+
+	// Once the cap is reached, the signer's txs no longer verify.
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, TxHash(bzA), s.Addr, 20))
+	found, authorized, _, err := VerifyEmergencyMsg(s.Ctx, txB, s.AccountKeeper, nil, s.Keeper, handler)
+	s.Require().ErrorIs(err, errTooManySignerEmergencyTxs)
+	s.Require().True(found)
+	s.Require().False(authorized)
+
+	// They verify again when an included tx times out.
+	s.Require().NoError(s.Keeper.PruneEmergencyTxs(s.Ctx.WithBlockHeight(20)))
+	_, authorized, _, err = VerifyEmergencyMsg(s.Ctx, txB, s.AccountKeeper, nil, s.Keeper, handler)
+	s.Require().NoError(err)
+	s.Require().True(authorized)
+}
+
 func (s *EmergencySuite) TestVerifyEmergencyMsgAccountMissing() {
 	priv, _, addr := testdata.KeyTestPubAddr()
 	msg := &vrftypes.MsgVrfEmergencyDisable{Authority: addr.String()}
 
 	builder := s.EncCfg.TxConfig.NewTxBuilder()
 	s.Require().NoError(builder.SetMsgs(msg))
+	builder.SetTimeoutHeight(uint64(s.Ctx.BlockHeight()) + 1)
 
 	sig := signing.SignatureV2{
 		PubKey: priv.PubKey(),
@@ -121,6 +243,132 @@ func (s *EmergencySuite) TestVerifyEmergencyMsgAccountMissing() {
 	s.Require().NoError(builder.SetSignatures(sigV2))
 
 	txSigned := builder.GetTx()
-	_, _, _, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Keeper, s.SignModeHandler)
+	_, _, _, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, nil, s.Keeper, s.SignModeHandler)
 	s.Require().ErrorIs(err, errSignerAccountNotFound)
 }
+
+func (s *EmergencySuite) TestVerifyEmergencyMsgAuthzExec() {
+	_, _, granter := testdata.KeyTestPubAddr()
+	exec := authz.NewMsgExec(s.Addr, []sdk.Msg{&vrftypes.MsgVrfEmergencyDisable{Authority: granter.String(), Reason: "grantee"}})
+	txSigned, err := s.BuildSignedTx(&exec)
+	s.Require().NoError(err)
+
+	verify := func(az AuthzKeeper) (bool, bool, string) {
+		found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, az, s.Keeper, s.SignModeHandler)
+		s.Require().NoError(err)
+		return found, authorized, reason
+	}
+
+	// The granter lacks the emergency role.
+	s.Authz.Grant(s.Addr, granter, vrftypes.NewEmergencyDisableAuthorization())
+	found, authorized, _ := verify(s.Authz)
+	s.Require().True(found)
+	s.Require().False(authorized)
+
+	s.setEmergencyMember(granter)
+	found, authorized, reason := verify(s.Authz)
+	s.Require().True(found)
+	s.Require().True(authorized)
+	s.Require().Equal("grantee", reason)
+
+	// Without an authz keeper, wrapped messages are never authorized.
+	found, authorized, _ = verify(nil)
+	s.Require().True(found)
+	s.Require().False(authorized)
+
+	// A generic grant does not carry emergency rights.
+	s.Authz.Grant(s.Addr, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(&vrftypes.MsgVrfEmergencyDisable{})))
+	found, authorized, _ = verify(s.Authz)
+	s.Require().True(found)
+	s.Require().False(authorized)
+}
+
+func (s *EmergencySuite) TestVerifyEmergencyMsgAuthzExecSelf() {
+	s.setEmergencyMember(s.Addr)
+	exec := authz.NewMsgExec(s.Addr, []sdk.Msg{&vrftypes.MsgVrfEmergencyDisable{Authority: s.Addr.String()}})
+	txSigned, err := s.BuildSignedTx(&exec)
+	s.Require().NoError(err)
+
+	// x/authz lets an account execute its own messages without a grant.
+	found, authorized, _, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(authorized)
+}
+
+func (s *EmergencySuite) TestVerifyEmergencyMsgAuthzExecNotDedicated() {
+	_, _, granter := testdata.KeyTestPubAddr()
+	emergencyMsg := &vrftypes.MsgVrfEmergencyDisable{Authority: granter.String()}
+	otherMsg := &vrftypes.MsgUpdateParams{Authority: granter.String(), Params: vrftypes.DefaultParams()}
+
+	mixed := authz.NewMsgExec(s.Addr, []sdk.Msg{emergencyMsg, otherMsg})
+	txSigned, err := s.BuildSignedTx(&mixed)
+	s.Require().NoError(err)
+	found, _, _, err := VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().ErrorIs(err, errEmergencyDisableTxNotDedicated)
+	s.Require().True(found)
+
+	exec := authz.NewMsgExec(s.Addr, []sdk.Msg{emergencyMsg})
+	txSigned, err = s.BuildSignedTx(&exec, otherMsg)
+	s.Require().NoError(err)
+	_, _, _, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().ErrorIs(err, errEmergencyDisableTxNotDedicated)
+
+	// A MsgExec without emergency messages is not an emergency tx.
+	other := authz.NewMsgExec(s.Addr, []sdk.Msg{otherMsg})
+	txSigned, err = s.BuildSignedTx(&other)
+	s.Require().NoError(err)
+	found, _, _, err = VerifyEmergencyMsg(s.Ctx, txSigned, s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *EmergencySuite) TestVerifyEmergencyMsgMultisig() {
+	privA, _, _ := testdata.KeyTestPubAddr()
+	privB, _, _ := testdata.KeyTestPubAddr()
+	privs := []cryptotypes.PrivKey{privA, privB}
+	pubKeys := []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	acc := s.AccountKeeper.NewAccountWithAddress(s.Ctx, addr)
+	s.Require().NoError(acc.SetAccountNumber(2))
+	s.Require().NoError(acc.SetPubKey(multisigKey))
+	s.AccountKeeper.SetAccount(s.Ctx, acc)
+	s.setEmergencyMember(addr)
+
+	builder := s.EncCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(&vrftypes.MsgVrfEmergencyDisable{Authority: addr.String(), Reason: "multisig"}))
+	builder.SetTimeoutHeight(uint64(s.Ctx.BlockHeight()) + 1)
+
+	signerData := authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       s.Ctx.ChainID(),
+		AccountNumber: 2,
+		PubKey:        multisigKey,
+	}
+
+	sigData := multisig.NewMultisig(len(pubKeys))
+	for _, priv := range privs {
+		sig, err := tx.SignWithPrivKey(s.Ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, builder, priv, s.EncCfg.TxConfig, 0)
+		s.Require().NoError(err)
+		s.Require().NoError(multisig.AddSignatureV2(sigData, sig, pubKeys))
+	}
+	s.Require().NoError(builder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: sigData}))
+
+	found, authorized, reason, err := VerifyEmergencyMsg(s.Ctx, builder.GetTx(), s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().True(authorized)
+	s.Require().Equal("multisig", reason)
+
+	// A single member signature does not meet the threshold.
+	sig, err := tx.SignWithPrivKey(s.Ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, builder, privs[0], s.EncCfg.TxConfig, 0)
+	s.Require().NoError(err)
+	partial := multisig.NewMultisig(len(pubKeys))
+	s.Require().NoError(multisig.AddSignatureV2(partial, sig, pubKeys))
+	s.Require().NoError(builder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: partial}))
+
+	_, _, _, err = VerifyEmergencyMsg(s.Ctx, builder.GetTx(), s.AccountKeeper, s.Authz, s.Keeper, s.SignModeHandler)
+	s.Require().Error(err)
+}
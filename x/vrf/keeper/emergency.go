@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasEmergencyTx reports whether an emergency disable tx with the given hash
+// was already included in a block and has not expired yet.
+func (k Keeper) HasEmergencyTx(ctx context.Context, hash []byte) (bool, error) {
+	return k.emergencyTxs.Has(ctx, hash)
+}
+
+// CountEmergencyTxs returns the number of recorded emergency disable txs of
+// signer, the first signer of each tx, that have not expired yet.
+func (k Keeper) CountEmergencyTxs(ctx context.Context, signer []byte) (int, error) {
+	iter, err := k.emergencyTxsBySigner.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, []byte](signer))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	n := 0
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+
+	return n, nil
+}
+
+// RecordEmergencyTx remembers an included emergency disable tx of signer
+// until its timeout height, after which it can no longer be included anyway.
+// An empty signer records the tx hash only.
+func (k Keeper) RecordEmergencyTx(ctx context.Context, hash, signer []byte, timeoutHeight uint64) error {
+	if err := k.emergencyTxs.Set(ctx, hash, timeoutHeight); err != nil {
+		return err
+	}
+	if err := k.emergencyTxsByExpiry.Set(ctx, collections.Join(timeoutHeight, hash)); err != nil {
+		return err
+	}
+	if len(signer) == 0 {
+		return nil
+	}
+
+	return k.emergencyTxsBySigner.Set(ctx, collections.Join(signer, hash), timeoutHeight)
+}
+
+// PruneEmergencyTxs forgets the recorded emergency disable txs that time out
+// before the next height.
+func (k Keeper) PruneEmergencyTxs(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height <= 0 {
+		return nil
+	}
+
+	var expired []collections.Pair[uint64, []byte]
+	err := k.emergencyTxsByExpiry.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (bool, error) {
+		if key.K1() > uint64(height) {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.emergencyTxs.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.emergencyTxsByExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	// The signer records are bounded by the per-signer cap, so walking all of
+	// them is cheap.
+	var expiredBySigner []collections.Pair[[]byte, []byte]
+	err = k.emergencyTxsBySigner.Walk(ctx, nil, func(key collections.Pair[[]byte, []byte], timeoutHeight uint64) (bool, error) {
+		if timeoutHeight <= uint64(height) {
+			expiredBySigner = append(expiredBySigner, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expiredBySigner {
+		if err := k.emergencyTxsBySigner.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emergencyTxSigners returns the signer of each recorded emergency disable
+// tx, by tx hash.
+func (k Keeper) emergencyTxSigners(ctx context.Context) (map[string][]byte, error) {
+	signers := make(map[string][]byte)
+	err := k.emergencyTxsBySigner.Walk(ctx, nil, func(key collections.Pair[[]byte, []byte], _ uint64) (bool, error) {
+		signers[string(key.K2())] = key.K1()
+		return false, nil
+	})
+
+	return signers, err
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import "cosmossdk.io/collections"
+
+func (s *KeeperSuite) TestEmergencyTxs() {
+	hashA, hashB := []byte("tx-a"), []byte("tx-b")
+	signer := []byte("signer")
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, hashA, signer, 10))
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, hashB, signer, 11))
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(s.Ctx, []byte("tx-c"), nil, 11))
+
+	requireRecorded := func(hash []byte, want bool) {
+		s.T().Helper()
+		ok, err := s.Keeper.HasEmergencyTx(s.Ctx, hash)
+		s.Require().NoError(err)
+		s.Require().Equal(want, ok, "%s", hash)
+	}
+	requireRecorded(hashA, true)
+	requireRecorded(hashB, true)
+	requireRecorded([]byte("tx-d"), false)
+
+	requireCount := func(want int) {
+		s.T().Helper()
+		n, err := s.Keeper.CountEmergencyTxs(s.Ctx, signer)
+		s.Require().NoError(err)
+		s.Require().Equal(want, n)
+	}
+	requireCount(2)
+
+	// A tx is kept through its timeout height, where it can still be included.
+	s.Ctx = s.Ctx.WithBlockHeight(9)
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+	requireRecorded(hashA, true)
+
+	requireCount(2)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+	requireRecorded(hashA, false)
+	requireRecorded(hashB, true)
+	requireCount(1)
+
+	has, err := s.Keeper.emergencyTxsByExpiry.Has(s.Ctx, collections.Join(uint64(10), hashA))
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// FallbackSeed returns the randomness of the latest beacon, which validators
+// bind into the ECVRF input of their vote extensions. It is empty before the
+// first beacon.
+func (k Keeper) FallbackSeed(ctx context.Context) ([]byte, error) {
+	beacon, err := k.latestBeacon.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return []byte{}, nil
+		}
+		return nil, err
+	}
+
+	return beacon.Randomness, nil
+}
+
+// RotateFallbackSeed records FallbackSeed for the next height and returns the
+// seed recorded at the previous height. Vote extensions are made on the state
+// of the height before the one that processes them, so that is the seed their
+// ECVRF proofs were made with. ok is false when no seed was recorded yet.
+func (k Keeper) RotateFallbackSeed(ctx context.Context) (prev []byte, ok bool, err error) {
+	prev, err = k.fallbackSeed.Get(ctx)
+	switch {
+	case err == nil:
+		ok = true
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, false, err
+	}
+
+	seed, err := k.FallbackSeed(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := k.fallbackSeed.Set(ctx, seed); err != nil {
+		return nil, false, err
+	}
+
+	return prev, ok, nil
+}
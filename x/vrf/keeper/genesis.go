@@ -1,11 +1,19 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dgtlkitchen/vrf/x/vrf/types"
 )
 
+var errGenesisEscrowExceedsBalance = errors.New("vrf: genesis request_escrow exceeds the vrf module account balance")
+
 // InitGenesis initializes the vrf module's state from genesis.
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := gs.Validate(); err != nil {
@@ -15,7 +23,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := k.SetParams(ctx, gs.Params); err != nil {
 		panic(err)
 	}
-	_ = k.SetParamsUpdatedHeight(ctx, ctx.BlockHeight())
+
+	if gs.PendingParams != nil {
+		if err := k.SetPendingParams(ctx, *gs.PendingParams); err != nil {
+			panic(err)
+		}
+	}
 
 	if gs.LatestBeacon != nil {
 		if err := k.SetLatestBeacon(ctx, *gs.LatestBeacon); err != nil {
@@ -24,14 +37,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	}
 
 	for _, e := range gs.Committee {
-		if err := k.SetCommitteeMember(ctx, e.Address, e.Label); err != nil {
+		if err := k.SetCommitteeMember(ctx, e); err != nil {
 			panic(err)
 		}
 	}
 
-	// Ensure the module authority is always a committee member by default.
+	// Ensure the module authority is always a committee member by default. It
+	// holds every role without grants.
 	if hasAuthority, err := k.committee.Has(ctx, k.GetAuthority()); err == nil && !hasAuthority {
-		_ = k.SetCommitteeMember(ctx, k.GetAuthority(), "module_authority")
+		_ = k.SetCommitteeMember(ctx, types.AllowlistEntry{Address: k.GetAuthority(), Label: "module_authority"})
 	}
 
 	for _, i := range gs.Identities {
@@ -40,6 +54,44 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		}
 	}
 
+	if err := k.initRequests(ctx, gs); err != nil {
+		panic(err)
+	}
+
+	if err := k.initTimelocked(ctx, gs); err != nil {
+		panic(err)
+	}
+
+	for _, tx := range gs.EmergencyTxs {
+		if err := k.RecordEmergencyTx(ctx, tx.TxHash, tx.Signer, tx.TimeoutHeight); err != nil {
+			panic(err)
+		}
+	}
+
+	// An older genesis without last_drand_round keeps the round of its
+	// latest drand beacon, set above.
+	if gs.LastDrandRound != 0 {
+		if err := k.lastDrandRound.Set(ctx, gs.LastDrandRound); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, key := range gs.EcvrfKeys {
+		if err := k.SetEcvrfKey(ctx, key.ValidatorAddress, key.PublicKey); err != nil {
+			panic(err)
+		}
+	}
+
+	if gs.EcvrfFallbackSeed != nil {
+		seed := gs.EcvrfFallbackSeed.Seed
+		if seed == nil {
+			seed = []byte{}
+		}
+		if err := k.fallbackSeed.Set(ctx, seed); err != nil {
+			panic(err)
+		}
+	}
+
 	// Initialize last block time to the current block time so that ExtendVote can derive
 	// Tref for the next height.
 	_ = k.SetLastBlockTime(ctx, ctx.BlockTime().Unix())
@@ -57,9 +109,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		beacon = &b
 	}
 
+	var pending *types.VrfPendingParams
+	if p, ok, err := k.GetPendingParams(ctx); err == nil && ok {
+		pending = &p
+	}
+
 	var committee []types.AllowlistEntry
-	_ = k.committee.Walk(ctx, nil, func(addr string, label string) (bool, error) {
-		committee = append(committee, types.AllowlistEntry{Address: addr, Label: label})
+	_ = k.committee.Walk(ctx, nil, func(_ string, member types.AllowlistEntry) (bool, error) {
+		committee = append(committee, member)
 		return false, nil
 	})
 
@@ -75,10 +132,105 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false, nil
 	})
 
+	var requests []types.RandomnessRequest
+	_ = k.requests.Walk(ctx, nil, func(_ uint64, req types.RandomnessRequest) (bool, error) {
+		requests = append(requests, req)
+		return false, nil
+	})
+
+	nextRequestID, _ := k.requestSeq.Peek(ctx)
+	escrow, _ := k.escrowedFees(ctx)
+
+	var timelocked []types.TimelockedMessage
+	_ = k.timelocked.Walk(ctx, nil, func(_ uint64, msg types.TimelockedMessage) (bool, error) {
+		timelocked = append(timelocked, msg)
+		return false, nil
+	})
+
+	nextTimelockID, _ := k.timelockSeq.Peek(ctx)
+
+	var emergencyTxs []types.EmergencyTxRecord
+	signers, _ := k.emergencyTxSigners(ctx)
+	_ = k.emergencyTxs.Walk(ctx, nil, func(hash []byte, timeoutHeight uint64) (bool, error) {
+		emergencyTxs = append(emergencyTxs, types.EmergencyTxRecord{TxHash: hash, TimeoutHeight: timeoutHeight, Signer: signers[string(hash)]})
+		return false, nil
+	})
+
+	var fallbackSeed *types.EcvrfFallbackSeed
+	if seed, err := k.fallbackSeed.Get(ctx); err == nil {
+		fallbackSeed = &types.EcvrfFallbackSeed{Seed: seed}
+	}
+
+	lastDrandRound, _ := k.GetLastDrandRound(ctx)
+
+	var ecvrfKeys []types.EcvrfKey
+	_ = k.ecvrfKeys.Walk(ctx, nil, func(valAddr string, pubKey []byte) (bool, error) {
+		ecvrfKeys = append(ecvrfKeys, types.EcvrfKey{ValidatorAddress: valAddr, PublicKey: pubKey})
+		return false, nil
+	})
+
 	return &types.GenesisState{
-		Params:       params,
-		LatestBeacon: beacon,
-		Committee:    committee,
-		Identities:   identities,
+		Params:            params,
+		LatestBeacon:      beacon,
+		Committee:         committee,
+		Identities:        identities,
+		PendingParams:     pending,
+		Requests:          requests,
+		NextRequestId:     nextRequestID,
+		RequestEscrow:     escrow,
+		Timelocked:        timelocked,
+		NextTimelockId:    nextTimelockID,
+		EmergencyTxs:      emergencyTxs,
+		EcvrfFallbackSeed: fallbackSeed,
+		LastDrandRound:    lastDrandRound,
+		EcvrfKeys:         ecvrfKeys,
+	}
+}
+
+// initRequests stores the randomness requests, queues the pending ones and
+// restores their escrow, which the vrf module account must hold.
+func (k Keeper) initRequests(ctx context.Context, gs types.GenesisState) error {
+	for _, req := range gs.Requests {
+		if err := k.requests.Set(ctx, req.Id, req); err != nil {
+			return err
+		}
+		if req.Status != types.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING {
+			continue
+		}
+		if err := k.pendingRequests.Set(ctx, collections.Join(req.MinDrandRound, req.Id)); err != nil {
+			return err
+		}
 	}
+
+	if err := k.requestSeq.Set(ctx, gs.NextRequestId); err != nil {
+		return err
+	}
+
+	if gs.RequestEscrow.IsZero() {
+		return nil
+	}
+	if k.bankKeeper != nil {
+		balance := k.bankKeeper.GetAllBalances(ctx, RewardPoolAddress())
+		if !gs.RequestEscrow.IsAllLTE(balance) {
+			return fmt.Errorf("%w: escrow %s, balance %s", errGenesisEscrowExceedsBalance, gs.RequestEscrow, balance)
+		}
+	}
+	return k.addEscrow(ctx, gs.RequestEscrow)
+}
+
+// initTimelocked stores the time-locked messages and queues the pending ones.
+func (k Keeper) initTimelocked(ctx context.Context, gs types.GenesisState) error {
+	for _, msg := range gs.Timelocked {
+		if err := k.timelocked.Set(ctx, msg.Id, msg); err != nil {
+			return err
+		}
+		if msg.Status != types.TimelockStatus_TIMELOCK_STATUS_PENDING {
+			continue
+		}
+		if err := k.pendingTimelock.Set(ctx, collections.Join(msg.DrandRound, msg.Id)); err != nil {
+			return err
+		}
+	}
+
+	return k.timelockSeq.Set(ctx, gs.NextTimelockId)
 }
@@ -3,6 +3,13 @@ package keeper
 import (
 	"time"
 
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,8 +31,12 @@ func (s *KeeperSuite) TestInitGenesis() {
 	gs := vrftypes.GenesisState{
 		Params:       vrftypes.DefaultParams(),
 		LatestBeacon: &beacon,
-		Committee:    []vrftypes.AllowlistEntry{{Address: member, Label: "member"}},
-		Identities:   []vrftypes.VrfIdentity{identity},
+		Committee: []vrftypes.AllowlistEntry{{
+			Address: member,
+			Label:   "member",
+			Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY, ExpiryHeight: 50}},
+		}},
+		Identities: []vrftypes.VrfIdentity{identity},
 	}
 
 	s.Keeper.InitGenesis(s.Ctx, gs)
@@ -38,13 +49,12 @@ func (s *KeeperSuite) TestInitGenesis() {
 	s.Require().NoError(err)
 	s.Require().Equal(beacon, gotBeacon)
 
-	ok, err := s.Keeper.IsCommitteeMember(s.Ctx, member)
+	entry, err := s.Keeper.GetCommitteeMember(s.Ctx, member)
 	s.Require().NoError(err)
-	s.Require().True(ok)
+	s.Require().Equal(gs.Committee[0], entry)
 
-	ok, err = s.Keeper.IsCommitteeMember(s.Ctx, s.Keeper.GetAuthority())
+	_, err = s.Keeper.GetCommitteeMember(s.Ctx, s.Keeper.GetAuthority())
 	s.Require().NoError(err)
-	s.Require().True(ok)
 
 	stored, err := s.Keeper.identities.Get(s.Ctx, valAddr)
 	s.Require().NoError(err)
@@ -58,9 +68,9 @@ func (s *KeeperSuite) TestInitGenesis() {
 	s.Require().NoError(err)
 	s.Require().Equal(s.Ctx.BlockTime().Unix(), prev)
 
-	h, err := s.Keeper.GetParamsUpdatedHeight(s.Ctx)
+	_, ok, err := s.Keeper.GetPendingParams(s.Ctx)
 	s.Require().NoError(err)
-	s.Require().Equal(s.Ctx.BlockHeight(), h)
+	s.Require().False(ok)
 }
 
 func (s *KeeperSuite) TestInitGenesisPanics() {
@@ -88,9 +98,213 @@ func (s *KeeperSuite) TestExportGenesis() {
 
 	beacon := vrftypes.VrfBeacon{DrandRound: 11, Randomness: []byte("next")}
 	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, beacon))
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, s.Keeper.GetAuthority(), "authority"))
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{Address: s.Keeper.GetAuthority(), Label: "authority"}))
+
+	pending := vrftypes.VrfPendingParams{Params: vrftypes.DefaultParams(), ActivationHeight: 42}
+	pending.Params.Enabled = true
+	s.Require().NoError(s.Keeper.SetPendingParams(s.Ctx, pending))
 
 	exported := s.Keeper.ExportGenesis(s.Ctx)
 	s.Require().NotNil(exported.LatestBeacon)
 	s.Require().Equal(beacon, *exported.LatestBeacon)
+	s.Require().NotNil(exported.PendingParams)
+	s.Require().Equal(pending, *exported.PendingParams)
+	s.Require().NoError(exported.Validate())
+}
+
+func (s *KeeperSuite) TestGenesisRandomnessRequestsRoundTrip() {
+	requester := sdk.AccAddress(append(make([]byte, 19), 9))
+	bank := &fakeBankKeeper{balances: map[string]sdk.Coins{
+		requester.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}}
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, bank, nil, nil)
+
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	params.GenesisUnixSec = 1_700_000_000
+	params.RequestBaseFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	ctx := s.Ctx.WithBlockTime(time.Unix(params.GenesisUnixSec+65, 0).UTC())
+
+	for range 2 {
+		_, err := k.RequestRandomness(ctx, requester, 1, nil, nil)
+		s.Require().NoError(err)
+	}
+
+	exported := k.ExportGenesis(ctx)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Requests, 2)
+	s.Require().Equal(uint64(2), exported.NextRequestId)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), exported.RequestEscrow)
+
+	// Import into a fresh store, backed by the same module account balance.
+	imported, importCtx := s.importGenesis(ctx, bank, *exported)
+
+	// The escrow of the imported pending requests can be refunded, and ids
+	// continue where they left off.
+	n, err := imported.RefundPendingRequests(importCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(2, n)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), bank.GetAllBalances(ctx, requester))
+
+	req, err := imported.RequestRandomness(importCtx, requester, 1, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), req.Id)
+
+	// The vrf module account must hold the escrow.
+	bank.balances[RewardPoolAddress().String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 19))
+	s.Require().Panics(func() { k.InitGenesis(ctx, *exported) })
+}
+
+func (s *KeeperSuite) TestGenesisTimelockedRoundTrip() {
+	scheme := crypto.NewPedersenBLSUnchained()
+	secret := scheme.KeyGroup.Scalar().SetInt64(1)
+	pubKey, err := scheme.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
+	s.Require().NoError(err)
+
+	params := vrftypes.DefaultParams()
+	params.GenesisUnixSec = 1_700_000_000
+	params.PublicKey = pubKey
+	params.SchemeId = crypto.UnchainedSchemeID
+	params.ChainHash, err = params.DrandChainHash()
+	s.Require().NoError(err)
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	ctx := s.Ctx.WithBlockTime(time.Unix(params.GenesisUnixSec+65, 0).UTC())
+
+	ct, err := vrftypes.TimelockEncrypt(pubKey, 5, []byte("bid"))
+	s.Require().NoError(err)
+	failed, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 4, ct)
+	s.Require().NoError(err)
+	bid, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 5, ct)
+	s.Require().NoError(err)
+
+	// A beacon without a valid signature fails the round 4 ciphertext.
+	_, err = s.Keeper.DecryptTimelocked(ctx, vrftypes.VrfBeacon{DrandRound: 4})
+	s.Require().NoError(err)
+
+	exported := s.Keeper.ExportGenesis(ctx)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Timelocked, 2)
+	s.Require().Equal(uint64(2), exported.NextTimelockId)
+
+	imported, importCtx := s.importGenesis(ctx, nil, *exported)
+
+	got, err := imported.GetTimelocked(importCtx, failed.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.TimelockStatus_TIMELOCK_STATUS_FAILED, got.Status)
+
+	// The pending ciphertext is still decrypted once its round is finalized.
+	sig, err := scheme.AuthScheme.Sign(secret, scheme.DigestBeacon(&common.Beacon{Round: 5}))
+	s.Require().NoError(err)
+	decrypted, err := imported.DecryptTimelocked(importCtx, vrftypes.VrfBeacon{DrandRound: 5, Signature: sig})
+	s.Require().NoError(err)
+	s.Require().Len(decrypted, 1)
+	s.Require().Equal(bid.Id, decrypted[0].Id)
+	s.Require().Equal([]byte("bid"), decrypted[0].Plaintext)
+
+	next, err := imported.SubmitTimelocked(importCtx, s.Authority, 6, ct)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), next.Id)
+}
+
+func (s *KeeperSuite) TestGenesisEmergencyTxsRoundTrip() {
+	ctx := s.Ctx.WithBlockHeight(20)
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(ctx, []byte("tx-a"), []byte("signer"), 25))
+	s.Require().NoError(s.Keeper.RecordEmergencyTx(ctx, []byte("tx-b"), []byte("signer"), 30))
+
+	exported := s.Keeper.ExportGenesis(ctx)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.EmergencyTxs, 2)
+
+	imported, importCtx := s.importGenesis(ctx, nil, *exported)
+
+	// The signer's txs still count against its cap.
+	n, err := imported.CountEmergencyTxs(importCtx, []byte("signer"))
+	s.Require().NoError(err)
+	s.Require().Equal(2, n)
+
+	// A recorded tx is still rejected after the import, until it times out.
+	included, err := imported.HasEmergencyTx(importCtx, []byte("tx-a"))
+	s.Require().NoError(err)
+	s.Require().True(included)
+
+	s.Require().NoError(imported.PruneEmergencyTxs(importCtx.WithBlockHeight(25)))
+	included, err = imported.HasEmergencyTx(importCtx, []byte("tx-a"))
+	s.Require().NoError(err)
+	s.Require().False(included)
+	included, err = imported.HasEmergencyTx(importCtx, []byte("tx-b"))
+	s.Require().NoError(err)
+	s.Require().True(included)
+}
+
+func (s *KeeperSuite) TestGenesisFallbackSeedRoundTrip() {
+	s.Require().Nil(s.Keeper.ExportGenesis(s.Ctx).EcvrfFallbackSeed)
+
+	// Before the first beacon the recorded seed is empty, but recorded.
+	_, _, err := s.Keeper.RotateFallbackSeed(s.Ctx)
+	s.Require().NoError(err)
+	exported := s.Keeper.ExportGenesis(s.Ctx)
+	s.Require().NotNil(exported.EcvrfFallbackSeed)
+	s.Require().Empty(exported.EcvrfFallbackSeed.Seed)
+	imported, importCtx := s.importGenesis(s.Ctx, nil, *exported)
+	_, ok, err := imported.RotateFallbackSeed(importCtx)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, vrftypes.VrfBeacon{DrandRound: 3, Randomness: []byte("randomness")}))
+	_, _, err = s.Keeper.RotateFallbackSeed(s.Ctx)
+	s.Require().NoError(err)
+	exported = s.Keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]byte("randomness"), exported.EcvrfFallbackSeed.Seed)
+
+	// The next height verifies the ECVRF proofs against the exported seed.
+	imported, importCtx = s.importGenesis(s.Ctx, nil, *exported)
+	prev, ok, err := imported.RotateFallbackSeed(importCtx)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal([]byte("randomness"), prev)
+}
+
+func (s *KeeperSuite) TestGenesisLastDrandRoundRoundTrip() {
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, vrftypes.VrfBeacon{DrandRound: 7, Randomness: []byte("randomness")}))
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, vrftypes.VrfBeacon{
+		Randomness: []byte("fallback randomness"),
+		Source:     vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF,
+	}))
+
+	exported := s.Keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(uint64(7), exported.LastDrandRound)
+
+	// The fallback beacon imported as the latest beacon keeps the round.
+	imported, importCtx := s.importGenesis(s.Ctx, nil, *exported)
+	round, err := imported.GetLastDrandRound(importCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(7), round)
+}
+
+func (s *KeeperSuite) TestGenesisEcvrfKeysRoundTrip() {
+	_, _, operator := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(operator).String()
+	s.Require().NoError(s.Keeper.SetEcvrfKey(s.Ctx, valAddr, make([]byte, 32)))
+
+	exported := s.Keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]vrftypes.EcvrfKey{{ValidatorAddress: valAddr, PublicKey: make([]byte, 32)}}, exported.EcvrfKeys)
+	s.importGenesis(s.Ctx, nil, *exported)
+
+	exported.EcvrfKeys = append(exported.EcvrfKeys, exported.EcvrfKeys[0])
+	s.Require().Error(exported.Validate())
+}
+
+// importGenesis initializes a keeper on a fresh store from gs and checks that
+// it exports gs unchanged.
+func (s *KeeperSuite) importGenesis(ctx sdk.Context, bank vrftypes.BankKeeper, gs vrftypes.GenesisState) (Keeper, sdk.Context) {
+	key := storetypes.NewKVStoreKey("vrf_import")
+	k := NewKeeper(runtime.NewKVStoreService(key), s.EncCfg.Codec, s.Authority, bank, nil, nil)
+	importCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("t_vrf_import")).Ctx.
+		WithBlockTime(ctx.BlockTime())
+
+	k.InitGenesis(importCtx, gs)
+	s.Require().Equal(&gs, k.ExportGenesis(importCtx))
+
+	return k, importCtx
 }
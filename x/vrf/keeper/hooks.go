@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,8 +27,12 @@ func (Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error { retu
 func (Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
 
 func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
-	// Best-effort cleanup: remove any identity binding for the removed validator.
-	return h.k.RemoveVrfIdentity(ctx, valAddr.String())
+	// Best-effort cleanup: remove any identity binding and ECVRF key of the
+	// removed validator.
+	if err := h.k.RemoveVrfIdentity(ctx, valAddr.String()); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return h.k.RemoveEcvrfKey(ctx, valAddr.String())
 }
 
 func (Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error { return nil }
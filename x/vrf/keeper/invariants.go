@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Routes of the x/vrf invariants.
+const (
+	AuthorityCommitteeInvariantRoute = "authority-committee"
+	LatestBeaconInvariantRoute       = "latest-beacon"
+	IdentityChainHashInvariantRoute  = "identity-chain-hash"
+	UniqueBlsKeyInvariantRoute       = "unique-bls-key"
+)
+
+var (
+	errInvariantBroken  = errors.New("vrf: invariant broken")
+	errUnknownInvariant = errors.New("vrf: unknown invariant")
+)
+
+// InvariantFunc checks one property of the module state. It returns a
+// description of the violation, or an empty string when the property holds.
+type InvariantFunc func(ctx context.Context, k Keeper) (violation string, err error)
+
+// Invariant is a registered x/vrf invariant.
+type Invariant struct {
+	Route string
+
+	// Cheap invariants read a bounded number of keys and hold on every valid
+	// state, so EndBlock can run them when invariant_check_interval_blocks is
+	// set.
+	Cheap bool
+
+	Check InvariantFunc
+}
+
+// Invariants returns the registered invariants of the module.
+func Invariants() []Invariant {
+	return []Invariant{
+		{Route: AuthorityCommitteeInvariantRoute, Cheap: true, Check: AuthorityCommitteeInvariant},
+		{Route: LatestBeaconInvariantRoute, Check: LatestBeaconInvariant},
+		{Route: IdentityChainHashInvariantRoute, Check: IdentityChainHashInvariant},
+		{Route: UniqueBlsKeyInvariantRoute, Check: UniqueBlsKeyInvariant},
+	}
+}
+
+// AuthorityCommitteeInvariant checks that the module authority is stored as a
+// committee member. InitGenesis adds it and RemoveCommitteeMember refuses to
+// remove it.
+func AuthorityCommitteeInvariant(ctx context.Context, k Keeper) (string, error) {
+	ok, err := k.committee.Has(ctx, k.authority)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return fmt.Sprintf("module authority %s is not a committee member", k.authority), nil
+	}
+
+	return "", nil
+}
+
+// LatestBeaconInvariant checks that, while VRF is enabled, the latest drand
+// beacon verifies against the current public key. A disabled chain may keep
+// the beacon of an older key. ECVRF fallback beacons carry no drand signature.
+//
+// A beacon above the round due at the block time cannot come from the current
+// drand chain: it is the last beacon of the chain before a chain hash switch,
+// kept until the new chain reaches its first round. The invariant skips it.
+// Such a beacon of the old chain may also stay below the due round when vote
+// extensions are off, so EndBlock does not run the invariant.
+func LatestBeaconInvariant(ctx context.Context, k Keeper) (string, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.Enabled {
+		return "", nil
+	}
+
+	beacon, err := k.latestBeacon.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if beacon.Source != types.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND {
+		return "", nil
+	}
+	if beacon.DrandRound > types.RoundAt(params, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return "", nil
+	}
+
+	if err := params.VerifyBeacon(beacon); err != nil {
+		return fmt.Sprintf("latest beacon does not verify: %v", err), nil
+	}
+
+	return "", nil
+}
+
+// IdentityChainHashInvariant checks that every identity bound to a drand chain
+// is bound to the chain of the current params. Identities registered before
+// the initial DKG carry no chain hash.
+func IdentityChainHashInvariant(ctx context.Context, k Keeper) (string, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(params.ChainHash) == 0 {
+		return "", nil
+	}
+
+	var stale []string
+	err = k.identities.Walk(ctx, nil, func(addr string, identity types.VrfIdentity) (bool, error) {
+		if len(identity.ChainHash) > 0 && !bytes.Equal(identity.ChainHash, params.ChainHash) {
+			stale = append(stale, fmt.Sprintf("%s is bound to chain %x", addr, identity.ChainHash))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(stale) > 0 {
+		return fmt.Sprintf("identities not bound to chain %x:\n%s", params.ChainHash, strings.Join(stale, "\n")), nil
+	}
+
+	return "", nil
+}
+
+// UniqueBlsKeyInvariant checks that no two validators registered the same
+// drand BLS public key.
+func UniqueBlsKeyInvariant(ctx context.Context, k Keeper) (string, error) {
+	owners := make(map[string]string)
+	var dups []string
+	err := k.identities.Walk(ctx, nil, func(addr string, identity types.VrfIdentity) (bool, error) {
+		key := hex.EncodeToString(identity.DrandBlsPublicKey)
+		if owner, ok := owners[key]; ok {
+			dups = append(dups, fmt.Sprintf("%s and %s share key %s", owner, addr, key))
+			return false, nil
+		}
+		owners[key] = addr
+		return false, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(dups) > 0 {
+		return strings.Join(dups, "\n"), nil
+	}
+
+	return "", nil
+}
+
+// RunInvariants checks the invariant with the given route, or all invariants
+// when route is empty, and reports the outcome of each.
+func (k Keeper) RunInvariants(ctx context.Context, route string) ([]types.InvariantResult, error) {
+	var results []types.InvariantResult
+	for _, inv := range Invariants() {
+		if route != "" && inv.Route != route {
+			continue
+		}
+
+		violation, err := inv.Check(ctx, k)
+		if err != nil {
+			return nil, fmt.Errorf("vrf: %s invariant: %w", inv.Route, err)
+		}
+		results = append(results, types.InvariantResult{
+			Route:   inv.Route,
+			Broken:  violation != "",
+			Message: violation,
+		})
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: %q", errUnknownInvariant, route)
+	}
+
+	return results, nil
+}
+
+// AssertInvariants checks the invariants, only the cheap ones when cheapOnly
+// is set, and returns an error naming the first broken one.
+func (k Keeper) AssertInvariants(ctx context.Context, cheapOnly bool) error {
+	for _, inv := range Invariants() {
+		if cheapOnly && !inv.Cheap {
+			continue
+		}
+
+		violation, err := inv.Check(ctx, k)
+		if err != nil {
+			return fmt.Errorf("vrf: %s invariant: %w", inv.Route, err)
+		}
+		if violation != "" {
+			return fmt.Errorf("%w: %s: %s", errInvariantBroken, inv.Route, violation)
+		}
+	}
+
+	return nil
+}
+
+// EndBlocker prunes the expired emergency tx records and checks the cheap
+// invariants every invariant_check_interval_blocks blocks. A broken invariant
+// fails the block and so halts the chain.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.PruneEmergencyTxs(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.InvariantCheckIntervalBlocks == 0 {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height <= 0 || uint64(height)%params.InvariantCheckIntervalBlocks != 0 {
+		return nil
+	}
+
+	return k.AssertInvariants(ctx, true)
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+func (s *KeeperSuite) requireBroken(route string, broken bool) {
+	results, err := s.Keeper.RunInvariants(s.Ctx, route)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().Equal(route, results[0].Route)
+	s.Require().Equal(broken, results[0].Broken, results[0].Message)
+}
+
+func (s *KeeperSuite) TestAuthorityCommitteeInvariant() {
+	s.requireBroken(AuthorityCommitteeInvariantRoute, true)
+
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{Address: s.Keeper.GetAuthority(), Label: "module_authority"}))
+	s.requireBroken(AuthorityCommitteeInvariantRoute, false)
+}
+
+func (s *KeeperSuite) TestLatestBeaconInvariant() {
+	scheme := crypto.NewPedersenBLSChained()
+	secret := scheme.KeyGroup.Scalar().SetInt64(7)
+	pubKey, err := scheme.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
+	s.Require().NoError(err)
+
+	params := vrftypes.DefaultParams()
+	params.PublicKey = pubKey
+	params.GenesisSeed = []byte("genesis seed")
+	params.ChainHash, err = params.DrandChainHash()
+	s.Require().NoError(err)
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	prev := []byte("previous signature")
+	sig, err := scheme.AuthScheme.Sign(secret, scheme.DigestBeacon(&common.Beacon{Round: 5, PreviousSig: prev}))
+	s.Require().NoError(err)
+	randomness := sha256.Sum256(sig)
+	beacon := vrftypes.VrfBeacon{DrandRound: 5, Randomness: randomness[:], Signature: sig, PreviousSignature: prev}
+
+	// No beacon yet.
+	s.requireBroken(LatestBeaconInvariantRoute, false)
+
+	beacon.DrandRound = 6
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, beacon))
+
+	// A disabled chain may keep a beacon it no longer verifies.
+	s.requireBroken(LatestBeaconInvariantRoute, false)
+
+	params.Enabled = true
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	s.requireBroken(LatestBeaconInvariantRoute, true)
+
+	beacon.DrandRound = 5
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, beacon))
+	s.requireBroken(LatestBeaconInvariantRoute, false)
+
+	// After a chain hash switch, the new chain starts in the future and the
+	// beacon of the old chain, above the due round, is kept.
+	params.GenesisUnixSec = s.Ctx.BlockTime().Unix() + 60
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	s.requireBroken(LatestBeaconInvariantRoute, false)
+	params.GenesisUnixSec = 0
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	// Nor does EndBlock check it.
+	params.InvariantCheckIntervalBlocks = 1
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{Address: s.Keeper.GetAuthority(), Label: "module_authority"}))
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, vrftypes.VrfBeacon{DrandRound: 5, Signature: []byte("old chain")}))
+	s.requireBroken(LatestBeaconInvariantRoute, true)
+	s.Require().NoError(s.Keeper.AssertInvariants(s.Ctx, true))
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, beacon))
+
+	s.Require().NoError(s.Keeper.SetLatestBeacon(s.Ctx, vrftypes.VrfBeacon{
+		Randomness: []byte("fallback"),
+		Source:     vrftypes.VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF,
+	}))
+	s.requireBroken(LatestBeaconInvariantRoute, false)
+}
+
+func (s *KeeperSuite) TestIdentityChainHashInvariant() {
+	_, _, addrA := testdata.KeyTestPubAddr()
+	_, _, addrB := testdata.KeyTestPubAddr()
+	valA := sdk.ValAddress(addrA).String()
+	valB := sdk.ValAddress(addrB).String()
+
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: valA, DrandBlsPublicKey: []byte("pk-a")}))
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: valB, DrandBlsPublicKey: []byte("pk-b"), ChainHash: []byte{0xaa}}))
+
+	// Params without chain-info bind nothing.
+	s.requireBroken(IdentityChainHashInvariantRoute, false)
+
+	params := vrftypes.DefaultParams()
+	params.ChainHash = []byte{0xaa}
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	s.requireBroken(IdentityChainHashInvariantRoute, false)
+
+	params.ChainHash = []byte{0xbb}
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	s.requireBroken(IdentityChainHashInvariantRoute, true)
+}
+
+func (s *KeeperSuite) TestUniqueBlsKeyInvariant() {
+	_, _, addrA := testdata.KeyTestPubAddr()
+	_, _, addrB := testdata.KeyTestPubAddr()
+	valA := sdk.ValAddress(addrA).String()
+	valB := sdk.ValAddress(addrB).String()
+
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: valA, DrandBlsPublicKey: []byte("pk-a")}))
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: valB, DrandBlsPublicKey: []byte("pk-b")}))
+	s.requireBroken(UniqueBlsKeyInvariantRoute, false)
+
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: valB, DrandBlsPublicKey: []byte("pk-a")}))
+	s.requireBroken(UniqueBlsKeyInvariantRoute, true)
+}
+
+func (s *KeeperSuite) TestRunInvariants() {
+	results, err := s.Keeper.RunInvariants(s.Ctx, "")
+	s.Require().NoError(err)
+	s.Require().Len(results, len(Invariants()))
+
+	_, err = s.Keeper.RunInvariants(s.Ctx, "unknown")
+	s.Require().ErrorIs(err, errUnknownInvariant)
+
+	resp, err := s.QueryServer.Invariants(s.Ctx, &vrftypes.QueryInvariantsRequest{Route: AuthorityCommitteeInvariantRoute})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Results, 1)
+	s.Require().True(resp.Results[0].Broken)
+}
+
+func (s *KeeperSuite) TestEndBlockerInvariants() {
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+
+	// The authority is not a committee member, but checks are disabled.
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+
+	params := vrftypes.DefaultParams()
+	params.InvariantCheckIntervalBlocks = 4
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	// Height 10 is not a check height.
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockHeight(12)
+	s.Require().ErrorIs(s.Keeper.EndBlocker(s.Ctx), errInvariantBroken)
+
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{Address: s.Keeper.GetAuthority(), Label: "module_authority"}))
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+
+	// EndBlock skips the identity walks.
+	_, _, addrA := testdata.KeyTestPubAddr()
+	_, _, addrB := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: sdk.ValAddress(addrA).String(), DrandBlsPublicKey: []byte("pk")}))
+	s.Require().NoError(s.Keeper.SetVrfIdentity(s.Ctx, vrftypes.VrfIdentity{ValidatorAddress: sdk.ValAddress(addrB).String(), DrandBlsPublicKey: []byte("pk")}))
+	s.Require().NoError(s.Keeper.EndBlocker(s.Ctx))
+	s.Require().ErrorIs(s.Keeper.AssertInvariants(s.Ctx, false), errInvariantBroken)
+}
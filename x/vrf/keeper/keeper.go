@@ -5,14 +5,51 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dgtlkitchen/vrf/x/vrf/types"
 )
 
-var errCannotRemoveModuleAuthority = errors.New("vrf: cannot remove module authority from committee")
+var (
+	errCannotRemoveModuleAuthority = errors.New("vrf: cannot remove module authority from committee")
+	errNoStakingKeeper             = errors.New("vrf: staking keeper not configured")
+)
+
+// IdentityIndexes are the secondary indexes of the stored VRF identities.
+type IdentityIndexes struct {
+	// ChainHash indexes identities by the drand chain hash they are bound to.
+	ChainHash *indexes.Multi[[]byte, string, types.VrfIdentity]
+	// BlsKey indexes identities by their drand BLS public key.
+	BlsKey *indexes.Multi[[]byte, string, types.VrfIdentity]
+}
+
+func (i IdentityIndexes) IndexesList() []collections.Index[string, types.VrfIdentity] {
+	return []collections.Index[string, types.VrfIdentity]{i.ChainHash, i.BlsKey}
+}
+
+func newIdentityIndexes(sb *collections.SchemaBuilder) IdentityIndexes {
+	return IdentityIndexes{
+		ChainHash: indexes.NewMulti(
+			sb, collections.NewPrefix(17), "vrf_identities_by_chain_hash",
+			collections.BytesKey, collections.StringKey,
+			func(_ string, identity types.VrfIdentity) ([]byte, error) {
+				return identity.ChainHash, nil
+			},
+		),
+		BlsKey: indexes.NewMulti(
+			sb, collections.NewPrefix(20), "vrf_identities_by_bls_key",
+			collections.BytesKey, collections.StringKey,
+			func(_ string, identity types.VrfIdentity) ([]byte, error) {
+				return identity.DrandBlsPublicKey, nil
+			},
+		),
+	}
+}
 
 type Keeper struct {
 	storeService store.KVStoreService
@@ -20,35 +57,82 @@ type Keeper struct {
 
 	authority string
 
-	schema              collections.Schema
-	params              collections.Item[types.VrfParams]
-	latestBeacon        collections.Item[types.VrfBeacon]
-	lastBlockTime       collections.Item[int64]
-	prevBlockTime       collections.Item[int64]
-	paramsUpdatedHeight collections.Item[int64]
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
+
+	hooks types.TimelockHooks
+
+	schema        collections.Schema
+	params        collections.Item[types.VrfParams]
+	pendingParams collections.Item[types.VrfPendingParams]
+	latestBeacon  collections.Item[types.VrfBeacon]
+	lastBlockTime collections.Item[int64]
+	prevBlockTime collections.Item[int64]
 
-	committee  collections.Map[string, string]
-	identities collections.Map[string, types.VrfIdentity]
+	committee  collections.Map[string, types.AllowlistEntry]
+	identities *collections.IndexedMap[string, types.VrfIdentity, IdentityIndexes]
+
+	participation collections.Map[int64, types.VrfParticipation]
+
+	requestSeq      collections.Sequence
+	requests        collections.Map[uint64, types.RandomnessRequest]
+	pendingRequests collections.KeySet[collections.Pair[uint64, uint64]]
+	requestEscrow   collections.Map[string, math.Int]
+
+	timelockSeq     collections.Sequence
+	timelocked      collections.Map[uint64, types.TimelockedMessage]
+	pendingTimelock collections.KeySet[collections.Pair[uint64, uint64]]
+
+	fallbackSeed   collections.Item[[]byte]
+	lastDrandRound collections.Item[uint64]
+	ecvrfKeys      collections.Map[string, []byte]
+
+	emergencyTxs         collections.Map[[]byte, uint64]
+	emergencyTxsByExpiry collections.KeySet[collections.Pair[uint64, []byte]]
+	emergencyTxsBySigner collections.Map[collections.Pair[[]byte, []byte], uint64]
 }
 
 func NewKeeper(
 	ss store.KVStoreService,
 	cdc codec.BinaryCodec,
 	authority string,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(ss)
 
 	k := Keeper{
-		storeService:        ss,
-		cdc:                 cdc,
-		authority:           authority,
-		params:              collections.NewItem(sb, collections.NewPrefix(0), "params", codec.CollValue[types.VrfParams](cdc)),
-		latestBeacon:        collections.NewItem(sb, collections.NewPrefix(1), "latest_beacon", codec.CollValue[types.VrfBeacon](cdc)),
-		lastBlockTime:       collections.NewItem(sb, collections.NewPrefix(2), "last_block_time", collections.Int64Value),
-		prevBlockTime:       collections.NewItem(sb, collections.NewPrefix(6), "prev_block_time", collections.Int64Value),
-		paramsUpdatedHeight: collections.NewItem(sb, collections.NewPrefix(4), "params_updated_height", collections.Int64Value),
-		committee:           collections.NewMap(sb, collections.NewPrefix(3), "vrf_committee", collections.StringKey, collections.StringValue),
-		identities:          collections.NewMap(sb, collections.NewPrefix(5), "vrf_identities", collections.StringKey, codec.CollValue[types.VrfIdentity](cdc)),
+		storeService:    ss,
+		cdc:             cdc,
+		authority:       authority,
+		bankKeeper:      bankKeeper,
+		stakingKeeper:   stakingKeeper,
+		distrKeeper:     distrKeeper,
+		params:          collections.NewItem(sb, collections.NewPrefix(0), "params", codec.CollValue[types.VrfParams](cdc)),
+		latestBeacon:    collections.NewItem(sb, collections.NewPrefix(1), "latest_beacon", codec.CollValue[types.VrfBeacon](cdc)),
+		lastBlockTime:   collections.NewItem(sb, collections.NewPrefix(2), "last_block_time", collections.Int64Value),
+		prevBlockTime:   collections.NewItem(sb, collections.NewPrefix(6), "prev_block_time", collections.Int64Value),
+		committee:       collections.NewMap(sb, collections.NewPrefix(3), "vrf_committee", collections.StringKey, codec.CollValue[types.AllowlistEntry](cdc)),
+		identities:      collections.NewIndexedMap(sb, collections.NewPrefix(5), "vrf_identities", collections.StringKey, codec.CollValue[types.VrfIdentity](cdc), newIdentityIndexes(sb)),
+		participation:   collections.NewMap(sb, collections.NewPrefix(7), "vrf_participation", collections.Int64Key, codec.CollValue[types.VrfParticipation](cdc)),
+		requestSeq:      collections.NewSequence(sb, collections.NewPrefix(8), "randomness_request_seq"),
+		requests:        collections.NewMap(sb, collections.NewPrefix(9), "randomness_requests", collections.Uint64Key, codec.CollValue[types.RandomnessRequest](cdc)),
+		pendingRequests: collections.NewKeySet(sb, collections.NewPrefix(10), "pending_randomness_requests", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		requestEscrow:   collections.NewMap(sb, collections.NewPrefix(11), "randomness_request_escrow", collections.StringKey, sdk.IntValue),
+		timelockSeq:     collections.NewSequence(sb, collections.NewPrefix(12), "timelock_seq"),
+		timelocked:      collections.NewMap(sb, collections.NewPrefix(13), "timelocked_messages", collections.Uint64Key, codec.CollValue[types.TimelockedMessage](cdc)),
+		pendingTimelock: collections.NewKeySet(sb, collections.NewPrefix(14), "pending_timelocked_messages", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		fallbackSeed:    collections.NewItem(sb, collections.NewPrefix(15), "ecvrf_fallback_seed", collections.BytesValue),
+		pendingParams:   collections.NewItem(sb, collections.NewPrefix(16), "pending_params", codec.CollValue[types.VrfPendingParams](cdc)),
+
+		emergencyTxs:         collections.NewMap(sb, collections.NewPrefix(18), "emergency_txs", collections.BytesKey, collections.Uint64Value),
+		emergencyTxsByExpiry: collections.NewKeySet(sb, collections.NewPrefix(19), "emergency_txs_by_expiry", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+		emergencyTxsBySigner: collections.NewMap(sb, collections.NewPrefix(21), "emergency_txs_by_signer", collections.PairKeyCodec(collections.BytesKey, collections.BytesKey), collections.Uint64Value),
+
+		lastDrandRound: collections.NewItem(sb, collections.NewPrefix(22), "last_drand_round", collections.Uint64Value),
+		ecvrfKeys:      collections.NewMap(sb, collections.NewPrefix(23), "ecvrf_keys", collections.StringKey, collections.BytesValue),
 	}
 
 	schema, err := sb.Build()
@@ -61,6 +145,18 @@ func NewKeeper(
 	return k
 }
 
+// SetHooks sets the consumer of decrypted time-locked messages. It panics if
+// called twice.
+func (k *Keeper) SetHooks(h types.TimelockHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set vrf hooks twice")
+	}
+
+	k.hooks = h
+
+	return k
+}
+
 func (k Keeper) Schema() collections.Schema {
 	return k.schema
 }
@@ -85,8 +181,29 @@ func (k Keeper) GetLatestBeacon(ctx context.Context) (types.VrfBeacon, error) {
 	return k.latestBeacon.Get(ctx)
 }
 
+// SetLatestBeacon stores the latest beacon. A drand beacon also becomes the
+// last drand round.
 func (k Keeper) SetLatestBeacon(ctx context.Context, b types.VrfBeacon) error {
-	return k.latestBeacon.Set(ctx, b)
+	if err := k.latestBeacon.Set(ctx, b); err != nil {
+		return err
+	}
+	if b.Source != types.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND {
+		return nil
+	}
+
+	return k.lastDrandRound.Set(ctx, b.DrandRound)
+}
+
+// GetLastDrandRound returns the round of the latest finalized drand beacon,
+// or zero before the first one. Unlike the latest beacon, it is kept while
+// ECVRF fallback beacons are finalized.
+func (k Keeper) GetLastDrandRound(ctx context.Context) (uint64, error) {
+	round, err := k.lastDrandRound.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return round, err
 }
 
 func (k Keeper) GetLastBlockTime(ctx context.Context) (int64, error) {
@@ -118,24 +235,18 @@ func (k Keeper) GetPrevBlockTime(ctx context.Context) (int64, error) {
 	return ts, nil
 }
 
-func (k Keeper) GetParamsUpdatedHeight(ctx context.Context) (int64, error) {
-	height, err := k.paramsUpdatedHeight.Get(ctx)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return 0, nil
-		}
-		return 0, err
+// SetCommitteeMember adds or updates a committee member in the on-chain
+// allowlist, replacing its roles.
+func (k Keeper) SetCommitteeMember(ctx context.Context, member types.AllowlistEntry) error {
+	if err := member.Validate(); err != nil {
+		return err
 	}
-	return height, nil
+	return k.committee.Set(ctx, member.Address, member)
 }
 
-func (k Keeper) SetParamsUpdatedHeight(ctx context.Context, height int64) error {
-	return k.paramsUpdatedHeight.Set(ctx, height)
-}
-
-// SetCommitteeMember adds or updates a committee member in the on-chain allowlist.
-func (k Keeper) SetCommitteeMember(ctx context.Context, addr string, label string) error {
-	return k.committee.Set(ctx, addr, label)
+// GetCommitteeMember returns the allowlist entry of a committee member.
+func (k Keeper) GetCommitteeMember(ctx context.Context, addr string) (types.AllowlistEntry, error) {
+	return k.committee.Get(ctx, addr)
 }
 
 // RemoveCommitteeMember removes a committee member from the allowlist. The module
@@ -147,13 +258,23 @@ func (k Keeper) RemoveCommitteeMember(ctx context.Context, addr string) error {
 	return k.committee.Remove(ctx, addr)
 }
 
-// IsCommitteeMember returns true if the given bech32 address string is present
-// in the committee allowlist, or if it is the module authority.
-func (k Keeper) IsCommitteeMember(ctx context.Context, addr string) (bool, error) {
+// HasCommitteeRole returns true if the given bech32 address string is a
+// committee member holding role at the current height, or if it is the module
+// authority, which holds every role.
+func (k Keeper) HasCommitteeRole(ctx context.Context, addr string, role types.VrfCommitteeRole) (bool, error) {
 	if addr == k.authority {
 		return true, nil
 	}
-	return k.committee.Has(ctx, addr)
+
+	member, err := k.committee.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return member.HasRole(role, sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
 }
 
 // SetVrfIdentity upserts a validator's VRF identity binding.
@@ -165,3 +286,66 @@ func (k Keeper) SetVrfIdentity(ctx context.Context, identity types.VrfIdentity)
 func (k Keeper) RemoveVrfIdentity(ctx context.Context, validatorAddr string) error {
 	return k.identities.Remove(ctx, validatorAddr)
 }
+
+// SetEcvrfKey registers the ed25519 public key of a validator's ECVRF
+// fallback proofs.
+func (k Keeper) SetEcvrfKey(ctx context.Context, validatorAddr string, pubKey []byte) error {
+	return k.ecvrfKeys.Set(ctx, validatorAddr, pubKey)
+}
+
+// RemoveEcvrfKey removes a validator's ECVRF fallback key.
+func (k Keeper) RemoveEcvrfKey(ctx context.Context, validatorAddr string) error {
+	return k.ecvrfKeys.Remove(ctx, validatorAddr)
+}
+
+// GetEcvrfKeyByConsAddr returns the ECVRF fallback key of the validator with
+// the given consensus address.
+func (k Keeper) GetEcvrfKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) ([]byte, error) {
+	if k.stakingKeeper == nil {
+		return nil, errNoStakingKeeper
+	}
+
+	val, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.ecvrfKeys.Get(ctx, val.GetOperator())
+}
+
+// IdentitiesByChainHash returns the VRF identities bound to the drand chain
+// with the given hash, ordered by validator address.
+func (k Keeper) IdentitiesByChainHash(ctx context.Context, chainHash []byte) ([]types.VrfIdentity, error) {
+	iter, err := k.identities.Indexes.ChainHash.MatchExact(ctx, chainHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexes.CollectValues(ctx, k.identities, iter)
+}
+
+// blsKeyHolders returns the validator addresses of the identities registered
+// with the drand BLS public key.
+func (k Keeper) blsKeyHolders(ctx context.Context, key []byte) ([]string, error) {
+	iter, err := k.identities.Indexes.BlsKey.MatchExact(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.PrimaryKeys()
+}
+
+// GetVrfIdentityByConsAddr returns the VRF identity of the validator with the
+// given consensus address.
+func (k Keeper) GetVrfIdentityByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (types.VrfIdentity, error) {
+	if k.stakingKeeper == nil {
+		return types.VrfIdentity{}, errNoStakingKeeper
+	}
+
+	val, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return types.VrfIdentity{}, err
+	}
+
+	return k.identities.Get(ctx, val.GetOperator())
+}
@@ -20,15 +20,25 @@ func (s *KeeperSuite) TestParams() {
 	s.Require().Error(s.Keeper.SetParams(s.Ctx, bad))
 }
 
-func (s *KeeperSuite) TestParamsUpdatedHeight() {
-	h, err := s.Keeper.GetParamsUpdatedHeight(s.Ctx)
+func (s *KeeperSuite) TestPendingParams() {
+	_, ok, err := s.Keeper.GetPendingParams(s.Ctx)
 	s.Require().NoError(err)
-	s.Require().Equal(int64(0), h)
+	s.Require().False(ok)
+
+	bad := vrftypes.VrfPendingParams{Params: vrftypes.DefaultParams()}
+	s.Require().Error(s.Keeper.SetPendingParams(s.Ctx, bad))
+
+	latest, err := s.Keeper.LatestParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.DefaultParams(), latest)
+
+	pending := vrftypes.VrfPendingParams{Params: vrftypes.DefaultParams(), ActivationHeight: 42}
+	pending.Params.RoundTolerance = 1
+	s.Require().NoError(s.Keeper.SetPendingParams(s.Ctx, pending))
 
-	s.Require().NoError(s.Keeper.SetParamsUpdatedHeight(s.Ctx, 42))
-	h, err = s.Keeper.GetParamsUpdatedHeight(s.Ctx)
+	latest, err = s.Keeper.LatestParams(s.Ctx)
 	s.Require().NoError(err)
-	s.Require().Equal(int64(42), h)
+	s.Require().Equal(pending.Params, latest)
 }
 
 func (s *KeeperSuite) TestBlockTimes() {
@@ -55,27 +65,61 @@ func (s *KeeperSuite) TestBlockTimes() {
 
 func (s *KeeperSuite) TestCommittee() {
 	authority := s.Keeper.GetAuthority()
-	ok, err := s.Keeper.IsCommitteeMember(s.Ctx, authority)
-	s.Require().NoError(err)
-	s.Require().True(ok)
+	for _, role := range vrftypes.AllCommitteeRoles() {
+		ok, err := s.Keeper.HasCommitteeRole(s.Ctx, authority, role)
+		s.Require().NoError(err)
+		s.Require().True(ok)
+	}
 
 	s.Require().ErrorIs(s.Keeper.RemoveCommitteeMember(s.Ctx, authority), errCannotRemoveModuleAuthority)
 
 	_, _, addr := testdata.KeyTestPubAddr()
 	addrStr := addr.String()
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, addrStr, "test"))
-	ok, err = s.Keeper.IsCommitteeMember(s.Ctx, addrStr)
-	s.Require().NoError(err)
-	s.Require().True(ok)
+	entry := vrftypes.AllowlistEntry{
+		Address: addrStr,
+		Label:   "test",
+		Roles: []vrftypes.VrfCommitteeRoleGrant{
+			{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY},
+			{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP, ExpiryHeight: 5},
+		},
+	}
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, entry))
 
-	label, err := s.Keeper.committee.Get(s.Ctx, addrStr)
+	stored, err := s.Keeper.GetCommitteeMember(s.Ctx, addrStr)
 	s.Require().NoError(err)
-	s.Require().Equal("test", label)
+	s.Require().Equal(entry, stored)
+
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY, true)
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP, true)
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER, false)
+
+	// A grant lapses at its expiry height.
+	s.Ctx = s.Ctx.WithBlockHeight(5)
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY, true)
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP, false)
+
+	entry.Roles = append(entry.Roles, vrftypes.VrfCommitteeRoleGrant{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY})
+	s.Require().ErrorContains(s.Keeper.SetCommitteeMember(s.Ctx, entry), "granted more than once")
 
 	s.Require().NoError(s.Keeper.RemoveCommitteeMember(s.Ctx, addrStr))
-	ok, err = s.Keeper.IsCommitteeMember(s.Ctx, addrStr)
+	s.requireRole(addrStr, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY, false)
+	_, err = s.Keeper.GetCommitteeMember(s.Ctx, addrStr)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperSuite) requireRole(addr string, role vrftypes.VrfCommitteeRole, want bool) {
+	ok, err := s.Keeper.HasCommitteeRole(s.Ctx, addr, role)
 	s.Require().NoError(err)
-	s.Require().False(ok)
+	s.Require().Equal(want, ok, role.String())
+}
+
+// setCommitteeRole makes addr a committee member holding only role.
+func (s *KeeperSuite) setCommitteeRole(addr string, role vrftypes.VrfCommitteeRole) {
+	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, vrftypes.AllowlistEntry{
+		Address: addr,
+		Label:   role.String(),
+		Roles:   []vrftypes.VrfCommitteeRoleGrant{{Role: role}},
+	}))
 }
 
 func (s *KeeperSuite) TestIdentity() {
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 
@@ -13,13 +15,23 @@ import (
 )
 
 var (
-	errInvalidAuthority        = errors.New("vrf: invalid authority")
-	errSchedulerNotInCommittee = errors.New("vrf: scheduler is not in committee")
-	errInitiatorNotInCommittee = errors.New("vrf: initiator is not in committee")
-	errInitialDkgAlreadySet    = errors.New("vrf: initial dkg already set")
-	errReshareEpochTooLow      = errors.New("vrf: reshare_epoch must be > current")
+	errInvalidAuthority         = errors.New("vrf: invalid authority")
+	errSchedulerNotInCommittee  = errors.New("vrf: scheduler does not hold the reshare-scheduler committee role")
+	errInitiatorNotInCommittee  = errors.New("vrf: initiator does not hold the dkg-bootstrap committee role")
+	errRoleAlreadyExpired       = errors.New("vrf: committee role expiry_height is not above the current height")
+	errInitialDkgAlreadySet     = errors.New("vrf: initial dkg already set")
+	errReshareEpochTooLow       = errors.New("vrf: reshare_epoch must be > current")
+	errBootstrapBeaconNotRecent = errors.New("vrf: bootstrap beacon is not recent")
+	errIdentityProofInvalid     = errors.New("vrf: drand_bls_proof does not verify against drand_bls_public_key")
+	errBlsKeyTaken              = errors.New("vrf: drand_bls_public_key is registered by another validator")
+	errEcvrfKeyProofInvalid     = errors.New("vrf: ecvrf_proof does not verify against ecvrf_public_key")
+	errEcvrfKeyIsConsensusKey   = errors.New("vrf: ecvrf_public_key must not be the validator's consensus key")
 )
 
+// maxBootstrapBeaconAge bounds how far the bootstrap beacon of MsgInitialDkg
+// may lag the block time, so that it shows the group is live now.
+const maxBootstrapBeaconAge = 10 * time.Minute
+
 type msgServer struct {
 	k Keeper
 }
@@ -44,13 +56,11 @@ func (s msgServer) InitialDkg(
 	ctx context.Context,
 	msg *types.MsgInitialDkg,
 ) (*types.MsgInitialDkgResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
-	allowed, err := s.k.IsCommitteeMember(ctx, msg.Initiator)
+	allowed, err := s.k.HasCommitteeRole(ctx, msg.Initiator, types.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +68,8 @@ func (s msgServer) InitialDkg(
 		return nil, fmt.Errorf("%w: %s", errInitiatorNotInCommittee, msg.Initiator)
 	}
 
-	params, err := s.k.GetParams(ctx)
+	// Build on a pending change so that a scheduled chain-info counts as set.
+	params, err := s.k.LatestParams(ctx)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return nil, err
 	}
@@ -74,6 +85,8 @@ func (s msgServer) InitialDkg(
 	params.PublicKey = msg.PublicKey
 	params.PeriodSeconds = msg.PeriodSeconds
 	params.GenesisUnixSec = msg.GenesisUnixSec
+	params.GenesisSeed = msg.GenesisSeed
+	params.BeaconId = msg.BeaconId
 
 	// Keep safety_margin_seconds valid if the period differs from defaults.
 	if params.SafetyMarginSeconds < params.PeriodSeconds {
@@ -85,10 +98,27 @@ func (s msgServer) InitialDkg(
 		params.ReshareEpoch = 1
 	}
 
-	if err := s.k.SetParams(ctx, params); err != nil {
-		return nil, err
+	// The scheme_id comes from the current params, so the key group can only
+	// be checked here rather than in ValidateBasic.
+	if err := params.ValidateDrandChain(); err != nil {
+		return nil, fmt.Errorf("MsgInitialDkg: %w", err)
 	}
-	if err := s.k.SetParamsUpdatedHeight(ctx, sdkCtx.BlockHeight()); err != nil {
+
+	activationHeight := msg.ActivationHeight
+	if msg.BootstrapBeacon != nil {
+		if err := verifyBootstrapBeacon(sdk.UnwrapSDKContext(ctx), params, *msg.BootstrapBeacon); err != nil {
+			return nil, fmt.Errorf("MsgInitialDkg: %w", err)
+		}
+
+		// A proven-live group may be enabled in the same transaction.
+		if params.BootstrapEnableDelayBlocks > 0 {
+			params.Enabled = true
+			delay := max(int64(params.BootstrapEnableDelayBlocks), MinParamsActivationDelay)
+			activationHeight = max(activationHeight, sdk.UnwrapSDKContext(ctx).BlockHeight()+delay)
+		}
+	}
+
+	if _, err := s.k.ScheduleParams(ctx, params, activationHeight); err != nil {
 		return nil, err
 	}
 
@@ -99,8 +129,6 @@ func (s msgServer) UpdateParams(
 	ctx context.Context,
 	msg *types.MsgUpdateParams,
 ) (*types.MsgUpdateParamsResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
@@ -109,10 +137,7 @@ func (s msgServer) UpdateParams(
 		return nil, fmt.Errorf("%w; expected %s, got %s", errInvalidAuthority, s.k.GetAuthority(), msg.Authority)
 	}
 
-	if err := s.k.SetParams(ctx, msg.Params); err != nil {
-		return nil, err
-	}
-	if err := s.k.SetParamsUpdatedHeight(ctx, sdkCtx.BlockHeight()); err != nil {
+	if _, err := s.k.ScheduleParams(ctx, msg.Params, msg.ActivationHeight); err != nil {
 		return nil, err
 	}
 
@@ -131,7 +156,15 @@ func (s msgServer) AddVrfCommitteeMember(
 		return nil, fmt.Errorf("%w; expected %s, got %s", errInvalidAuthority, s.k.GetAuthority(), msg.Authority)
 	}
 
-	if err := s.k.SetCommitteeMember(ctx, msg.Address, msg.Label); err != nil {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, grant := range msg.Roles {
+		if !grant.Active(height) {
+			return nil, fmt.Errorf("%w: %s expires at %d", errRoleAlreadyExpired, grant.Role, grant.ExpiryHeight)
+		}
+	}
+
+	member := types.AllowlistEntry{Address: msg.Address, Label: msg.Label, Roles: msg.Roles}
+	if err := s.k.SetCommitteeMember(ctx, member); err != nil {
 		return nil, err
 	}
 
@@ -179,12 +212,47 @@ func (s msgServer) RegisterVrfIdentity(
 		return nil, err
 	}
 
+	// The operator must hold the key it registers, so that nobody can claim
+	// another validator's public share.
+	scheme, err := params.Scheme()
+	if err != nil {
+		return nil, err
+	}
+	if err := types.VerifyIdentityProof(scheme, msg.DrandBlsPublicKey, sdkCtx.ChainID(), validatorAddr, msg.ShareIndex, msg.DrandBlsProof); err != nil {
+		return nil, fmt.Errorf("%w: %w", errIdentityProofInvalid, err)
+	}
+
+	// With the proof above, a key already held by another validator means
+	// two operators share one drand key; refuse the second registration.
+	holders, err := s.k.blsKeyHolders(ctx, msg.DrandBlsPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range holders {
+		if addr != validatorAddr {
+			return nil, fmt.Errorf("%w: registered by %s", errBlsKeyTaken, addr)
+		}
+	}
+
 	identity := types.VrfIdentity{
 		ValidatorAddress:   validatorAddr,
 		DrandBlsPublicKey:  msg.DrandBlsPublicKey,
 		ChainHash:          params.ChainHash,
 		SignalUnixSec:      sdkCtx.BlockTime().Unix(),
 		SignalReshareEpoch: params.ReshareEpoch,
+		ShareIndex:         msg.ShareIndex,
+	}
+
+	// In threshold mode, current or scheduled, a share index belongs to a
+	// single bonded validator of the chain.
+	latest, err := s.k.LatestParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.ThresholdMode() || latest.ThresholdMode() {
+		if err := s.k.checkShareIndex(ctx, params.ChainHash, sdk.ValAddress(operatorAcc), msg.ShareIndex); err != nil {
+			return nil, err
+		}
 	}
 
 	// If the identity already exists, preserve the original signal time and signal epoch.
@@ -206,6 +274,51 @@ func (s msgServer) RegisterVrfIdentity(
 	return &types.MsgRegisterVrfIdentityResponse{}, nil
 }
 
+func (s msgServer) RegisterEcvrfKey(
+	ctx context.Context,
+	msg *types.MsgRegisterEcvrfKey,
+) (*types.MsgRegisterEcvrfKeyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	operatorAcc, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, fmt.Errorf("vrf: invalid operator address: %w", err)
+	}
+
+	validatorAddr := sdk.ValAddress(operatorAcc)
+
+	// The operator must hold the key it registers, so that nobody can replay
+	// another validator's fallback proofs.
+	alpha := types.ECVRFKeyProofInput(sdkCtx.ChainID(), validatorAddr.String())
+	if _, err := types.ECVRFVerify(msg.EcvrfPublicKey, alpha, msg.EcvrfProof); err != nil {
+		return nil, fmt.Errorf("%w: %w", errEcvrfKeyProofInvalid, err)
+	}
+
+	// The ECVRF key is kept on the node even where the consensus key lives in
+	// a remote signer, so it must not be the consensus key.
+	if s.k.stakingKeeper != nil {
+		if val, err := s.k.stakingKeeper.Validator(ctx, validatorAddr); err == nil {
+			consKey, err := val.ConsPubKey()
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(consKey.Bytes(), msg.EcvrfPublicKey) {
+				return nil, errEcvrfKeyIsConsensusKey
+			}
+		}
+	}
+
+	if err := s.k.SetEcvrfKey(ctx, validatorAddr.String(), msg.EcvrfPublicKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterEcvrfKeyResponse{}, nil
+}
+
 func (s msgServer) ScheduleVrfReshare(
 	ctx context.Context,
 	msg *types.MsgScheduleVrfReshare,
@@ -214,7 +327,7 @@ func (s msgServer) ScheduleVrfReshare(
 		return nil, err
 	}
 
-	allowed, err := s.k.IsCommitteeMember(ctx, msg.Scheduler)
+	allowed, err := s.k.HasCommitteeRole(ctx, msg.Scheduler, types.VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER)
 	if err != nil {
 		return nil, err
 	}
@@ -236,5 +349,74 @@ func (s msgServer) ScheduleVrfReshare(
 		return nil, err
 	}
 
+	// The epoch is bookkeeping only and applies immediately; carry it into a
+	// pending change so that activating it does not roll the epoch back.
+	pending, ok, err := s.k.GetPendingParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ok && pending.Params.ReshareEpoch < msg.ReshareEpoch {
+		pending.Params.ReshareEpoch = msg.ReshareEpoch
+		if err := s.k.SetPendingParams(ctx, pending); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgScheduleVrfReshareResponse{}, nil
 }
+
+func (s msgServer) RequestRandomness(
+	ctx context.Context,
+	msg *types.MsgRequestRandomness,
+) (*types.MsgRequestRandomnessResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, fmt.Errorf("vrf: invalid requester address: %w", err)
+	}
+
+	req, err := s.k.RequestRandomness(ctx, requester, msg.NumWords, msg.UserSeed, msg.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestRandomnessResponse{
+		RequestId:     req.Id,
+		MinDrandRound: req.MinDrandRound,
+		Fee:           req.Fee,
+	}, nil
+}
+
+func (s msgServer) SubmitTimelocked(
+	ctx context.Context,
+	msg *types.MsgSubmitTimelocked,
+) (*types.MsgSubmitTimelockedResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.k.SubmitTimelocked(ctx, msg.Submitter, msg.DrandRound, msg.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTimelockedResponse{Id: stored.Id}, nil
+}
+
+// verifyBootstrapBeacon checks that beacon is a valid beacon of the chain
+// params describe and that it is scheduled no later than one period after the
+// block time and no earlier than maxBootstrapBeaconAge before it.
+func verifyBootstrapBeacon(ctx sdk.Context, params types.VrfParams, beacon types.VrfBeacon) error {
+	roundTime := types.RoundTime(params, beacon.DrandRound)
+	now := ctx.BlockTime()
+	period := time.Duration(params.PeriodSeconds) * time.Second
+	if roundTime.After(now.Add(period)) || roundTime.Before(now.Add(-maxBootstrapBeaconAge)) {
+		return fmt.Errorf("%w: round %d is scheduled at %s, block time is %s",
+			errBootstrapBeaconNotRecent, beacon.DrandRound, roundTime.Format(time.RFC3339), now.UTC().Format(time.RFC3339))
+	}
+
+	return params.VerifyBeacon(beacon)
+}
@@ -1,11 +1,22 @@
 package keeper
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
 	"time"
 
+	"cosmossdk.io/collections"
+
+	sdked25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/util/random"
 
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
 )
 
@@ -28,13 +39,65 @@ func (s *KeeperSuite) TestMsgUpdateParams() {
 	_, err = s.MsgServer.UpdateParams(s.Ctx, msg)
 	s.Require().NoError(err)
 
+	// The change is pending until the earliest activation height.
+	stored, err := s.Keeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.DefaultParams(), stored)
+
+	pending, ok, err := s.Keeper.GetPendingParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal(params, pending.Params)
+	s.Require().Equal(s.Ctx.BlockHeight()+MinParamsActivationDelay, pending.ActivationHeight)
+
+	msg.ActivationHeight = s.Ctx.BlockHeight() + 1
+	_, err = s.MsgServer.UpdateParams(s.Ctx, msg)
+	s.Require().ErrorIs(err, errActivationHeightTooEarly)
+
+	msg.ActivationHeight = 20
+	_, err = s.MsgServer.UpdateParams(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	pending, _, err = s.Keeper.GetPendingParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), pending.ActivationHeight)
+}
+
+func (s *KeeperSuite) TestActivatePendingParams() {
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+
+	params := vrftypes.DefaultParams()
+	params.Enabled = true
+	_, err := s.Keeper.ScheduleParams(s.Ctx, params, 12)
+	s.Require().NoError(err)
+
+	// ExtendVote at 11 builds extensions for PreBlock at 12.
+	at, err := s.Keeper.ParamsAt(s.Ctx, 11)
+	s.Require().NoError(err)
+	s.Require().False(at.Enabled)
+	at, err = s.Keeper.ParamsAt(s.Ctx, 12)
+	s.Require().NoError(err)
+	s.Require().True(at.Enabled)
+
+	activated, err := s.Keeper.ActivatePendingParams(s.Ctx.WithBlockHeight(11))
+	s.Require().NoError(err)
+	s.Require().False(activated)
+
+	activated, err = s.Keeper.ActivatePendingParams(s.Ctx.WithBlockHeight(12))
+	s.Require().NoError(err)
+	s.Require().True(activated)
+
 	stored, err := s.Keeper.GetParams(s.Ctx)
 	s.Require().NoError(err)
 	s.Require().Equal(params, stored)
 
-	h, err := s.Keeper.GetParamsUpdatedHeight(s.Ctx)
+	_, ok, err := s.Keeper.GetPendingParams(s.Ctx)
 	s.Require().NoError(err)
-	s.Require().Equal(s.Ctx.BlockHeight(), h)
+	s.Require().False(ok)
+
+	resp, err := s.QueryServer.PendingParams(s.Ctx, &vrftypes.QueryPendingParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.PendingParams)
 }
 
 func (s *KeeperSuite) TestMsgCommittee() {
@@ -45,17 +108,24 @@ func (s *KeeperSuite) TestMsgCommittee() {
 		Authority: member,
 		Address:   member,
 		Label:     "member",
+		Roles:     []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY}},
 	}
 	_, err := s.MsgServer.AddVrfCommitteeMember(s.Ctx, msg)
 	s.Require().ErrorIs(err, errInvalidAuthority)
 
 	msg.Authority = s.Keeper.GetAuthority()
+	msg.Roles[0].ExpiryHeight = s.Ctx.BlockHeight()
+	_, err = s.MsgServer.AddVrfCommitteeMember(s.Ctx, msg)
+	s.Require().ErrorIs(err, errRoleAlreadyExpired)
+
+	msg.Roles[0].ExpiryHeight = s.Ctx.BlockHeight() + 10
 	_, err = s.MsgServer.AddVrfCommitteeMember(s.Ctx, msg)
 	s.Require().NoError(err)
 
-	label, err := s.Keeper.committee.Get(s.Ctx, member)
+	entry, err := s.Keeper.GetCommitteeMember(s.Ctx, member)
 	s.Require().NoError(err)
-	s.Require().Equal("member", label)
+	s.Require().Equal("member", entry.Label)
+	s.Require().Equal(msg.Roles, entry.Roles)
 
 	remove := &vrftypes.MsgRemoveVrfCommitteeMember{
 		Authority: member,
@@ -68,9 +138,8 @@ func (s *KeeperSuite) TestMsgCommittee() {
 	_, err = s.MsgServer.RemoveVrfCommitteeMember(s.Ctx, remove)
 	s.Require().NoError(err)
 
-	ok, err := s.Keeper.IsCommitteeMember(s.Ctx, member)
-	s.Require().NoError(err)
-	s.Require().False(ok)
+	_, err = s.Keeper.GetCommitteeMember(s.Ctx, member)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
 
 	remove.Address = s.Keeper.GetAuthority()
 	_, err = s.MsgServer.RemoveVrfCommitteeMember(s.Ctx, remove)
@@ -81,22 +150,46 @@ func (s *KeeperSuite) TestMsgInitialDkg() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	initiator := addr.String()
 
+	chain, err := vrftestutil.WithDrandChain(vrftypes.VrfParams{PeriodSeconds: 60, GenesisUnixSec: 1700002100})
+	s.Require().NoError(err)
+
 	msg := &vrftypes.MsgInitialDkg{
 		Initiator:      initiator,
 		ChainHash:      []byte{0x01},
-		PublicKey:      []byte("pk"),
-		PeriodSeconds:  60,
-		GenesisUnixSec: 1700002100,
+		PublicKey:      chain.PublicKey,
+		PeriodSeconds:  chain.PeriodSeconds,
+		GenesisUnixSec: chain.GenesisUnixSec,
+		GenesisSeed:    chain.GenesisSeed,
 	}
-	_, err := s.MsgServer.InitialDkg(s.Ctx, msg)
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
 	s.Require().ErrorIs(err, errInitiatorNotInCommittee)
 
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, initiator, "init"))
+	// Other roles do not allow a DKG bootstrap.
+	s.setCommitteeRole(initiator, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER)
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorIs(err, errInitiatorNotInCommittee)
+
+	s.setCommitteeRole(initiator, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP)
+
+	// The chain hash must be derived from the chain-info.
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorContains(err, "chain_hash does not match")
+
+	msg.ChainHash = chain.ChainHash
+	msg.PublicKey = []byte("pk")
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorContains(err, "not a valid point")
+
+	msg.PublicKey = chain.PublicKey
 	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
 	s.Require().NoError(err)
 
-	params, err := s.Keeper.GetParams(s.Ctx)
+	pending, ok, err := s.Keeper.GetPendingParams(s.Ctx)
 	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal(s.Ctx.BlockHeight()+MinParamsActivationDelay, pending.ActivationHeight)
+
+	params := pending.Params
 	s.Require().Equal(msg.ChainHash, params.ChainHash)
 	s.Require().Equal(msg.PublicKey, params.PublicKey)
 	s.Require().Equal(msg.PeriodSeconds, params.PeriodSeconds)
@@ -104,12 +197,84 @@ func (s *KeeperSuite) TestMsgInitialDkg() {
 	s.Require().Equal(uint64(1), params.ReshareEpoch)
 	s.Require().Equal(msg.PeriodSeconds, params.SafetyMarginSeconds)
 
-	h, err := s.Keeper.GetParamsUpdatedHeight(s.Ctx)
+	// A scheduled chain-info already counts as set.
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorIs(err, errInitialDkgAlreadySet)
+}
+
+func (s *KeeperSuite) TestMsgInitialDkgBootstrapBeacon() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	initiator := addr.String()
+	s.setCommitteeRole(initiator, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP)
+
+	scheme := crypto.NewPedersenBLSChained()
+	secret := scheme.KeyGroup.Scalar().SetInt64(7)
+	pubKey, err := scheme.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
 	s.Require().NoError(err)
-	s.Require().Equal(s.Ctx.BlockHeight(), h)
 
+	// Round 121 is scheduled at the block time.
+	chain := vrftypes.VrfParams{
+		PublicKey:      pubKey,
+		PeriodSeconds:  30,
+		GenesisUnixSec: s.Ctx.BlockTime().Unix() - 3600,
+		GenesisSeed:    []byte("genesis seed"),
+	}
+	chain.ChainHash, err = chain.DrandChainHash()
+	s.Require().NoError(err)
+
+	beaconAt := func(round uint64) *vrftypes.VrfBeacon {
+		prev := []byte("previous signature")
+		sig, err := scheme.AuthScheme.Sign(secret, scheme.DigestBeacon(&common.Beacon{Round: round, PreviousSig: prev}))
+		s.Require().NoError(err)
+		randomness := sha256.Sum256(sig)
+		return &vrftypes.VrfBeacon{DrandRound: round, Randomness: randomness[:], Signature: sig, PreviousSignature: prev}
+	}
+
+	msg := &vrftypes.MsgInitialDkg{
+		Initiator:      initiator,
+		ChainHash:      chain.ChainHash,
+		PublicKey:      chain.PublicKey,
+		PeriodSeconds:  chain.PeriodSeconds,
+		GenesisUnixSec: chain.GenesisUnixSec,
+		GenesisSeed:    chain.GenesisSeed,
+	}
+
+	msg.BootstrapBeacon = beaconAt(100)
 	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
-	s.Require().ErrorIs(err, errInitialDkgAlreadySet)
+	s.Require().ErrorIs(err, errBootstrapBeaconNotRecent)
+
+	msg.BootstrapBeacon = beaconAt(123)
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorIs(err, errBootstrapBeaconNotRecent)
+
+	msg.BootstrapBeacon = beaconAt(121)
+	msg.BootstrapBeacon.DrandRound = 122
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().ErrorContains(err, "beacon signature does not verify")
+
+	// Without bootstrap_enable_delay_blocks the beacon is only checked.
+	msg.BootstrapBeacon = beaconAt(121)
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	pending, ok, err := s.Keeper.GetPendingParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().False(pending.Params.Enabled)
+
+	s.Require().NoError(s.Keeper.pendingParams.Remove(s.Ctx))
+	params := vrftypes.DefaultParams()
+	params.BootstrapEnableDelayBlocks = 10
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	_, err = s.MsgServer.InitialDkg(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	pending, ok, err = s.Keeper.GetPendingParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().True(pending.Params.Enabled)
+	s.Require().Equal(s.Ctx.BlockHeight()+10, pending.ActivationHeight)
 }
 
 func (s *KeeperSuite) TestMsgRegisterIdentity() {
@@ -119,19 +284,16 @@ func (s *KeeperSuite) TestMsgRegisterIdentity() {
 	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
 
 	_, _, addr := testdata.KeyTestPubAddr()
-	operator := addr.String()
 	valAddr := sdk.ValAddress(addr).String()
 
-	msg := &vrftypes.MsgRegisterVrfIdentity{
-		Operator:          operator,
-		DrandBlsPublicKey: []byte("pk1"),
-	}
-	_, err := s.MsgServer.RegisterVrfIdentity(s.Ctx, msg)
+	msg, err := vrftestutil.NewRegisterIdentityMsg(params, s.Ctx.ChainID(), addr, 0)
+	s.Require().NoError(err)
+	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, msg)
 	s.Require().NoError(err)
 
 	identity, err := s.Keeper.identities.Get(s.Ctx, valAddr)
 	s.Require().NoError(err)
-	s.Require().Equal([]byte("pk1"), identity.DrandBlsPublicKey)
+	s.Require().Equal(msg.DrandBlsPublicKey, identity.DrandBlsPublicKey)
 	s.Require().Equal(params.ChainHash, identity.ChainHash)
 	s.Require().Equal(s.Ctx.BlockTime().Unix(), identity.SignalUnixSec)
 	s.Require().Equal(params.ReshareEpoch, identity.SignalReshareEpoch)
@@ -140,17 +302,198 @@ func (s *KeeperSuite) TestMsgRegisterIdentity() {
 	oldSignalEpoch := identity.SignalReshareEpoch
 
 	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1700003000, 0).UTC())
-	msg.DrandBlsPublicKey = []byte("pk2")
+	msg, err = vrftestutil.NewRegisterIdentityMsg(params, s.Ctx.ChainID(), addr, 0)
+	s.Require().NoError(err)
 	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, msg)
 	s.Require().NoError(err)
 
 	updated, err := s.Keeper.identities.Get(s.Ctx, valAddr)
 	s.Require().NoError(err)
-	s.Require().Equal([]byte("pk2"), updated.DrandBlsPublicKey)
+	s.Require().Equal(msg.DrandBlsPublicKey, updated.DrandBlsPublicKey)
 	s.Require().Equal(oldSignalTime, updated.SignalUnixSec)
 	s.Require().Equal(oldSignalEpoch, updated.SignalReshareEpoch)
 }
 
+func (s *KeeperSuite) TestMsgRegisterIdentityProof() {
+	params := vrftypes.DefaultParams()
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	_, _, owner := testdata.KeyTestPubAddr()
+	_, _, squatter := testdata.KeyTestPubAddr()
+
+	msg, err := vrftestutil.NewRegisterIdentityMsg(params, s.Ctx.ChainID(), owner, 1)
+	s.Require().NoError(err)
+
+	// The owner's proof does not let another operator register the key.
+	stolen := *msg
+	stolen.Operator = squatter.String()
+	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, &stolen)
+	s.Require().ErrorIs(err, errIdentityProofInvalid)
+
+	// Nor does it cover another share index or chain.
+	wrongIndex := *msg
+	wrongIndex.ShareIndex = 2
+	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, &wrongIndex)
+	s.Require().ErrorIs(err, errIdentityProofInvalid)
+
+	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx.WithChainID("other-chain"), msg)
+	s.Require().ErrorIs(err, errIdentityProofInvalid)
+
+	_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperSuite) TestMsgRegisterIdentityBlsKeyTaken() {
+	params := vrftypes.DefaultParams()
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+	scheme, err := params.Scheme()
+	s.Require().NoError(err)
+
+	// Both operators hold the same drand key and sign valid proofs with it.
+	priv := scheme.KeyGroup.Scalar().Pick(random.New())
+	pubKey, err := scheme.KeyGroup.Point().Mul(priv, nil).MarshalBinary()
+	s.Require().NoError(err)
+	register := func(operator sdk.AccAddress) error {
+		proof, err := vrftypes.SignIdentityProof(scheme, priv, s.Ctx.ChainID(), sdk.ValAddress(operator).String(), 1)
+		s.Require().NoError(err)
+		_, err = s.MsgServer.RegisterVrfIdentity(s.Ctx, &vrftypes.MsgRegisterVrfIdentity{
+			Operator:          operator.String(),
+			DrandBlsPublicKey: pubKey,
+			ShareIndex:        1,
+			DrandBlsProof:     proof,
+		})
+		return err
+	}
+
+	_, _, first := testdata.KeyTestPubAddr()
+	_, _, second := testdata.KeyTestPubAddr()
+	s.Require().NoError(register(first))
+	s.Require().ErrorIs(register(second), errBlsKeyTaken)
+
+	// The holder itself may register the key again.
+	s.Require().NoError(register(first))
+
+	holders, err := s.Keeper.blsKeyHolders(s.Ctx, pubKey)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{sdk.ValAddress(first).String()}, holders)
+}
+
+func (s *KeeperSuite) TestMsgRegisterIdentityShareIndex() {
+	accs := make([]sdk.AccAddress, 4)
+	for i := range accs {
+		_, _, accs[i] = testdata.KeyTestPubAddr()
+	}
+	bondedA, bondedB, unbonded, outsider := accs[0], accs[1], accs[2], accs[3]
+
+	validator := func(acc sdk.AccAddress, status stakingtypes.BondStatus) stakingtypes.Validator {
+		return stakingtypes.Validator{OperatorAddress: sdk.ValAddress(acc).String(), Status: status}
+	}
+	staking := fakeStakingKeeper{validators: map[string]stakingtypes.Validator{
+		"a": validator(bondedA, stakingtypes.Bonded),
+		"b": validator(bondedB, stakingtypes.Bonded),
+		"c": validator(unbonded, stakingtypes.Unbonding),
+	}}
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, staking, nil)
+	msgServer := NewMsgServerImpl(k)
+
+	params := vrftypes.DefaultParams()
+	params.SchemeId = crypto.UnchainedSchemeID
+	params, err := vrftestutil.WithDrandChain(params)
+	s.Require().NoError(err)
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+
+	register := func(acc sdk.AccAddress, shareIndex uint32) error {
+		msg, err := vrftestutil.NewRegisterIdentityMsg(params, s.Ctx.ChainID(), acc, shareIndex)
+		s.Require().NoError(err)
+		_, err = msgServer.RegisterVrfIdentity(s.Ctx, msg)
+		return err
+	}
+
+	// Before threshold mode share indexes are not checked: a bonded
+	// validator and an outsider both claim index 1 held by another.
+	s.Require().NoError(register(bondedA, 1))
+	s.Require().NoError(register(outsider, 1))
+
+	// Two bonded validators holding the same index, e.g. after one of them
+	// rebonds, do not block turning on threshold mode. PreBlock counts the
+	// index once per round.
+	threshold := params
+	threshold.PartialThreshold = 2
+	s.Require().NoError(register(bondedB, 1))
+	_, err = k.ScheduleParams(s.Ctx, threshold, 0)
+	s.Require().NoError(err)
+
+	// Once threshold mode is scheduled, only bonded validators may claim an
+	// index, and only one no other bonded validator holds. The duplicate
+	// does not block registrations of other indexes.
+	s.Require().ErrorIs(register(outsider, 3), errOperatorNotBonded)
+	s.Require().ErrorIs(register(unbonded, 3), errOperatorNotBonded)
+	s.Require().ErrorIs(register(bondedB, 1), errShareIndexTaken)
+	s.Require().NoError(register(bondedB, 2))
+	s.Require().NoError(register(bondedB, 2), "re-registering keeps the own index")
+	s.Require().NoError(register(bondedA, 1), "the outsider's claim does not count")
+	s.Require().NoError(register(bondedB, 3))
+
+	// A share index of another chain does not count.
+	threshold.ChainHash = []byte{0xbb}
+	s.Require().NoError(k.SetParams(s.Ctx, threshold))
+	s.Require().NoError(register(bondedB, 1))
+}
+
+func (s *KeeperSuite) TestMsgRegisterEcvrfKey() {
+	consPriv := sdked25519.GenPrivKey()
+	_, _, operator := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(operator)
+	validator, err := stakingtypes.NewValidator(valAddr.String(), consPriv.PubKey(), stakingtypes.Description{})
+	s.Require().NoError(err)
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	staking := fakeStakingKeeper{validators: map[string]stakingtypes.Validator{consAddr.String(): validator}}
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, staking, nil)
+	msgServer := NewMsgServerImpl(k)
+
+	newMsg := func(ctx sdk.Context, key ed25519.PrivateKey, operator sdk.AccAddress) *vrftypes.MsgRegisterEcvrfKey {
+		proof, err := vrftypes.ECVRFProve(key, vrftypes.ECVRFKeyProofInput(ctx.ChainID(), sdk.ValAddress(operator).String()))
+		s.Require().NoError(err)
+		return &vrftypes.MsgRegisterEcvrfKey{
+			Operator:       operator.String(),
+			EcvrfPublicKey: key.Public().(ed25519.PublicKey),
+			EcvrfProof:     proof,
+		}
+	}
+
+	_, key, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	msg := newMsg(s.Ctx, key, operator)
+
+	// The owner's proof does not let another operator register the key, nor
+	// does it cover another chain.
+	_, _, squatter := testdata.KeyTestPubAddr()
+	stolen := *msg
+	stolen.Operator = squatter.String()
+	_, err = msgServer.RegisterEcvrfKey(s.Ctx, &stolen)
+	s.Require().ErrorIs(err, errEcvrfKeyProofInvalid)
+
+	_, err = msgServer.RegisterEcvrfKey(s.Ctx.WithChainID("other-chain"), msg)
+	s.Require().ErrorIs(err, errEcvrfKeyProofInvalid)
+
+	// The consensus key cannot double as the ECVRF key.
+	_, err = msgServer.RegisterEcvrfKey(s.Ctx, newMsg(s.Ctx, ed25519.PrivateKey(consPriv.Key), operator))
+	s.Require().ErrorIs(err, errEcvrfKeyIsConsensusKey)
+
+	_, err = msgServer.RegisterEcvrfKey(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	registered, err := k.GetEcvrfKeyByConsAddr(s.Ctx, consAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(msg.EcvrfPublicKey, registered)
+
+	// The key goes away with the validator.
+	s.Require().NoError(k.Hooks().AfterValidatorRemoved(s.Ctx, consAddr, valAddr))
+	_, err = k.GetEcvrfKeyByConsAddr(s.Ctx, consAddr)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
 func (s *KeeperSuite) TestMsgScheduleReshare() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	scheduler := addr.String()
@@ -167,7 +510,11 @@ func (s *KeeperSuite) TestMsgScheduleReshare() {
 	_, err := s.MsgServer.ScheduleVrfReshare(s.Ctx, msg)
 	s.Require().ErrorIs(err, errSchedulerNotInCommittee)
 
-	s.Require().NoError(s.Keeper.SetCommitteeMember(s.Ctx, scheduler, "sched"))
+	s.setCommitteeRole(scheduler, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY)
+	_, err = s.MsgServer.ScheduleVrfReshare(s.Ctx, msg)
+	s.Require().ErrorIs(err, errSchedulerNotInCommittee)
+
+	s.setCommitteeRole(scheduler, vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER)
 	msg.ReshareEpoch = 5
 	_, err = s.MsgServer.ScheduleVrfReshare(s.Ctx, msg)
 	s.Require().ErrorIs(err, errReshareEpochTooLow)
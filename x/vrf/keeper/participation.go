@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// GetParticipation returns the participation record stored for height.
+func (k Keeper) GetParticipation(ctx context.Context, height int64) (types.VrfParticipation, error) {
+	return k.participation.Get(ctx, height)
+}
+
+// SetParticipation stores the participation record for p.Height.
+func (k Keeper) SetParticipation(ctx context.Context, p types.VrfParticipation) error {
+	return k.participation.Set(ctx, p.Height, p)
+}
+
+// PruneParticipation removes every participation record below minHeight.
+func (k Keeper) PruneParticipation(ctx context.Context, minHeight int64) error {
+	rng := new(collections.Range[int64]).EndExclusive(minHeight)
+
+	var stale []int64
+	err := k.participation.Walk(ctx, rng, func(height int64, _ types.VrfParticipation) (bool, error) {
+		stale = append(stale, height)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, height := range stale {
+		if err := k.participation.Remove(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParticipationSummary aggregates the retained participation records for the
+// validator with the given consensus address.
+func (k Keeper) ParticipationSummary(ctx context.Context, consAddr []byte) (types.VrfParticipationSummary, error) {
+	var summary types.VrfParticipationSummary
+	counts := make(map[types.VrfParticipationReason]uint64)
+
+	err := k.participation.Walk(ctx, nil, func(height int64, p types.VrfParticipation) (bool, error) {
+		for i, addr := range p.Validators {
+			if !bytes.Equal(addr, consAddr) || i >= len(p.Reasons) {
+				continue
+			}
+
+			reason := p.Reasons[i]
+			counts[reason]++
+			summary.Heights++
+			summary.LastHeight = height
+			summary.LastReason = reason
+
+			if reason == types.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED {
+				summary.Contributed++
+				summary.LastContributedHeight = height
+			}
+			break
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.VrfParticipationSummary{}, err
+	}
+
+	for reason, count := range counts {
+		summary.ReasonCounts = append(summary.ReasonCounts, types.VrfParticipationReasonCount{
+			Reason: reason,
+			Count:  count,
+		})
+	}
+	sort.Slice(summary.ReasonCounts, func(i, j int) bool {
+		return summary.ReasonCounts[i].Reason < summary.ReasonCounts[j].Reason
+	})
+
+	return summary, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+func (s *KeeperSuite) TestParticipationPruneAndQuery() {
+	valA := sdk.ConsAddress(make([]byte, 20))
+	valB := sdk.ConsAddress(append(make([]byte, 19), 1))
+
+	contributed := vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED
+	empty := vrftypes.VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY
+
+	for height := int64(1); height <= 3; height++ {
+		reasonB := contributed
+		if height == 3 {
+			reasonB = empty
+		}
+		s.Require().NoError(s.Keeper.SetParticipation(s.Ctx, vrftypes.VrfParticipation{
+			Height:      height,
+			Validators:  [][]byte{valA, valB},
+			Contributed: []byte{0b01},
+			Reasons:     []vrftypes.VrfParticipationReason{contributed, reasonB},
+		}))
+	}
+
+	s.Require().NoError(s.Keeper.PruneParticipation(s.Ctx, 2))
+	_, err := s.Keeper.GetParticipation(s.Ctx, 1)
+	s.Require().True(errors.Is(err, collections.ErrNotFound))
+
+	resp, err := s.QueryServer.Participation(s.Ctx, &vrftypes.QueryParticipationRequest{Height: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), resp.Participation.Height)
+
+	_, err = s.QueryServer.Participation(s.Ctx, nil)
+	s.Require().ErrorIs(err, errNilQueryParticipationRequest)
+
+	summary, err := s.QueryServer.ValidatorParticipation(s.Ctx, &vrftypes.QueryValidatorParticipationRequest{
+		ConsensusAddress: valB.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.VrfParticipationSummary{
+		ConsensusAddress: valB.String(),
+		Heights:          2,
+		Contributed:      1,
+		ReasonCounts: []vrftypes.VrfParticipationReasonCount{
+			{Reason: contributed, Count: 1},
+			{Reason: empty, Count: 1},
+		},
+		LastHeight:            3,
+		LastReason:            empty,
+		LastContributedHeight: 2,
+	}, summary.Summary)
+
+	_, err = s.QueryServer.ValidatorParticipation(s.Ctx, &vrftypes.QueryValidatorParticipationRequest{ConsensusAddress: "bad"})
+	s.Require().ErrorIs(err, errInvalidConsensusAddress)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// MinParamsActivationDelay is the smallest distance between the height that
+// schedules a params change and its activation height. A change included at
+// height H is first visible to ExtendVote at H+1, whose extensions PreBlock
+// aggregates at H+2.
+const MinParamsActivationDelay int64 = 2
+
+var errActivationHeightTooEarly = errors.New("vrf: activation_height is too early")
+
+// GetPendingParams returns the scheduled params change. ok is false when no
+// change is pending.
+func (k Keeper) GetPendingParams(ctx context.Context) (pending types.VrfPendingParams, ok bool, err error) {
+	pending, err = k.pendingParams.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.VrfPendingParams{}, false, nil
+		}
+		return types.VrfPendingParams{}, false, err
+	}
+	return pending, true, nil
+}
+
+// SetPendingParams stores a scheduled params change, replacing any previous
+// one.
+func (k Keeper) SetPendingParams(ctx context.Context, pending types.VrfPendingParams) error {
+	if err := pending.Validate(); err != nil {
+		return err
+	}
+
+	return k.pendingParams.Set(ctx, pending)
+}
+
+// ScheduleParams schedules params to take effect at activationHeight, or at
+// the earliest allowed height when activationHeight is zero. It returns the
+// resolved activation height.
+func (k Keeper) ScheduleParams(ctx context.Context, params types.VrfParams, activationHeight int64) (int64, error) {
+	earliest := sdk.UnwrapSDKContext(ctx).BlockHeight() + MinParamsActivationDelay
+	if activationHeight == 0 {
+		activationHeight = earliest
+	}
+	if activationHeight < earliest {
+		return 0, fmt.Errorf("%w: got %d, earliest is %d", errActivationHeightTooEarly, activationHeight, earliest)
+	}
+
+	if err := k.SetPendingParams(ctx, types.VrfPendingParams{Params: params, ActivationHeight: activationHeight}); err != nil {
+		return 0, err
+	}
+
+	return activationHeight, nil
+}
+
+// LatestParams returns the pending params if a change is scheduled, and the
+// current params otherwise. Changes build on top of it so that a second
+// change does not silently drop the first.
+func (k Keeper) LatestParams(ctx context.Context) (types.VrfParams, error) {
+	pending, ok, err := k.GetPendingParams(ctx)
+	if err != nil {
+		return types.VrfParams{}, err
+	}
+	if ok {
+		return pending.Params, nil
+	}
+
+	return k.GetParams(ctx)
+}
+
+// ParamsAt returns the params that govern PreBlock at height: the pending
+// params once height reaches their activation height, the current params
+// otherwise. ExtendVote and VerifyVoteExtension at H use ParamsAt(H+1), so
+// the extensions are built under the params PreBlock checks them with.
+func (k Keeper) ParamsAt(ctx context.Context, height int64) (types.VrfParams, error) {
+	pending, ok, err := k.GetPendingParams(ctx)
+	if err != nil {
+		return types.VrfParams{}, err
+	}
+	if ok && height >= pending.ActivationHeight {
+		return pending.Params, nil
+	}
+
+	return k.GetParams(ctx)
+}
+
+// ActivatePendingParams makes the pending params current once the block
+// height reaches their activation height. It reports whether params changed.
+func (k Keeper) ActivatePendingParams(ctx context.Context) (bool, error) {
+	pending, ok, err := k.GetPendingParams(ctx)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() < pending.ActivationHeight {
+		return false, nil
+	}
+
+	if err := k.SetParams(ctx, pending.Params); err != nil {
+		return false, err
+	}
+	if err := k.pendingParams.Remove(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
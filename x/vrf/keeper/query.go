@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/dgtlkitchen/vrf/x/vrf/types"
 )
 
@@ -14,6 +16,12 @@ var (
 	errNilQueryRandomWordsRequest     = errors.New("vrf: nil QueryRandomWordsRequest")
 	errRandomWordsCountMustBePositive = errors.New("vrf: count must be > 0")
 	errRandomWordsCountExceedsMax     = errors.New("vrf: count exceeds maximum")
+	errNilQueryParticipationRequest   = errors.New("vrf: nil QueryParticipationRequest")
+	errNilValidatorParticipationReq   = errors.New("vrf: nil QueryValidatorParticipationRequest")
+	errInvalidConsensusAddress        = errors.New("vrf: invalid consensus address")
+	errNilRandomnessRequestReq        = errors.New("vrf: nil QueryRandomnessRequestRequest")
+	errNilTimelockedReq               = errors.New("vrf: nil QueryTimelockedRequest")
+	errNilInvariantsReq               = errors.New("vrf: nil QueryInvariantsRequest")
 )
 
 type queryServer struct {
@@ -37,6 +45,21 @@ func (q queryServer) Params(
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+func (q queryServer) PendingParams(
+	ctx context.Context,
+	_ *types.QueryPendingParamsRequest,
+) (*types.QueryPendingParamsResponse, error) {
+	pending, ok, err := q.k.GetPendingParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryPendingParamsResponse{}, nil
+	}
+
+	return &types.QueryPendingParamsResponse{PendingParams: &pending}, nil
+}
+
 func (q queryServer) Beacon(
 	ctx context.Context,
 	_ *types.QueryBeaconRequest,
@@ -76,3 +99,89 @@ func (q queryServer) RandomWords(
 		Words:      words,
 	}, nil
 }
+
+func (q queryServer) Participation(
+	ctx context.Context,
+	req *types.QueryParticipationRequest,
+) (*types.QueryParticipationResponse, error) {
+	if req == nil {
+		return nil, errNilQueryParticipationRequest
+	}
+
+	participation, err := q.k.GetParticipation(ctx, req.Height)
+	if err != nil {
+		return nil, fmt.Errorf("vrf: participation at height %d: %w", req.Height, err)
+	}
+
+	return &types.QueryParticipationResponse{Participation: participation}, nil
+}
+
+func (q queryServer) ValidatorParticipation(
+	ctx context.Context,
+	req *types.QueryValidatorParticipationRequest,
+) (*types.QueryValidatorParticipationResponse, error) {
+	if req == nil {
+		return nil, errNilValidatorParticipationReq
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsensusAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidConsensusAddress, err)
+	}
+
+	summary, err := q.k.ParticipationSummary(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	summary.ConsensusAddress = req.ConsensusAddress
+
+	return &types.QueryValidatorParticipationResponse{Summary: summary}, nil
+}
+
+func (q queryServer) RandomnessRequest(
+	ctx context.Context,
+	req *types.QueryRandomnessRequestRequest,
+) (*types.QueryRandomnessRequestResponse, error) {
+	if req == nil {
+		return nil, errNilRandomnessRequestReq
+	}
+
+	request, err := q.k.GetRandomnessRequest(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("vrf: randomness request %d: %w", req.Id, err)
+	}
+
+	return &types.QueryRandomnessRequestResponse{Request: request}, nil
+}
+
+func (q queryServer) Timelocked(
+	ctx context.Context,
+	req *types.QueryTimelockedRequest,
+) (*types.QueryTimelockedResponse, error) {
+	if req == nil {
+		return nil, errNilTimelockedReq
+	}
+
+	msg, err := q.k.GetTimelocked(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("vrf: timelocked message %d: %w", req.Id, err)
+	}
+
+	return &types.QueryTimelockedResponse{Message: msg}, nil
+}
+
+func (q queryServer) Invariants(
+	ctx context.Context,
+	req *types.QueryInvariantsRequest,
+) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, errNilInvariantsReq
+	}
+
+	results, err := q.k.RunInvariants(ctx, req.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInvariantsResponse{Results: results}, nil
+}
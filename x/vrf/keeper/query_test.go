@@ -10,6 +10,20 @@ func (s *KeeperSuite) TestQueryParams() {
 	s.Require().Equal(vrftypes.DefaultParams(), resp.Params)
 }
 
+func (s *KeeperSuite) TestQueryPendingParams() {
+	resp, err := s.QueryServer.PendingParams(s.Ctx, &vrftypes.QueryPendingParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.PendingParams)
+
+	pending := vrftypes.VrfPendingParams{Params: vrftypes.DefaultParams(), ActivationHeight: 7}
+	s.Require().NoError(s.Keeper.SetPendingParams(s.Ctx, pending))
+
+	resp, err = s.QueryServer.PendingParams(s.Ctx, &vrftypes.QueryPendingParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp.PendingParams)
+	s.Require().Equal(pending, *resp.PendingParams)
+}
+
 func (s *KeeperSuite) TestQueryBeacon() {
 	_, err := s.QueryServer.Beacon(s.Ctx, &vrftypes.QueryBeaconRequest{})
 	s.Require().ErrorIs(err, errGetBeaconWhileDisabled)
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+var (
+	errRequestWhileDisabled = errors.New("vrf: randomness requests are unavailable while VRF is disabled")
+	errRequestFeeExceedsMax = errors.New("vrf: request fee exceeds max_fee")
+)
+
+// GetRandomnessRequest returns the randomness request with the given id.
+func (k Keeper) GetRandomnessRequest(ctx context.Context, id uint64) (types.RandomnessRequest, error) {
+	return k.requests.Get(ctx, id)
+}
+
+// RequestRandomness escrows the request fee from requester and records a
+// pending request committed to the first drand round after the current block
+// time.
+func (k Keeper) RequestRandomness(
+	ctx context.Context,
+	requester sdk.AccAddress,
+	numWords uint32,
+	userSeed []byte,
+	maxFee sdk.Coins,
+) (types.RandomnessRequest, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.RandomnessRequest{}, err
+	}
+
+	currentRound := types.RoundAt(params, sdkCtx.BlockTime())
+	if !params.Enabled || currentRound == 0 {
+		return types.RandomnessRequest{}, errRequestWhileDisabled
+	}
+
+	fee := params.RequestFee(numWords)
+	if !maxFee.Empty() && !fee.IsAllLTE(maxFee) {
+		return types.RandomnessRequest{}, fmt.Errorf("%w: fee %s, max_fee %s", errRequestFeeExceedsMax, fee, maxFee)
+	}
+
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleName, fee); err != nil {
+			return types.RandomnessRequest{}, err
+		}
+		if err := k.addEscrow(ctx, fee); err != nil {
+			return types.RandomnessRequest{}, err
+		}
+	}
+
+	id, err := k.requestSeq.Next(ctx)
+	if err != nil {
+		return types.RandomnessRequest{}, err
+	}
+
+	req := types.RandomnessRequest{
+		Id:            id,
+		Requester:     requester.String(),
+		NumWords:      numWords,
+		UserSeed:      userSeed,
+		Fee:           fee,
+		MinDrandRound: currentRound + 1,
+		RequestHeight: sdkCtx.BlockHeight(),
+		Status:        types.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING,
+	}
+
+	if err := k.requests.Set(ctx, id, req); err != nil {
+		return types.RandomnessRequest{}, err
+	}
+	if err := k.pendingRequests.Set(ctx, collections.Join(req.MinDrandRound, id)); err != nil {
+		return types.RandomnessRequest{}, err
+	}
+
+	return req, nil
+}
+
+// FulfillRandomnessRequests fulfills every pending request whose
+// min_drand_round is at or below the finalized beacon's round, and pays the
+// escrowed fees to the contributors of that beacon. It returns the number of
+// fulfilled requests.
+func (k Keeper) FulfillRandomnessRequests(
+	ctx context.Context,
+	beacon types.VrfBeacon,
+	contributors []Contributor,
+) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	due, err := k.pendingUpTo(ctx, beacon.DrandRound)
+	if err != nil || len(due) == 0 {
+		return 0, err
+	}
+
+	fees := sdk.NewCoins()
+	for _, key := range due {
+		req, err := k.requests.Get(ctx, key.K2())
+		if err != nil {
+			return 0, err
+		}
+
+		words, err := deriveRandomWords(beacon, req.NumWords, requestSeed(req))
+		if err != nil {
+			return 0, err
+		}
+
+		req.Status = types.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_FULFILLED
+		req.DrandRound = beacon.DrandRound
+		req.FulfilledHeight = sdkCtx.BlockHeight()
+		req.Words = words
+
+		if err := k.requests.Set(ctx, req.Id, req); err != nil {
+			return 0, err
+		}
+		if err := k.pendingRequests.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+		fees = fees.Add(req.Fee...)
+	}
+
+	if err := k.subEscrow(ctx, fees); err != nil {
+		return 0, err
+	}
+
+	// Fees that cannot be paid out (no bonded contributor, truncation dust)
+	// are released into the reward pool.
+	if _, err := k.payContributors(ctx, fees, contributors); err != nil {
+		return 0, err
+	}
+
+	return len(due), nil
+}
+
+// RefundPendingRequests refunds and closes every pending request. It is
+// called while VRF is disabled so that no fee stays escrowed for a beacon that
+// will not be produced. It returns the number of refunded requests.
+func (k Keeper) RefundPendingRequests(ctx context.Context) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	due, err := k.pendingUpTo(ctx, ^uint64(0))
+	if err != nil || len(due) == 0 {
+		return 0, err
+	}
+
+	for _, key := range due {
+		req, err := k.requests.Get(ctx, key.K2())
+		if err != nil {
+			return 0, err
+		}
+
+		if !req.Fee.IsZero() {
+			requester, err := sdk.AccAddressFromBech32(req.Requester)
+			if err != nil {
+				return 0, err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, requester, req.Fee); err != nil {
+				return 0, err
+			}
+			if err := k.subEscrow(ctx, req.Fee); err != nil {
+				return 0, err
+			}
+		}
+
+		req.Status = types.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_REFUNDED
+		req.FulfilledHeight = sdkCtx.BlockHeight()
+
+		if err := k.requests.Set(ctx, req.Id, req); err != nil {
+			return 0, err
+		}
+		if err := k.pendingRequests.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(due), nil
+}
+
+// pendingUpTo returns the pending request keys with min_drand_round <= round,
+// ordered by (min_drand_round, id).
+func (k Keeper) pendingUpTo(ctx context.Context, round uint64) ([]collections.Pair[uint64, uint64], error) {
+	var due []collections.Pair[uint64, uint64]
+	err := k.pendingRequests.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		if key.K1() > round {
+			return true, nil
+		}
+		due = append(due, key)
+		return false, nil
+	})
+	return due, err
+}
+
+// requestSeed mixes the request id into the user seed so that requests with
+// the same seed fulfilled by the same beacon receive distinct words.
+func requestSeed(req types.RandomnessRequest) []byte {
+	seed := make([]byte, 0, len(req.UserSeed)+8)
+	seed = append(seed, req.UserSeed...)
+	return binary.BigEndian.AppendUint64(seed, req.Id)
+}
+
+func (k Keeper) escrowedFees(ctx context.Context) (sdk.Coins, error) {
+	escrow := sdk.NewCoins()
+	err := k.requestEscrow.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		escrow = escrow.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return escrow, err
+}
+
+func (k Keeper) addEscrow(ctx context.Context, amt sdk.Coins) error {
+	for _, c := range amt {
+		current, err := k.requestEscrow.Get(ctx, c.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			current = math.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.requestEscrow.Set(ctx, c.Denom, current.Add(c.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) subEscrow(ctx context.Context, amt sdk.Coins) error {
+	for _, c := range amt {
+		current, err := k.requestEscrow.Get(ctx, c.Denom)
+		if err != nil {
+			return err
+		}
+		remaining := current.Sub(c.Amount)
+		if remaining.IsZero() {
+			err = k.requestEscrow.Remove(ctx, c.Denom)
+		} else {
+			err = k.requestEscrow.Set(ctx, c.Denom, remaining)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
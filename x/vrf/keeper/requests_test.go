@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+func (s *KeeperSuite) TestRandomnessRequests() {
+	requester := sdk.AccAddress(append(make([]byte, 19), 9))
+	consA := sdk.ConsAddress(append(make([]byte, 19), 1))
+
+	bank := &fakeBankKeeper{balances: map[string]sdk.Coins{
+		requester.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}}
+	staking := fakeStakingKeeper{validators: map[string]stakingtypes.Validator{
+		consA.String(): {OperatorAddress: "valA", Status: stakingtypes.Bonded},
+	}}
+	distr := &fakeDistrKeeper{allocated: map[string]sdk.DecCoins{}}
+
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, bank, staking, distr)
+	msgServer := NewMsgServerImpl(k)
+
+	params := vrftypes.DefaultParams()
+	params.GenesisUnixSec = 1_700_000_000
+	params.RequestBaseFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	params.RequestFeePerWord = sdk.NewCoins(sdk.NewInt64Coin("stake", 2))
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+
+	// At genesis + 65s the current round is 3.
+	ctx := s.Ctx.WithBlockHeight(7).WithBlockTime(time.Unix(params.GenesisUnixSec+65, 0).UTC())
+
+	msg := &vrftypes.MsgRequestRandomness{Requester: requester.String(), NumWords: 5}
+
+	_, err := msgServer.RequestRandomness(ctx, msg)
+	s.Require().ErrorIs(err, errRequestWhileDisabled)
+
+	params.Enabled = true
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	msg.MaxFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 19))
+	_, err = msgServer.RequestRandomness(ctx, msg)
+	s.Require().ErrorIs(err, errRequestFeeExceedsMax)
+
+	msg.MaxFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	resp, err := msgServer.RequestRandomness(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), resp.MinDrandRound)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), resp.Fee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 980)), bank.GetAllBalances(ctx, requester))
+
+	// Escrowed fees are not part of the reward pool.
+	pool, err := k.rewardPoolBalance(ctx)
+	s.Require().NoError(err)
+	s.Require().True(pool.IsZero())
+
+	// A beacon below min_drand_round does not fulfill the request.
+	contributors := []Contributor{{ConsAddress: consA, Power: 1}}
+	n, err := k.FulfillRandomnessRequests(ctx, vrftypes.VrfBeacon{DrandRound: 3, Randomness: []byte("r3")}, contributors)
+	s.Require().NoError(err)
+	s.Require().Zero(n)
+
+	beacon := vrftypes.VrfBeacon{DrandRound: 4, Randomness: []byte("r4")}
+	n, err = k.FulfillRandomnessRequests(ctx.WithBlockHeight(9), beacon, contributors)
+	s.Require().NoError(err)
+	s.Require().Equal(1, n)
+
+	req, err := k.GetRandomnessRequest(ctx, resp.RequestId)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_FULFILLED, req.Status)
+	s.Require().Equal(uint64(4), req.DrandRound)
+	s.Require().Equal(int64(9), req.FulfilledHeight)
+	words, err := deriveRandomWords(beacon, 5, requestSeed(req))
+	s.Require().NoError(err)
+	s.Require().Equal(words, req.Words)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(20))), distr.allocated["valA"])
+
+	// Disabling VRF refunds pending requests.
+	resp, err = msgServer.RequestRandomness(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 960)), bank.GetAllBalances(ctx, requester))
+
+	n, err = k.RefundPendingRequests(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, n)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 980)), bank.GetAllBalances(ctx, requester))
+	s.Require().True(bank.GetAllBalances(ctx, authtypes.NewModuleAddress(vrftypes.ModuleName)).IsZero())
+
+	req, err = k.GetRandomnessRequest(ctx, resp.RequestId)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_REFUNDED, req.Status)
+
+	escrow, err := k.escrowedFees(ctx)
+	s.Require().NoError(err)
+	s.Require().True(escrow.IsZero())
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Contributor is a validator whose vote extension backed a finalized beacon.
+type Contributor struct {
+	ConsAddress sdk.ConsAddress
+	Power       int64
+}
+
+// RewardPoolAddress returns the address of the vrf module account that holds
+// the reward pool.
+func RewardPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// FundRewardPool moves reward_fee_share_bps of the fee collector balance into
+// the reward pool.
+func (k Keeper) FundRewardPool(ctx context.Context, params types.VrfParams) error {
+	if params.RewardFeeShareBps == 0 {
+		return nil
+	}
+
+	fees := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+
+	share := sdk.NewCoins()
+	for _, fee := range fees {
+		amt := fee.Amount.MulRaw(int64(params.RewardFeeShareBps)).QuoRaw(types.MaxRewardFeeShareBps)
+		if amt.IsPositive() {
+			share = share.Add(sdk.NewCoin(fee.Denom, amt))
+		}
+	}
+
+	if share.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share)
+}
+
+// DistributeRewards pays up to reward_per_block from the reward pool to the
+// bonded contributors. It returns the total amount paid.
+func (k Keeper) DistributeRewards(ctx context.Context, params types.VrfParams, contributors []Contributor) (sdk.Coins, error) {
+	if params.RewardPerBlock.IsZero() || len(contributors) == 0 {
+		return nil, nil
+	}
+
+	pool, err := k.rewardPoolBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.payContributors(ctx, params.RewardPerBlock.Min(pool), contributors)
+}
+
+// rewardPoolBalance returns the vrf module account balance that is not
+// escrowed for pending randomness requests.
+func (k Keeper) rewardPoolBalance(ctx context.Context) (sdk.Coins, error) {
+	escrow, err := k.escrowedFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, hasNeg := k.bankKeeper.GetAllBalances(ctx, RewardPoolAddress()).SafeSub(escrow...)
+	if hasNeg {
+		return sdk.NewCoins(), nil
+	}
+	return pool, nil
+}
+
+// payContributors pays budget from the vrf module account to the bonded
+// contributors in proportion to their voting power, crediting them through
+// x/distribution. Truncation dust stays in the module account. It returns the
+// total amount paid.
+func (k Keeper) payContributors(ctx context.Context, budget sdk.Coins, contributors []Contributor) (sdk.Coins, error) {
+	if budget.IsZero() || len(contributors) == 0 {
+		return nil, nil
+	}
+
+	type recipient struct {
+		validator stakingtypes.ValidatorI
+		power     int64
+	}
+
+	recipients := make([]recipient, 0, len(contributors))
+	totalPower := int64(0)
+	for _, c := range contributors {
+		if c.Power <= 0 {
+			continue
+		}
+
+		val, err := k.stakingKeeper.ValidatorByConsAddr(ctx, c.ConsAddress)
+		if err != nil || val == nil || !val.IsBonded() {
+			continue
+		}
+
+		recipients = append(recipients, recipient{validator: val, power: c.Power})
+		totalPower += c.Power
+	}
+
+	if totalPower == 0 {
+		return nil, nil
+	}
+
+	shares := make([]sdk.Coins, len(recipients))
+	paid := sdk.NewCoins()
+	for i, r := range recipients {
+		share := sdk.NewCoins()
+		for _, coin := range budget {
+			amt := coin.Amount.Mul(math.NewInt(r.power)).QuoRaw(totalPower)
+			if amt.IsPositive() {
+				share = share.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+		shares[i] = share
+		paid = paid.Add(share...)
+	}
+
+	if paid.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, paid); err != nil {
+		return nil, fmt.Errorf("vrf: failed to move rewards to distribution: %w", err)
+	}
+
+	for i, r := range recipients {
+		if shares[i].IsZero() {
+			continue
+		}
+		if err := k.distrKeeper.AllocateTokensToValidator(ctx, r.validator, sdk.NewDecCoinsFromCoins(shares[i]...)); err != nil {
+			return nil, fmt.Errorf("vrf: failed to allocate rewards to %s: %w", r.validator.GetOperator(), err)
+		}
+	}
+
+	return paid, nil
+}
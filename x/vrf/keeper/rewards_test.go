@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type fakeBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (b *fakeBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *fakeBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToModule(_ context.Context, from, to string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(from), authtypes.NewModuleAddress(to), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, to string, amt sdk.Coins) error {
+	return b.send(from, authtypes.NewModuleAddress(to), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, from string, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(from), to, amt)
+}
+
+func (b *fakeBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return errors.New("insufficient funds")
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+type fakeStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (s fakeStakingKeeper) Validator(_ context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+	for _, val := range s.validators {
+		if val.OperatorAddress == addr.String() {
+			return val, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (s fakeStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	val, ok := s.validators[consAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+type fakeDistrKeeper struct {
+	allocated map[string]sdk.DecCoins
+}
+
+func (d *fakeDistrKeeper) AllocateTokensToValidator(_ context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
+	d.allocated[val.GetOperator()] = d.allocated[val.GetOperator()].Add(tokens...)
+	return nil
+}
+
+func (s *KeeperSuite) TestRewards() {
+	consA := sdk.ConsAddress(append(make([]byte, 19), 1))
+	consB := sdk.ConsAddress(append(make([]byte, 19), 2))
+	consUnbonded := sdk.ConsAddress(append(make([]byte, 19), 3))
+
+	bank := &fakeBankKeeper{balances: map[string]sdk.Coins{
+		authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}}
+	staking := fakeStakingKeeper{validators: map[string]stakingtypes.Validator{
+		consA.String():        {OperatorAddress: "valA", Status: stakingtypes.Bonded},
+		consB.String():        {OperatorAddress: "valB", Status: stakingtypes.Bonded},
+		consUnbonded.String(): {OperatorAddress: "valC", Status: stakingtypes.Unbonded},
+	}}
+	distr := &fakeDistrKeeper{allocated: map[string]sdk.DecCoins{}}
+
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, bank, staking, distr)
+
+	params := vrftypes.DefaultParams()
+	params.RewardFeeShareBps = 1_000
+	params.RewardPerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
+
+	// 10% of the fee collector balance funds the pool.
+	s.Require().NoError(k.FundRewardPool(s.Ctx, params))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.GetAllBalances(s.Ctx, RewardPoolAddress()))
+
+	// The payout is capped by the pool balance, split by power, and skips
+	// validators that are not bonded.
+	paid, err := k.DistributeRewards(s.Ctx, params, []Contributor{
+		{ConsAddress: consA, Power: 2},
+		{ConsAddress: consB, Power: 1},
+		{ConsAddress: consUnbonded, Power: 10},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 99)), paid)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(66))), distr.allocated["valA"])
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(33))), distr.allocated["valB"])
+	s.Require().Empty(distr.allocated["valC"])
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), bank.GetAllBalances(s.Ctx, RewardPoolAddress()))
+	s.Require().Equal(paid, bank.GetAllBalances(s.Ctx, authtypes.NewModuleAddress(distrtypes.ModuleName)))
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	errOperatorNotBonded = errors.New("vrf: operator is not a bonded validator")
+	errShareIndexTaken   = errors.New("vrf: share_index is registered by another bonded validator")
+)
+
+// requireBondedValidator returns an error unless valAddr is a bonded
+// validator.
+func (k Keeper) requireBondedValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	if k.stakingKeeper == nil {
+		return errNoStakingKeeper
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", errOperatorNotBonded, valAddr, err)
+	}
+	if !val.IsBonded() {
+		return fmt.Errorf("%w: %s", errOperatorNotBonded, valAddr)
+	}
+
+	return nil
+}
+
+// checkShareIndex returns an error if, in threshold mode, validatorAddr may
+// not claim shareIndex on chainHash: it must be a bonded validator, and no
+// other bonded validator of the chain may hold the index. Only bonded
+// validators vote, so an index registered by any other account cannot shadow
+// a real share in PreBlock and is ignored. Duplicates among other validators,
+// e.g. after one of them rebonds, are left to PreBlock, which counts each
+// index once per round.
+func (k Keeper) checkShareIndex(ctx context.Context, chainHash []byte, validatorAddr sdk.ValAddress, shareIndex uint32) error {
+	if err := k.requireBondedValidator(ctx, validatorAddr); err != nil {
+		return err
+	}
+
+	bound, err := k.IdentitiesByChainHash(ctx, chainHash)
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range bound {
+		if identity.ShareIndex != shareIndex || identity.ValidatorAddress == validatorAddr.String() {
+			continue
+		}
+		holder, err := sdk.ValAddressFromBech32(identity.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if k.requireBondedValidator(ctx, holder) == nil {
+			return fmt.Errorf("%w: %d is held by %s", errShareIndexTaken, shareIndex, identity.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
@@ -26,7 +26,7 @@ func TestKeeperSuite(t *testing.T) {
 func (s *KeeperSuite) SetupTest() {
 	s.VrfTestSuite.SetupTest()
 
-	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority)
+	k := NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
 	s.Require().NoError(k.SetParams(s.Ctx, vrftypes.DefaultParams()))
 
 	s.Keeper = k
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+var (
+	errTimelockSchemeChained = errors.New("vrf: time-lock encryption requires an unchained drand scheme")
+	errTimelockRoundNotAhead = errors.New("vrf: drand_round is not in the future")
+)
+
+// GetTimelocked returns the time-locked message with the given id.
+func (k Keeper) GetTimelocked(ctx context.Context, id uint64) (types.TimelockedMessage, error) {
+	return k.timelocked.Get(ctx, id)
+}
+
+// SubmitTimelocked stores a ciphertext encrypted to a drand round that has not
+// been published at the current block time.
+func (k Keeper) SubmitTimelocked(
+	ctx context.Context,
+	submitter string,
+	round uint64,
+	ciphertext types.TimelockCiphertext,
+) (types.TimelockedMessage, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.TimelockedMessage{}, err
+	}
+
+	if !params.Unchained() {
+		return types.TimelockedMessage{}, errTimelockSchemeChained
+	}
+
+	if current := types.RoundAt(params, sdkCtx.BlockTime()); round <= current {
+		return types.TimelockedMessage{}, fmt.Errorf("%w: round %d, current %d", errTimelockRoundNotAhead, round, current)
+	}
+
+	id, err := k.timelockSeq.Next(ctx)
+	if err != nil {
+		return types.TimelockedMessage{}, err
+	}
+
+	msg := types.TimelockedMessage{
+		Id:           id,
+		Submitter:    submitter,
+		DrandRound:   round,
+		Ciphertext:   ciphertext,
+		SubmitHeight: sdkCtx.BlockHeight(),
+		Status:       types.TimelockStatus_TIMELOCK_STATUS_PENDING,
+	}
+
+	if err := k.timelocked.Set(ctx, id, msg); err != nil {
+		return types.TimelockedMessage{}, err
+	}
+	if err := k.pendingTimelock.Set(ctx, collections.Join(round, id)); err != nil {
+		return types.TimelockedMessage{}, err
+	}
+
+	return msg, nil
+}
+
+// DecryptTimelocked decrypts every pending ciphertext encrypted to the
+// finalized beacon's round and delivers the plaintexts to the hooks.
+// Ciphertexts for earlier rounds that were never finalized are marked skipped.
+// It returns the decrypted messages.
+func (k Keeper) DecryptTimelocked(ctx context.Context, beacon types.VrfBeacon) ([]types.TimelockedMessage, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var due []collections.Pair[uint64, uint64]
+	err := k.pendingTimelock.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		if key.K1() > beacon.DrandRound {
+			return true, nil
+		}
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var decrypted []types.TimelockedMessage
+	for _, key := range due {
+		msg, err := k.timelocked.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+
+		msg.DecryptedHeight = sdkCtx.BlockHeight()
+		if msg.DrandRound != beacon.DrandRound {
+			msg.Status = types.TimelockStatus_TIMELOCK_STATUS_SKIPPED
+		} else if plaintext, err := types.TimelockDecrypt(beacon.Signature, msg.DrandRound, msg.Ciphertext); err != nil {
+			msg.Status = types.TimelockStatus_TIMELOCK_STATUS_FAILED
+		} else {
+			msg.Status = types.TimelockStatus_TIMELOCK_STATUS_DECRYPTED
+			msg.Plaintext = plaintext
+			decrypted = append(decrypted, msg)
+		}
+
+		if err := k.timelocked.Set(ctx, msg.Id, msg); err != nil {
+			return nil, err
+		}
+		if err := k.pendingTimelock.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return decrypted, nil
+}
+
+// AfterTimelockDecrypted delivers a decrypted message to the registered hooks,
+// if any.
+func (k Keeper) AfterTimelockDecrypted(ctx context.Context, msg types.TimelockedMessage) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.AfterTimelockDecrypted(ctx, msg)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type recordingTimelockHooks struct {
+	delivered []vrftypes.TimelockedMessage
+	err       error
+}
+
+func (h *recordingTimelockHooks) AfterTimelockDecrypted(_ context.Context, msg vrftypes.TimelockedMessage) error {
+	h.delivered = append(h.delivered, msg)
+	return h.err
+}
+
+func (s *KeeperSuite) TestTimelocked() {
+	scheme := crypto.NewPedersenBLSUnchained()
+	secret := scheme.KeyGroup.Scalar().SetInt64(1)
+	pubKey, err := scheme.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
+	s.Require().NoError(err)
+
+	beaconAt := func(round uint64) vrftypes.VrfBeacon {
+		sig, err := scheme.AuthScheme.Sign(secret, scheme.DigestBeacon(&common.Beacon{Round: round}))
+		s.Require().NoError(err)
+		return vrftypes.VrfBeacon{DrandRound: round, Signature: sig}
+	}
+
+	params := vrftypes.DefaultParams()
+	params.GenesisUnixSec = 1_700_000_000
+	params.PublicKey = pubKey
+	s.Require().NoError(s.Keeper.SetParams(s.Ctx, params))
+
+	// At genesis + 65s the current round is 3.
+	ctx := s.Ctx.WithBlockHeight(7).WithBlockTime(time.Unix(params.GenesisUnixSec+65, 0).UTC())
+
+	ct, err := vrftypes.TimelockEncrypt(pubKey, 5, []byte("bid"))
+	s.Require().NoError(err)
+
+	_, err = s.Keeper.SubmitTimelocked(ctx, s.Authority, 5, ct)
+	s.Require().ErrorIs(err, errTimelockSchemeChained)
+
+	params.SchemeId = crypto.UnchainedSchemeID
+	s.Require().NoError(s.Keeper.SetParams(ctx, params))
+
+	_, err = s.Keeper.SubmitTimelocked(ctx, s.Authority, 3, ct)
+	s.Require().ErrorIs(err, errTimelockRoundNotAhead)
+
+	skipped, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 4, ct)
+	s.Require().NoError(err)
+	bid, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 5, ct)
+	s.Require().NoError(err)
+	corrupt, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 5, vrftypes.TimelockCiphertext{
+		U: ct.U, V: ct.V, W: ct.W, Payload: append([]byte{}, ct.Payload[1:]...),
+	})
+	s.Require().NoError(err)
+	later, err := s.Keeper.SubmitTimelocked(ctx, s.Authority, 6, ct)
+	s.Require().NoError(err)
+
+	hooks := &recordingTimelockHooks{err: errors.New("consumer failed")}
+	s.Keeper.SetHooks(hooks)
+	s.Require().Panics(func() { s.Keeper.SetHooks(hooks) })
+
+	decrypted, err := s.Keeper.DecryptTimelocked(ctx.WithBlockHeight(9), beaconAt(5))
+	s.Require().NoError(err)
+	s.Require().Len(decrypted, 1)
+	s.Require().Equal(bid.Id, decrypted[0].Id)
+	s.Require().Equal([]byte("bid"), decrypted[0].Plaintext)
+
+	s.Require().ErrorContains(s.Keeper.AfterTimelockDecrypted(ctx, decrypted[0]), "consumer failed")
+	s.Require().Equal(decrypted, hooks.delivered)
+
+	for id, status := range map[uint64]vrftypes.TimelockStatus{
+		skipped.Id: vrftypes.TimelockStatus_TIMELOCK_STATUS_SKIPPED,
+		bid.Id:     vrftypes.TimelockStatus_TIMELOCK_STATUS_DECRYPTED,
+		corrupt.Id: vrftypes.TimelockStatus_TIMELOCK_STATUS_FAILED,
+		later.Id:   vrftypes.TimelockStatus_TIMELOCK_STATUS_PENDING,
+	} {
+		msg, err := s.Keeper.GetTimelocked(ctx, id)
+		s.Require().NoError(err)
+		s.Require().Equal(status, msg.Status, "id %d", id)
+	}
+
+	res, err := s.QueryServer.Timelocked(ctx, &vrftypes.QueryTimelockedRequest{Id: bid.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(9), res.Message.DecryptedHeight)
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// ParamsUpdatedHeightKey is the v1 key of the height at which params last
+// changed. v2 schedules params changes instead and no longer reads it.
+var ParamsUpdatedHeightKey = collections.NewPrefix(4)
+
+// MigrateStore performs the in-place store migration from version 1 to 2:
+//
+//   - it deletes the params_updated_height item;
+//   - it writes every stored identity again through identities, which fills
+//     in the secondary indexes that v1 did not have.
+func MigrateStore[I any](
+	ctx context.Context,
+	storeService store.KVStoreService,
+	identities *collections.IndexedMap[string, types.VrfIdentity, I],
+) error {
+	if err := storeService.OpenKVStore(ctx).Delete(ParamsUpdatedHeightKey); err != nil {
+		return err
+	}
+
+	// Collect first: writing the indexes while iterating the primary map is
+	// not safe on every store.
+	var stored []collections.KeyValue[string, types.VrfIdentity]
+	err := identities.Walk(ctx, nil, func(valAddr string, identity types.VrfIdentity) (bool, error) {
+		stored = append(stored, collections.KeyValue[string, types.VrfIdentity]{Key: valAddr, Value: identity})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range stored {
+		if err := identities.Set(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
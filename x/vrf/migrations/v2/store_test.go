@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	v2 "github.com/dgtlkitchen/vrf/x/vrf/migrations/v2"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type MigrationSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationSuite))
+}
+
+// setV1Fixture writes a v1 store: params, the params_updated_height item and
+// two identities under the raw v1 keys, without any index entries.
+func (s *MigrationSuite) setV1Fixture(identities ...vrftypes.VrfIdentity) {
+	store := s.Ctx.KVStore(s.KeyVrf)
+
+	params := vrftypes.DefaultParams()
+	params.ChainHash = []byte{0xaa}
+	store.Set([]byte{0}, s.EncCfg.Codec.MustMarshal(&params))
+
+	// collections encodes int64 values as big-endian with the sign bit flipped.
+	updatedHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(updatedHeight, uint64(12)^(1<<63))
+	store.Set(v2.ParamsUpdatedHeightKey, updatedHeight)
+
+	for _, identity := range identities {
+		key := append([]byte{5}, identity.ValidatorAddress...)
+		store.Set(key, s.EncCfg.Codec.MustMarshal(&identity))
+	}
+}
+
+func (s *MigrationSuite) TestMigrate1to2() {
+	valA := sdk.ValAddress([]byte("validator-a_________")).String()
+	valB := sdk.ValAddress([]byte("validator-b_________")).String()
+	identityA := vrftypes.VrfIdentity{ValidatorAddress: valA, DrandBlsPublicKey: []byte("pk-a"), ChainHash: []byte{0xaa}, ShareIndex: 1}
+	identityB := vrftypes.VrfIdentity{ValidatorAddress: valB, DrandBlsPublicKey: []byte("pk-b"), ChainHash: []byte{0xbb}, ShareIndex: 2}
+	s.setV1Fixture(identityA, identityB)
+
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+
+	// v1 identities are readable but not indexed.
+	bound, err := k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().Empty(bound)
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+
+	s.Require().False(s.Ctx.KVStore(s.KeyVrf).Has(v2.ParamsUpdatedHeightKey))
+
+	bound, err = k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().Equal([]vrftypes.VrfIdentity{identityA}, bound)
+
+	bound, err = k.IdentitiesByChainHash(s.Ctx, []byte{0xbb})
+	s.Require().NoError(err)
+	s.Require().Equal([]vrftypes.VrfIdentity{identityB}, bound)
+
+	params, err := k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte{0xaa}, params.ChainHash)
+
+	// The migration is idempotent.
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+	bound, err = k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().Len(bound, 1)
+
+	// Index entries follow later updates.
+	identityA.ChainHash = []byte{0xbb}
+	s.Require().NoError(k.SetVrfIdentity(s.Ctx, identityA))
+	bound, err = k.IdentitiesByChainHash(s.Ctx, []byte{0xbb})
+	s.Require().NoError(err)
+	s.Require().Len(bound, 2)
+	bound, err = k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().Empty(bound)
+}
+
+func (s *MigrationSuite) TestMigrate1to2EmptyStore() {
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+}
@@ -0,0 +1,59 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// CommitteeKey is the key prefix of the committee allowlist. v2 stored the
+// label of each member; v3 stores an AllowlistEntry with its role grants.
+var CommitteeKey = collections.NewPrefix(3)
+
+// MigrateStore performs the in-place store migration from version 2 to 3. A v2
+// committee member could send every committee message, so each member is
+// granted every role without expiry. The module authority holds every role
+// implicitly and gets no grants.
+func MigrateStore(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	authority string,
+	committee collections.Map[string, types.AllowlistEntry],
+) error {
+	labels := collections.NewMap(
+		collections.NewSchemaBuilder(storeService),
+		CommitteeKey,
+		"vrf_committee",
+		collections.StringKey,
+		collections.StringValue,
+	)
+
+	// Collect first: rewriting the keys while iterating them is not safe on
+	// every store.
+	var stored []collections.KeyValue[string, string]
+	err := labels.Walk(ctx, nil, func(addr, label string) (bool, error) {
+		stored = append(stored, collections.KeyValue[string, string]{Key: addr, Value: label})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range stored {
+		entry := types.AllowlistEntry{Address: kv.Key, Label: kv.Value}
+		if kv.Key != authority {
+			for _, role := range types.AllCommitteeRoles() {
+				entry.Roles = append(entry.Roles, types.VrfCommitteeRoleGrant{Role: role})
+			}
+		}
+
+		if err := committee.Set(ctx, kv.Key, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	v3 "github.com/dgtlkitchen/vrf/x/vrf/migrations/v3"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type MigrationSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationSuite))
+}
+
+// setV2Committee writes v2 committee entries: the raw label under the member
+// address.
+func (s *MigrationSuite) setV2Committee(labels map[string]string) {
+	store := s.Ctx.KVStore(s.KeyVrf)
+	for addr, label := range labels {
+		store.Set(append(append([]byte{}, v3.CommitteeKey...), addr...), []byte(label))
+	}
+}
+
+func (s *MigrationSuite) TestMigrate2to3() {
+	member := sdk.AccAddress([]byte("member______________")).String()
+	s.setV2Committee(map[string]string{
+		s.Authority: "module_authority",
+		member:      "member",
+	})
+
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	s.Require().NoError(keeper.NewMigrator(k).Migrate2to3(s.Ctx))
+
+	entry, err := k.GetCommitteeMember(s.Ctx, member)
+	s.Require().NoError(err)
+	s.Require().Equal("member", entry.Label)
+	for _, role := range vrftypes.AllCommitteeRoles() {
+		ok, err := k.HasCommitteeRole(s.Ctx, member, role)
+		s.Require().NoError(err)
+		s.Require().True(ok, role.String())
+	}
+
+	authority, err := k.GetCommitteeMember(s.Ctx, s.Authority)
+	s.Require().NoError(err)
+	s.Require().Equal(vrftypes.AllowlistEntry{Address: s.Authority, Label: "module_authority"}, authority)
+
+	gs := k.ExportGenesis(s.Ctx)
+	s.Require().Len(gs.Committee, 2)
+}
+
+func (s *MigrationSuite) TestMigrate2to3EmptyStore() {
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	s.Require().NoError(keeper.NewMigrator(k).Migrate2to3(s.Ctx))
+}
@@ -0,0 +1,52 @@
+package v4
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// MigrateStore performs the in-place store migration from version 3 to 4: it
+// writes every stored identity again through identities, which fills in the
+// drand BLS public key index that v3 did not have, and records the round of
+// the latest drand beacon as the last drand round.
+func MigrateStore[I any](
+	ctx context.Context,
+	identities *collections.IndexedMap[string, types.VrfIdentity, I],
+	latestBeacon collections.Item[types.VrfBeacon],
+	lastDrandRound collections.Item[uint64],
+) error {
+	// Collect first: writing the indexes while iterating the primary map is
+	// not safe on every store.
+	var stored []collections.KeyValue[string, types.VrfIdentity]
+	err := identities.Walk(ctx, nil, func(valAddr string, identity types.VrfIdentity) (bool, error) {
+		stored = append(stored, collections.KeyValue[string, types.VrfIdentity]{Key: valAddr, Value: identity})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range stored {
+		if err := identities.Set(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	// A v3 store after an ECVRF fallback beacon no longer knows the last drand
+	// round; the non-regression check resumes with the next drand beacon.
+	beacon, err := latestBeacon.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case beacon.Source != types.VrfBeaconSource_VRF_BEACON_SOURCE_DRAND:
+		return nil
+	}
+
+	return lastDrandRound.Set(ctx, beacon.DrandRound)
+}
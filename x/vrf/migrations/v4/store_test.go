@@ -0,0 +1,64 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// blsKeyIndexPrefix is the key prefix of the identities-by-BLS-key index.
+var blsKeyIndexPrefix = []byte{20}
+
+type MigrationSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationSuite))
+}
+
+// countBlsKeyIndexEntries returns the number of entries in the BLS key index.
+func (s *MigrationSuite) countBlsKeyIndexEntries() int {
+	iter := storetypes.KVStorePrefixIterator(s.Ctx.KVStore(s.KeyVrf), blsKeyIndexPrefix)
+	defer iter.Close()
+
+	n := 0
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	return n
+}
+
+func (s *MigrationSuite) TestMigrate3to4() {
+	store := s.Ctx.KVStore(s.KeyVrf)
+	for _, name := range []string{"validator-a_________", "validator-b_________"} {
+		valAddr := sdk.ValAddress([]byte(name)).String()
+		identity := vrftypes.VrfIdentity{ValidatorAddress: valAddr, DrandBlsPublicKey: []byte("pk-" + name), ChainHash: []byte{0xaa}}
+		store.Set(append([]byte{5}, valAddr...), s.EncCfg.Codec.MustMarshal(&identity))
+	}
+	beacon := vrftypes.VrfBeacon{DrandRound: 42, Randomness: []byte("randomness")}
+	store.Set([]byte{1}, s.EncCfg.Codec.MustMarshal(&beacon))
+
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	s.Require().Zero(s.countBlsKeyIndexEntries())
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate3to4(s.Ctx))
+	s.Require().Equal(2, s.countBlsKeyIndexEntries())
+
+	identities, err := k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().Len(identities, 2)
+
+	round, err := k.GetLastDrandRound(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(42), round)
+}
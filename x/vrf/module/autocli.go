@@ -14,6 +14,11 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current VRF parameters",
 				},
+				{
+					RpcMethod: "PendingParams",
+					Use:       "pending-params",
+					Short:     "Query the scheduled VRF params change and its activation height",
+				},
 				{
 					RpcMethod: "Beacon",
 					Use:       "beacon",
@@ -24,6 +29,36 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "random-words",
 					Short:     "Expand the latest beacon into random words",
 				},
+				{
+					RpcMethod:      "Participation",
+					Use:            "participation [height]",
+					Short:          "Query the vote extension participation record for a height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "ValidatorParticipation",
+					Use:            "validator-participation [consensus-address]",
+					Short:          "Summarize a validator's vote extension participation over the retention window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address"}},
+				},
+				{
+					RpcMethod:      "RandomnessRequest",
+					Use:            "randomness-request [id]",
+					Short:          "Query a paid randomness request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "Timelocked",
+					Use:            "timelocked [id]",
+					Short:          "Query a time-locked ciphertext and its plaintext once decrypted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Invariants",
+					Use:       "invariants",
+					Short:     "Check the VRF module invariants against the current state",
+					Long:      "Check the VRF module invariants against the current state. --route limits the check to one of authority-committee, latest-beacon, identity-chain-hash and unique-bls-key.",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -55,13 +90,30 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "RegisterVrfIdentity",
 					Use:       "register-identity",
-					Short:     "Register the operator's drand BLS public key",
+					Short:     "Register the operator's drand BLS public key and share index",
+				},
+				{
+					RpcMethod: "RegisterEcvrfKey",
+					Use:       "register-ecvrf-key",
+					Short:     "Register the ed25519 key of the validator's ECVRF fallback proofs",
 				},
 				{
 					RpcMethod: "ScheduleVrfReshare",
 					Use:       "schedule-reshare",
 					Short:     "Schedule a VRF reshare by bumping reshare_epoch",
 				},
+				{
+					RpcMethod:      "RequestRandomness",
+					Use:            "request-randomness [num-words]",
+					Short:          "Pay for random words derived from the next drand round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "num_words"}},
+				},
+				{
+					RpcMethod:      "SubmitTimelocked",
+					Use:            "submit-timelocked [drand-round] [ciphertext]",
+					Short:          "Submit a ciphertext time-lock encrypted to a future drand round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "drand_round"}, {ProtoField: "ciphertext"}},
+				},
 			},
 		},
 	}
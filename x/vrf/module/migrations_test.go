@@ -0,0 +1,101 @@
+package module_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	v2 "github.com/dgtlkitchen/vrf/x/vrf/migrations/v2"
+	v3 "github.com/dgtlkitchen/vrf/x/vrf/migrations/v3"
+	vrfmodule "github.com/dgtlkitchen/vrf/x/vrf/module"
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type MigrationSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationSuite))
+}
+
+// TestMigrateFromV1 runs every registered migration, through the module
+// manager as an upgrade does, on a populated v1 store.
+func (s *MigrationSuite) TestMigrateFromV1() {
+	store := s.Ctx.KVStore(s.KeyVrf)
+
+	params := vrftypes.DefaultParams()
+	params.ChainHash = []byte{0xaa}
+	store.Set([]byte{0}, s.EncCfg.Codec.MustMarshal(&params))
+
+	updatedHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(updatedHeight, uint64(12)^(1<<63))
+	store.Set(v2.ParamsUpdatedHeightKey, updatedHeight)
+
+	member := sdk.AccAddress([]byte("member______________")).String()
+	store.Set(append(append([]byte{}, v3.CommitteeKey...), member...), []byte("member"))
+
+	var stored []vrftypes.VrfIdentity
+	for i, name := range []string{"validator-a_________", "validator-b_________"} {
+		identity := vrftypes.VrfIdentity{
+			ValidatorAddress:  sdk.ValAddress([]byte(name)).String(),
+			DrandBlsPublicKey: []byte("pk-" + name),
+			ChainHash:         []byte{0xaa},
+			ShareIndex:        uint32(i + 1),
+		}
+		store.Set(append([]byte{5}, identity.ValidatorAddress...), s.EncCfg.Codec.MustMarshal(&identity))
+		stored = append(stored, identity)
+	}
+
+	k := keeper.NewKeeper(runtime.NewKVStoreService(s.KeyVrf), s.EncCfg.Codec, s.Authority, nil, nil, nil)
+	am := vrfmodule.NewAppModule(s.EncCfg.Codec, k, nil, nil)
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(s.EncCfg.InterfaceRegistry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(s.EncCfg.InterfaceRegistry)
+	cfg := module.NewConfigurator(s.EncCfg.Codec, msgRouter, queryRouter)
+	am.RegisterServices(cfg)
+
+	mm := module.NewManager(am)
+	versions, err := mm.RunMigrations(s.Ctx, cfg, module.VersionMap{vrftypes.ModuleName: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(vrfmodule.ConsensusVersion), versions[vrftypes.ModuleName])
+
+	// v2: the params_updated_height item is gone and identities are indexed
+	// by chain hash.
+	s.Require().False(store.Has(v2.ParamsUpdatedHeightKey))
+	bound, err := k.IdentitiesByChainHash(s.Ctx, []byte{0xaa})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(stored, bound)
+
+	// v3: the v1 committee member holds every role.
+	for _, role := range vrftypes.AllCommitteeRoles() {
+		ok, err := k.HasCommitteeRole(s.Ctx, member, role)
+		s.Require().NoError(err)
+		s.Require().True(ok, role.String())
+	}
+
+	// v4: identities are indexed by drand key.
+	iter := storetypes.KVStorePrefixIterator(store, []byte{20})
+	defer iter.Close()
+	n := 0
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	s.Require().Equal(len(stored), n)
+
+	got, err := k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, got)
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -16,22 +17,27 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	"github.com/dgtlkitchen/vrf/x/vrf/simulation"
 	"github.com/dgtlkitchen/vrf/x/vrf/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 4
 
 var (
 	_ module.HasName        = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasServices    = AppModule{}
-	_ appmodule.AppModule   = AppModule{}
+
+	_ module.AppModuleSimulation = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -61,13 +67,17 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
 type AppModule struct {
 	AppModuleBasic
 
-	k keeper.Keeper
+	k             keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
-func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		k:              k,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
 	}
 }
 
@@ -80,6 +90,23 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.k))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.k))
+
+	m := keeper.NewMigrator(am.k)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+}
+
+// EndBlock prunes expired emergency tx records and checks the cheap module
+// invariants when enabled by params.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.k.EndBlocker(ctx)
 }
 
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
@@ -106,11 +133,38 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the vrf module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.k)
+}
+
+// RegisterStoreDecoder registers a decoder for the vrf module's collections.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.k.Schema())
+}
+
+// WeightedOperations returns the all the vrf module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.k)
+}
+
 type Inputs struct {
 	depinject.In
 
 	Cdc          codec.Codec
 	StoreService store.KVStoreService
+
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper
 }
 
 type Outputs struct {
@@ -123,9 +177,9 @@ type Outputs struct {
 
 func ProvideModule(in Inputs) Outputs {
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
-	k := keeper.NewKeeper(in.StoreService, in.Cdc, authority)
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, authority, in.BankKeeper, in.StakingKeeper, in.DistributionKeeper)
 
-	m := NewAppModule(in.Cdc, k)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 
 	return Outputs{
 		Keeper: &k,
@@ -2,6 +2,7 @@ package sidecar
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"cosmossdk.io/log"
@@ -23,16 +25,18 @@ var (
 	errNilLogger          = errors.New("logger cannot be nil")
 	errTimeoutNotPositive = errors.New("timeout must be positive")
 	errClientNotStarted   = errors.New("vrf sidecar client not started")
+	errClientDisabled     = errors.New("vrf sidecar client disabled")
 )
 
 type GRPCClient struct {
 	logger log.Logger
 	mutex  sync.Mutex
 
-	addr    string
-	conn    *grpc.ClientConn
-	client  sidecarv1.VrfClient
-	timeout time.Duration
+	addr      string
+	tlsConfig *tls.Config
+	conn      *grpc.ClientConn
+	client    sidecarv1.VrfClient
+	timeout   time.Duration
 }
 
 func NewClient(
@@ -55,11 +59,27 @@ func NewClient(
 	}, nil
 }
 
+// SetTLSConfig makes Start connect over TLS with cfg. A nil cfg, the
+// default, connects in plaintext.
+func (c *GRPCClient) SetTLSConfig(cfg *tls.Config) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.tlsConfig = cfg
+}
+
 func (c *GRPCClient) Start(_ context.Context) error {
-	c.logger.Info("starting vrf sidecar client", "addr", c.addr)
+	c.mutex.Lock()
+	tlsConfig := c.tlsConfig
+	c.mutex.Unlock()
+
+	c.logger.Info("starting vrf sidecar client", "addr", c.addr, "tls", tlsConfig != nil)
 
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	}
 
 	if after, ok := strings.CutPrefix(c.addr, "unix://"); ok {
@@ -139,3 +159,41 @@ func (c *GRPCClient) Info(
 
 	return cl.Info(ctx, req, opts...)
 }
+
+func (c *GRPCClient) PartialSignature(
+	ctx context.Context,
+	req *sidecarv1.QueryPartialSignatureRequest,
+	opts ...grpc.CallOption,
+) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	c.mutex.Lock()
+	cl := c.client
+	c.mutex.Unlock()
+
+	if cl == nil {
+		return nil, errClientNotStarted
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return cl.PartialSignature(ctx, req, opts...)
+}
+
+// WatchRandomness opens a WatchRandomness stream. Unlike the unary calls it
+// applies no timeout: the stream ends when ctx is cancelled or the sidecar
+// closes it.
+func (c *GRPCClient) WatchRandomness(
+	ctx context.Context,
+	req *sidecarv1.WatchRandomnessRequest,
+	opts ...grpc.CallOption,
+) (sidecarv1.Vrf_WatchRandomnessClient, error) {
+	c.mutex.Lock()
+	cl := c.client
+	c.mutex.Unlock()
+
+	if cl == nil {
+		return nil, errClientNotStarted
+	}
+
+	return cl.WatchRandomness(ctx, req, opts...)
+}
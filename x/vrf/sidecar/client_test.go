@@ -42,4 +42,15 @@ func (s *SidecarSuite) TestNotStarted() {
 
 	_, err = client.Info(context.Background(), &sidecarv1.QueryInfoRequest{})
 	s.Require().ErrorIs(err, errClientNotStarted)
+
+	_, err = client.PartialSignature(context.Background(), &sidecarv1.QueryPartialSignatureRequest{Round: 1})
+	s.Require().ErrorIs(err, errClientNotStarted)
+
+	_, err = client.WatchRandomness(context.Background(), &sidecarv1.WatchRandomnessRequest{})
+	s.Require().ErrorIs(err, errClientNotStarted)
+}
+
+func (s *SidecarSuite) TestNoOpClientWatch() {
+	_, err := NoOpClient{}.WatchRandomness(context.Background(), &sidecarv1.WatchRandomnessRequest{})
+	s.Require().ErrorIs(err, errClientDisabled)
 }
@@ -11,6 +11,10 @@ import (
 type Client interface {
 	Randomness(ctx context.Context, in *sidecarv1.QueryRandomnessRequest, opts ...grpc.CallOption) (*sidecarv1.QueryRandomnessResponse, error)
 	Info(ctx context.Context, in *sidecarv1.QueryInfoRequest, opts ...grpc.CallOption) (*sidecarv1.QueryInfoResponse, error)
+	PartialSignature(ctx context.Context, in *sidecarv1.QueryPartialSignatureRequest, opts ...grpc.CallOption) (*sidecarv1.QueryPartialSignatureResponse, error)
+	// WatchRandomness opens a stream of verified beacons. The stream lives
+	// until ctx is cancelled, so callers must not pass a request-scoped ctx.
+	WatchRandomness(ctx context.Context, in *sidecarv1.WatchRandomnessRequest, opts ...grpc.CallOption) (sidecarv1.Vrf_WatchRandomnessClient, error)
 
 	Start(context.Context) error
 	Stop() error
@@ -41,3 +45,21 @@ func (NoOpClient) Info(
 ) (*sidecarv1.QueryInfoResponse, error) {
 	return nil, nil
 }
+
+func (NoOpClient) PartialSignature(
+	_ context.Context,
+	_ *sidecarv1.QueryPartialSignatureRequest,
+	_ ...grpc.CallOption,
+) (*sidecarv1.QueryPartialSignatureResponse, error) {
+	return nil, nil
+}
+
+// WatchRandomness fails because there is no sidecar to stream from; returning
+// a nil stream would only move the failure to the caller's first Recv.
+func (NoOpClient) WatchRandomness(
+	_ context.Context,
+	_ *sidecarv1.WatchRandomnessRequest,
+	_ ...grpc.CallOption,
+) (sidecarv1.Vrf_WatchRandomnessClient, error) {
+	return nil, errClientDisabled
+}
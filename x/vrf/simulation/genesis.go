@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/util/random"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Simulation parameter constants
+const (
+	PeriodSeconds                = "period_seconds"
+	SafetyMarginSeconds          = "safety_margin_seconds"
+	RoundTolerance               = "round_tolerance"
+	ParticipationRetentionBlocks = "participation_retention_blocks"
+	SchemeID                     = "scheme_id"
+	WithDrandChain               = "with_drand_chain"
+	CommitteeSize                = "committee_size"
+	InvariantCheckIntervalBlocks = "invariant_check_interval_blocks"
+)
+
+// GenPeriodSeconds randomized PeriodSeconds
+func GenPeriodSeconds(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 61))
+}
+
+// GenSafetyMarginSeconds randomized SafetyMarginSeconds, at least one period
+func GenSafetyMarginSeconds(r *rand.Rand, period uint64) uint64 {
+	return period + uint64(r.Intn(61))
+}
+
+// GenRoundTolerance randomized RoundTolerance
+func GenRoundTolerance(r *rand.Rand) uint64 {
+	return uint64(r.Intn(types.MaxRoundTolerance + 1))
+}
+
+// GenParticipationRetentionBlocks randomized ParticipationRetentionBlocks
+func GenParticipationRetentionBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(2000))
+}
+
+// GenInvariantCheckIntervalBlocks randomized InvariantCheckIntervalBlocks,
+// zero (disabled) half of the time
+func GenInvariantCheckIntervalBlocks(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 11))
+}
+
+// GenCommitteeRoles randomized non-empty set of committee role grants. A
+// third of the grants expire within 100 blocks after height.
+func GenCommitteeRoles(r *rand.Rand, height int64) []types.VrfCommitteeRoleGrant {
+	all := types.AllCommitteeRoles()
+	n := simtypes.RandIntBetween(r, 1, len(all)+1)
+
+	grants := make([]types.VrfCommitteeRoleGrant, 0, n)
+	for _, i := range r.Perm(len(all))[:n] {
+		grant := types.VrfCommitteeRoleGrant{Role: all[i]}
+		if r.Intn(3) == 0 {
+			grant.ExpiryHeight = height + int64(simtypes.RandIntBetween(r, 1, 101))
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
+
+// GenSchemeID randomized SchemeId
+func GenSchemeID(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return crypto.DefaultSchemeID
+	}
+	return crypto.UnchainedSchemeID
+}
+
+// GenDrandChain fills in a random drand chain-info of params, as if the
+// initial DKG had completed before genesisTime. The group public key is drawn
+// from r, so the chain hash is deterministic for a seed.
+func GenDrandChain(r *rand.Rand, params types.VrfParams, genesisTime time.Time) (types.VrfParams, error) {
+	scheme, err := params.Scheme()
+	if err != nil {
+		return params, err
+	}
+
+	params.PublicKey, err = scheme.KeyGroup.Point().Pick(random.New(r)).MarshalBinary()
+	if err != nil {
+		return params, err
+	}
+
+	params.GenesisSeed = randBytes(r, 32)
+	params.GenesisUnixSec = genesisTime.Unix() - int64(r.Intn(86400))
+	params.ReshareEpoch = 1
+
+	params.ChainHash, err = params.DrandChainHash()
+	return params, err
+}
+
+// RandomizedGenState generates a random GenesisState for vrf. VRF stays
+// disabled: simulations produce no vote extensions, so an enabled chain could
+// not finalize a height.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(PeriodSeconds, &params.PeriodSeconds, simState.Rand, func(r *rand.Rand) { params.PeriodSeconds = GenPeriodSeconds(r) })
+
+	simState.AppParams.GetOrGenerate(SafetyMarginSeconds, &params.SafetyMarginSeconds, simState.Rand, func(r *rand.Rand) {
+		params.SafetyMarginSeconds = GenSafetyMarginSeconds(r, params.PeriodSeconds)
+	})
+
+	simState.AppParams.GetOrGenerate(RoundTolerance, &params.RoundTolerance, simState.Rand, func(r *rand.Rand) { params.RoundTolerance = GenRoundTolerance(r) })
+
+	simState.AppParams.GetOrGenerate(ParticipationRetentionBlocks, &params.ParticipationRetentionBlocks, simState.Rand, func(r *rand.Rand) {
+		params.ParticipationRetentionBlocks = GenParticipationRetentionBlocks(r)
+	})
+
+	simState.AppParams.GetOrGenerate(InvariantCheckIntervalBlocks, &params.InvariantCheckIntervalBlocks, simState.Rand, func(r *rand.Rand) {
+		params.InvariantCheckIntervalBlocks = GenInvariantCheckIntervalBlocks(r)
+	})
+
+	simState.AppParams.GetOrGenerate(SchemeID, &params.SchemeId, simState.Rand, func(r *rand.Rand) { params.SchemeId = GenSchemeID(r) })
+
+	var withChain bool
+	simState.AppParams.GetOrGenerate(WithDrandChain, &withChain, simState.Rand, func(r *rand.Rand) { withChain = r.Intn(2) == 0 })
+	if withChain {
+		var err error
+		params, err = GenDrandChain(simState.Rand, params, simState.GenTimestamp)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var committeeSize int
+	simState.AppParams.GetOrGenerate(CommitteeSize, &committeeSize, simState.Rand, func(r *rand.Rand) {
+		committeeSize = r.Intn(len(simState.Accounts) + 1)
+	})
+
+	committee := make([]types.AllowlistEntry, 0, committeeSize)
+	for _, acc := range simState.Rand.Perm(len(simState.Accounts))[:min(committeeSize, len(simState.Accounts))] {
+		committee = append(committee, types.AllowlistEntry{
+			Address: simState.Accounts[acc].Address.String(),
+			Label:   simtypes.RandStringOfLength(simState.Rand, 8),
+			Roles:   GenCommitteeRoles(simState.Rand, 0),
+		})
+	}
+
+	genesis := types.GenesisState{
+		Params:    params,
+		Committee: committee,
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+func randBytes(r *rand.Rand, n int) []byte {
+	bz := make([]byte, n)
+	_, _ = r.Read(bz)
+	return bz
+}
@@ -0,0 +1,74 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	vrftestutil "github.com/dgtlkitchen/vrf/x/vrf/testutil"
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+type GenesisSuite struct {
+	vrftestutil.VrfTestSuite
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(GenesisSuite))
+}
+
+func (s *GenesisSuite) TestRandomizedGenState() {
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          s.EncCfg.Codec,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 5),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: time.Unix(1700000000, 0),
+		}
+
+		RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		s.Require().NoError(s.EncCfg.Codec.UnmarshalJSON(simState.GenState[types.ModuleName], &genesis))
+		s.Require().NoError(genesis.Validate(), "seed %d", seed)
+		s.Require().False(genesis.Params.Enabled)
+		s.Require().LessOrEqual(len(genesis.Committee), len(simState.Accounts))
+
+		if len(genesis.Params.ChainHash) > 0 {
+			chainHash, err := genesis.Params.DrandChainHash()
+			s.Require().NoError(err)
+			s.Require().Equal(chainHash, genesis.Params.ChainHash)
+		}
+	}
+}
+
+func (s *GenesisSuite) TestRandomizedGenStateDeterministic() {
+	gen := func() json.RawMessage {
+		r := rand.New(rand.NewSource(42))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          s.EncCfg.Codec,
+			Rand:         r,
+			Accounts:     simtypes.RandomAccounts(r, 5),
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: time.Unix(1700000000, 0),
+		}
+		RandomizedGenState(&simState)
+		return simState.GenState[types.ModuleName]
+	}
+
+	s.Require().JSONEq(string(gen()), string(gen()))
+}
@@ -0,0 +1,473 @@
+package simulation
+
+import (
+	"crypto/ed25519"
+	"math/rand"
+
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber/util/random"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/emergency"
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgVrfEmergencyDisable = "op_weight_msg_vrf_emergency_disable"
+	OpWeightMsgInitialDkg          = "op_weight_msg_initial_dkg"
+	OpWeightMsgRegisterVrfIdentity = "op_weight_msg_register_vrf_identity"
+	OpWeightMsgRegisterEcvrfKey    = "op_weight_msg_register_ecvrf_key"
+	OpWeightMsgScheduleVrfReshare  = "op_weight_msg_schedule_vrf_reshare"
+	OpWeightMsgRequestRandomness   = "op_weight_msg_request_randomness"
+	OpWeightMsgSubmitTimelocked    = "op_weight_msg_submit_timelocked"
+
+	DefaultWeightMsgVrfEmergencyDisable = 5
+	DefaultWeightMsgInitialDkg          = 10
+	DefaultWeightMsgRegisterVrfIdentity = 50
+	DefaultWeightMsgRegisterEcvrfKey    = 20
+	DefaultWeightMsgScheduleVrfReshare  = 20
+	DefaultWeightMsgRequestRandomness   = 50
+	DefaultWeightMsgSubmitTimelocked    = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgVrfEmergencyDisable int
+		weightMsgInitialDkg          int
+		weightMsgRegisterVrfIdentity int
+		weightMsgRegisterEcvrfKey    int
+		weightMsgScheduleVrfReshare  int
+		weightMsgRequestRandomness   int
+		weightMsgSubmitTimelocked    int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgVrfEmergencyDisable, &weightMsgVrfEmergencyDisable, nil, func(_ *rand.Rand) {
+		weightMsgVrfEmergencyDisable = DefaultWeightMsgVrfEmergencyDisable
+	})
+	appParams.GetOrGenerate(OpWeightMsgInitialDkg, &weightMsgInitialDkg, nil, func(_ *rand.Rand) {
+		weightMsgInitialDkg = DefaultWeightMsgInitialDkg
+	})
+	appParams.GetOrGenerate(OpWeightMsgRegisterVrfIdentity, &weightMsgRegisterVrfIdentity, nil, func(_ *rand.Rand) {
+		weightMsgRegisterVrfIdentity = DefaultWeightMsgRegisterVrfIdentity
+	})
+	appParams.GetOrGenerate(OpWeightMsgRegisterEcvrfKey, &weightMsgRegisterEcvrfKey, nil, func(_ *rand.Rand) {
+		weightMsgRegisterEcvrfKey = DefaultWeightMsgRegisterEcvrfKey
+	})
+	appParams.GetOrGenerate(OpWeightMsgScheduleVrfReshare, &weightMsgScheduleVrfReshare, nil, func(_ *rand.Rand) {
+		weightMsgScheduleVrfReshare = DefaultWeightMsgScheduleVrfReshare
+	})
+	appParams.GetOrGenerate(OpWeightMsgRequestRandomness, &weightMsgRequestRandomness, nil, func(_ *rand.Rand) {
+		weightMsgRequestRandomness = DefaultWeightMsgRequestRandomness
+	})
+	appParams.GetOrGenerate(OpWeightMsgSubmitTimelocked, &weightMsgSubmitTimelocked, nil, func(_ *rand.Rand) {
+		weightMsgSubmitTimelocked = DefaultWeightMsgSubmitTimelocked
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgVrfEmergencyDisable, SimulateMsgVrfEmergencyDisable(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgInitialDkg, SimulateMsgInitialDkg(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRegisterVrfIdentity, SimulateMsgRegisterVrfIdentity(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRegisterEcvrfKey, SimulateMsgRegisterEcvrfKey(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgScheduleVrfReshare, SimulateMsgScheduleVrfReshare(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRequestRandomness, SimulateMsgRequestRandomness(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitTimelocked, SimulateMsgSubmitTimelocked(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgVrfEmergencyDisable generates a MsgVrfEmergencyDisable signed by
+// a random committee member. The tx is gasless, so it is delivered without
+// fees.
+func SimulateMsgVrfEmergencyDisable(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVrfEmergencyDisable{})
+
+		member, ok := randomRoleHolder(r, ctx, k, accs, types.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no emergency role holder among the accounts"), nil, nil
+		}
+
+		// The emergency path skips the decorator that sets public keys, so it
+		// can only authorize accounts that already sent a regular tx.
+		if acc := ak.GetAccount(ctx, member.Address); acc == nil || acc.GetPubKey() == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "committee member has no public key"), nil, nil
+		}
+		included, err := k.CountEmergencyTxs(ctx, member.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to count emergency txs"), nil, err
+		}
+		if included >= emergency.MaxTxsPerSigner {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "committee member reached the emergency tx cap"), nil, nil
+		}
+
+		msg := &types.MsgVrfEmergencyDisable{
+			Authority: member.Address.String(),
+			Reason:    simtypes.RandStringOfLength(r, 16),
+		}
+
+		return deliverEmergencyTx(r, app, ctx, txGen, ak, member, msg)
+	}
+}
+
+// deliverEmergencyTx signs msg into a fee-less tx that times out within
+// emergency.MaxTimeoutBlocks, as emergency disable txs must, and delivers it.
+func deliverEmergencyTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	account := ak.GetAccount(ctx, simAccount.Address)
+
+	builder := txGen.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to set msgs"), nil, err
+	}
+	builder.SetGasLimit(simtestutil.DefaultGenTxGas)
+	builder.SetTimeoutHeight(uint64(ctx.BlockHeight()) + 1 + uint64(r.Intn(emergency.MaxTimeoutBlocks)))
+
+	// The first round sets the signer infos that the signature covers.
+	sig := signing.SignatureV2{
+		PubKey:   simAccount.PubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: account.GetSequence(),
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to set signatures"), nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       simAccount.Address.String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+		PubKey:        simAccount.PubKey,
+	}
+	sig, err := clienttx.SignWithPrivKey(ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, builder, simAccount.PrivKey, txGen, account.GetSequence())
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign tx"), nil, err
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to set signatures"), nil, err
+	}
+
+	if _, _, err := app.SimDeliver(txGen.TxEncoder(), builder.GetTx()); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// SimulateMsgInitialDkg generates a MsgInitialDkg with a random drand
+// chain-info, submitted by a random committee member while no chain-info is
+// set.
+func SimulateMsgInitialDkg(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgInitialDkg{})
+
+		params, err := k.LatestParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		if params.ReshareEpoch != 0 || len(params.ChainHash) > 0 || len(params.PublicKey) > 0 || params.GenesisUnixSec != 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "initial dkg already set"), nil, nil
+		}
+
+		member, ok := randomRoleHolder(r, ctx, k, accs, types.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no dkg-bootstrap role holder among the accounts"), nil, nil
+		}
+
+		params.PeriodSeconds = GenPeriodSeconds(r)
+		chain, err := GenDrandChain(r, params, ctx.BlockTime())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate drand chain"), nil, err
+		}
+
+		msg := &types.MsgInitialDkg{
+			Initiator:      member.Address.String(),
+			ChainHash:      chain.ChainHash,
+			PublicKey:      chain.PublicKey,
+			PeriodSeconds:  chain.PeriodSeconds,
+			GenesisUnixSec: chain.GenesisUnixSec,
+			GenesisSeed:    chain.GenesisSeed,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, member, msg, nil))
+	}
+}
+
+// SimulateMsgRegisterVrfIdentity generates a MsgRegisterVrfIdentity for a
+// random account. In threshold mode it picks a share index no other validator
+// of the chain holds.
+func SimulateMsgRegisterVrfIdentity(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterVrfIdentity{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+
+		operator, _ := simtypes.RandomAcc(r, accs)
+		shareIndex := uint32(r.Intn(100))
+
+		if params.PartialThreshold > 0 {
+			bound, err := k.IdentitiesByChainHash(ctx, params.ChainHash)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get identities"), nil, err
+			}
+
+			taken := make(map[uint32]bool, len(bound))
+			valAddr := sdk.ValAddress(operator.Address).String()
+			for _, identity := range bound {
+				if identity.ValidatorAddress != valAddr {
+					taken[identity.ShareIndex] = true
+				}
+			}
+			for taken[shareIndex] {
+				shareIndex++
+			}
+		}
+
+		scheme, err := params.Scheme()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unsupported drand scheme"), nil, nil
+		}
+
+		priv := scheme.KeyGroup.Scalar().Pick(random.New(r))
+		pubKey, err := scheme.KeyGroup.Point().Mul(priv, nil).MarshalBinary()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to encode drand key"), nil, err
+		}
+
+		proof, err := types.SignIdentityProof(scheme, priv, ctx.ChainID(), sdk.ValAddress(operator.Address).String(), shareIndex)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign identity proof"), nil, err
+		}
+
+		msg := &types.MsgRegisterVrfIdentity{
+			Operator:          operator.Address.String(),
+			DrandBlsPublicKey: pubKey,
+			ShareIndex:        shareIndex,
+			DrandBlsProof:     proof,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, operator, msg, nil))
+	}
+}
+
+// SimulateMsgRegisterEcvrfKey generates a MsgRegisterEcvrfKey with a new
+// ECVRF key for a random account.
+func SimulateMsgRegisterEcvrfKey(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterEcvrfKey{})
+
+		operator, _ := simtypes.RandomAcc(r, accs)
+
+		seed := make([]byte, ed25519.SeedSize)
+		_, _ = r.Read(seed)
+		priv := ed25519.NewKeyFromSeed(seed)
+
+		proof, err := types.ECVRFProve(priv, types.ECVRFKeyProofInput(ctx.ChainID(), sdk.ValAddress(operator.Address).String()))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to prove ECVRF key"), nil, err
+		}
+
+		msg := &types.MsgRegisterEcvrfKey{
+			Operator:       operator.Address.String(),
+			EcvrfPublicKey: priv.Public().(ed25519.PublicKey),
+			EcvrfProof:     proof,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, operator, msg, nil))
+	}
+}
+
+// SimulateMsgScheduleVrfReshare generates a MsgScheduleVrfReshare that bumps
+// the reshare epoch, submitted by a random committee member.
+func SimulateMsgScheduleVrfReshare(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgScheduleVrfReshare{})
+
+		member, ok := randomRoleHolder(r, ctx, k, accs, types.VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no reshare-scheduler role holder among the accounts"), nil, nil
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+
+		msg := &types.MsgScheduleVrfReshare{
+			Scheduler:    member.Address.String(),
+			ReshareEpoch: params.ReshareEpoch + uint64(simtypes.RandIntBetween(r, 1, 4)),
+			Reason:       simtypes.RandStringOfLength(r, 16),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, member, msg, nil))
+	}
+}
+
+// SimulateMsgRequestRandomness generates a MsgRequestRandomness from a random
+// account that can afford the request fee. Requests need VRF enabled.
+func SimulateMsgRequestRandomness(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRequestRandomness{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		if !params.Enabled || types.RoundAt(params, ctx.BlockTime()) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "vrf is disabled"), nil, nil
+		}
+
+		requester, _ := simtypes.RandomAcc(r, accs)
+		numWords := uint32(simtypes.RandIntBetween(r, 1, types.MaxRequestWords+1))
+		fee := params.RequestFee(numWords)
+		if !fee.IsAllLTE(bk.SpendableCoins(ctx, requester.Address)) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "requester cannot afford the fee"), nil, nil
+		}
+
+		msg := &types.MsgRequestRandomness{
+			Requester: requester.Address.String(),
+			NumWords:  numWords,
+			UserSeed:  randBytes(r, r.Intn(types.MaxRequestUserSeedLength+1)),
+			MaxFee:    fee,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, requester, msg, fee))
+	}
+}
+
+// SimulateMsgSubmitTimelocked generates a MsgSubmitTimelocked to a future
+// round. The ciphertext is well-formed random data drawn from r: encrypting
+// for real draws from crypto/rand and would make runs non-deterministic.
+func SimulateMsgSubmitTimelocked(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitTimelocked{})
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		if !params.Unchained() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "time-lock encryption needs an unchained scheme"), nil, nil
+		}
+
+		u, err := crypto.NewPedersenBLSUnchained().KeyGroup.Point().Pick(random.New(r)).MarshalBinary()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate ciphertext"), nil, err
+		}
+
+		submitter, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSubmitTimelocked{
+			Submitter:  submitter.Address.String(),
+			DrandRound: types.RoundAt(params, ctx.BlockTime()) + uint64(simtypes.RandIntBetween(r, 1, 100)),
+			Ciphertext: types.TimelockCiphertext{
+				U:       u,
+				V:       randBytes(r, chacha20poly1305.KeySize),
+				W:       randBytes(r, chacha20poly1305.KeySize),
+				Payload: randBytes(r, chacha20poly1305.Overhead+r.Intn(types.MaxTimelockPayloadLength+1)),
+			},
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, txGen, ak, bk, submitter, msg, nil))
+	}
+}
+
+// randomCommitteeMember returns a random account of accs that is in the
+// committee allowlist.
+func randomCommitteeMember(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	return randomAccount(r, accs, func(addr string) bool {
+		_, err := k.GetCommitteeMember(ctx, addr)
+		return err == nil
+	})
+}
+
+// randomRoleHolder returns a random account of accs that holds role.
+func randomRoleHolder(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, role types.VrfCommitteeRole) (simtypes.Account, bool) {
+	return randomAccount(r, accs, func(addr string) bool {
+		ok, err := k.HasCommitteeRole(ctx, addr, role)
+		return err == nil && ok
+	})
+}
+
+func randomAccount(r *rand.Rand, accs []simtypes.Account, filter func(addr string) bool) (simtypes.Account, bool) {
+	var matches []simtypes.Account
+	for _, acc := range accs {
+		if filter(acc.Address.String()) {
+			matches = append(matches, acc)
+		}
+	}
+
+	if len(matches) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return matches[r.Intn(len(matches))], true
+}
+
+func operationInput(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+}
@@ -0,0 +1,104 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/dgtlkitchen/vrf/x/vrf/keeper"
+	"github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams             int = 50
+	DefaultWeightMsgAddVrfCommitteeMember    int = 50
+	DefaultWeightMsgRemoveVrfCommitteeMember int = 20
+
+	OpWeightMsgUpdateParams             = "op_weight_msg_update_params"
+	OpWeightMsgAddVrfCommitteeMember    = "op_weight_msg_add_vrf_committee_member"
+	OpWeightMsgRemoveVrfCommitteeMember = "op_weight_msg_remove_vrf_committee_member"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents. These are the
+// messages only the module authority may send.
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgAddVrfCommitteeMember,
+			DefaultWeightMsgAddVrfCommitteeMember,
+			SimulateMsgAddVrfCommitteeMember,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgRemoveVrfCommitteeMember,
+			DefaultWeightMsgRemoveVrfCommitteeMember,
+			SimulateMsgRemoveVrfCommitteeMember(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams that keeps the drand
+// chain-info and enabled flag of the latest params and randomizes the rest.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params, err := k.LatestParams(ctx)
+		if err != nil {
+			params = types.DefaultParams()
+		}
+
+		params.SafetyMarginSeconds = GenSafetyMarginSeconds(r, params.PeriodSeconds)
+		params.RoundTolerance = GenRoundTolerance(r)
+		params.ParticipationRetentionBlocks = GenParticipationRetentionBlocks(r)
+		params.SlashingGraceBlocks = uint64(r.Intn(1000))
+		params.InvariantCheckIntervalBlocks = GenInvariantCheckIntervalBlocks(r)
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
+
+// SimulateMsgAddVrfCommitteeMember returns a MsgAddVrfCommitteeMember for a
+// random account.
+func SimulateMsgAddVrfCommitteeMember(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+	var authority sdk.AccAddress = address.Module("gov")
+
+	acc, _ := simtypes.RandomAcc(r, accs)
+
+	return &types.MsgAddVrfCommitteeMember{
+		Authority: authority.String(),
+		Address:   acc.Address.String(),
+		Label:     simtypes.RandStringOfLength(r, 8),
+		Roles:     GenCommitteeRoles(r, ctx.BlockHeight()),
+	}
+}
+
+// SimulateMsgRemoveVrfCommitteeMember returns a MsgRemoveVrfCommitteeMember
+// for a random committee member, or nil when no account is in the committee.
+func SimulateMsgRemoveVrfCommitteeMember(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		var authority sdk.AccAddress = address.Module("gov")
+
+		member, ok := randomCommitteeMember(r, ctx, k, accs)
+		if !ok {
+			return nil
+		}
+
+		return &types.MsgRemoveVrfCommitteeMember{
+			Authority: authority.String(),
+			Address:   member.Address.String(),
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AuthzKeeper is an in-memory stand-in for the x/authz keeper. It serves the
+// grants added with Grant and does not model expiration.
+type AuthzKeeper struct {
+	grants map[string]authz.Authorization
+}
+
+// NewAuthzKeeper returns an AuthzKeeper without grants.
+func NewAuthzKeeper() *AuthzKeeper {
+	return &AuthzKeeper{grants: make(map[string]authz.Authorization)}
+}
+
+// Grant stores a from granter to grantee, replacing any grant for the same
+// msg type.
+func (k *AuthzKeeper) Grant(grantee, granter sdk.AccAddress, a authz.Authorization) {
+	k.grants[grantKey(grantee, granter, a.MsgTypeURL())] = a
+}
+
+// GetAuthorization returns the grant from granter to grantee for msgType, or
+// nil when there is none.
+func (k *AuthzKeeper) GetAuthorization(_ context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	return k.grants[grantKey(grantee, granter, msgType)], nil
+}
+
+func grantKey(grantee, granter sdk.AccAddress, msgType string) string {
+	return grantee.String() + "/" + granter.String() + "/" + msgType
+}
@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"github.com/drand/kyber/util/random"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
+)
+
+// WithDrandChain fills in the drand chain-info of params: a random group
+// public key on the key group of params.scheme_id, a genesis seed and the
+// matching chain hash. period_seconds and genesis_unix_sec are kept.
+func WithDrandChain(params vrftypes.VrfParams) (vrftypes.VrfParams, error) {
+	scheme, err := params.Scheme()
+	if err != nil {
+		return params, err
+	}
+
+	pubKey, err := scheme.KeyGroup.Point().Pick(random.New()).MarshalBinary()
+	if err != nil {
+		return params, err
+	}
+
+	params.PublicKey = pubKey
+	params.GenesisSeed = []byte("genesis seed")
+
+	params.ChainHash, err = params.DrandChainHash()
+	return params, err
+}
+
+// NewRegisterIdentityMsg returns a MsgRegisterVrfIdentity of operator with a
+// fresh drand key on the key group of params.scheme_id and its proof for
+// chainID and shareIndex.
+func NewRegisterIdentityMsg(
+	params vrftypes.VrfParams,
+	chainID string,
+	operator sdk.AccAddress,
+	shareIndex uint32,
+) (*vrftypes.MsgRegisterVrfIdentity, error) {
+	scheme, err := params.Scheme()
+	if err != nil {
+		return nil, err
+	}
+
+	priv := scheme.KeyGroup.Scalar().Pick(random.New())
+	pubKey, err := scheme.KeyGroup.Point().Mul(priv, nil).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := vrftypes.SignIdentityProof(scheme, priv, chainID, sdk.ValAddress(operator).String(), shareIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vrftypes.MsgRegisterVrfIdentity{
+		Operator:          operator.String(),
+		DrandBlsPublicKey: pubKey,
+		ShareIndex:        shareIndex,
+		DrandBlsProof:     proof,
+	}, nil
+}
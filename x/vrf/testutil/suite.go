@@ -21,6 +21,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	vrftypes "github.com/dgtlkitchen/vrf/x/vrf/types"
@@ -57,7 +58,7 @@ func (s *VrfTestSuite) SetupTest() {
 	)
 	ctx = ctx.WithChainID("test-chain").WithBlockHeight(1).WithBlockTime(time.Unix(1700000000, 0).UTC())
 
-	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, authzmodule.AppModuleBasic{})
 	vrftypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -77,6 +78,8 @@ func (s *VrfTestSuite) SetupTest() {
 	s.Require().NoError(acc.SetAccountNumber(1))
 	s.Require().NoError(acc.SetPubKey(priv.PubKey()))
 	ak.SetAccount(ctx, acc)
+	// Skip number 1, so that AddAccount does not reuse it.
+	_ = ak.NextAccountNumber(ctx)
 
 	s.Ctx = ctx
 	s.EncCfg = encCfg
@@ -87,14 +90,43 @@ func (s *VrfTestSuite) SetupTest() {
 	s.Addr = addr
 }
 
+// BuildSignedTx builds a tx of msgs signed by the suite account. It times out
+// 20 blocks after the suite height, which emergency disable txs require.
 func (s *VrfTestSuite) BuildSignedTx(msgs ...sdk.Msg) (authsigning.Tx, error) {
+	return s.BuildSignedTxWithTimeout(uint64(s.Ctx.BlockHeight())+20, msgs...)
+}
+
+// BuildSignedTxWithTimeout is BuildSignedTx with the given timeout_height.
+func (s *VrfTestSuite) BuildSignedTxWithTimeout(timeoutHeight uint64, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	return s.BuildSignedTxAs(s.Priv, timeoutHeight, msgs...)
+}
+
+// AddAccount stores a new account with a public key and returns its key.
+func (s *VrfTestSuite) AddAccount() (cryptotypes.PrivKey, sdk.AccAddress) {
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := s.AccountKeeper.NewAccountWithAddress(s.Ctx, addr)
+	s.Require().NoError(acc.SetPubKey(priv.PubKey()))
+	s.AccountKeeper.SetAccount(s.Ctx, acc)
+
+	return priv, addr
+}
+
+// BuildSignedTxAs is BuildSignedTxWithTimeout signed by the account of priv.
+func (s *VrfTestSuite) BuildSignedTxAs(priv cryptotypes.PrivKey, timeoutHeight uint64, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	var accNum uint64
+	if acc := s.AccountKeeper.GetAccount(s.Ctx, addr); acc != nil {
+		accNum = acc.GetAccountNumber()
+	}
+
 	txBuilder := s.EncCfg.TxConfig.NewTxBuilder()
 	if err := txBuilder.SetMsgs(msgs...); err != nil {
 		return nil, err
 	}
+	txBuilder.SetTimeoutHeight(timeoutHeight)
 
 	sig := signing.SignatureV2{
-		PubKey: s.Priv.PubKey(),
+		PubKey: priv.PubKey(),
 		Data: &signing.SingleSignatureData{
 			SignMode: signing.SignMode_SIGN_MODE_DIRECT,
 		},
@@ -105,11 +137,11 @@ func (s *VrfTestSuite) BuildSignedTx(msgs ...sdk.Msg) (authsigning.Tx, error) {
 	}
 
 	signerData := authsigning.SignerData{
-		Address:       s.Addr.String(),
+		Address:       addr.String(),
 		ChainID:       s.Ctx.ChainID(),
-		AccountNumber: 1,
+		AccountNumber: accNum,
 		Sequence:      0,
-		PubKey:        s.Priv.PubKey(),
+		PubKey:        priv.PubKey(),
 	}
 
 	sigV2, err := tx.SignWithPrivKey(
@@ -117,7 +149,7 @@ func (s *VrfTestSuite) BuildSignedTx(msgs ...sdk.Msg) (authsigning.Tx, error) {
 		signing.SignMode_SIGN_MODE_DIRECT,
 		signerData,
 		txBuilder,
-		s.Priv,
+		priv,
 		s.EncCfg.TxConfig,
 		0,
 	)
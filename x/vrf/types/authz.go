@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &EmergencyDisableAuthorization{}
+
+var errEmergencyDisableAuthorizationMsgType = errors.New("EmergencyDisableAuthorization: unexpected msg type")
+
+// NewEmergencyDisableAuthorization returns an authorization to submit
+// MsgVrfEmergencyDisable on behalf of the granter.
+func NewEmergencyDisableAuthorization() *EmergencyDisableAuthorization {
+	return &EmergencyDisableAuthorization{}
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a EmergencyDisableAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgVrfEmergencyDisable{})
+}
+
+// Accept implements authz.Authorization. The grant is not consumed: it keeps
+// working until it expires or the granter revokes it.
+func (a EmergencyDisableAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if _, ok := msg.(*MsgVrfEmergencyDisable); !ok {
+		return authz.AcceptResponse{}, fmt.Errorf("%w: %T", errEmergencyDisableAuthorizationMsgType, msg)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a EmergencyDisableAuthorization) ValidateBasic() error {
+	return nil
+}
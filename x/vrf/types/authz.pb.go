@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: digitalkitchen/vrf/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EmergencyDisableAuthorization lets a grantee submit MsgVrfEmergencyDisable
+// on behalf of the granter through x/authz MsgExec. The emergency path only
+// honors this authorization type, so that a generic grant for every message
+// type does not also hand out emergency rights. The granter must hold the
+// emergency committee role when the MsgExec is checked.
+type EmergencyDisableAuthorization struct {
+}
+
+func (m *EmergencyDisableAuthorization) Reset()         { *m = EmergencyDisableAuthorization{} }
+func (m *EmergencyDisableAuthorization) String() string { return proto.CompactTextString(m) }
+func (*EmergencyDisableAuthorization) ProtoMessage()    {}
+func (*EmergencyDisableAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7a6c4ee0876953, []int{0}
+}
+func (m *EmergencyDisableAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyDisableAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyDisableAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencyDisableAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyDisableAuthorization.Merge(m, src)
+}
+func (m *EmergencyDisableAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyDisableAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyDisableAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyDisableAuthorization proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EmergencyDisableAuthorization)(nil), "digitalkitchen.vrf.v1.EmergencyDisableAuthorization")
+}
+
+func init() { proto.RegisterFile("digitalkitchen/vrf/v1/authz.proto", fileDescriptor_0b7a6c4ee0876953) }
+
+var fileDescriptor_0b7a6c4ee0876953 = []byte{
+	// 219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xc9, 0x4c, 0xcf,
+	0x2c, 0x49, 0xcc, 0xc9, 0xce, 0x2c, 0x49, 0xce, 0x48, 0xcd, 0xd3, 0x2f, 0x2b, 0x4a, 0xd3, 0x2f,
+	0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45,
+	0x55, 0xa2, 0x57, 0x56, 0x94, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf,
+	0x0f, 0x26, 0x21, 0x2a, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x3c, 0x7d,
+	0x08, 0x07, 0x22, 0xa5, 0x94, 0xcb, 0x25, 0xeb, 0x9a, 0x9b, 0x5a, 0x94, 0x9e, 0x9a, 0x97, 0x5c,
+	0xe9, 0x92, 0x59, 0x9c, 0x98, 0x94, 0x93, 0xea, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x95,
+	0x58, 0x92, 0x99, 0x9f, 0x67, 0xe5, 0x73, 0x6a, 0x8b, 0xae, 0x12, 0x54, 0x0b, 0xc4, 0xf6, 0x32,
+	0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0x3d, 0x14, 0x75, 0x5d, 0xcf, 0x37, 0x68, 0x29, 0x82, 0x1c,
+	0x89, 0xd7, 0x34, 0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
+	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52,
+	0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0x49, 0x2f, 0x41, 0xf1,
+	0x78, 0x05, 0x98, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xdb, 0x18, 0x10, 0x00,
+	0x00, 0xff, 0xff, 0x95, 0xfe, 0x03, 0xe8, 0x21, 0x01, 0x00, 0x00,
+}
+
+func (m *EmergencyDisableAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyDisableAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyDisableAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmergencyDisableAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmergencyDisableAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyDisableAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyDisableAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec.
@@ -15,7 +16,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddVrfCommitteeMember{}, "vrf/MsgAddVrfCommitteeMember", nil)
 	cdc.RegisterConcrete(&MsgRemoveVrfCommitteeMember{}, "vrf/MsgRemoveVrfCommitteeMember", nil)
 	cdc.RegisterConcrete(&MsgRegisterVrfIdentity{}, "vrf/MsgRegisterVrfIdentity", nil)
+	cdc.RegisterConcrete(&MsgRegisterEcvrfKey{}, "vrf/MsgRegisterEcvrfKey", nil)
 	cdc.RegisterConcrete(&MsgScheduleVrfReshare{}, "vrf/MsgScheduleVrfReshare", nil)
+	cdc.RegisterConcrete(&MsgRequestRandomness{}, "vrf/MsgRequestRandomness", nil)
+	cdc.RegisterConcrete(&MsgSubmitTimelocked{}, "vrf/MsgSubmitTimelocked", nil)
+	cdc.RegisterConcrete(&EmergencyDisableAuthorization{}, "vrf/EmergencyDisableAuthorization", nil)
 }
 
 // RegisterInterfaces registers the module's interface types.
@@ -28,7 +33,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddVrfCommitteeMember{},
 		&MsgRemoveVrfCommitteeMember{},
 		&MsgRegisterVrfIdentity{},
+		&MsgRegisterEcvrfKey{},
 		&MsgScheduleVrfReshare{},
+		&MsgRequestRandomness{},
+		&MsgSubmitTimelocked{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&EmergencyDisableAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	errCommitteeRoleInvalid        = errors.New("committee role is invalid")
+	errCommitteeRoleDuplicate      = errors.New("committee role is granted more than once")
+	errCommitteeRoleExpiryNegative = errors.New("committee role expiry_height must not be negative")
+)
+
+// AllCommitteeRoles returns every committee role.
+func AllCommitteeRoles() []VrfCommitteeRole {
+	return []VrfCommitteeRole{
+		VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY,
+		VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP,
+		VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER,
+	}
+}
+
+// Active reports whether the grant applies at height.
+func (g VrfCommitteeRoleGrant) Active(height int64) bool {
+	return g.ExpiryHeight == 0 || height < g.ExpiryHeight
+}
+
+// Validate checks the address and the role grants of the entry.
+func (e AllowlistEntry) Validate() error {
+	if e.Address == "" {
+		return errCommitteeAddressEmpty
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("committee address is invalid: %w", err)
+	}
+
+	return ValidateCommitteeRoles(e.Roles)
+}
+
+// HasRole reports whether the entry holds role at height.
+func (e AllowlistEntry) HasRole(role VrfCommitteeRole, height int64) bool {
+	for _, grant := range e.Roles {
+		if grant.Role == role && grant.Active(height) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateCommitteeRoles checks that every grant names a known role, at most
+// once, with a non-negative expiry height.
+func ValidateCommitteeRoles(roles []VrfCommitteeRoleGrant) error {
+	seen := make(map[VrfCommitteeRole]bool, len(roles))
+	for _, grant := range roles {
+		if _, ok := VrfCommitteeRole_name[int32(grant.Role)]; !ok || grant.Role == VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED {
+			return fmt.Errorf("%w: %d", errCommitteeRoleInvalid, grant.Role)
+		}
+		if seen[grant.Role] {
+			return fmt.Errorf("%w: %s", errCommitteeRoleDuplicate, grant.Role)
+		}
+		seen[grant.Role] = true
+
+		if grant.ExpiryHeight < 0 {
+			return errCommitteeRoleExpiryNegative
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+
+	"filippo.io/edwards25519"
+)
+
+var (
+	errECVRFInvalidPrivateKey = errors.New("invalid ECVRF private key")
+	errECVRFInvalidPublicKey  = errors.New("invalid ECVRF public key")
+	errECVRFInvalidProof      = errors.New("invalid ECVRF proof")
+	errECVRFHashToCurveFailed = errors.New("ECVRF hash to curve failed")
+)
+
+const (
+	// ECVRFProofLength is the size of an ECVRF-EDWARDS25519-SHA512-TAI proof:
+	// Gamma (32 bytes) || c (16 bytes) || s (32 bytes).
+	ECVRFProofLength = 80
+	// ECVRFOutputLength is the size of the ECVRF output beta.
+	ECVRFOutputLength = sha512.Size
+
+	ecvrfPointLength     = 32
+	ecvrfChallengeLength = 16
+
+	// ecvrfSuite is the RFC 9381 suite_string of ECVRF-EDWARDS25519-SHA512-TAI.
+	ecvrfSuite = 0x03
+)
+
+// ecvrfDomain separates the combined fallback randomness from other uses of
+// the same proofs.
+var ecvrfDomain = []byte("vrf/ecvrf-fallback/v1")
+
+// ecvrfKeyProofDomain separates the key registration proofs from the
+// fallback proofs made with the same key.
+var ecvrfKeyProofDomain = []byte("vrf/ecvrf-key-proof/v1")
+
+// ECVRFProve computes the RFC 9381 ECVRF-EDWARDS25519-SHA512-TAI proof of
+// alpha under the ed25519 key sk.
+func ECVRFProve(sk ed25519.PrivateKey, alpha []byte) ([]byte, error) {
+	if len(sk) != ed25519.PrivateKeySize {
+		return nil, errECVRFInvalidPrivateKey
+	}
+
+	h := sha512.Sum512(sk.Seed())
+	x, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, err
+	}
+	pk := sk.Public().(ed25519.PublicKey)
+
+	H, err := ecvrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, err
+	}
+	hString := H.Bytes()
+
+	gamma := new(edwards25519.Point).ScalarMult(x, H)
+
+	nonce := sha512.Sum512(append(h[32:], hString...))
+	k, err := edwards25519.NewScalar().SetUniformBytes(nonce[:])
+	if err != nil {
+		return nil, err
+	}
+
+	c := ecvrfChallenge(
+		pk,
+		hString,
+		gamma.Bytes(),
+		new(edwards25519.Point).ScalarBaseMult(k).Bytes(),
+		new(edwards25519.Point).ScalarMult(k, H).Bytes(),
+	)
+	cScalar, err := ecvrfChallengeScalar(c)
+	if err != nil {
+		return nil, err
+	}
+	s := edwards25519.NewScalar().MultiplyAdd(cScalar, x, k)
+
+	proof := make([]byte, 0, ECVRFProofLength)
+	proof = append(proof, gamma.Bytes()...)
+	proof = append(proof, c...)
+	proof = append(proof, s.Bytes()...)
+	return proof, nil
+}
+
+// ECVRFVerify checks proof against pk and alpha and returns the VRF output
+// beta.
+func ECVRFVerify(pk ed25519.PublicKey, alpha, proof []byte) ([]byte, error) {
+	Y, err := ecvrfDecodePoint(pk)
+	if err != nil {
+		return nil, errECVRFInvalidPublicKey
+	}
+	if new(edwards25519.Point).MultByCofactor(Y).Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, errECVRFInvalidPublicKey
+	}
+
+	gamma, c, s, err := ecvrfDecodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	H, err := ecvrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	cScalar, err := ecvrfChallengeScalar(c)
+	if err != nil {
+		return nil, err
+	}
+	negC := edwards25519.NewScalar().Negate(cScalar)
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	U := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(negC, Y, s)
+	V := new(edwards25519.Point).Add(
+		new(edwards25519.Point).ScalarMult(s, H),
+		new(edwards25519.Point).ScalarMult(negC, gamma),
+	)
+
+	expected := ecvrfChallenge(pk, H.Bytes(), gamma.Bytes(), U.Bytes(), V.Bytes())
+	if !bytes.Equal(expected, c) {
+		return nil, errECVRFInvalidProof
+	}
+
+	return ecvrfProofToHash(gamma), nil
+}
+
+// ECVRFFallbackInput returns the ECVRF input alpha for the vote extensions of
+// height: be64(height) || seed, where seed is the latest beacon randomness
+// in the state the extensions were made on.
+func ECVRFFallbackInput(height int64, seed []byte) []byte {
+	alpha := make([]byte, 8, 8+len(seed))
+	binary.BigEndian.PutUint64(alpha, uint64(height))
+	return append(alpha, seed...)
+}
+
+// ECVRFKeyProofInput returns the ECVRF input proven by ecvrf_proof of
+// MsgRegisterEcvrfKey. It binds the key to the chain and the validator, so
+// that a proof cannot be replayed by another operator.
+func ECVRFKeyProofInput(chainID, validatorAddr string) []byte {
+	alpha := make([]byte, 0, len(ecvrfKeyProofDomain)+len(chainID)+len(validatorAddr)+8)
+	alpha = append(alpha, ecvrfKeyProofDomain...)
+	alpha = binary.BigEndian.AppendUint32(alpha, uint32(len(chainID)))
+	alpha = append(alpha, chainID...)
+	alpha = binary.BigEndian.AppendUint32(alpha, uint32(len(validatorAddr)))
+	return append(alpha, validatorAddr...)
+}
+
+// CombineECVRFOutputs derives the fallback randomness from the outputs of the
+// valid proofs, in commit order.
+func CombineECVRFOutputs(alpha []byte, outputs [][]byte) []byte {
+	h := sha256.New()
+	h.Write(ecvrfDomain)
+	h.Write(alpha)
+	for _, beta := range outputs {
+		h.Write(beta)
+	}
+	return h.Sum(nil)
+}
+
+// ecvrfEncodeToCurve implements ECVRF_encode_to_curve_try_and_increment with
+// the public key as salt.
+func ecvrfEncodeToCurve(pk ed25519.PublicKey, alpha []byte) (*edwards25519.Point, error) {
+	buf := make([]byte, 0, 2+len(pk)+len(alpha)+2)
+	buf = append(buf, ecvrfSuite, 0x01)
+	buf = append(buf, pk...)
+	buf = append(buf, alpha...)
+
+	for ctr := 0; ctr < 256; ctr++ {
+		hash := sha512.Sum512(append(buf, byte(ctr), 0x00))
+		p, err := ecvrfDecodePoint(hash[:ecvrfPointLength])
+		if err != nil {
+			continue
+		}
+		return p.MultByCofactor(p), nil
+	}
+
+	return nil, errECVRFHashToCurveFailed
+}
+
+func ecvrfChallenge(points ...[]byte) []byte {
+	buf := make([]byte, 0, 3+len(points)*ecvrfPointLength)
+	buf = append(buf, ecvrfSuite, 0x02)
+	for _, p := range points {
+		buf = append(buf, p...)
+	}
+	buf = append(buf, 0x00)
+
+	hash := sha512.Sum512(buf)
+	return hash[:ecvrfChallengeLength]
+}
+
+func ecvrfChallengeScalar(c []byte) (*edwards25519.Scalar, error) {
+	var buf [32]byte
+	copy(buf[:], c)
+	return edwards25519.NewScalar().SetCanonicalBytes(buf[:])
+}
+
+func ecvrfProofToHash(gamma *edwards25519.Point) []byte {
+	buf := make([]byte, 0, 3+ecvrfPointLength)
+	buf = append(buf, ecvrfSuite, 0x03)
+	buf = append(buf, new(edwards25519.Point).MultByCofactor(gamma).Bytes()...)
+	buf = append(buf, 0x00)
+
+	hash := sha512.Sum512(buf)
+	return hash[:]
+}
+
+func ecvrfDecodeProof(proof []byte) (*edwards25519.Point, []byte, *edwards25519.Scalar, error) {
+	if len(proof) != ECVRFProofLength {
+		return nil, nil, nil, errECVRFInvalidProof
+	}
+
+	gamma, err := ecvrfDecodePoint(proof[:ecvrfPointLength])
+	if err != nil {
+		return nil, nil, nil, errECVRFInvalidProof
+	}
+
+	c := proof[ecvrfPointLength : ecvrfPointLength+ecvrfChallengeLength]
+
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(proof[ecvrfPointLength+ecvrfChallengeLength:])
+	if err != nil {
+		return nil, nil, nil, errECVRFInvalidProof
+	}
+
+	return gamma, c, s, nil
+}
+
+// ecvrfDecodePoint decodes a point, rejecting the non-canonical encodings
+// that RFC 8032 decoding rejects.
+func ecvrfDecodePoint(bz []byte) (*edwards25519.Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(p.Bytes(), bz) {
+		return nil, errECVRFInvalidProof
+	}
+	return p, nil
+}
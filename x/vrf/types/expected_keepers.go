@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AccountKeeper defines the account functionality needed to sign simulated
+// transactions.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the bank functionality needed to fund and pay VRF
+// rewards, to escrow randomness request fees and to pay the fees of simulated
+// transactions.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the staking functionality needed to resolve reward
+// recipients and threshold-mode key shares from consensus addresses, and to
+// check that threshold-mode identities belong to bonded validators.
+type StakingKeeper interface {
+	Validator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error)
+	ValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+}
+
+// DistributionKeeper defines the distribution functionality needed to credit
+// VRF rewards to validators and their delegators.
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
+}
+
+// TimelockHooks is implemented by modules that consume decrypted time-locked
+// messages. The hook runs in PreBlock; an error discards the hook's state
+// changes but not the stored plaintext.
+type TimelockHooks interface {
+	AfterTimelockDecrypted(ctx context.Context, msg TimelockedMessage) error
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 
@@ -13,6 +14,22 @@ var (
 	errIdentitiesValidatorAddressEmpty       = errors.New("identities validator_address must not be empty")
 	errIdentitiesDrandBLSPublicKeyEmpty      = errors.New("identities drand_bls_public_key must not be empty")
 	errIdentitiesChainHashMismatchWithParams = errors.New("identities chain_hash must match params.chain_hash")
+	errPendingActivationHeightNotPositive    = errors.New("pending_params activation_height must be positive")
+	errRequestsDuplicateID                   = errors.New("requests id must be unique")
+	errRequestsIDNotBelowNext                = errors.New("requests id must be below next_request_id")
+	errRequestsStatusUnspecified             = errors.New("requests status must be specified")
+	errRequestsPendingMinRoundZero           = errors.New("pending requests min_drand_round must be positive")
+	errRequestEscrowMismatch                 = errors.New("request_escrow must equal the fees of the pending requests")
+	errTimelockedDuplicateID                 = errors.New("timelocked id must be unique")
+	errTimelockedIDNotBelowNext              = errors.New("timelocked id must be below next_timelock_id")
+	errTimelockedStatusUnspecified           = errors.New("timelocked status must be specified")
+	errTimelockedRoundZero                   = errors.New("timelocked drand_round must be positive")
+	errEmergencyTxsHashEmpty                 = errors.New("emergency_txs tx_hash must not be empty")
+	errEmergencyTxsDuplicateHash             = errors.New("emergency_txs tx_hash must be unique")
+	errEmergencyTxsTimeoutZero               = errors.New("emergency_txs timeout_height must be positive")
+	errEcvrfKeysDuplicateValidator           = errors.New("ecvrf_keys validator_address must be unique")
+	errEcvrfKeysPublicKeyLength              = errors.New("ecvrf_keys public_key must be 32 bytes")
+	errLastDrandRoundBelowLatestBeacon       = errors.New("last_drand_round must not be below the latest drand beacon round")
 )
 
 func (gs GenesisState) Validate() error {
@@ -20,12 +37,19 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	for _, e := range gs.Committee {
-		if e.Address == "" {
-			return errCommitteeAddressEmpty
+	if err := gs.Params.ValidateDrandChain(); err != nil {
+		return err
+	}
+
+	if gs.PendingParams != nil {
+		if err := gs.PendingParams.Validate(); err != nil {
+			return err
 		}
-		if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
-			return fmt.Errorf("committee address is invalid: %w", err)
+	}
+
+	for _, e := range gs.Committee {
+		if err := e.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -47,6 +71,147 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.validateRequests(); err != nil {
+		return err
+	}
+
+	if err := gs.validateTimelocked(); err != nil {
+		return err
+	}
+
+	if err := gs.validateEcvrfKeys(); err != nil {
+		return err
+	}
+
+	if b := gs.LatestBeacon; b != nil && b.Source == VrfBeaconSource_VRF_BEACON_SOURCE_DRAND &&
+		gs.LastDrandRound != 0 && gs.LastDrandRound < b.DrandRound {
+		return fmt.Errorf("%w: %d < %d", errLastDrandRoundBelowLatestBeacon, gs.LastDrandRound, b.DrandRound)
+	}
+
+	return gs.validateEmergencyTxs()
+}
+
+// validateEcvrfKeys checks that each validator has at most one well-formed
+// ECVRF key.
+func (gs GenesisState) validateEcvrfKeys() error {
+	seen := make(map[string]struct{}, len(gs.EcvrfKeys))
+	for _, key := range gs.EcvrfKeys {
+		if _, err := sdk.ValAddressFromBech32(key.ValidatorAddress); err != nil {
+			return fmt.Errorf("ecvrf_keys validator_address is invalid: %w", err)
+		}
+		if _, ok := seen[key.ValidatorAddress]; ok {
+			return fmt.Errorf("%w: %s", errEcvrfKeysDuplicateValidator, key.ValidatorAddress)
+		}
+		seen[key.ValidatorAddress] = struct{}{}
+		if len(key.PublicKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("%w: %s", errEcvrfKeysPublicKeyLength, key.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+// validateRequests checks the randomness requests against next_request_id and
+// the escrowed fees.
+func (gs GenesisState) validateRequests() error {
+	if err := gs.RequestEscrow.Validate(); err != nil {
+		return fmt.Errorf("request_escrow is invalid: %w", err)
+	}
+
+	ids := make(map[uint64]struct{}, len(gs.Requests))
+	pendingFees := sdk.NewCoins()
+	for _, r := range gs.Requests {
+		if _, ok := ids[r.Id]; ok {
+			return fmt.Errorf("%w: %d", errRequestsDuplicateID, r.Id)
+		}
+		ids[r.Id] = struct{}{}
+		if r.Id >= gs.NextRequestId {
+			return fmt.Errorf("%w: %d, next_request_id %d", errRequestsIDNotBelowNext, r.Id, gs.NextRequestId)
+		}
+		if _, err := sdk.AccAddressFromBech32(r.Requester); err != nil {
+			return fmt.Errorf("requests %d requester is invalid: %w", r.Id, err)
+		}
+		if err := r.Fee.Validate(); err != nil {
+			return fmt.Errorf("requests %d fee is invalid: %w", r.Id, err)
+		}
+
+		switch r.Status {
+		case RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_UNSPECIFIED:
+			return fmt.Errorf("%w: %d", errRequestsStatusUnspecified, r.Id)
+		case RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING:
+			if r.MinDrandRound == 0 {
+				return fmt.Errorf("%w: %d", errRequestsPendingMinRoundZero, r.Id)
+			}
+			pendingFees = pendingFees.Add(r.Fee...)
+		}
+	}
+
+	if !pendingFees.Equal(gs.RequestEscrow) {
+		return fmt.Errorf("%w: escrow %s, pending fees %s", errRequestEscrowMismatch, gs.RequestEscrow, pendingFees)
+	}
+
+	return nil
+}
+
+// validateTimelocked checks the time-locked messages against
+// next_timelock_id.
+func (gs GenesisState) validateTimelocked() error {
+	ids := make(map[uint64]struct{}, len(gs.Timelocked))
+	for _, m := range gs.Timelocked {
+		if _, ok := ids[m.Id]; ok {
+			return fmt.Errorf("%w: %d", errTimelockedDuplicateID, m.Id)
+		}
+		ids[m.Id] = struct{}{}
+		if m.Id >= gs.NextTimelockId {
+			return fmt.Errorf("%w: %d, next_timelock_id %d", errTimelockedIDNotBelowNext, m.Id, gs.NextTimelockId)
+		}
+		if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+			return fmt.Errorf("timelocked %d submitter is invalid: %w", m.Id, err)
+		}
+		if m.Status == TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED {
+			return fmt.Errorf("%w: %d", errTimelockedStatusUnspecified, m.Id)
+		}
+		if m.DrandRound == 0 {
+			return fmt.Errorf("%w: %d", errTimelockedRoundZero, m.Id)
+		}
+	}
+
+	return nil
+}
+
+// validateEmergencyTxs checks the included emergency disable txs.
+func (gs GenesisState) validateEmergencyTxs() error {
+	hashes := make(map[string]struct{}, len(gs.EmergencyTxs))
+	for _, tx := range gs.EmergencyTxs {
+		if len(tx.TxHash) == 0 {
+			return errEmergencyTxsHashEmpty
+		}
+		if _, ok := hashes[string(tx.TxHash)]; ok {
+			return fmt.Errorf("%w: %X", errEmergencyTxsDuplicateHash, tx.TxHash)
+		}
+		hashes[string(tx.TxHash)] = struct{}{}
+		if tx.TimeoutHeight == 0 {
+			return fmt.Errorf("%w: %X", errEmergencyTxsTimeoutZero, tx.TxHash)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the scheduled params and activation height.
+func (p VrfPendingParams) Validate() error {
+	if p.ActivationHeight <= 0 {
+		return errPendingActivationHeightNotPositive
+	}
+
+	if err := p.Params.Validate(); err != nil {
+		return fmt.Errorf("pending_params: %w", err)
+	}
+
+	if err := p.Params.ValidateDrandChain(); err != nil {
+		return fmt.Errorf("pending_params: %w", err)
+	}
+
 	return nil
 }
 
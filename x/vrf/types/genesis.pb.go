@@ -6,6 +6,9 @@ package types
 import (
 	bytes "bytes"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -36,6 +39,34 @@ type GenesisState struct {
 	Committee []AllowlistEntry `protobuf:"bytes,3,rep,name=committee,proto3" json:"committee"`
 	// identities contains validator -> drand identity bindings.
 	Identities []VrfIdentity `protobuf:"bytes,4,rep,name=identities,proto3" json:"identities"`
+	// pending_params is the scheduled params change, if any.
+	PendingParams *VrfPendingParams `protobuf:"bytes,5,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+	// requests are the randomness requests in every status. Pending requests
+	// are queued for fulfillment again on import.
+	Requests []RandomnessRequest `protobuf:"bytes,6,rep,name=requests,proto3" json:"requests"`
+	// next_request_id is the id the next randomness request receives.
+	NextRequestId uint64 `protobuf:"varint,7,opt,name=next_request_id,json=nextRequestId,proto3" json:"next_request_id,omitempty"`
+	// request_escrow is the fee held in the vrf module account for the pending
+	// requests. It must equal the sum of their fees.
+	RequestEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=request_escrow,json=requestEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"request_escrow"`
+	// timelocked are the time-locked messages in every status. Pending
+	// messages are queued for decryption again on import.
+	Timelocked []TimelockedMessage `protobuf:"bytes,9,rep,name=timelocked,proto3" json:"timelocked"`
+	// next_timelock_id is the id the next time-locked message receives.
+	NextTimelockId uint64 `protobuf:"varint,10,opt,name=next_timelock_id,json=nextTimelockId,proto3" json:"next_timelock_id,omitempty"`
+	// emergency_txs are the included emergency disable txs that have not timed
+	// out yet. The ante handler rejects them if they are sent again.
+	EmergencyTxs []EmergencyTxRecord `protobuf:"bytes,11,rep,name=emergency_txs,json=emergencyTxs,proto3" json:"emergency_txs"`
+	// ecvrf_fallback_seed is the ECVRF fallback seed recorded at the exported
+	// height, if any. PreBlock of the next height verifies the ECVRF proofs of
+	// the vote extensions against it.
+	EcvrfFallbackSeed *EcvrfFallbackSeed `protobuf:"bytes,12,opt,name=ecvrf_fallback_seed,json=ecvrfFallbackSeed,proto3" json:"ecvrf_fallback_seed,omitempty"`
+	// last_drand_round is the round of the latest finalized drand beacon. It
+	// outlives the ECVRF fallback beacons stored after it, so that no earlier
+	// drand round is finalized again.
+	LastDrandRound uint64 `protobuf:"varint,13,opt,name=last_drand_round,json=lastDrandRound,proto3" json:"last_drand_round,omitempty"`
+	// ecvrf_keys are the registered ECVRF fallback keys of the validators.
+	EcvrfKeys []EcvrfKey `protobuf:"bytes,14,rep,name=ecvrf_keys,json=ecvrfKeys,proto3" json:"ecvrf_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -99,6 +130,245 @@ func (m *GenesisState) GetIdentities() []VrfIdentity {
 	return nil
 }
 
+func (m *GenesisState) GetPendingParams() *VrfPendingParams {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRequests() []RandomnessRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRequestId() uint64 {
+	if m != nil {
+		return m.NextRequestId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRequestEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestEscrow
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelocked() []TimelockedMessage {
+	if m != nil {
+		return m.Timelocked
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTimelockId() uint64 {
+	if m != nil {
+		return m.NextTimelockId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEmergencyTxs() []EmergencyTxRecord {
+	if m != nil {
+		return m.EmergencyTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEcvrfFallbackSeed() *EcvrfFallbackSeed {
+	if m != nil {
+		return m.EcvrfFallbackSeed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastDrandRound() uint64 {
+	if m != nil {
+		return m.LastDrandRound
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEcvrfKeys() []EcvrfKey {
+	if m != nil {
+		return m.EcvrfKeys
+	}
+	return nil
+}
+
+// EcvrfFallbackSeed is a recorded ECVRF fallback seed.
+type EcvrfFallbackSeed struct {
+	// seed is the randomness of the latest beacon when the seed was recorded.
+	// It is empty before the first beacon.
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *EcvrfFallbackSeed) Reset()         { *m = EcvrfFallbackSeed{} }
+func (m *EcvrfFallbackSeed) String() string { return proto.CompactTextString(m) }
+func (*EcvrfFallbackSeed) ProtoMessage()    {}
+func (*EcvrfFallbackSeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee145f85ab93e65, []int{1}
+}
+func (m *EcvrfFallbackSeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EcvrfFallbackSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EcvrfFallbackSeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EcvrfFallbackSeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EcvrfFallbackSeed.Merge(m, src)
+}
+func (m *EcvrfFallbackSeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EcvrfFallbackSeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EcvrfFallbackSeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EcvrfFallbackSeed proto.InternalMessageInfo
+
+func (m *EcvrfFallbackSeed) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+// EcvrfKey is the registered ECVRF fallback key of a validator.
+type EcvrfKey struct {
+	// validator_address is the validator operator address.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// public_key is the ed25519 public key the validator's ECVRF fallback
+	// proofs verify against.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *EcvrfKey) Reset()         { *m = EcvrfKey{} }
+func (m *EcvrfKey) String() string { return proto.CompactTextString(m) }
+func (*EcvrfKey) ProtoMessage()    {}
+func (*EcvrfKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee145f85ab93e65, []int{2}
+}
+func (m *EcvrfKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EcvrfKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EcvrfKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EcvrfKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EcvrfKey.Merge(m, src)
+}
+func (m *EcvrfKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EcvrfKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EcvrfKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EcvrfKey proto.InternalMessageInfo
+
+func (m *EcvrfKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EcvrfKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+// EmergencyTxRecord is an included emergency disable tx.
+type EmergencyTxRecord struct {
+	// tx_hash is the hash of the tx bytes.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// timeout_height is the timeout height of the tx, after which the record
+	// is pruned.
+	TimeoutHeight uint64 `protobuf:"varint,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// signer is the first signer of the tx. The records of a signer count
+	// against the per-signer cap on emergency disable txs.
+	Signer []byte `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EmergencyTxRecord) Reset()         { *m = EmergencyTxRecord{} }
+func (m *EmergencyTxRecord) String() string { return proto.CompactTextString(m) }
+func (*EmergencyTxRecord) ProtoMessage()    {}
+func (*EmergencyTxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee145f85ab93e65, []int{3}
+}
+func (m *EmergencyTxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyTxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyTxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencyTxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyTxRecord.Merge(m, src)
+}
+func (m *EmergencyTxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyTxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyTxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyTxRecord proto.InternalMessageInfo
+
+func (m *EmergencyTxRecord) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EmergencyTxRecord) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *EmergencyTxRecord) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 // VrfParams mirrors the PRD definition and contains all cryptographic and timing
 // context needed to verify drand beacons on-chain and map block time to drand
 // rounds.
@@ -120,13 +390,60 @@ type VrfParams struct {
 	ReshareEpoch uint64 `protobuf:"varint,7,opt,name=reshare_epoch,json=reshareEpoch,proto3" json:"reshare_epoch,omitempty"`
 	// slashing_grace_blocks configures the slashing grace window.
 	SlashingGraceBlocks uint64 `protobuf:"varint,8,opt,name=slashing_grace_blocks,json=slashingGraceBlocks,proto3" json:"slashing_grace_blocks,omitempty"`
+	// round_tolerance is the number of drand rounds below target_round(H) that
+	// PreBlock accepts when no quorum forms on the target round itself. Zero
+	// requires the exact target round.
+	RoundTolerance uint64 `protobuf:"varint,9,opt,name=round_tolerance,json=roundTolerance,proto3" json:"round_tolerance,omitempty"`
+	// participation_retention_blocks is the number of heights for which
+	// per-height participation records are kept. Zero disables recording.
+	ParticipationRetentionBlocks uint64 `protobuf:"varint,10,opt,name=participation_retention_blocks,json=participationRetentionBlocks,proto3" json:"participation_retention_blocks,omitempty"`
+	// reward_per_block is paid from the vrf module account at every finalized
+	// height to the validators whose vote extensions backed the beacon, in
+	// proportion to their voting power. Empty disables rewards.
+	RewardPerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=reward_per_block,json=rewardPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_block"`
+	// reward_fee_share_bps is the share of the fee collector balance, in basis
+	// points, moved into the vrf module account at every finalized height.
+	RewardFeeShareBps uint32 `protobuf:"varint,12,opt,name=reward_fee_share_bps,json=rewardFeeShareBps,proto3" json:"reward_fee_share_bps,omitempty"`
+	// request_base_fee is charged for every MsgRequestRandomness.
+	RequestBaseFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=request_base_fee,json=requestBaseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"request_base_fee"`
+	// request_fee_per_word is charged per requested word on top of
+	// request_base_fee.
+	RequestFeePerWord github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=request_fee_per_word,json=requestFeePerWord,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"request_fee_per_word"`
+	// scheme_id is the drand signature scheme of the chain: "pedersen-bls-chained"
+	// (the default when empty) or "pedersen-bls-unchained". Only unchained chains
+	// support time-lock encryption.
+	SchemeId string `protobuf:"bytes,15,opt,name=scheme_id,json=schemeId,proto3" json:"scheme_id,omitempty"`
+	// partial_threshold, when non-zero, switches the chain to threshold mode:
+	// vote extensions carry partial signatures from the validators' drand key
+	// shares and PreBlock recovers the beacon from at least partial_threshold
+	// of them. It requires an unchained scheme_id.
+	PartialThreshold uint32 `protobuf:"varint,16,opt,name=partial_threshold,json=partialThreshold,proto3" json:"partial_threshold,omitempty"`
+	// ecvrf_fallback makes validators add an ECVRF proof, made with their
+	// registered ECVRF key, to their vote extensions. When no drand round reaches
+	// quorum, PreBlock combines the proofs into a beacon with source
+	// VRF_BEACON_SOURCE_ECVRF instead of rejecting the block.
+	EcvrfFallback bool `protobuf:"varint,17,opt,name=ecvrf_fallback,json=ecvrfFallback,proto3" json:"ecvrf_fallback,omitempty"`
+	// genesis_seed is the drand group's genesis seed, which the chain hash
+	// commits to.
+	GenesisSeed []byte `protobuf:"bytes,18,opt,name=genesis_seed,json=genesisSeed,proto3" json:"genesis_seed,omitempty"`
+	// beacon_id is the drand beacon ID. Empty and "default" both denote the
+	// default beacon, which the chain hash does not commit to.
+	BeaconId string `protobuf:"bytes,19,opt,name=beacon_id,json=beaconId,proto3" json:"beacon_id,omitempty"`
+	// bootstrap_enable_delay_blocks, when non-zero, lets a MsgInitialDkg that
+	// carries a verified bootstrap_beacon also enable VRF, at the latest of its
+	// activation_height and this many blocks after its inclusion.
+	BootstrapEnableDelayBlocks uint64 `protobuf:"varint,20,opt,name=bootstrap_enable_delay_blocks,json=bootstrapEnableDelayBlocks,proto3" json:"bootstrap_enable_delay_blocks,omitempty"`
+	// invariant_check_interval_blocks, when non-zero, makes EndBlock check the
+	// cheap module invariants every this many blocks and halt the chain when
+	// one is broken. Zero disables the check.
+	InvariantCheckIntervalBlocks uint64 `protobuf:"varint,21,opt,name=invariant_check_interval_blocks,json=invariantCheckIntervalBlocks,proto3" json:"invariant_check_interval_blocks,omitempty"`
 }
 
 func (m *VrfParams) Reset()         { *m = VrfParams{} }
 func (m *VrfParams) String() string { return proto.CompactTextString(m) }
 func (*VrfParams) ProtoMessage()    {}
 func (*VrfParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee145f85ab93e65, []int{1}
+	return fileDescriptor_6ee145f85ab93e65, []int{4}
 }
 func (m *VrfParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,9 +528,162 @@ func (m *VrfParams) GetSlashingGraceBlocks() uint64 {
 	return 0
 }
 
+func (m *VrfParams) GetRoundTolerance() uint64 {
+	if m != nil {
+		return m.RoundTolerance
+	}
+	return 0
+}
+
+func (m *VrfParams) GetParticipationRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ParticipationRetentionBlocks
+	}
+	return 0
+}
+
+func (m *VrfParams) GetRewardPerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPerBlock
+	}
+	return nil
+}
+
+func (m *VrfParams) GetRewardFeeShareBps() uint32 {
+	if m != nil {
+		return m.RewardFeeShareBps
+	}
+	return 0
+}
+
+func (m *VrfParams) GetRequestBaseFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestBaseFee
+	}
+	return nil
+}
+
+func (m *VrfParams) GetRequestFeePerWord() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestFeePerWord
+	}
+	return nil
+}
+
+func (m *VrfParams) GetSchemeId() string {
+	if m != nil {
+		return m.SchemeId
+	}
+	return ""
+}
+
+func (m *VrfParams) GetPartialThreshold() uint32 {
+	if m != nil {
+		return m.PartialThreshold
+	}
+	return 0
+}
+
+func (m *VrfParams) GetEcvrfFallback() bool {
+	if m != nil {
+		return m.EcvrfFallback
+	}
+	return false
+}
+
+func (m *VrfParams) GetGenesisSeed() []byte {
+	if m != nil {
+		return m.GenesisSeed
+	}
+	return nil
+}
+
+func (m *VrfParams) GetBeaconId() string {
+	if m != nil {
+		return m.BeaconId
+	}
+	return ""
+}
+
+func (m *VrfParams) GetBootstrapEnableDelayBlocks() uint64 {
+	if m != nil {
+		return m.BootstrapEnableDelayBlocks
+	}
+	return 0
+}
+
+func (m *VrfParams) GetInvariantCheckIntervalBlocks() uint64 {
+	if m != nil {
+		return m.InvariantCheckIntervalBlocks
+	}
+	return 0
+}
+
+// VrfPendingParams is a params change scheduled by MsgUpdateParams or
+// MsgInitialDkg. The params govern PreBlock from activation_height on, and the
+// vote extensions of the height before it, which PreBlock at
+// activation_height aggregates.
+type VrfPendingParams struct {
+	// params are the scheduled parameters.
+	Params VrfParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// activation_height is the first height whose PreBlock uses params.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *VrfPendingParams) Reset()         { *m = VrfPendingParams{} }
+func (m *VrfPendingParams) String() string { return proto.CompactTextString(m) }
+func (*VrfPendingParams) ProtoMessage()    {}
+func (*VrfPendingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee145f85ab93e65, []int{5}
+}
+func (m *VrfPendingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfPendingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfPendingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VrfPendingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfPendingParams.Merge(m, src)
+}
+func (m *VrfPendingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfPendingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfPendingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfPendingParams proto.InternalMessageInfo
+
+func (m *VrfPendingParams) GetParams() VrfParams {
+	if m != nil {
+		return m.Params
+	}
+	return VrfParams{}
+}
+
+func (m *VrfPendingParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "digitalkitchen.vrf.v1.GenesisState")
+	proto.RegisterType((*EcvrfFallbackSeed)(nil), "digitalkitchen.vrf.v1.EcvrfFallbackSeed")
+	proto.RegisterType((*EcvrfKey)(nil), "digitalkitchen.vrf.v1.EcvrfKey")
+	proto.RegisterType((*EmergencyTxRecord)(nil), "digitalkitchen.vrf.v1.EmergencyTxRecord")
 	proto.RegisterType((*VrfParams)(nil), "digitalkitchen.vrf.v1.VrfParams")
+	proto.RegisterType((*VrfPendingParams)(nil), "digitalkitchen.vrf.v1.VrfPendingParams")
 }
 
 func init() {
@@ -221,40 +691,87 @@ func init() {
 }
 
 var fileDescriptor_6ee145f85ab93e65 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x18, 0x6f, 0xd6, 0xbe, 0xdd, 0xea, 0xb5, 0xd3, 0x4b, 0x60, 0x52, 0x34, 0x89, 0x34, 0xea, 0x34,
-	0x14, 0x71, 0x48, 0xb4, 0x72, 0xe3, 0x04, 0x45, 0xd5, 0x36, 0x21, 0x24, 0x94, 0x0a, 0x0e, 0x5c,
-	0x22, 0x27, 0x79, 0x9a, 0x58, 0x4d, 0xec, 0xc8, 0x76, 0x4b, 0xfb, 0x2d, 0xf8, 0x08, 0x1c, 0xb9,
-	0xc1, 0xb7, 0x60, 0xc7, 0x1d, 0x39, 0x21, 0xd4, 0x1e, 0xe0, 0x63, 0xa0, 0xd8, 0xe9, 0x60, 0xd2,
-	0x26, 0x2e, 0x96, 0xfd, 0xfb, 0xf7, 0x3c, 0x7e, 0xf4, 0xa0, 0xe3, 0x84, 0xa4, 0x44, 0xe2, 0x7c,
-	0x46, 0x64, 0x9c, 0x01, 0xf5, 0x17, 0x7c, 0xea, 0x2f, 0x4e, 0xfd, 0x14, 0x28, 0x08, 0x22, 0xbc,
-	0x92, 0x33, 0xc9, 0xcc, 0xc3, 0x9b, 0x22, 0x6f, 0xc1, 0xa7, 0xde, 0xe2, 0xf4, 0xe8, 0x1e, 0x2e,
-	0x08, 0x65, 0xbe, 0x3a, 0xb5, 0xf2, 0xa8, 0x7f, 0x7b, 0x5c, 0x65, 0xd0, 0x82, 0x07, 0x29, 0x4b,
-	0x99, 0xba, 0xfa, 0xd5, 0x4d, 0xa3, 0x83, 0xcf, 0x3b, 0xa8, 0x7b, 0xa6, 0x4b, 0x4e, 0x24, 0x96,
-	0x60, 0xbe, 0x40, 0xed, 0x12, 0x73, 0x5c, 0x08, 0xcb, 0x70, 0x0c, 0x77, 0x7f, 0xe8, 0x78, 0xb7,
-	0xb6, 0xe0, 0xbd, 0xe5, 0xd3, 0xd7, 0x4a, 0x37, 0xea, 0x5c, 0x7e, 0xef, 0x37, 0x3e, 0xfd, 0xfc,
-	0xf2, 0xd8, 0x08, 0x6a, 0xab, 0x39, 0x46, 0xbd, 0x1c, 0x4b, 0x10, 0x32, 0x8c, 0x00, 0xc7, 0x8c,
-	0x5a, 0x3b, 0xff, 0xca, 0x1a, 0x29, 0x5d, 0xd0, 0xd5, 0x36, 0xfd, 0x32, 0x2f, 0x50, 0x27, 0x66,
-	0x45, 0x41, 0xa4, 0x04, 0xb0, 0x9a, 0x4e, 0xd3, 0xdd, 0x1f, 0x9e, 0xdc, 0x11, 0xf1, 0x3c, 0xcf,
-	0xd9, 0xfb, 0x9c, 0x08, 0x39, 0xa6, 0x92, 0xaf, 0x46, 0xad, 0xaa, 0xa7, 0xe0, 0x8f, 0xdb, 0x3c,
-	0x47, 0x88, 0x24, 0x40, 0x25, 0x91, 0x04, 0x84, 0xd5, 0x52, 0x59, 0x83, 0xbb, 0xdb, 0xb9, 0xd0,
-	0xda, 0x6d, 0xd0, 0x5f, 0xde, 0xc1, 0xd7, 0x1d, 0xd4, 0xb9, 0xfe, 0xbc, 0xf9, 0x10, 0xa1, 0x38,
-	0xc3, 0x84, 0x86, 0x19, 0x16, 0x99, 0x1a, 0x59, 0x37, 0xe8, 0x28, 0xe4, 0x1c, 0x8b, 0xac, 0xa2,
-	0xcb, 0x79, 0x94, 0x93, 0x38, 0x9c, 0xc1, 0x4a, 0x4d, 0xa1, 0x1b, 0x74, 0x34, 0xf2, 0x12, 0x56,
-	0xe6, 0x09, 0x3a, 0x28, 0x81, 0x13, 0x96, 0x84, 0x02, 0x62, 0x46, 0x13, 0x61, 0x35, 0x1d, 0xc3,
-	0x6d, 0x05, 0x3d, 0x8d, 0x4e, 0x34, 0x68, 0xba, 0xe8, 0xff, 0x7a, 0x2d, 0xc2, 0x39, 0x25, 0xcb,
-	0x4a, 0x6c, 0xb5, 0x1c, 0xc3, 0x6d, 0x06, 0x07, 0x35, 0xfe, 0x86, 0x92, 0xe5, 0x04, 0x62, 0x73,
-	0x88, 0x0e, 0x05, 0x9e, 0x82, 0x5c, 0x85, 0x05, 0xe6, 0x29, 0xa1, 0xd7, 0xb9, 0xff, 0xa9, 0xdc,
-	0xfb, 0x9a, 0x7c, 0xa5, 0xb8, 0x6d, 0xba, 0x85, 0x76, 0x81, 0xe2, 0x28, 0x87, 0xc4, 0x6a, 0x3b,
-	0x86, 0xbb, 0x17, 0x6c, 0x9f, 0xe6, 0x31, 0xea, 0x71, 0x10, 0x19, 0xe6, 0x10, 0x42, 0xc9, 0xe2,
-	0xcc, 0xda, 0x55, 0x29, 0xdd, 0x1a, 0x1c, 0x57, 0x98, 0x2a, 0x99, 0x63, 0x91, 0x11, 0x9a, 0x86,
-	0x29, 0xc7, 0x31, 0x84, 0x51, 0xce, 0xe2, 0x99, 0xb0, 0xf6, 0xea, 0x92, 0x35, 0x79, 0x56, 0x71,
-	0x23, 0x45, 0x3d, 0x6d, 0xfd, 0xfa, 0xd8, 0x37, 0x46, 0xcf, 0x2e, 0xd7, 0xb6, 0x71, 0xb5, 0xb6,
-	0x8d, 0x1f, 0x6b, 0xdb, 0xf8, 0xb0, 0xb1, 0x1b, 0x57, 0x1b, 0xbb, 0xf1, 0x6d, 0x63, 0x37, 0xde,
-	0x3d, 0x4a, 0x89, 0xcc, 0xe6, 0x91, 0x17, 0xb3, 0xc2, 0x4f, 0x52, 0x79, 0x63, 0xa9, 0x97, 0xea,
-	0x94, 0xab, 0x12, 0x44, 0xd4, 0x56, 0x4b, 0xfc, 0xe4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa7,
-	0x6b, 0xe1, 0x96, 0x4c, 0x03, 0x00, 0x00,
+	// 1278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x13, 0x47,
+	0x1b, 0x8e, 0xbf, 0x98, 0x10, 0x4f, 0xec, 0x60, 0x0f, 0xf0, 0x7d, 0x0b, 0x7c, 0x38, 0xc1, 0x08,
+	0xb0, 0x8a, 0xb0, 0x15, 0xaa, 0x5e, 0x7a, 0x2a, 0x26, 0x0e, 0xb8, 0x08, 0x84, 0xd6, 0x29, 0xad,
+	0x7a, 0x59, 0x8d, 0x77, 0x5e, 0xef, 0x8e, 0xb2, 0x9e, 0xd9, 0xce, 0x4c, 0x1c, 0xbb, 0xb7, 0x4a,
+	0xed, 0xbd, 0x7f, 0x41, 0xd5, 0x63, 0xd5, 0x53, 0x0f, 0xfd, 0x23, 0x38, 0xa2, 0x9e, 0x7a, 0x6a,
+	0x2b, 0x38, 0xb4, 0x7f, 0x46, 0x35, 0x3f, 0xd6, 0x49, 0x80, 0xa4, 0x17, 0x7a, 0x49, 0x3c, 0xcf,
+	0xfb, 0xbc, 0xef, 0xfb, 0xcc, 0xec, 0x3b, 0xcf, 0x2e, 0xba, 0x4e, 0x59, 0xc2, 0x34, 0xc9, 0xf6,
+	0x98, 0x8e, 0x53, 0xe0, 0xdd, 0xa9, 0x1c, 0x77, 0xa7, 0x5b, 0xdd, 0x04, 0x38, 0x28, 0xa6, 0x3a,
+	0xb9, 0x14, 0x5a, 0xe0, 0x8b, 0xc7, 0x49, 0x9d, 0xa9, 0x1c, 0x77, 0xa6, 0x5b, 0x97, 0x1b, 0x64,
+	0xc2, 0xb8, 0xe8, 0xda, 0xbf, 0x8e, 0x79, 0xb9, 0x19, 0x0b, 0x35, 0x11, 0xaa, 0x3b, 0x22, 0x0a,
+	0xba, 0xd3, 0xad, 0x11, 0x68, 0xb2, 0xd5, 0x8d, 0x05, 0xe3, 0x3e, 0x7e, 0xc9, 0xc5, 0x23, 0xbb,
+	0xea, 0xba, 0x85, 0x0f, 0x6d, 0xbc, 0x5d, 0x89, 0xe9, 0xe5, 0x08, 0x17, 0x12, 0x91, 0x08, 0x97,
+	0x68, 0x7e, 0x39, 0xb4, 0xf5, 0xcd, 0x2a, 0xaa, 0x3e, 0x70, 0x6a, 0x87, 0x9a, 0x68, 0xc0, 0xf7,
+	0xd1, 0x4a, 0x4e, 0x24, 0x99, 0xa8, 0xa0, 0xb4, 0x59, 0x6a, 0xaf, 0xdd, 0xdd, 0xec, 0xbc, 0x55,
+	0x7d, 0xe7, 0x99, 0x1c, 0x3f, 0xb5, 0xbc, 0x5e, 0xe5, 0xf9, 0x6f, 0x1b, 0x4b, 0x3f, 0xfc, 0xf9,
+	0xd3, 0x7b, 0xa5, 0xd0, 0xa7, 0xe2, 0x3e, 0xaa, 0x65, 0x44, 0x83, 0xd2, 0xd1, 0x08, 0x48, 0x2c,
+	0x78, 0xf0, 0x9f, 0x7f, 0xaa, 0xd5, 0xb3, 0xbc, 0xb0, 0xea, 0xd2, 0xdc, 0x0a, 0x0f, 0x50, 0x25,
+	0x16, 0x93, 0x09, 0xd3, 0x1a, 0x20, 0x58, 0xde, 0x5c, 0x6e, 0xaf, 0xdd, 0xbd, 0x71, 0x42, 0x89,
+	0x7b, 0x59, 0x26, 0x0e, 0x32, 0xa6, 0x74, 0x9f, 0x6b, 0x39, 0xef, 0x95, 0x8d, 0xa6, 0xf0, 0x30,
+	0x1b, 0x3f, 0x44, 0x88, 0x51, 0xe0, 0x9a, 0x69, 0x06, 0x2a, 0x28, 0xdb, 0x5a, 0xad, 0x93, 0xe5,
+	0x0c, 0x1c, 0xb7, 0x28, 0x74, 0x24, 0x17, 0x3f, 0x41, 0xeb, 0x39, 0x70, 0xca, 0x78, 0x12, 0xf9,
+	0x83, 0x3a, 0x63, 0x37, 0x77, 0xeb, 0x94, 0x83, 0x72, 0x7c, 0x77, 0x5e, 0x61, 0x2d, 0x3f, 0xba,
+	0xc4, 0x1f, 0xa3, 0x55, 0x09, 0x5f, 0xec, 0x83, 0xd2, 0x2a, 0x58, 0xb1, 0xba, 0xda, 0x27, 0x54,
+	0x0a, 0x09, 0xa7, 0x62, 0xc2, 0x41, 0xa9, 0xd0, 0x25, 0x78, 0x75, 0x8b, 0x7c, 0x7c, 0x13, 0x9d,
+	0xe3, 0x30, 0xd3, 0x91, 0x07, 0x22, 0x46, 0x83, 0xb3, 0x9b, 0xa5, 0x76, 0x39, 0xac, 0x19, 0xd8,
+	0x67, 0x0d, 0x28, 0x3e, 0x40, 0xeb, 0x05, 0x05, 0x54, 0x2c, 0xc5, 0x41, 0xb0, 0x6a, 0x3b, 0x5f,
+	0xea, 0xf8, 0x99, 0x32, 0x03, 0xd8, 0xf1, 0x03, 0xd8, 0xb9, 0x2f, 0x18, 0xef, 0x7d, 0x60, 0x5a,
+	0xfd, 0xf8, 0xfb, 0x46, 0x3b, 0x61, 0x3a, 0xdd, 0x1f, 0x75, 0x62, 0x31, 0xf1, 0x03, 0xe8, 0xff,
+	0xdd, 0x51, 0x74, 0xaf, 0xab, 0xe7, 0x39, 0x28, 0x9b, 0xa0, 0xdc, 0x44, 0xd4, 0x7c, 0x9f, 0xbe,
+	0x6d, 0x83, 0x9f, 0x20, 0xa4, 0xd9, 0x04, 0x32, 0x11, 0xef, 0x01, 0x0d, 0x2a, 0xa7, 0x6e, 0x77,
+	0x77, 0x41, 0x7c, 0x0c, 0x4a, 0x91, 0x04, 0x8a, 0x87, 0x71, 0x58, 0x01, 0xb7, 0x51, 0xdd, 0x6e,
+	0xb8, 0x80, 0xcc, 0x8e, 0x91, 0xdd, 0xf1, 0xba, 0xc1, 0x8b, 0x12, 0x03, 0x8a, 0x87, 0xa8, 0x06,
+	0x13, 0x90, 0x09, 0xf0, 0x78, 0x1e, 0xe9, 0x99, 0x0a, 0xd6, 0x4e, 0x6d, 0xde, 0x2f, 0xb8, 0xbb,
+	0xb3, 0x10, 0x62, 0x21, 0xa9, 0x6f, 0x5e, 0x85, 0xc3, 0x80, 0xc2, 0x9f, 0xa1, 0xf3, 0x10, 0x4f,
+	0xe5, 0x38, 0x1a, 0x93, 0x2c, 0x1b, 0x91, 0x78, 0x2f, 0x52, 0x00, 0x34, 0xa8, 0xda, 0x81, 0x38,
+	0xb1, 0xb4, 0xc9, 0xd8, 0xf1, 0x09, 0x43, 0x00, 0x1a, 0x36, 0xe0, 0x75, 0xc8, 0x6c, 0x2c, 0x23,
+	0x4a, 0x47, 0x54, 0x12, 0x4e, 0x23, 0x29, 0xf6, 0x39, 0x0d, 0x6a, 0x6e, 0x63, 0x06, 0xdf, 0x36,
+	0x70, 0x68, 0x50, 0xbc, 0x8d, 0x90, 0xd3, 0xb0, 0x07, 0x73, 0x15, 0xac, 0xdb, 0x5d, 0x6d, 0x9c,
+	0xd6, 0xfa, 0x11, 0x2c, 0xee, 0x07, 0xf8, 0xb5, 0x6a, 0xdd, 0x42, 0x8d, 0x37, 0x74, 0x61, 0x8c,
+	0xca, 0x76, 0x3f, 0xc6, 0x09, 0xaa, 0xa1, 0xfd, 0xdd, 0x9a, 0xa3, 0xd5, 0xa2, 0x0a, 0x7e, 0x82,
+	0x1a, 0x53, 0x92, 0x31, 0x4a, 0xb4, 0x90, 0x11, 0xa1, 0x54, 0x82, 0x72, 0xb6, 0x51, 0xe9, 0x5d,
+	0xfb, 0xe5, 0xe7, 0x3b, 0x57, 0xfd, 0x30, 0x3d, 0x2b, 0x38, 0xf7, 0x1c, 0x65, 0xa8, 0x25, 0xe3,
+	0x49, 0x58, 0x9f, 0xbe, 0x86, 0xe3, 0xab, 0x08, 0xe5, 0xfb, 0xa3, 0x8c, 0xc5, 0x66, 0x2f, 0xd6,
+	0x33, 0xaa, 0x61, 0xc5, 0x21, 0x8f, 0x60, 0xde, 0xda, 0x43, 0x8d, 0x37, 0x1e, 0x0b, 0xfe, 0x1f,
+	0x3a, 0xab, 0x67, 0x51, 0x4a, 0x54, 0xea, 0x65, 0xae, 0xe8, 0xd9, 0x43, 0xa2, 0x52, 0x7c, 0x03,
+	0xad, 0x9b, 0xa9, 0x10, 0xfb, 0x3a, 0x4a, 0x81, 0x25, 0xa9, 0xb6, 0x05, 0xcb, 0x61, 0xcd, 0xa3,
+	0x0f, 0x2d, 0x88, 0xff, 0x8b, 0x56, 0x14, 0x4b, 0x38, 0xc8, 0x60, 0xd9, 0xa5, 0xbb, 0x55, 0xeb,
+	0xbb, 0x0a, 0xaa, 0x2c, 0x3c, 0xce, 0x28, 0x8b, 0x53, 0xc2, 0xf8, 0xd1, 0x46, 0x15, 0x8b, 0xd8,
+	0x5e, 0xa7, 0x0b, 0x37, 0x52, 0x72, 0x90, 0x4c, 0xd0, 0x48, 0x41, 0x2c, 0x38, 0x55, 0xb6, 0x57,
+	0xd9, 0x38, 0x81, 0x41, 0x87, 0x0e, 0x34, 0xcf, 0xdc, 0xbf, 0x38, 0xa2, 0x7d, 0xce, 0x66, 0x86,
+	0x1c, 0x94, 0x37, 0x4b, 0xed, 0xe5, 0x70, 0xdd, 0xe3, 0x9f, 0x70, 0x36, 0x1b, 0x42, 0x8c, 0xef,
+	0xa2, 0x8b, 0x8a, 0x8c, 0x41, 0xcf, 0xa3, 0x09, 0x91, 0x09, 0xe3, 0x8b, 0xba, 0x67, 0x6c, 0xdd,
+	0xf3, 0x2e, 0xf8, 0xd8, 0xc6, 0x8a, 0xea, 0x01, 0x3a, 0x0b, 0x9c, 0x8c, 0x32, 0xa0, 0xc1, 0xca,
+	0x66, 0xa9, 0xbd, 0x1a, 0x16, 0x4b, 0x7c, 0x1d, 0xd5, 0x24, 0xa8, 0x94, 0x48, 0x88, 0x20, 0x17,
+	0x71, 0xea, 0x3d, 0xa3, 0xea, 0xc1, 0xbe, 0xc1, 0x6c, 0xcb, 0x8c, 0xa8, 0xd4, 0xf8, 0x5e, 0x22,
+	0x49, 0x0c, 0xd1, 0xc8, 0xdc, 0x2c, 0x15, 0xac, 0xfa, 0x96, 0x3e, 0xf8, 0xc0, 0xc4, 0x7a, 0x36,
+	0x84, 0x6f, 0xa1, 0x73, 0x76, 0x72, 0x23, 0x2d, 0x32, 0x90, 0x84, 0xc7, 0x10, 0x54, 0xdc, 0x0c,
+	0x5b, 0x78, 0xb7, 0x40, 0xf1, 0x36, 0x6a, 0xe6, 0x44, 0x6a, 0x16, 0xb3, 0x9c, 0x68, 0x26, 0x78,
+	0x24, 0x41, 0x1b, 0xc3, 0x15, 0xbc, 0xe8, 0xe2, 0x2e, 0xf5, 0xff, 0x8f, 0xb1, 0xc2, 0x82, 0xe4,
+	0xdb, 0x7d, 0x89, 0xea, 0x12, 0x0e, 0x88, 0xa4, 0x51, 0x0e, 0xd2, 0x25, 0xfa, 0x5b, 0xfe, 0xee,
+	0x7d, 0x6d, 0xdd, 0x75, 0x7a, 0x0a, 0xd2, 0x36, 0xc7, 0x5d, 0x74, 0xc1, 0xf7, 0x1e, 0x03, 0x44,
+	0xee, 0x30, 0x47, 0xb9, 0xb2, 0x56, 0x50, 0x0b, 0x1b, 0x2e, 0xb6, 0x03, 0x30, 0x34, 0x91, 0x5e,
+	0xee, 0xc5, 0x3a, 0x0b, 0x36, 0xa2, 0x4c, 0x5a, 0x50, 0xfb, 0xf7, 0xc4, 0xba, 0xf7, 0x05, 0x51,
+	0xb0, 0x03, 0x80, 0xbf, 0x2a, 0x19, 0xb5, 0xae, 0xb9, 0x91, 0x6b, 0x8e, 0xeb, 0x40, 0x48, 0xea,
+	0xdd, 0xe3, 0xdd, 0x0b, 0x68, 0xf8, 0x6e, 0x3b, 0x00, 0x4f, 0x41, 0x7e, 0x6a, 0xee, 0xed, 0x15,
+	0x54, 0x51, 0x71, 0x0a, 0x13, 0x30, 0x96, 0x7d, 0xce, 0x78, 0x46, 0xb8, 0xea, 0x80, 0x01, 0xc5,
+	0xb7, 0x51, 0xc3, 0x3e, 0x69, 0x92, 0x45, 0x3a, 0x35, 0x63, 0x28, 0x32, 0x1a, 0xd4, 0xed, 0x51,
+	0xd6, 0x7d, 0x60, 0xb7, 0xc0, 0xcd, 0xed, 0x3a, 0x6e, 0xc2, 0x41, 0xc3, 0xce, 0x77, 0xed, 0x98,
+	0xab, 0xe2, 0x6b, 0xa8, 0x5a, 0xdc, 0x2e, 0x6b, 0x6a, 0xd8, 0xde, 0xd2, 0x35, 0x8f, 0x59, 0xbf,
+	0xbb, 0x82, 0x2a, 0xee, 0x7b, 0xc5, 0x68, 0x3a, 0xef, 0x34, 0x39, 0x60, 0x40, 0xf1, 0x3d, 0x74,
+	0x75, 0x24, 0x84, 0x56, 0x5a, 0x92, 0x3c, 0x72, 0x57, 0x27, 0xa2, 0x90, 0x91, 0x79, 0x31, 0xa2,
+	0x17, 0xec, 0x88, 0x5e, 0x5e, 0x90, 0xfa, 0x96, 0xb3, 0x6d, 0x28, 0x7e, 0x40, 0xfb, 0x68, 0x83,
+	0xf1, 0x29, 0x91, 0x8c, 0x70, 0x1d, 0xc5, 0x29, 0x98, 0xf7, 0x15, 0xd7, 0x20, 0xa7, 0x24, 0x2b,
+	0x8a, 0x5c, 0x74, 0x73, 0xbe, 0xa0, 0xdd, 0x37, 0xac, 0x81, 0x27, 0xb9, 0x32, 0x1f, 0x96, 0xff,
+	0xfa, 0x7e, 0xa3, 0xd4, 0xfa, 0xba, 0x84, 0xea, 0xaf, 0x7f, 0x5b, 0xbc, 0x9b, 0xaf, 0xb7, 0xdb,
+	0xa8, 0x41, 0x62, 0xcd, 0xa6, 0xee, 0x2a, 0x1e, 0x31, 0xcf, 0xe5, 0xb0, 0x7e, 0x18, 0x70, 0xfe,
+	0xd9, 0xfb, 0xe8, 0xf9, 0xcb, 0x66, 0xe9, 0xc5, 0xcb, 0x66, 0xe9, 0x8f, 0x97, 0xcd, 0xd2, 0xb7,
+	0xaf, 0x9a, 0x4b, 0x2f, 0x5e, 0x35, 0x97, 0x7e, 0x7d, 0xd5, 0x5c, 0xfa, 0xfc, 0xe6, 0x91, 0x19,
+	0xa1, 0x89, 0x3e, 0xf6, 0x65, 0x3a, 0xb3, 0x7f, 0xed, 0x9c, 0x8c, 0x56, 0xec, 0x97, 0xe8, 0xfb,
+	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x15, 0xd3, 0x72, 0x6c, 0x4c, 0x0b, 0x00, 0x00,
 }
 
 func (this *VrfParams) Equal(that interface{}) bool {
@@ -300,20 +817,74 @@ func (this *VrfParams) Equal(that interface{}) bool {
 	if this.SlashingGraceBlocks != that1.SlashingGraceBlocks {
 		return false
 	}
-	return true
-}
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.RoundTolerance != that1.RoundTolerance {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if this.ParticipationRetentionBlocks != that1.ParticipationRetentionBlocks {
+		return false
+	}
+	if len(this.RewardPerBlock) != len(that1.RewardPerBlock) {
+		return false
+	}
+	for i := range this.RewardPerBlock {
+		if !this.RewardPerBlock[i].Equal(&that1.RewardPerBlock[i]) {
+			return false
+		}
+	}
+	if this.RewardFeeShareBps != that1.RewardFeeShareBps {
+		return false
+	}
+	if len(this.RequestBaseFee) != len(that1.RequestBaseFee) {
+		return false
+	}
+	for i := range this.RequestBaseFee {
+		if !this.RequestBaseFee[i].Equal(&that1.RequestBaseFee[i]) {
+			return false
+		}
+	}
+	if len(this.RequestFeePerWord) != len(that1.RequestFeePerWord) {
+		return false
+	}
+	for i := range this.RequestFeePerWord {
+		if !this.RequestFeePerWord[i].Equal(&that1.RequestFeePerWord[i]) {
+			return false
+		}
+	}
+	if this.SchemeId != that1.SchemeId {
+		return false
+	}
+	if this.PartialThreshold != that1.PartialThreshold {
+		return false
+	}
+	if this.EcvrfFallback != that1.EcvrfFallback {
+		return false
+	}
+	if !bytes.Equal(this.GenesisSeed, that1.GenesisSeed) {
+		return false
+	}
+	if this.BeaconId != that1.BeaconId {
+		return false
+	}
+	if this.BootstrapEnableDelayBlocks != that1.BootstrapEnableDelayBlocks {
+		return false
+	}
+	if this.InvariantCheckIntervalBlocks != that1.InvariantCheckIntervalBlocks {
+		return false
+	}
+	return true
+}
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -322,6 +893,115 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EcvrfKeys) > 0 {
+		for iNdEx := len(m.EcvrfKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EcvrfKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LastDrandRound != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastDrandRound))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.EcvrfFallbackSeed != nil {
+		{
+			size, err := m.EcvrfFallbackSeed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.EmergencyTxs) > 0 {
+		for iNdEx := len(m.EmergencyTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmergencyTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextTimelockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTimelockId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Timelocked) > 0 {
+		for iNdEx := len(m.Timelocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timelocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RequestEscrow) > 0 {
+		for iNdEx := len(m.RequestEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRequestId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingParams != nil {
+		{
+			size, err := m.PendingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Identities) > 0 {
 		for iNdEx := len(m.Identities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -375,7 +1055,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VrfParams) Marshal() (dAtA []byte, err error) {
+func (m *EcvrfFallbackSeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -385,51 +1065,46 @@ func (m *VrfParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VrfParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *EcvrfFallbackSeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VrfParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EcvrfFallbackSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SlashingGraceBlocks != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashingGraceBlocks))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.ReshareEpoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ReshareEpoch))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SafetyMarginSeconds != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SafetyMarginSeconds))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.GenesisUnixSec != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.GenesisUnixSec))
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Seed)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa
 	}
-	if m.PeriodSeconds != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PeriodSeconds))
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *EcvrfKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EcvrfKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EcvrfKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.PublicKey) > 0 {
 		i -= len(m.PublicKey)
 		copy(dAtA[i:], m.PublicKey)
@@ -437,96 +1112,1348 @@ func (m *VrfParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ChainHash) > 0 {
-		i -= len(m.ChainHash)
-		copy(dAtA[i:], m.ChainHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainHash)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EmergencyTxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EmergencyTxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyTxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.LatestBeacon != nil {
-		l = m.LatestBeacon.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Committee) > 0 {
-		for _, e := range m.Committee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Identities) > 0 {
-		for _, e := range m.Identities {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VrfParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VrfParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.InvariantCheckIntervalBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InvariantCheckIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BootstrapEnableDelayBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BootstrapEnableDelayBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.PeriodSeconds != 0 {
-		n += 1 + sovGenesis(uint64(m.PeriodSeconds))
+	if len(m.BeaconId) > 0 {
+		i -= len(m.BeaconId)
+		copy(dAtA[i:], m.BeaconId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeaconId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.GenesisUnixSec != 0 {
-		n += 1 + sovGenesis(uint64(m.GenesisUnixSec))
+	if len(m.GenesisSeed) > 0 {
+		i -= len(m.GenesisSeed)
+		copy(dAtA[i:], m.GenesisSeed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GenesisSeed)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.SafetyMarginSeconds != 0 {
-		n += 1 + sovGenesis(uint64(m.SafetyMarginSeconds))
+	if m.EcvrfFallback {
+		i--
+		if m.EcvrfFallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.Enabled {
-		n += 2
+	if m.PartialThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PartialThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.ReshareEpoch != 0 {
-		n += 1 + sovGenesis(uint64(m.ReshareEpoch))
+	if len(m.SchemeId) > 0 {
+		i -= len(m.SchemeId)
+		copy(dAtA[i:], m.SchemeId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SchemeId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RequestFeePerWord) > 0 {
+		for iNdEx := len(m.RequestFeePerWord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestFeePerWord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RequestBaseFee) > 0 {
+		for iNdEx := len(m.RequestBaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestBaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.RewardFeeShareBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardFeeShareBps))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RewardPerBlock) > 0 {
+		for iNdEx := len(m.RewardPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ParticipationRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ParticipationRetentionBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RoundTolerance != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoundTolerance))
+		i--
+		dAtA[i] = 0x48
 	}
 	if m.SlashingGraceBlocks != 0 {
-		n += 1 + sovGenesis(uint64(m.SlashingGraceBlocks))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashingGraceBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReshareEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReshareEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SafetyMarginSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SafetyMarginSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GenesisUnixSec != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GenesisUnixSec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainHash) > 0 {
+		i -= len(m.ChainHash)
+		copy(dAtA[i:], m.ChainHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfPendingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfPendingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfPendingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LatestBeacon != nil {
+		l = m.LatestBeacon.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Committee) > 0 {
+		for _, e := range m.Committee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Identities) > 0 {
+		for _, e := range m.Identities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingParams != nil {
+		l = m.PendingParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRequestId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRequestId))
+	}
+	if len(m.RequestEscrow) > 0 {
+		for _, e := range m.RequestEscrow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Timelocked) > 0 {
+		for _, e := range m.Timelocked {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTimelockId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTimelockId))
+	}
+	if len(m.EmergencyTxs) > 0 {
+		for _, e := range m.EmergencyTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EcvrfFallbackSeed != nil {
+		l = m.EcvrfFallbackSeed.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastDrandRound != 0 {
+		n += 1 + sovGenesis(uint64(m.LastDrandRound))
+	}
+	if len(m.EcvrfKeys) > 0 {
+		for _, e := range m.EcvrfKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EcvrfFallbackSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EcvrfKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EmergencyTxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *VrfParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.PeriodSeconds))
+	}
+	if m.GenesisUnixSec != 0 {
+		n += 1 + sovGenesis(uint64(m.GenesisUnixSec))
+	}
+	if m.SafetyMarginSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.SafetyMarginSeconds))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ReshareEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.ReshareEpoch))
+	}
+	if m.SlashingGraceBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashingGraceBlocks))
+	}
+	if m.RoundTolerance != 0 {
+		n += 1 + sovGenesis(uint64(m.RoundTolerance))
+	}
+	if m.ParticipationRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.ParticipationRetentionBlocks))
+	}
+	if len(m.RewardPerBlock) > 0 {
+		for _, e := range m.RewardPerBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RewardFeeShareBps != 0 {
+		n += 1 + sovGenesis(uint64(m.RewardFeeShareBps))
+	}
+	if len(m.RequestBaseFee) > 0 {
+		for _, e := range m.RequestBaseFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RequestFeePerWord) > 0 {
+		for _, e := range m.RequestFeePerWord {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.SchemeId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PartialThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.PartialThreshold))
+	}
+	if m.EcvrfFallback {
+		n += 3
+	}
+	l = len(m.GenesisSeed)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BeaconId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.BootstrapEnableDelayBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.BootstrapEnableDelayBlocks))
+	}
+	if m.InvariantCheckIntervalBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.InvariantCheckIntervalBlocks))
+	}
+	return n
+}
+
+func (m *VrfPendingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBeacon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBeacon == nil {
+				m.LatestBeacon = &VrfBeacon{}
+			}
+			if err := m.LatestBeacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committee = append(m.Committee, AllowlistEntry{})
+			if err := m.Committee[len(m.Committee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identities = append(m.Identities, VrfIdentity{})
+			if err := m.Identities[len(m.Identities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingParams == nil {
+				m.PendingParams = &VrfPendingParams{}
+			}
+			if err := m.PendingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, RandomnessRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRequestId", wireType)
+			}
+			m.NextRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestEscrow = append(m.RequestEscrow, types.Coin{})
+			if err := m.RequestEscrow[len(m.RequestEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timelocked = append(m.Timelocked, TimelockedMessage{})
+			if err := m.Timelocked[len(m.Timelocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTimelockId", wireType)
+			}
+			m.NextTimelockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTimelockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyTxs = append(m.EmergencyTxs, EmergencyTxRecord{})
+			if err := m.EmergencyTxs[len(m.EmergencyTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfFallbackSeed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EcvrfFallbackSeed == nil {
+				m.EcvrfFallbackSeed = &EcvrfFallbackSeed{}
+			}
+			if err := m.EcvrfFallbackSeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDrandRound", wireType)
+			}
+			m.LastDrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcvrfKeys = append(m.EcvrfKeys, EcvrfKey{})
+			if err := m.EcvrfKeys[len(m.EcvrfKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EcvrfFallbackSeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EcvrfFallbackSeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EcvrfFallbackSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EcvrfKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EcvrfKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EcvrfKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencyTxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyTxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyTxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *VrfParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -545,19 +2472,240 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainHash == nil {
+				m.ChainHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
+			}
+			m.GenesisUnixSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisUnixSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyMarginSeconds", wireType)
+			}
+			m.SafetyMarginSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafetyMarginSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+			}
+			m.ReshareEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReshareEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingGraceBlocks", wireType)
+			}
+			m.SlashingGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingGraceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTolerance", wireType)
+			}
+			m.RoundTolerance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundTolerance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRetentionBlocks", wireType)
+			}
+			m.ParticipationRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -584,13 +2732,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
+			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFeeShareBps", wireType)
+			}
+			m.RewardFeeShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardFeeShareBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestBeacon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBaseFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -617,16 +2785,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestBeacon == nil {
-				m.LatestBeacon = &VrfBeacon{}
-			}
-			if err := m.LatestBeacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestBaseFee = append(m.RequestBaseFee, types.Coin{})
+			if err := m.RequestBaseFee[len(m.RequestBaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestFeePerWord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -653,16 +2819,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committee = append(m.Committee, AllowlistEntry{})
-			if err := m.Committee[len(m.Committee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestFeePerWord = append(m.RequestFeePerWord, types.Coin{})
+			if err := m.RequestFeePerWord[len(m.RequestFeePerWord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -672,81 +2838,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identities = append(m.Identities, VrfIdentity{})
-			if err := m.Identities[len(m.Identities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SchemeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VrfParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VrfParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VrfParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialThreshold", wireType)
 			}
-			var byteLen int
+			m.PartialThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -756,29 +2870,34 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PartialThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfFallback", wireType)
 			}
-			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainHash == nil {
-				m.ChainHash = []byte{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.EcvrfFallback = bool(v != 0)
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisSeed", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -805,16 +2924,16 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.GenesisSeed = append(m.GenesisSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisSeed == nil {
+				m.GenesisSeed = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconId", wireType)
 			}
-			m.PeriodSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -824,35 +2943,29 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.GenesisUnixSec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GenesisUnixSec |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeaconId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SafetyMarginSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapEnableDelayBlocks", wireType)
 			}
-			m.SafetyMarginSeconds = 0
+			m.BootstrapEnableDelayBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -862,16 +2975,16 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SafetyMarginSeconds |= uint64(b&0x7F) << shift
+				m.BootstrapEnableDelayBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantCheckIntervalBlocks", wireType)
 			}
-			var v int
+			m.InvariantCheckIntervalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -881,17 +2994,66 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.InvariantCheckIntervalBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ReshareEpoch = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfPendingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfPendingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfPendingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -901,16 +3063,30 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReshareEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingGraceBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.SlashingGraceBlocks = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -920,7 +3096,7 @@ func (m *VrfParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashingGraceBlocks |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
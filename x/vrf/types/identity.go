@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/drand/drand/v2/crypto"
+	"github.com/drand/kyber"
+)
+
+// identityProofDomain separates identity proofs from every other message
+// signed with a drand key.
+var identityProofDomain = []byte("vrf/identity-proof/v1")
+
+// IdentityProofMessage returns the message signed by drand_bls_proof of
+// MsgRegisterVrfIdentity. It binds the key to the chain, the validator and
+// the share index, so that a proof cannot be replayed by another operator.
+func IdentityProofMessage(chainID, validatorAddr string, shareIndex uint32) []byte {
+	msg := make([]byte, 0, len(identityProofDomain)+len(chainID)+len(validatorAddr)+12)
+	msg = append(msg, identityProofDomain...)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(chainID)))
+	msg = append(msg, chainID...)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(validatorAddr)))
+	msg = append(msg, validatorAddr...)
+	msg = binary.BigEndian.AppendUint32(msg, shareIndex)
+	return msg
+}
+
+// SignIdentityProof signs IdentityProofMessage with the private drand key
+// share, using the authentication scheme of scheme.
+func SignIdentityProof(scheme *crypto.Scheme, priv kyber.Scalar, chainID, validatorAddr string, shareIndex uint32) ([]byte, error) {
+	return scheme.AuthScheme.Sign(priv, IdentityProofMessage(chainID, validatorAddr, shareIndex))
+}
+
+// VerifyIdentityProof checks that proof is a signature by pubKey, a point of
+// the key group of scheme, over IdentityProofMessage.
+func VerifyIdentityProof(scheme *crypto.Scheme, pubKey []byte, chainID, validatorAddr string, shareIndex uint32, proof []byte) error {
+	pub := scheme.KeyGroup.Point()
+	if err := pub.UnmarshalBinary(pubKey); err != nil {
+		return fmt.Errorf("invalid drand_bls_public_key: %w", err)
+	}
+
+	return scheme.AuthScheme.Verify(pub, IdentityProofMessage(chainID, validatorAddr, shareIndex), proof)
+}
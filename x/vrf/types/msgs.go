@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 
@@ -14,13 +15,37 @@ var (
 	errMsgInitialDkgPublicKeyEmpty          = errors.New("MsgInitialDkg: public_key must not be empty")
 	errMsgInitialDkgPeriodSecondsZero       = errors.New("MsgInitialDkg: period_seconds must be > 0")
 	errMsgInitialDkgGenesisUnixSecZero      = errors.New("MsgInitialDkg: genesis_unix_sec must be non-zero")
+	errMsgInitialDkgActivationHeight        = errors.New("MsgInitialDkg: activation_height must not be negative")
+	errMsgInitialDkgBootstrapBeacon         = errors.New("MsgInitialDkg: bootstrap_beacon needs a drand_round and a signature")
 	errMsgUpdateParamsNil                   = errors.New("MsgUpdateParams: message cannot be nil")
+	errMsgUpdateParamsActivationHeight      = errors.New("MsgUpdateParams: activation_height must not be negative")
 	errMsgAddVrfCommitteeMemberNil          = errors.New("MsgAddVrfCommitteeMember: message cannot be nil")
+	errMsgAddVrfCommitteeMemberRolesEmpty   = errors.New("MsgAddVrfCommitteeMember: roles must not be empty")
 	errMsgRemoveVrfCommitteeMemberNil       = errors.New("MsgRemoveVrfCommitteeMember: message cannot be nil")
 	errMsgRegisterVrfIdentityNil            = errors.New("MsgRegisterVrfIdentity: message cannot be nil")
 	errMsgRegisterVrfIdentityPublicKeyEmpty = errors.New("MsgRegisterVrfIdentity: drand_bls_public_key must not be empty")
+	errMsgRegisterVrfIdentityProofEmpty     = errors.New("MsgRegisterVrfIdentity: drand_bls_proof must not be empty")
+	errMsgRegisterEcvrfKeyNil               = errors.New("MsgRegisterEcvrfKey: message cannot be nil")
+	errMsgRegisterEcvrfKeyPublicKeyLength   = errors.New("MsgRegisterEcvrfKey: ecvrf_public_key must be 32 bytes")
+	errMsgRegisterEcvrfKeyProofLength       = errors.New("MsgRegisterEcvrfKey: ecvrf_proof must be 80 bytes")
 	errMsgScheduleVrfReshareNil             = errors.New("MsgScheduleVrfReshare: message cannot be nil")
 	errMsgScheduleVrfReshareEpochZero       = errors.New("MsgScheduleVrfReshare: reshare_epoch must be > 0")
+	errMsgRequestRandomnessNil              = errors.New("MsgRequestRandomness: message cannot be nil")
+	errMsgRequestRandomnessNumWords         = errors.New("MsgRequestRandomness: num_words out of range")
+	errMsgRequestRandomnessUserSeedTooLong  = errors.New("MsgRequestRandomness: user_seed too long")
+	errMsgSubmitTimelockedNil               = errors.New("MsgSubmitTimelocked: message cannot be nil")
+	errMsgSubmitTimelockedRoundZero         = errors.New("MsgSubmitTimelocked: drand_round must be > 0")
+)
+
+const (
+	// MaxRequestWords bounds MsgRequestRandomness.num_words.
+	MaxRequestWords = 256
+
+	// MaxRequestUserSeedLength bounds MsgRequestRandomness.user_seed.
+	MaxRequestUserSeedLength = 128
+
+	// MaxTimelockPayloadLength bounds MsgSubmitTimelocked.ciphertext.payload.
+	MaxTimelockPayloadLength = 4096
 )
 
 func (m *MsgVrfEmergencyDisable) ValidateBasic() error {
@@ -61,6 +86,14 @@ func (m *MsgInitialDkg) ValidateBasic() error {
 		return errMsgInitialDkgGenesisUnixSecZero
 	}
 
+	if m.ActivationHeight < 0 {
+		return errMsgInitialDkgActivationHeight
+	}
+
+	if b := m.BootstrapBeacon; b != nil && (b.DrandRound == 0 || len(b.Signature) == 0) {
+		return errMsgInitialDkgBootstrapBeacon
+	}
+
 	return nil
 }
 
@@ -77,6 +110,14 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 		return fmt.Errorf("MsgUpdateParams: invalid params: %w", err)
 	}
 
+	if err := m.Params.ValidateDrandChain(); err != nil {
+		return fmt.Errorf("MsgUpdateParams: invalid params: %w", err)
+	}
+
+	if m.ActivationHeight < 0 {
+		return errMsgUpdateParamsActivationHeight
+	}
+
 	return nil
 }
 
@@ -93,6 +134,13 @@ func (m *MsgAddVrfCommitteeMember) ValidateBasic() error {
 		return fmt.Errorf("MsgAddVrfCommitteeMember: invalid address: %w", err)
 	}
 
+	if len(m.Roles) == 0 {
+		return errMsgAddVrfCommitteeMemberRolesEmpty
+	}
+	if err := ValidateCommitteeRoles(m.Roles); err != nil {
+		return fmt.Errorf("MsgAddVrfCommitteeMember: %w", err)
+	}
+
 	return nil
 }
 
@@ -125,6 +173,30 @@ func (m *MsgRegisterVrfIdentity) ValidateBasic() error {
 		return errMsgRegisterVrfIdentityPublicKeyEmpty
 	}
 
+	if len(m.DrandBlsProof) == 0 {
+		return errMsgRegisterVrfIdentityProofEmpty
+	}
+
+	return nil
+}
+
+func (m *MsgRegisterEcvrfKey) ValidateBasic() error {
+	if m == nil {
+		return errMsgRegisterEcvrfKeyNil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return fmt.Errorf("MsgRegisterEcvrfKey: invalid operator: %w", err)
+	}
+
+	if len(m.EcvrfPublicKey) != ed25519.PublicKeySize {
+		return errMsgRegisterEcvrfKeyPublicKeyLength
+	}
+
+	if len(m.EcvrfProof) != ECVRFProofLength {
+		return errMsgRegisterEcvrfKeyProofLength
+	}
+
 	return nil
 }
 
@@ -143,3 +215,47 @@ func (m *MsgScheduleVrfReshare) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgRequestRandomness) ValidateBasic() error {
+	if m == nil {
+		return errMsgRequestRandomnessNil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Requester); err != nil {
+		return fmt.Errorf("MsgRequestRandomness: invalid requester address: %w", err)
+	}
+
+	if m.NumWords == 0 || m.NumWords > MaxRequestWords {
+		return fmt.Errorf("%w: got %d, max %d", errMsgRequestRandomnessNumWords, m.NumWords, MaxRequestWords)
+	}
+
+	if len(m.UserSeed) > MaxRequestUserSeedLength {
+		return fmt.Errorf("%w: got %d, max %d", errMsgRequestRandomnessUserSeedTooLong, len(m.UserSeed), MaxRequestUserSeedLength)
+	}
+
+	if err := m.MaxFee.Validate(); err != nil {
+		return fmt.Errorf("MsgRequestRandomness: invalid max_fee: %w", err)
+	}
+
+	return nil
+}
+
+func (m *MsgSubmitTimelocked) ValidateBasic() error {
+	if m == nil {
+		return errMsgSubmitTimelockedNil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return fmt.Errorf("MsgSubmitTimelocked: invalid submitter address: %w", err)
+	}
+
+	if m.DrandRound == 0 {
+		return errMsgSubmitTimelockedRoundZero
+	}
+
+	if err := m.Ciphertext.Validate(); err != nil {
+		return fmt.Errorf("MsgSubmitTimelocked: %w", err)
+	}
+
+	return nil
+}
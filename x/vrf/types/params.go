@@ -1,23 +1,53 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/common/chain"
+	"github.com/drand/drand/v2/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	errPeriodSecondsMustBePositive = errors.New("period_seconds must be positive")
 	errSafetyMarginTooLow          = errors.New("safety_margin_seconds must be >= period_seconds")
+	errRoundToleranceTooHigh       = errors.New("round_tolerance exceeds maximum")
+	errInvalidRewardPerBlock       = errors.New("invalid reward_per_block")
+	errRewardFeeShareTooHigh       = errors.New("reward_fee_share_bps exceeds 10000")
+	errInvalidRequestFee           = errors.New("invalid randomness request fee schedule")
+	errUnsupportedScheme           = errors.New("unsupported drand scheme_id")
+	errThresholdModeRequiresScheme = errors.New("partial_threshold requires an unchained scheme_id")
+	errChainHashEmpty              = errors.New("chain_hash must not be empty when public_key is set")
+	errPublicKeyEmpty              = errors.New("public_key must not be empty when chain_hash is set")
+	errInvalidPublicKey            = errors.New("public_key is not a valid point of the scheme's key group")
+	errChainHashMismatch           = errors.New("chain_hash does not match the drand chain info")
+	errBeaconRandomnessMismatch    = errors.New("beacon randomness is not SHA256(signature)")
+	errBeaconVerificationFailed    = errors.New("beacon signature does not verify under public_key")
 )
 
+// MaxRoundTolerance bounds how many rounds below the target round a vote
+// extension may carry, which also bounds the vote extension size.
+const MaxRoundTolerance = 4
+
+// MaxRewardFeeShareBps is 100% expressed in basis points.
+const MaxRewardFeeShareBps = 10_000
+
 // DefaultParams mirrors the PRD definition and contains all cryptographic and timing
 // context needed to verify drand beacons on-chain and map block time to drand
 // rounds.
 func DefaultParams() VrfParams {
 	return VrfParams{
-		PeriodSeconds:       30,
-		SafetyMarginSeconds: 30,
-		Enabled:             false,
+		PeriodSeconds:                30,
+		SafetyMarginSeconds:          30,
+		Enabled:                      false,
+		RoundTolerance:               1,
+		ParticipationRetentionBlocks: 1000,
 	}
 }
 
@@ -30,5 +60,153 @@ func (p VrfParams) Validate() error {
 		return fmt.Errorf("%w: got %d, expected >=%d", errSafetyMarginTooLow, p.SafetyMarginSeconds, p.PeriodSeconds)
 	}
 
+	if p.RoundTolerance > MaxRoundTolerance {
+		return fmt.Errorf("%w: got %d, max %d", errRoundToleranceTooHigh, p.RoundTolerance, MaxRoundTolerance)
+	}
+
+	if err := p.RewardPerBlock.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidRewardPerBlock, err)
+	}
+
+	if p.RewardFeeShareBps > MaxRewardFeeShareBps {
+		return fmt.Errorf("%w: got %d", errRewardFeeShareTooHigh, p.RewardFeeShareBps)
+	}
+
+	if err := p.RequestBaseFee.Validate(); err != nil {
+		return fmt.Errorf("%w: request_base_fee: %w", errInvalidRequestFee, err)
+	}
+
+	if err := p.RequestFeePerWord.Validate(); err != nil {
+		return fmt.Errorf("%w: request_fee_per_word: %w", errInvalidRequestFee, err)
+	}
+
+	if _, err := p.Scheme(); err != nil {
+		return err
+	}
+
+	if p.PartialThreshold > 0 && !p.Unchained() {
+		return errThresholdModeRequiresScheme
+	}
+
+	return nil
+}
+
+// Scheme returns the drand scheme named by scheme_id. Only schemes with the
+// group public key on G1 and signatures on G2 are supported.
+func (p VrfParams) Scheme() (*crypto.Scheme, error) {
+	switch p.SchemeId {
+	case "", crypto.DefaultSchemeID:
+		return crypto.NewPedersenBLSChained(), nil
+	case crypto.UnchainedSchemeID:
+		return crypto.NewPedersenBLSUnchained(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedScheme, p.SchemeId)
+	}
+}
+
+// DrandChainHash recomputes the drand chain hash from public_key,
+// period_seconds, genesis_unix_sec, genesis_seed and beacon_id, as drand's
+// chain info does. It fails if public_key is not a point of the key group of
+// scheme_id.
+func (p VrfParams) DrandChainHash() ([]byte, error) {
+	scheme, err := p.Scheme()
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := scheme.KeyGroup.Point()
+	if err := pubKey.UnmarshalBinary(p.PublicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPublicKey, err)
+	}
+
+	info := chain.Info{
+		PublicKey:   pubKey,
+		ID:          p.BeaconId,
+		Period:      time.Duration(p.PeriodSeconds) * time.Second,
+		Scheme:      scheme.Name,
+		GenesisTime: p.GenesisUnixSec,
+		GenesisSeed: p.GenesisSeed,
+	}
+
+	return info.Hash(), nil
+}
+
+// VerifyBeacon checks that beacon was produced by the drand chain the params
+// describe: randomness, when set, is SHA256(signature), and the signature
+// verifies under public_key with scheme_id.
+func (p VrfParams) VerifyBeacon(beacon VrfBeacon) error {
+	if len(beacon.Randomness) > 0 {
+		hash := sha256.Sum256(beacon.Signature)
+		if !bytes.Equal(hash[:], beacon.Randomness) {
+			return errBeaconRandomnessMismatch
+		}
+	}
+
+	scheme, err := p.Scheme()
+	if err != nil {
+		return err
+	}
+
+	pubKey := scheme.KeyGroup.Point()
+	if err := pubKey.UnmarshalBinary(p.PublicKey); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidPublicKey, err)
+	}
+
+	err = scheme.VerifyBeacon(&common.Beacon{
+		PreviousSig: beacon.PreviousSignature,
+		Round:       beacon.DrandRound,
+		Signature:   beacon.Signature,
+	}, pubKey)
+	if err != nil {
+		return fmt.Errorf("%w: round %d: %w", errBeaconVerificationFailed, beacon.DrandRound, err)
+	}
+
+	return nil
+}
+
+// ValidateDrandChain checks that public_key decodes on the scheme's key group
+// and that chain_hash is the hash of the drand chain the params describe.
+// Params without chain-info yet, before the initial DKG, are valid.
+func (p VrfParams) ValidateDrandChain() error {
+	switch {
+	case len(p.ChainHash) == 0 && len(p.PublicKey) == 0:
+		return nil
+	case len(p.ChainHash) == 0:
+		return errChainHashEmpty
+	case len(p.PublicKey) == 0:
+		return errPublicKeyEmpty
+	}
+
+	hash, err := p.DrandChainHash()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hash, p.ChainHash) {
+		return fmt.Errorf("%w: got %x, derived %x", errChainHashMismatch, p.ChainHash, hash)
+	}
+
 	return nil
 }
+
+// ThresholdMode reports whether beacons are recovered on chain from partial
+// signatures instead of being fetched whole from drand.
+func (p VrfParams) ThresholdMode() bool {
+	return p.PartialThreshold > 0
+}
+
+// Unchained reports whether beacons sign the round number alone, which is what
+// makes future rounds usable as time-lock encryption identities.
+func (p VrfParams) Unchained() bool {
+	return p.SchemeId == crypto.UnchainedSchemeID
+}
+
+// RequestFee returns the fee charged for a randomness request of numWords
+// words: request_base_fee + num_words * request_fee_per_word.
+func (p VrfParams) RequestFee(numWords uint32) sdk.Coins {
+	fee := sdk.NewCoins(p.RequestBaseFee...)
+	for _, c := range p.RequestFeePerWord {
+		fee = fee.Add(sdk.NewCoin(c.Denom, c.Amount.MulRaw(int64(numWords))))
+	}
+	return fee
+}
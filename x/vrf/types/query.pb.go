@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -114,6 +115,89 @@ func (m *QueryParamsResponse) GetParams() VrfParams {
 	return VrfParams{}
 }
 
+// QueryPendingParamsRequest requests the scheduled params change.
+type QueryPendingParamsRequest struct {
+}
+
+func (m *QueryPendingParamsRequest) Reset()         { *m = QueryPendingParamsRequest{} }
+func (m *QueryPendingParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsRequest) ProtoMessage()    {}
+func (*QueryPendingParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{2}
+}
+func (m *QueryPendingParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsRequest.Merge(m, src)
+}
+func (m *QueryPendingParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsRequest proto.InternalMessageInfo
+
+// QueryPendingParamsResponse carries the scheduled params change. It is unset
+// when no change is pending.
+type QueryPendingParamsResponse struct {
+	PendingParams *VrfPendingParams `protobuf:"bytes,1,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+}
+
+func (m *QueryPendingParamsResponse) Reset()         { *m = QueryPendingParamsResponse{} }
+func (m *QueryPendingParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsResponse) ProtoMessage()    {}
+func (*QueryPendingParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{3}
+}
+func (m *QueryPendingParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsResponse.Merge(m, src)
+}
+func (m *QueryPendingParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamsResponse) GetPendingParams() *VrfPendingParams {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
 // QueryBeaconRequest requests the VrfBeacon for the current context height.
 // Historical requests use the ABCI height parameter; no explicit height field
 // is needed here.
@@ -124,7 +208,7 @@ func (m *QueryBeaconRequest) Reset()         { *m = QueryBeaconRequest{} }
 func (m *QueryBeaconRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBeaconRequest) ProtoMessage()    {}
 func (*QueryBeaconRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb04c73ba3d3cf3f, []int{2}
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{4}
 }
 func (m *QueryBeaconRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +246,7 @@ func (m *QueryBeaconResponse) Reset()         { *m = QueryBeaconResponse{} }
 func (m *QueryBeaconResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBeaconResponse) ProtoMessage()    {}
 func (*QueryBeaconResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb04c73ba3d3cf3f, []int{3}
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{5}
 }
 func (m *QueryBeaconResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +296,7 @@ func (m *QueryRandomWordsRequest) Reset()         { *m = QueryRandomWordsRequest
 func (m *QueryRandomWordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRandomWordsRequest) ProtoMessage()    {}
 func (*QueryRandomWordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb04c73ba3d3cf3f, []int{4}
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{6}
 }
 func (m *QueryRandomWordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +351,7 @@ func (m *QueryRandomWordsResponse) Reset()         { *m = QueryRandomWordsRespon
 func (m *QueryRandomWordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRandomWordsResponse) ProtoMessage()    {}
 func (*QueryRandomWordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb04c73ba3d3cf3f, []int{5}
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{7}
 }
 func (m *QueryRandomWordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,499 +401,2832 @@ func (m *QueryRandomWordsResponse) GetWords() [][]byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "digitalkitchen.vrf.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "digitalkitchen.vrf.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryBeaconRequest)(nil), "digitalkitchen.vrf.v1.QueryBeaconRequest")
-	proto.RegisterType((*QueryBeaconResponse)(nil), "digitalkitchen.vrf.v1.QueryBeaconResponse")
-	proto.RegisterType((*QueryRandomWordsRequest)(nil), "digitalkitchen.vrf.v1.QueryRandomWordsRequest")
-	proto.RegisterType((*QueryRandomWordsResponse)(nil), "digitalkitchen.vrf.v1.QueryRandomWordsResponse")
+// QueryParticipationRequest requests the participation record for a height.
+type QueryParticipationRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() { proto.RegisterFile("digitalkitchen/vrf/v1/query.proto", fileDescriptor_eb04c73ba3d3cf3f) }
-
-var fileDescriptor_eb04c73ba3d3cf3f = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x18, 0x8c, 0x9b, 0x36, 0xfa, 0xbb, 0xe9, 0x8f, 0x60, 0x09, 0x10, 0x39, 0xc8, 0x49, 0x8d, 0x84,
-	0x42, 0x0f, 0xb6, 0x5a, 0x5e, 0x00, 0x85, 0x2b, 0x07, 0x30, 0x12, 0x48, 0xbd, 0x44, 0x1b, 0xef,
-	0xc6, 0xb5, 0xa8, 0xf7, 0x73, 0x77, 0xd7, 0x81, 0x4a, 0x9c, 0x38, 0x71, 0x04, 0x71, 0xe0, 0x15,
-	0x38, 0xf2, 0x18, 0x3d, 0x56, 0xe2, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0xd7, 0x40, 0xbb, 0xeb, 0xd0,
-	0xba, 0x49, 0x43, 0x2f, 0xd6, 0x7a, 0x34, 0xdf, 0xcc, 0xec, 0x37, 0x36, 0xda, 0xa6, 0x69, 0x92,
-	0x2a, 0x72, 0xf8, 0x2a, 0x55, 0xf1, 0x01, 0xe3, 0xe1, 0x44, 0x8c, 0xc3, 0xc9, 0x6e, 0x78, 0x54,
-	0x30, 0x71, 0x1c, 0xe4, 0x02, 0x14, 0xe0, 0x5b, 0x55, 0x4a, 0x30, 0x11, 0xe3, 0x60, 0xb2, 0xeb,
-	0xde, 0x20, 0x59, 0xca, 0x21, 0x34, 0x4f, 0xcb, 0x74, 0x3b, 0x31, 0xc8, 0x0c, 0xa4, 0x9d, 0xbe,
-	0x20, 0xe3, 0xde, 0x5b, 0xee, 0x94, 0x30, 0xce, 0x64, 0x2a, 0x4b, 0x52, 0x77, 0x39, 0x49, 0x5b,
-	0x5a, 0x42, 0x2b, 0x81, 0x04, 0xcc, 0x31, 0xd4, 0xa7, 0x12, 0xbd, 0x9b, 0x00, 0x24, 0x87, 0x2c,
-	0x24, 0x79, 0x1a, 0x12, 0xce, 0x41, 0x11, 0x95, 0x02, 0x2f, 0x45, 0xfd, 0x16, 0xc2, 0xcf, 0x74,
-	0x90, 0xa7, 0x44, 0x90, 0x4c, 0x46, 0xec, 0xa8, 0x60, 0x52, 0xf9, 0xfb, 0xe8, 0x66, 0x05, 0x95,
-	0x39, 0x70, 0xc9, 0xf0, 0x63, 0xd4, 0xc8, 0x0d, 0xd2, 0x76, 0x7a, 0x4e, 0xbf, 0xb9, 0xd7, 0x0b,
-	0x96, 0x5e, 0x3f, 0x78, 0x21, 0xc6, 0x76, 0x72, 0xb0, 0x79, 0xf2, 0xa3, 0x5b, 0xfb, 0xf2, 0xfb,
-	0xeb, 0x8e, 0x13, 0x95, 0xa3, 0x7f, 0x1d, 0x07, 0x8c, 0xc4, 0xc0, 0x2f, 0x3a, 0xce, 0xd1, 0x33,
-	0xc7, 0x91, 0x41, 0xfe, 0xed, 0x68, 0x27, 0x2b, 0x8e, 0x76, 0xd4, 0x7f, 0x82, 0xee, 0x18, 0xed,
-	0x88, 0x70, 0x0a, 0xd9, 0x4b, 0x10, 0x74, 0x7e, 0x51, 0xdc, 0x42, 0x1b, 0x31, 0x14, 0x5c, 0x19,
-	0xf9, 0xff, 0x23, 0xfb, 0x82, 0x3b, 0x68, 0xb3, 0x90, 0x4c, 0x0c, 0x25, 0x63, 0xb4, 0xbd, 0xd6,
-	0x73, 0xfa, 0x5b, 0xd1, 0x7f, 0x1a, 0x78, 0xce, 0x18, 0xf5, 0x19, 0x6a, 0x2f, 0xaa, 0x95, 0x71,
-	0xbb, 0xa8, 0x49, 0x05, 0xe1, 0x74, 0x28, 0xa0, 0xe0, 0xd4, 0x88, 0xae, 0x47, 0xc8, 0x40, 0x91,
-	0x46, 0x30, 0x46, 0xeb, 0xe7, 0x44, 0xcd, 0x59, 0x67, 0x78, 0xad, 0x55, 0xda, 0xf5, 0x5e, 0xbd,
-	0xbf, 0x15, 0xd9, 0x97, 0xbd, 0xcf, 0x75, 0xb4, 0x61, 0x7c, 0xf0, 0x5b, 0xd4, 0xb0, 0xdb, 0xc4,
-	0x0f, 0x2e, 0xb9, 0xfd, 0x62, 0x83, 0xee, 0xce, 0x55, 0xa8, 0x36, 0xb5, 0xdf, 0x79, 0xaf, 0xd7,
-	0xf5, 0xee, 0xdb, 0xaf, 0x4f, 0x6b, 0xd7, 0xf1, 0xb5, 0xf9, 0x77, 0x65, 0xeb, 0xd2, 0xee, 0x76,
-	0xb3, 0xab, 0xdd, 0x2b, 0x6d, 0xae, 0x76, 0xaf, 0x56, 0x7c, 0x89, 0xbb, 0xad, 0x0e, 0x7f, 0x74,
-	0x50, 0xf3, 0xdc, 0xa2, 0x71, 0xb0, 0x4a, 0x78, 0xb1, 0x5f, 0x37, 0xbc, 0x32, 0xbf, 0x4c, 0xb3,
-	0x7d, 0x96, 0xe6, 0x36, 0x6e, 0xcd, 0xd3, 0x08, 0xc3, 0x1c, 0x9a, 0x66, 0x06, 0x8f, 0x4e, 0xa6,
-	0x9e, 0x73, 0x3a, 0xf5, 0x9c, 0x9f, 0x53, 0xcf, 0xf9, 0x30, 0xf3, 0x6a, 0xa7, 0x33, 0xaf, 0xf6,
-	0x7d, 0xe6, 0xd5, 0xf6, 0xef, 0x27, 0xa9, 0x3a, 0x28, 0x46, 0x41, 0x0c, 0x59, 0x48, 0x13, 0x55,
-	0xf9, 0x53, 0xdf, 0x98, 0xa7, 0x3a, 0xce, 0x99, 0x1c, 0x35, 0xcc, 0xbf, 0xf7, 0xf0, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x3c, 0x02, 0x69, 0x61, 0x04, 0x00, 0x00,
+func (m *QueryParticipationRequest) Reset()         { *m = QueryParticipationRequest{} }
+func (m *QueryParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationRequest) ProtoMessage()    {}
+func (*QueryParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{8}
+}
+func (m *QueryParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationRequest.Merge(m, src)
+}
+func (m *QueryParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryParticipationRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the on-chain VRF parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Beacon returns the latest VrfBeacon for the current or specified height
-	Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error)
-	// RandomWords expands the current block's VRF seed into N random words
-	RandomWords(ctx context.Context, in *QueryRandomWordsRequest, opts ...grpc.CallOption) (*QueryRandomWordsResponse, error)
+func (m *QueryParticipationRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryParticipationResponse carries the participation record for a height.
+type QueryParticipationResponse struct {
+	Participation VrfParticipation `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryParticipationResponse) Reset()         { *m = QueryParticipationResponse{} }
+func (m *QueryParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationResponse) ProtoMessage()    {}
+func (*QueryParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationResponse.Merge(m, src)
+}
+func (m *QueryParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error) {
-	out := new(QueryBeaconResponse)
-	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Beacon", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationResponse) GetParticipation() VrfParticipation {
+	if m != nil {
+		return m.Participation
 	}
-	return out, nil
+	return VrfParticipation{}
 }
 
-func (c *queryClient) RandomWords(ctx context.Context, in *QueryRandomWordsRequest, opts ...grpc.CallOption) (*QueryRandomWordsResponse, error) {
-	out := new(QueryRandomWordsResponse)
-	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/RandomWords", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryValidatorParticipationRequest requests a validator's participation
+// summary.
+type QueryValidatorParticipationRequest struct {
+	// consensus_address is the bech32 validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *QueryValidatorParticipationRequest) Reset()         { *m = QueryValidatorParticipationRequest{} }
+func (m *QueryValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationRequest) ProtoMessage()    {}
+func (*QueryValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{10}
+}
+func (m *QueryValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationRequest.Merge(m, src)
+}
+func (m *QueryValidatorParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the on-chain VRF parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Beacon returns the latest VrfBeacon for the current or specified height
-	Beacon(context.Context, *QueryBeaconRequest) (*QueryBeaconResponse, error)
-	// RandomWords expands the current block's VRF seed into N random words
-	RandomWords(context.Context, *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error)
+var xxx_messageInfo_QueryValidatorParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorParticipationRequest) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryValidatorParticipationResponse carries a validator's participation
+// summary.
+type QueryValidatorParticipationResponse struct {
+	Summary VrfParticipationSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryValidatorParticipationResponse) Reset()         { *m = QueryValidatorParticipationResponse{} }
+func (m *QueryValidatorParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorParticipationResponse) ProtoMessage()    {}
+func (*QueryValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{11}
 }
-func (*UnimplementedQueryServer) Beacon(ctx context.Context, req *QueryBeaconRequest) (*QueryBeaconResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Beacon not implemented")
+func (m *QueryValidatorParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RandomWords(ctx context.Context, req *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RandomWords not implemented")
+func (m *QueryValidatorParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryValidatorParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorParticipationResponse.Merge(m, src)
+}
+func (m *QueryValidatorParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorParticipationResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/digitalkitchen.vrf.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryValidatorParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorParticipationResponse) GetSummary() VrfParticipationSummary {
+	if m != nil {
+		return m.Summary
 	}
-	return interceptor(ctx, in, info, handler)
+	return VrfParticipationSummary{}
 }
 
-func _Query_Beacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBeaconRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Beacon(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/digitalkitchen.vrf.v1.Query/Beacon",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Beacon(ctx, req.(*QueryBeaconRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRandomnessRequestRequest requests a paid randomness request by id.
+type QueryRandomnessRequestRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_RandomWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRandomWordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RandomWords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/digitalkitchen.vrf.v1.Query/RandomWords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RandomWords(ctx, req.(*QueryRandomWordsRequest))
+func (m *QueryRandomnessRequestRequest) Reset()         { *m = QueryRandomnessRequestRequest{} }
+func (m *QueryRandomnessRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomnessRequestRequest) ProtoMessage()    {}
+func (*QueryRandomnessRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{12}
+}
+func (m *QueryRandomnessRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomnessRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomnessRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRandomnessRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomnessRequestRequest.Merge(m, src)
+}
+func (m *QueryRandomnessRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomnessRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomnessRequestRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "digitalkitchen.vrf.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Beacon",
-			Handler:    _Query_Beacon_Handler,
-		},
-		{
-			MethodName: "RandomWords",
-			Handler:    _Query_RandomWords_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "digitalkitchen/vrf/v1/query.proto",
-}
+var xxx_messageInfo_QueryRandomnessRequestRequest proto.InternalMessageInfo
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRandomnessRequestRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRandomnessRequestResponse carries a paid randomness request.
+type QueryRandomnessRequestResponse struct {
+	Request RandomnessRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRandomnessRequestResponse) Reset()         { *m = QueryRandomnessRequestResponse{} }
+func (m *QueryRandomnessRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomnessRequestResponse) ProtoMessage()    {}
+func (*QueryRandomnessRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{13}
+}
+func (m *QueryRandomnessRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomnessRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomnessRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRandomnessRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomnessRequestResponse.Merge(m, src)
+}
+func (m *QueryRandomnessRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomnessRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomnessRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRandomnessRequestResponse proto.InternalMessageInfo
+
+func (m *QueryRandomnessRequestResponse) GetRequest() RandomnessRequest {
+	if m != nil {
+		return m.Request
 	}
-	return dAtA[:n], nil
+	return RandomnessRequest{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTimelockedRequest requests a time-locked ciphertext by id.
+type QueryTimelockedRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTimelockedRequest) Reset()         { *m = QueryTimelockedRequest{} }
+func (m *QueryTimelockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedRequest) ProtoMessage()    {}
+func (*QueryTimelockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{14}
+}
+func (m *QueryTimelockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBeaconRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTimelockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedRequest.Merge(m, src)
 }
-
-func (m *QueryBeaconRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTimelockedRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBeaconRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTimelockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBeaconResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimelockedRequest proto.InternalMessageInfo
+
+func (m *QueryTimelockedRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBeaconResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTimelockedResponse carries a time-locked ciphertext.
+type QueryTimelockedResponse struct {
+	Message TimelockedMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 }
 
-func (m *QueryBeaconResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Beacon.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTimelockedResponse) Reset()         { *m = QueryTimelockedResponse{} }
+func (m *QueryTimelockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockedResponse) ProtoMessage()    {}
+func (*QueryTimelockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{15}
+}
+func (m *QueryTimelockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTimelockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockedResponse.Merge(m, src)
+}
+func (m *QueryTimelockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRandomWordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimelockedResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockedResponse) GetMessage() TimelockedMessage {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return TimelockedMessage{}
 }
 
-func (m *QueryRandomWordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInvariantsRequest requests an invariant check.
+type QueryInvariantsRequest struct {
+	// route selects a single invariant. Empty checks all of them.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
 }
 
-func (m *QueryRandomWordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserSeed) > 0 {
-		i -= len(m.UserSeed)
-		copy(dAtA[i:], m.UserSeed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserSeed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{16}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRandomWordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryInvariantsRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRandomWordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInvariantsResponse carries the outcome of every checked invariant.
+type QueryInvariantsResponse struct {
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *QueryRandomWordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Words) > 0 {
-		for iNdEx := len(m.Words) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Words[iNdEx])
-			copy(dAtA[i:], m.Words[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Words[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{17}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Seed) > 0 {
-		i -= len(m.Seed)
-		copy(dAtA[i:], m.Seed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DrandRound != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DrandRound))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// InvariantResult is the outcome of one invariant check.
+type InvariantResult struct {
+	// route names the invariant.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true when the state violates the invariant.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the violation of a broken invariant.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb04c73ba3d3cf3f, []int{18}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryBeaconRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *QueryBeaconResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	var l int
-	_ = l
-	l = m.Beacon.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryRandomWordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "digitalkitchen.vrf.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "digitalkitchen.vrf.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingParamsRequest)(nil), "digitalkitchen.vrf.v1.QueryPendingParamsRequest")
+	proto.RegisterType((*QueryPendingParamsResponse)(nil), "digitalkitchen.vrf.v1.QueryPendingParamsResponse")
+	proto.RegisterType((*QueryBeaconRequest)(nil), "digitalkitchen.vrf.v1.QueryBeaconRequest")
+	proto.RegisterType((*QueryBeaconResponse)(nil), "digitalkitchen.vrf.v1.QueryBeaconResponse")
+	proto.RegisterType((*QueryRandomWordsRequest)(nil), "digitalkitchen.vrf.v1.QueryRandomWordsRequest")
+	proto.RegisterType((*QueryRandomWordsResponse)(nil), "digitalkitchen.vrf.v1.QueryRandomWordsResponse")
+	proto.RegisterType((*QueryParticipationRequest)(nil), "digitalkitchen.vrf.v1.QueryParticipationRequest")
+	proto.RegisterType((*QueryParticipationResponse)(nil), "digitalkitchen.vrf.v1.QueryParticipationResponse")
+	proto.RegisterType((*QueryValidatorParticipationRequest)(nil), "digitalkitchen.vrf.v1.QueryValidatorParticipationRequest")
+	proto.RegisterType((*QueryValidatorParticipationResponse)(nil), "digitalkitchen.vrf.v1.QueryValidatorParticipationResponse")
+	proto.RegisterType((*QueryRandomnessRequestRequest)(nil), "digitalkitchen.vrf.v1.QueryRandomnessRequestRequest")
+	proto.RegisterType((*QueryRandomnessRequestResponse)(nil), "digitalkitchen.vrf.v1.QueryRandomnessRequestResponse")
+	proto.RegisterType((*QueryTimelockedRequest)(nil), "digitalkitchen.vrf.v1.QueryTimelockedRequest")
+	proto.RegisterType((*QueryTimelockedResponse)(nil), "digitalkitchen.vrf.v1.QueryTimelockedResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "digitalkitchen.vrf.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "digitalkitchen.vrf.v1.QueryInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "digitalkitchen.vrf.v1.InvariantResult")
+}
+
+func init() { proto.RegisterFile("digitalkitchen/vrf/v1/query.proto", fileDescriptor_eb04c73ba3d3cf3f) }
+
+var fileDescriptor_eb04c73ba3d3cf3f = []byte{
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc7, 0xb3, 0x71, 0x9a, 0x36, 0x93, 0x26, 0xbf, 0x66, 0x7e, 0xae, 0xe3, 0x6c, 0xa8, 0xe3,
+	0x4c, 0x50, 0x31, 0x45, 0xdd, 0x25, 0x69, 0x41, 0x02, 0x71, 0x00, 0x57, 0x42, 0x42, 0xa2, 0x15,
+	0x6c, 0x50, 0x81, 0x5e, 0xac, 0x8d, 0x77, 0xb2, 0x1e, 0xc5, 0x9e, 0x71, 0x67, 0x76, 0x0d, 0x21,
+	0xe4, 0xc2, 0x05, 0x8e, 0x20, 0x4e, 0x1c, 0x91, 0x38, 0x70, 0xe4, 0xc0, 0x1f, 0xd1, 0x0b, 0x52,
+	0x05, 0x17, 0x4e, 0x08, 0x25, 0x48, 0x5c, 0xf8, 0x23, 0xd0, 0xce, 0xcc, 0x7a, 0x77, 0xb3, 0xeb,
+	0xb5, 0xcb, 0xc5, 0xf2, 0xbc, 0xfd, 0xbe, 0x37, 0x9f, 0xf7, 0x66, 0xf6, 0xbd, 0x05, 0xdb, 0x1e,
+	0xf1, 0x49, 0xe0, 0xf6, 0x8f, 0x48, 0xd0, 0xed, 0x61, 0x6a, 0x8f, 0xf8, 0xa1, 0x3d, 0xda, 0xb5,
+	0x1f, 0x87, 0x98, 0x1f, 0x5b, 0x43, 0xce, 0x02, 0x06, 0xaf, 0x67, 0x25, 0xd6, 0x88, 0x1f, 0x5a,
+	0xa3, 0x5d, 0x73, 0xcd, 0x1d, 0x10, 0xca, 0x6c, 0xf9, 0xab, 0x94, 0xe6, 0x66, 0x97, 0x89, 0x01,
+	0x13, 0xca, 0xfb, 0x42, 0x18, 0x73, 0x43, 0x3d, 0xec, 0xc8, 0x95, 0xad, 0x16, 0xfa, 0xd1, 0x4e,
+	0x31, 0x84, 0x8f, 0x29, 0x16, 0x24, 0x16, 0x6d, 0x15, 0x8b, 0x22, 0x1a, 0x25, 0xa8, 0xfa, 0xcc,
+	0x67, 0x2a, 0x7a, 0xf4, 0x4f, 0x5b, 0x9f, 0xf3, 0x19, 0xf3, 0xfb, 0xd8, 0x76, 0x87, 0xc4, 0x76,
+	0x29, 0x65, 0x81, 0x1b, 0x10, 0x46, 0x75, 0x50, 0x54, 0x05, 0xf0, 0xfd, 0x88, 0xf1, 0x3d, 0x97,
+	0xbb, 0x03, 0xe1, 0xe0, 0xc7, 0x21, 0x16, 0x01, 0x7a, 0x04, 0xfe, 0x9f, 0xb1, 0x8a, 0x21, 0xa3,
+	0x02, 0xc3, 0x7b, 0x60, 0x71, 0x28, 0x2d, 0x75, 0xa3, 0x69, 0xb4, 0x96, 0xf7, 0x9a, 0x56, 0x61,
+	0x65, 0xac, 0x87, 0xfc, 0x50, 0x79, 0xb6, 0x97, 0x9e, 0xfc, 0xb1, 0x35, 0xf7, 0xe3, 0xdf, 0x3f,
+	0xdd, 0x32, 0x1c, 0xed, 0x8a, 0x36, 0xc1, 0x86, 0x8a, 0x8d, 0xa9, 0x47, 0xa8, 0x9f, 0xdd, 0xb8,
+	0x0f, 0xcc, 0xa2, 0x87, 0x7a, 0xff, 0x07, 0x60, 0x75, 0xa8, 0x1e, 0x74, 0x32, 0x1c, 0x2f, 0x94,
+	0x70, 0x64, 0x02, 0xad, 0x0c, 0xd3, 0xcb, 0x71, 0xf2, 0x6d, 0xec, 0x76, 0x19, 0xbd, 0x98, 0x7c,
+	0x6c, 0x4d, 0x92, 0x3f, 0x90, 0x96, 0xe9, 0xc9, 0x2b, 0xcf, 0x4c, 0xf2, 0xca, 0x15, 0xbd, 0x0b,
+	0xd6, 0x65, 0x6c, 0xc7, 0xa5, 0x1e, 0x1b, 0x7c, 0xc8, 0xb8, 0x17, 0xa7, 0x0e, 0xab, 0xe0, 0x52,
+	0x97, 0x85, 0x34, 0x90, 0xe1, 0x57, 0x1c, 0xb5, 0x80, 0x9b, 0x60, 0x29, 0x14, 0x98, 0x77, 0x04,
+	0xc6, 0x5e, 0x7d, 0xbe, 0x69, 0xb4, 0xae, 0x3a, 0x57, 0x22, 0xc3, 0x3e, 0xc6, 0x1e, 0xc2, 0xa0,
+	0x9e, 0x8f, 0xa6, 0x71, 0xb7, 0xc0, 0xb2, 0xc7, 0x5d, 0xea, 0x75, 0x38, 0x0b, 0xa9, 0x27, 0x83,
+	0x2e, 0x38, 0x40, 0x9a, 0x9c, 0xc8, 0x02, 0x21, 0x58, 0x48, 0x05, 0x95, 0xff, 0x23, 0x86, 0x4f,
+	0xa2, 0x28, 0xf5, 0x4a, 0xb3, 0xd2, 0xba, 0xea, 0xa8, 0x05, 0xba, 0x13, 0x9f, 0x98, 0xcb, 0x03,
+	0xd2, 0x25, 0x43, 0x79, 0x81, 0x62, 0xec, 0x1a, 0x58, 0xec, 0x61, 0xe2, 0xf7, 0x14, 0x77, 0xc5,
+	0xd1, 0x2b, 0x34, 0x8a, 0x4f, 0x32, 0xeb, 0xa4, 0xe9, 0x3e, 0x02, 0x2b, 0xc3, 0xf4, 0x83, 0x19,
+	0x0e, 0x32, 0x2d, 0x4f, 0x97, 0x36, 0x1b, 0x08, 0x05, 0x00, 0xc9, 0x7d, 0x1f, 0xba, 0x7d, 0xe2,
+	0xb9, 0x01, 0xe3, 0x85, 0xd4, 0x0f, 0xc0, 0x5a, 0x37, 0x02, 0xa1, 0x22, 0x14, 0x1d, 0xd7, 0xf3,
+	0x38, 0x16, 0xea, 0x32, 0x2d, 0xb5, 0xb7, 0x7f, 0xfd, 0xf9, 0xf6, 0x0d, 0xfd, 0x76, 0xde, 0x8b,
+	0x35, 0x6f, 0x29, 0xc9, 0x7e, 0xc0, 0x09, 0xf5, 0x9d, 0x6b, 0xdd, 0x0b, 0x76, 0xf4, 0x19, 0xd8,
+	0x29, 0xdd, 0x55, 0xa7, 0xbd, 0x0f, 0x2e, 0x8b, 0x70, 0x30, 0x70, 0xf9, 0xb1, 0x4e, 0xd8, 0x9a,
+	0x31, 0xe1, 0x7d, 0xe5, 0x95, 0xce, 0x3b, 0x8e, 0x84, 0x6c, 0x70, 0x23, 0x75, 0x0b, 0x28, 0x16,
+	0xf1, 0x95, 0x8a, 0x93, 0x5d, 0x05, 0xf3, 0x24, 0xbe, 0x01, 0xf3, 0xc4, 0x43, 0x0c, 0x34, 0x26,
+	0x39, 0x68, 0xce, 0xfb, 0xe0, 0x32, 0x57, 0x26, 0xcd, 0xd9, 0x9a, 0xc0, 0x99, 0x0b, 0x91, 0x21,
+	0xd4, 0x31, 0x50, 0x0b, 0xd4, 0xe4, 0x86, 0x1f, 0x90, 0x01, 0xee, 0xb3, 0xee, 0x11, 0xf6, 0x26,
+	0xa1, 0xf5, 0xf4, 0xfb, 0x91, 0x56, 0x26, 0x4c, 0x03, 0x2c, 0x84, 0xeb, 0xe3, 0x29, 0x4c, 0x89,
+	0xef, 0x7d, 0xa5, 0xcf, 0x30, 0xe9, 0x18, 0xc8, 0xd2, 0x4c, 0xef, 0xd0, 0x91, 0xcb, 0x89, 0x4b,
+	0x83, 0xf4, 0x8b, 0xc8, 0x59, 0x18, 0xa8, 0x6d, 0x96, 0x1c, 0xb5, 0x40, 0xae, 0x26, 0x4b, 0xeb,
+	0x35, 0xd9, 0xdb, 0x51, 0xb5, 0x44, 0xd8, 0x0f, 0xa2, 0x2b, 0x54, 0x69, 0x2d, 0xef, 0xdd, 0x9c,
+	0x40, 0x36, 0xf6, 0x75, 0xa4, 0xbc, 0xbd, 0x10, 0x71, 0x39, 0xb1, 0x33, 0xfa, 0x18, 0xfc, 0xef,
+	0x82, 0xa2, 0x98, 0x25, 0x7a, 0xe7, 0x0e, 0x38, 0x3b, 0xc2, 0x54, 0xbe, 0xbc, 0x57, 0x1c, 0xbd,
+	0x82, 0xf5, 0xa4, 0x44, 0x15, 0xa9, 0x8f, 0x97, 0x7b, 0xff, 0x00, 0x70, 0x49, 0xe2, 0xc3, 0xcf,
+	0xc1, 0xa2, 0xea, 0x7e, 0xf0, 0xc5, 0x09, 0x94, 0xf9, 0x79, 0x60, 0xde, 0x9a, 0x45, 0xaa, 0xaa,
+	0x81, 0x36, 0xbf, 0x8a, 0x0a, 0xfd, 0xc5, 0x6f, 0x7f, 0x7d, 0x3b, 0x7f, 0x0d, 0xae, 0xc6, 0x53,
+	0x4a, 0xf5, 0x6b, 0xf8, 0x9d, 0x01, 0x56, 0x32, 0x2d, 0x19, 0xbe, 0x5c, 0x1a, 0xba, 0x60, 0x46,
+	0x98, 0xbb, 0xcf, 0xe0, 0xa1, 0x99, 0x76, 0x12, 0xa6, 0x3a, 0xac, 0x65, 0x99, 0x6c, 0x3d, 0x13,
+	0xa2, 0xca, 0xa8, 0xc6, 0x5d, 0x5e, 0x99, 0xcc, 0xb0, 0x28, 0xaf, 0x4c, 0x76, 0x82, 0x4c, 0xa8,
+	0x8c, 0x9a, 0x0c, 0xf0, 0x1b, 0x03, 0x2c, 0xa7, 0xfa, 0x38, 0xb4, 0xca, 0x02, 0xe7, 0xc7, 0x87,
+	0x69, 0xcf, 0xac, 0xd7, 0x34, 0xdb, 0x09, 0x4d, 0x0d, 0x56, 0x63, 0x1a, 0x2e, 0x95, 0x1d, 0xd9,
+	0xf8, 0xe1, 0xf7, 0xd1, 0x69, 0xa5, 0xdb, 0xd0, 0x94, 0xd3, 0x2a, 0xe8, 0xb4, 0x53, 0x4e, 0xab,
+	0xa8, 0x4b, 0xa2, 0x97, 0x12, 0xb2, 0x26, 0x6c, 0xa4, 0x4e, 0x2b, 0xd1, 0xda, 0x27, 0x6a, 0xcc,
+	0x9c, 0xc2, 0x5f, 0x0c, 0x50, 0x2b, 0xee, 0xba, 0xf0, 0xb5, 0xb2, 0xad, 0x4b, 0xe7, 0x83, 0xf9,
+	0xfa, 0x7f, 0x71, 0xd5, 0xf8, 0x6f, 0x48, 0xf2, 0x57, 0xe1, 0xdd, 0x62, 0xf2, 0x51, 0xec, 0x2d,
+	0xec, 0x93, 0xdc, 0x0c, 0x3a, 0x85, 0x3f, 0x18, 0x60, 0x2d, 0xd7, 0x55, 0xe1, 0xdd, 0xe9, 0xa7,
+	0x9b, 0x6f, 0xfc, 0xe6, 0x2b, 0xcf, 0xe8, 0xa5, 0x13, 0x40, 0x49, 0xfd, 0xd7, 0xe1, 0xf5, 0xf1,
+	0xcd, 0x50, 0x2a, 0x61, 0x9f, 0x10, 0xef, 0x34, 0xba, 0xae, 0x20, 0x69, 0xb4, 0xf0, 0x76, 0xd9,
+	0x4e, 0xb9, 0xb6, 0x6f, 0x5a, 0xb3, 0xca, 0x35, 0xd1, 0xf3, 0x09, 0xd1, 0x06, 0x5c, 0x8f, 0x89,
+	0x82, 0xb1, 0x50, 0x31, 0x7d, 0x69, 0x00, 0x90, 0xb4, 0xe7, 0x72, 0xa6, 0x5c, 0xdb, 0x2f, 0x67,
+	0xca, 0x77, 0x7d, 0x64, 0x4a, 0x9c, 0x2a, 0x84, 0x31, 0x0e, 0x19, 0x6b, 0xda, 0x6f, 0x3e, 0x39,
+	0x6b, 0x18, 0x4f, 0xcf, 0x1a, 0xc6, 0x9f, 0x67, 0x0d, 0xe3, 0xeb, 0xf3, 0xc6, 0xdc, 0xd3, 0xf3,
+	0xc6, 0xdc, 0xef, 0xe7, 0x8d, 0xb9, 0x47, 0x37, 0x7d, 0x12, 0xf4, 0xc2, 0x03, 0xab, 0xcb, 0x06,
+	0xb6, 0xe7, 0x07, 0x99, 0x8f, 0xf9, 0x4f, 0xe5, 0x6f, 0x70, 0x3c, 0xc4, 0xe2, 0x60, 0x51, 0x7e,
+	0x9e, 0xdf, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x53, 0x6a, 0x92, 0x9f, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the on-chain VRF parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParams returns the scheduled params change, if any.
+	PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error)
+	// Beacon returns the latest VrfBeacon for the current or specified height
+	Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error)
+	// RandomWords expands the current block's VRF seed into N random words
+	RandomWords(ctx context.Context, in *QueryRandomWordsRequest, opts ...grpc.CallOption) (*QueryRandomWordsResponse, error)
+	// Participation returns the vote extension participation record for a height
+	Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error)
+	// ValidatorParticipation summarizes a validator's participation over the
+	// retained participation records
+	ValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error)
+	// RandomnessRequest returns a paid randomness request by id
+	RandomnessRequest(ctx context.Context, in *QueryRandomnessRequestRequest, opts ...grpc.CallOption) (*QueryRandomnessRequestResponse, error)
+	// Timelocked returns a time-locked ciphertext and its plaintext, if decrypted
+	Timelocked(ctx context.Context, in *QueryTimelockedRequest, opts ...grpc.CallOption) (*QueryTimelockedResponse, error)
+	// Invariants checks the module invariants against the current state
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	return out, nil
+}
+
+func (c *queryClient) PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error) {
+	out := new(QueryPendingParamsResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/PendingParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.UserSeed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Beacon(ctx context.Context, in *QueryBeaconRequest, opts ...grpc.CallOption) (*QueryBeaconResponse, error) {
+	out := new(QueryBeaconResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Beacon", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRandomWordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RandomWords(ctx context.Context, in *QueryRandomWordsRequest, opts ...grpc.CallOption) (*QueryRandomWordsResponse, error) {
+	out := new(QueryRandomWordsResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/RandomWords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DrandRound != 0 {
-		n += 1 + sovQuery(uint64(m.DrandRound))
+	return out, nil
+}
+
+func (c *queryClient) Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error) {
+	out := new(QueryParticipationResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Participation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ValidatorParticipation(ctx context.Context, in *QueryValidatorParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorParticipationResponse, error) {
+	out := new(QueryValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/ValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Words) > 0 {
-		for _, b := range m.Words {
-			l = len(b)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) RandomnessRequest(ctx context.Context, in *QueryRandomnessRequestRequest, opts ...grpc.CallOption) (*QueryRandomnessRequestResponse, error) {
+	out := new(QueryRandomnessRequestResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/RandomnessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Timelocked(ctx context.Context, in *QueryTimelockedRequest, opts ...grpc.CallOption) (*QueryTimelockedResponse, error) {
+	out := new(QueryTimelockedResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Timelocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the on-chain VRF parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParams returns the scheduled params change, if any.
+	PendingParams(context.Context, *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error)
+	// Beacon returns the latest VrfBeacon for the current or specified height
+	Beacon(context.Context, *QueryBeaconRequest) (*QueryBeaconResponse, error)
+	// RandomWords expands the current block's VRF seed into N random words
+	RandomWords(context.Context, *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error)
+	// Participation returns the vote extension participation record for a height
+	Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error)
+	// ValidatorParticipation summarizes a validator's participation over the
+	// retained participation records
+	ValidatorParticipation(context.Context, *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error)
+	// RandomnessRequest returns a paid randomness request by id
+	RandomnessRequest(context.Context, *QueryRandomnessRequestRequest) (*QueryRandomnessRequestResponse, error)
+	// Timelocked returns a time-locked ciphertext and its plaintext, if decrypted
+	Timelocked(context.Context, *QueryTimelockedRequest) (*QueryTimelockedResponse, error)
+	// Invariants checks the module invariants against the current state
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PendingParams(ctx context.Context, req *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParams not implemented")
+}
+func (*UnimplementedQueryServer) Beacon(ctx context.Context, req *QueryBeaconRequest) (*QueryBeaconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Beacon not implemented")
+}
+func (*UnimplementedQueryServer) RandomWords(ctx context.Context, req *QueryRandomWordsRequest) (*QueryRandomWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomWords not implemented")
+}
+func (*UnimplementedQueryServer) Participation(ctx context.Context, req *QueryParticipationRequest) (*QueryParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participation not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorParticipation(ctx context.Context, req *QueryValidatorParticipationRequest) (*QueryValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorParticipation not implemented")
+}
+func (*UnimplementedQueryServer) RandomnessRequest(ctx context.Context, req *QueryRandomnessRequestRequest) (*QueryRandomnessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomnessRequest not implemented")
+}
+func (*UnimplementedQueryServer) Timelocked(ctx context.Context, req *QueryTimelockedRequest) (*QueryTimelockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timelocked not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/PendingParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParams(ctx, req.(*QueryPendingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Beacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeaconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Beacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/Beacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Beacon(ctx, req.(*QueryBeaconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/RandomWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomWords(ctx, req.(*QueryRandomWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/Participation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participation(ctx, req.(*QueryParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/ValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorParticipation(ctx, req.(*QueryValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomnessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomnessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomnessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/RandomnessRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomnessRequest(ctx, req.(*QueryRandomnessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Timelocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Timelocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/Timelocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Timelocked(ctx, req.(*QueryTimelockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "digitalkitchen.vrf.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingParams",
+			Handler:    _Query_PendingParams_Handler,
+		},
+		{
+			MethodName: "Beacon",
+			Handler:    _Query_Beacon_Handler,
+		},
+		{
+			MethodName: "RandomWords",
+			Handler:    _Query_RandomWords_Handler,
+		},
+		{
+			MethodName: "Participation",
+			Handler:    _Query_Participation_Handler,
+		},
+		{
+			MethodName: "ValidatorParticipation",
+			Handler:    _Query_ValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "RandomnessRequest",
+			Handler:    _Query_RandomnessRequest_Handler,
+		},
+		{
+			MethodName: "Timelocked",
+			Handler:    _Query_Timelocked_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "digitalkitchen/vrf/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingParams != nil {
+		{
+			size, err := m.PendingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeaconRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeaconRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeaconRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeaconResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeaconResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeaconResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Beacon.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomWordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomWordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomWordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserSeed) > 0 {
+		i -= len(m.UserSeed)
+		copy(dAtA[i:], m.UserSeed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserSeed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomWordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomWordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomWordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Words) > 0 {
+		for iNdEx := len(m.Words) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Words[iNdEx])
+			copy(dAtA[i:], m.Words[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Words[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomnessRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomnessRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomnessRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomnessRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomnessRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomnessRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingParams != nil {
+		l = m.PendingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeaconRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBeaconResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Beacon.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRandomWordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = len(m.UserSeed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRandomWordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrandRound != 0 {
+		n += 1 + sovQuery(uint64(m.DrandRound))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Words) > 0 {
+		for _, b := range m.Words {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRandomnessRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRandomnessRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTimelockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTimelockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingParams == nil {
+				m.PendingParams = &VrfPendingParams{}
+			}
+			if err := m.PendingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeaconRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeaconRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeaconRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeaconResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeaconResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomWordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomWordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserSeed = append(m.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserSeed == nil {
+				m.UserSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomWordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomWordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Words = append(m.Words, make([]byte, postIndex-iNdEx))
+			copy(m.Words[len(m.Words)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryValidatorParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRandomnessRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,12 +3249,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRandomnessRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRandomnessRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -859,7 +3295,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRandomnessRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -882,15 +3318,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRandomnessRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRandomnessRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -917,7 +3353,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -942,7 +3378,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeaconRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimelockedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -965,12 +3401,31 @@ func (m *QueryBeaconRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeaconRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimelockedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeaconRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimelockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -992,7 +3447,7 @@ func (m *QueryBeaconRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimelockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1015,15 +3470,15 @@ func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeaconResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimelockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeaconResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimelockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1050,7 +3505,7 @@ func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Beacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1075,7 +3530,7 @@ func (m *QueryBeaconResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,17 +3553,17 @@ func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomWordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomWordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1118,16 +3573,79 @@ func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1137,24 +3655,24 @@ func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserSeed = append(m.UserSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserSeed == nil {
-				m.UserSeed = []byte{}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1178,7 +3696,7 @@ func (m *QueryRandomWordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,17 +3719,17 @@ func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomWordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomWordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			m.DrandRound = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1221,16 +3739,29 @@ func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DrandRound |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1240,31 +3771,17 @@ func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seed == nil {
-				m.Seed = []byte{}
-			}
-			iNdEx = postIndex
+			m.Broken = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1274,23 +3791,23 @@ func (m *QueryRandomWordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Words = append(m.Words, make([]byte, postIndex-iNdEx))
-			copy(m.Words[len(m.Words)-1], dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19,3 +19,35 @@ func RoundAt(params VrfParams, t time.Time) uint64 {
 
 	return uint64(dt/period) + 1
 }
+
+// RoundTime returns the time at which round is scheduled, the inverse of
+// RoundAt. Round 0 maps to the genesis time.
+func RoundTime(params VrfParams, round uint64) time.Time {
+	genesis := time.Unix(params.GenesisUnixSec, 0).UTC()
+	if round == 0 {
+		return genesis
+	}
+
+	return genesis.Add(time.Duration(round-1) * time.Duration(params.PeriodSeconds) * time.Second)
+}
+
+// EligibleRounds returns the drand rounds accepted for targetRound under
+// params.RoundTolerance, ordered from the highest round down. Round 0 is never
+// eligible.
+func EligibleRounds(params VrfParams, targetRound uint64) []uint64 {
+	if targetRound == 0 {
+		return nil
+	}
+
+	tolerance := params.RoundTolerance
+	if tolerance >= targetRound {
+		tolerance = targetRound - 1
+	}
+
+	rounds := make([]uint64, 0, tolerance+1)
+	for i := uint64(0); i <= tolerance; i++ {
+		rounds = append(rounds, targetRound-i)
+	}
+
+	return rounds
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/encrypt/ibe"
+	"github.com/drand/kyber/pairing"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var (
+	errTimelockCiphertextMalformed = errors.New("malformed timelock ciphertext")
+	errTimelockPayloadTooLong      = errors.New("timelock payload too long")
+)
+
+// timelockKeyLength is the size of the symmetric key carried by the IBE part
+// of a TimelockCiphertext.
+const timelockKeyLength = chacha20poly1305.KeySize
+
+// timelockSuite is the pairing used by the supported pedersen-bls schemes.
+func timelockSuite() pairing.Suite {
+	return bls.NewBLS12381SuiteWithDST(
+		[]byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"),
+		[]byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"),
+	)
+}
+
+// TimelockIdentity returns the IBE identity of round, which is the message an
+// unchained drand network signs for that round.
+func TimelockIdentity(round uint64) []byte {
+	return crypto.NewPedersenBLSUnchained().DigestBeacon(&common.Beacon{Round: round})
+}
+
+// Validate checks the sizes of the ciphertext components.
+func (c TimelockCiphertext) Validate() error {
+	if len(c.U) != timelockSuite().G1().PointLen() || len(c.V) != timelockKeyLength || len(c.W) != timelockKeyLength {
+		return errTimelockCiphertextMalformed
+	}
+
+	if len(c.Payload) < chacha20poly1305.Overhead {
+		return errTimelockCiphertextMalformed
+	}
+
+	if len(c.Payload)-chacha20poly1305.Overhead > MaxTimelockPayloadLength {
+		return fmt.Errorf("%w: got %d, max %d", errTimelockPayloadTooLong, len(c.Payload)-chacha20poly1305.Overhead, MaxTimelockPayloadLength)
+	}
+
+	return nil
+}
+
+// TimelockEncrypt encrypts plaintext so that it can only be decrypted with the
+// signature of round by the drand group holding publicKey.
+func TimelockEncrypt(publicKey []byte, round uint64, plaintext []byte) (TimelockCiphertext, error) {
+	if len(plaintext) > MaxTimelockPayloadLength {
+		return TimelockCiphertext{}, fmt.Errorf("%w: got %d, max %d", errTimelockPayloadTooLong, len(plaintext), MaxTimelockPayloadLength)
+	}
+
+	suite := timelockSuite()
+	master := suite.G1().Point()
+	if err := master.UnmarshalBinary(publicKey); err != nil {
+		return TimelockCiphertext{}, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	key := make([]byte, timelockKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return TimelockCiphertext{}, err
+	}
+
+	ct, err := ibe.EncryptCCAonG1(suite, master, TimelockIdentity(round), key)
+	if err != nil {
+		return TimelockCiphertext{}, err
+	}
+
+	u, err := ct.U.MarshalBinary()
+	if err != nil {
+		return TimelockCiphertext{}, err
+	}
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return TimelockCiphertext{}, err
+	}
+
+	return TimelockCiphertext{
+		U:       u,
+		V:       ct.V,
+		W:       ct.W,
+		Payload: aead.Seal(nil, make([]byte, aead.NonceSize()), plaintext, timelockAAD(round)),
+	}, nil
+}
+
+// TimelockDecrypt decrypts c, encrypted to round, with the round's beacon
+// signature.
+func TimelockDecrypt(signature []byte, round uint64, c TimelockCiphertext) ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	suite := timelockSuite()
+	private := suite.G2().Point()
+	if err := private.UnmarshalBinary(signature); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	u := suite.G1().Point()
+	if err := u.UnmarshalBinary(c.U); err != nil {
+		return nil, fmt.Errorf("%w: %w", errTimelockCiphertextMalformed, err)
+	}
+
+	key, err := ibe.DecryptCCAonG1(suite, private, &ibe.Ciphertext{U: u, V: c.V, W: c.W})
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, make([]byte, aead.NonceSize()), c.Payload, timelockAAD(round))
+}
+
+// timelockAAD binds the sealed payload to its round. Each ciphertext uses a
+// fresh key, so the fixed zero nonce is never reused under the same key.
+func timelockAAD(round uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, round)
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,8 +33,9 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// MsgVrfEmergencyDisable is a gasless, committee-only message that requests
-// emergency disabling of VRF for the chain. The effective state change
+// MsgVrfEmergencyDisable is a gasless message, restricted to committee members
+// with the emergency role, that requests emergency disabling of VRF for the
+// chain. The effective state change
 // (toggling VrfParams.enabled = false) is handled in PreBlock once an
 // authorized transaction including this message has been verified.
 type MsgVrfEmergencyDisable struct {
@@ -131,7 +134,8 @@ var xxx_messageInfo_MsgVrfEmergencyDisableResponse proto.InternalMessageInfo
 
 // MsgInitialDkg sets the initial drand chain-info required to bootstrap VRF.
 type MsgInitialDkg struct {
-	// initiator is the bech32 account address initiating initial DKG bootstrap.
+	// initiator is the bech32 account address initiating initial DKG bootstrap
+	// (must hold the dkg-bootstrap committee role).
 	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	// chain_hash is the drand chain hash identifier.
 	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
@@ -141,6 +145,17 @@ type MsgInitialDkg struct {
 	PeriodSeconds uint64 `protobuf:"varint,4,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
 	// genesis_unix_sec is the drand genesis time (UNIX seconds) for round 1.
 	GenesisUnixSec int64 `protobuf:"varint,5,opt,name=genesis_unix_sec,json=genesisUnixSec,proto3" json:"genesis_unix_sec,omitempty"`
+	// activation_height is the height from which the chain-info takes effect.
+	// Zero selects the earliest allowed height, two blocks after inclusion.
+	ActivationHeight int64 `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// genesis_seed is the drand group's genesis seed.
+	GenesisSeed []byte `protobuf:"bytes,7,opt,name=genesis_seed,json=genesisSeed,proto3" json:"genesis_seed,omitempty"`
+	// beacon_id is the drand beacon ID; empty selects the default beacon.
+	BeaconId string `protobuf:"bytes,8,opt,name=beacon_id,json=beaconId,proto3" json:"beacon_id,omitempty"`
+	// bootstrap_beacon is an optional recent beacon of the drand chain, proving
+	// that the group is producing beacons. It is verified against public_key
+	// and the scheme_id of the params.
+	BootstrapBeacon *VrfBeacon `protobuf:"bytes,9,opt,name=bootstrap_beacon,json=bootstrapBeacon,proto3" json:"bootstrap_beacon,omitempty"`
 }
 
 func (m *MsgInitialDkg) Reset()         { *m = MsgInitialDkg{} }
@@ -221,6 +236,9 @@ type MsgUpdateParams struct {
 	//
 	// NOTE: All parameters must be supplied.
 	Params VrfParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// activation_height is the height from which params take effect. Zero
+	// selects the earliest allowed height, two blocks after inclusion.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
@@ -301,6 +319,9 @@ type MsgAddVrfCommitteeMember struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// label is free-form metadata for auditing.
 	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// roles are the permissions granted to the member. They replace the roles
+	// of an existing member.
+	Roles []VrfCommitteeRoleGrant `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles"`
 }
 
 func (m *MsgAddVrfCommitteeMember) Reset()         { *m = MsgAddVrfCommitteeMember{} }
@@ -458,6 +479,12 @@ type MsgRegisterVrfIdentity struct {
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
 	// drand_bls_public_key is the validator's drand BLS public key/share.
 	DrandBlsPublicKey []byte `protobuf:"bytes,2,opt,name=drand_bls_public_key,json=drandBlsPublicKey,proto3" json:"drand_bls_public_key,omitempty"`
+	// share_index is the validator's index in the drand group.
+	ShareIndex uint32 `protobuf:"varint,3,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
+	// drand_bls_proof is a signature by the private key of drand_bls_public_key
+	// over the chain id, the validator operator address and share_index. It
+	// proves that the operator holds the key it registers.
+	DrandBlsProof []byte `protobuf:"bytes,4,opt,name=drand_bls_proof,json=drandBlsProof,proto3" json:"drand_bls_proof,omitempty"`
 }
 
 func (m *MsgRegisterVrfIdentity) Reset()         { *m = MsgRegisterVrfIdentity{} }
@@ -531,10 +558,98 @@ func (m *MsgRegisterVrfIdentityResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterVrfIdentityResponse proto.InternalMessageInfo
 
+// MsgRegisterEcvrfKey registers the ed25519 public key that verifies the
+// ECVRF fallback proofs of the operator's validator, replacing any earlier
+// one.
+type MsgRegisterEcvrfKey struct {
+	// operator is the bech32 account address controlling the validator.
+	// The corresponding validator operator address is derived from this value.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// ecvrf_public_key is the ed25519 public key of the validator's ECVRF key.
+	// It must not be the validator's consensus key.
+	EcvrfPublicKey []byte `protobuf:"bytes,2,opt,name=ecvrf_public_key,json=ecvrfPublicKey,proto3" json:"ecvrf_public_key,omitempty"`
+	// ecvrf_proof is an ECVRF proof under ecvrf_public_key over the chain id
+	// and the validator operator address. It proves that the operator holds
+	// the key it registers.
+	EcvrfProof []byte `protobuf:"bytes,3,opt,name=ecvrf_proof,json=ecvrfProof,proto3" json:"ecvrf_proof,omitempty"`
+}
+
+func (m *MsgRegisterEcvrfKey) Reset()         { *m = MsgRegisterEcvrfKey{} }
+func (m *MsgRegisterEcvrfKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterEcvrfKey) ProtoMessage()    {}
+func (*MsgRegisterEcvrfKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{12}
+}
+func (m *MsgRegisterEcvrfKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterEcvrfKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterEcvrfKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterEcvrfKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterEcvrfKey.Merge(m, src)
+}
+func (m *MsgRegisterEcvrfKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterEcvrfKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterEcvrfKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterEcvrfKey proto.InternalMessageInfo
+
+// MsgRegisterEcvrfKeyResponse is returned on successful delivery of
+// MsgRegisterEcvrfKey.
+type MsgRegisterEcvrfKeyResponse struct {
+}
+
+func (m *MsgRegisterEcvrfKeyResponse) Reset()         { *m = MsgRegisterEcvrfKeyResponse{} }
+func (m *MsgRegisterEcvrfKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterEcvrfKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterEcvrfKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{13}
+}
+func (m *MsgRegisterEcvrfKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterEcvrfKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterEcvrfKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterEcvrfKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterEcvrfKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterEcvrfKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterEcvrfKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterEcvrfKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterEcvrfKeyResponse proto.InternalMessageInfo
+
 // MsgScheduleVrfReshare increments VrfParams.reshare_epoch to signal a new
 // resharing round.
 type MsgScheduleVrfReshare struct {
-	// scheduler is the account requesting the reshare (must be a committee member).
+	// scheduler is the account requesting the reshare (must hold the
+	// reshare-scheduler committee role).
 	Scheduler string `protobuf:"bytes,1,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
 	// reshare_epoch is the new reshare epoch value (must be strictly greater than the current).
 	ReshareEpoch uint64 `protobuf:"varint,2,opt,name=reshare_epoch,json=reshareEpoch,proto3" json:"reshare_epoch,omitempty"`
@@ -546,7 +661,7 @@ func (m *MsgScheduleVrfReshare) Reset()         { *m = MsgScheduleVrfReshare{} }
 func (m *MsgScheduleVrfReshare) String() string { return proto.CompactTextString(m) }
 func (*MsgScheduleVrfReshare) ProtoMessage()    {}
 func (*MsgScheduleVrfReshare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a678cd2e95c8cef8, []int{12}
+	return fileDescriptor_a678cd2e95c8cef8, []int{14}
 }
 func (m *MsgScheduleVrfReshare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +699,7 @@ func (m *MsgScheduleVrfReshareResponse) Reset()         { *m = MsgScheduleVrfRes
 func (m *MsgScheduleVrfReshareResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgScheduleVrfReshareResponse) ProtoMessage()    {}
 func (*MsgScheduleVrfReshareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a678cd2e95c8cef8, []int{13}
+	return fileDescriptor_a678cd2e95c8cef8, []int{15}
 }
 func (m *MsgScheduleVrfReshareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +728,205 @@ func (m *MsgScheduleVrfReshareResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgScheduleVrfReshareResponse proto.InternalMessageInfo
 
+// MsgRequestRandomness pays for random words derived from the first beacon
+// finalized at or above a drand round that was not yet published.
+type MsgRequestRandomness struct {
+	// requester is the account paying the request fee.
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	// num_words is the number of 32-byte words to derive.
+	NumWords uint32 `protobuf:"varint,2,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// user_seed is mixed into the word derivation.
+	UserSeed []byte `protobuf:"bytes,3,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+	// max_fee bounds the fee charged under the current fee schedule. Empty
+	// accepts any fee.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+}
+
+func (m *MsgRequestRandomness) Reset()         { *m = MsgRequestRandomness{} }
+func (m *MsgRequestRandomness) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRandomness) ProtoMessage()    {}
+func (*MsgRequestRandomness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{16}
+}
+func (m *MsgRequestRandomness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRandomness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRandomness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestRandomness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRandomness.Merge(m, src)
+}
+func (m *MsgRequestRandomness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRandomness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRandomness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRandomness proto.InternalMessageInfo
+
+// MsgRequestRandomnessResponse is returned on successful delivery of
+// MsgRequestRandomness.
+type MsgRequestRandomnessResponse struct {
+	RequestId     uint64                                   `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinDrandRound uint64                                   `protobuf:"varint,2,opt,name=min_drand_round,json=minDrandRound,proto3" json:"min_drand_round,omitempty"`
+	Fee           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *MsgRequestRandomnessResponse) Reset()         { *m = MsgRequestRandomnessResponse{} }
+func (m *MsgRequestRandomnessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestRandomnessResponse) ProtoMessage()    {}
+func (*MsgRequestRandomnessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{17}
+}
+func (m *MsgRequestRandomnessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestRandomnessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestRandomnessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestRandomnessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestRandomnessResponse.Merge(m, src)
+}
+func (m *MsgRequestRandomnessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestRandomnessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestRandomnessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestRandomnessResponse proto.InternalMessageInfo
+
+func (m *MsgRequestRandomnessResponse) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *MsgRequestRandomnessResponse) GetMinDrandRound() uint64 {
+	if m != nil {
+		return m.MinDrandRound
+	}
+	return 0
+}
+
+func (m *MsgRequestRandomnessResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// MsgSubmitTimelocked submits a ciphertext that can only be decrypted with the
+// signature of drand_round.
+type MsgSubmitTimelocked struct {
+	// submitter is the account submitting the ciphertext.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// drand_round is the round the ciphertext is encrypted to. It must not have
+	// been published at the block time of submission.
+	DrandRound uint64 `protobuf:"varint,2,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// ciphertext is the time-lock encrypted payload.
+	Ciphertext TimelockCiphertext `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext"`
+}
+
+func (m *MsgSubmitTimelocked) Reset()         { *m = MsgSubmitTimelocked{} }
+func (m *MsgSubmitTimelocked) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTimelocked) ProtoMessage()    {}
+func (*MsgSubmitTimelocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{18}
+}
+func (m *MsgSubmitTimelocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTimelocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTimelocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitTimelocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTimelocked.Merge(m, src)
+}
+func (m *MsgSubmitTimelocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTimelocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTimelocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitTimelocked proto.InternalMessageInfo
+
+// MsgSubmitTimelockedResponse returns the id of the stored ciphertext.
+type MsgSubmitTimelockedResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgSubmitTimelockedResponse) Reset()         { *m = MsgSubmitTimelockedResponse{} }
+func (m *MsgSubmitTimelockedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTimelockedResponse) ProtoMessage()    {}
+func (*MsgSubmitTimelockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a678cd2e95c8cef8, []int{19}
+}
+func (m *MsgSubmitTimelockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTimelockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTimelockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitTimelockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTimelockedResponse.Merge(m, src)
+}
+func (m *MsgSubmitTimelockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTimelockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTimelockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitTimelockedResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitTimelockedResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgVrfEmergencyDisable)(nil), "digitalkitchen.vrf.v1.MsgVrfEmergencyDisable")
 	proto.RegisterType((*MsgVrfEmergencyDisableResponse)(nil), "digitalkitchen.vrf.v1.MsgVrfEmergencyDisableResponse")
@@ -626,72 +940,114 @@ func init() {
 	proto.RegisterType((*MsgRemoveVrfCommitteeMemberResponse)(nil), "digitalkitchen.vrf.v1.MsgRemoveVrfCommitteeMemberResponse")
 	proto.RegisterType((*MsgRegisterVrfIdentity)(nil), "digitalkitchen.vrf.v1.MsgRegisterVrfIdentity")
 	proto.RegisterType((*MsgRegisterVrfIdentityResponse)(nil), "digitalkitchen.vrf.v1.MsgRegisterVrfIdentityResponse")
+	proto.RegisterType((*MsgRegisterEcvrfKey)(nil), "digitalkitchen.vrf.v1.MsgRegisterEcvrfKey")
+	proto.RegisterType((*MsgRegisterEcvrfKeyResponse)(nil), "digitalkitchen.vrf.v1.MsgRegisterEcvrfKeyResponse")
 	proto.RegisterType((*MsgScheduleVrfReshare)(nil), "digitalkitchen.vrf.v1.MsgScheduleVrfReshare")
 	proto.RegisterType((*MsgScheduleVrfReshareResponse)(nil), "digitalkitchen.vrf.v1.MsgScheduleVrfReshareResponse")
+	proto.RegisterType((*MsgRequestRandomness)(nil), "digitalkitchen.vrf.v1.MsgRequestRandomness")
+	proto.RegisterType((*MsgRequestRandomnessResponse)(nil), "digitalkitchen.vrf.v1.MsgRequestRandomnessResponse")
+	proto.RegisterType((*MsgSubmitTimelocked)(nil), "digitalkitchen.vrf.v1.MsgSubmitTimelocked")
+	proto.RegisterType((*MsgSubmitTimelockedResponse)(nil), "digitalkitchen.vrf.v1.MsgSubmitTimelockedResponse")
 }
 
 func init() { proto.RegisterFile("digitalkitchen/vrf/v1/tx.proto", fileDescriptor_a678cd2e95c8cef8) }
 
 var fileDescriptor_a678cd2e95c8cef8 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6b, 0xdc, 0x46,
-	0x14, 0x5e, 0xc5, 0x3f, 0x5a, 0xbf, 0xda, 0x69, 0xa3, 0xd8, 0xf1, 0x5a, 0x25, 0xbb, 0x8b, 0x5c,
-	0x07, 0x63, 0xea, 0x15, 0xd9, 0xb8, 0x2d, 0x2c, 0xb4, 0x24, 0x6e, 0x02, 0x0d, 0x65, 0x21, 0xc8,
-	0xc4, 0x87, 0x5e, 0x54, 0xad, 0x34, 0x3b, 0x1a, 0x2c, 0x69, 0xc4, 0xcc, 0xec, 0xb2, 0x4b, 0x2e,
-	0x6d, 0x4f, 0xc5, 0xa7, 0xfe, 0x03, 0x85, 0xfc, 0x09, 0x3e, 0xf4, 0xd6, 0x43, 0xaf, 0x86, 0x5e,
-	0x42, 0x4f, 0x85, 0x40, 0x29, 0xf6, 0xc1, 0xfd, 0x33, 0x8a, 0x34, 0x5a, 0xed, 0xaa, 0x96, 0xb6,
-	0x76, 0x2e, 0xb9, 0x2c, 0x3b, 0xef, 0x7d, 0xef, 0xcd, 0xf7, 0x3d, 0xcd, 0x7c, 0x12, 0xd4, 0x5c,
-	0x82, 0x89, 0xb0, 0xfd, 0x23, 0x22, 0x1c, 0x0f, 0x85, 0xc6, 0x80, 0xf5, 0x8c, 0xc1, 0x7d, 0x43,
-	0x0c, 0x9b, 0x11, 0xa3, 0x82, 0xaa, 0x6b, 0xf9, 0x7c, 0x73, 0xc0, 0x7a, 0xcd, 0xc1, 0x7d, 0xed,
-	0x96, 0x1d, 0x90, 0x90, 0x1a, 0xc9, 0xaf, 0x44, 0x6a, 0xeb, 0x0e, 0xe5, 0x01, 0xe5, 0x46, 0xc0,
-	0x71, 0xdc, 0x21, 0xe0, 0x38, 0x4d, 0x6c, 0xc8, 0x84, 0x95, 0xac, 0x0c, 0xb9, 0x48, 0x53, 0x9b,
-	0xc5, 0xbb, 0x63, 0x14, 0x22, 0x4e, 0xc6, 0xa0, 0x55, 0x4c, 0x31, 0x95, 0xc5, 0xf1, 0x3f, 0x19,
-	0xd5, 0x7f, 0x56, 0xe0, 0x4e, 0x87, 0xe3, 0x43, 0xd6, 0x7b, 0x12, 0x20, 0x86, 0x51, 0xe8, 0x8c,
-	0x1e, 0x13, 0x6e, 0x77, 0x7d, 0xa4, 0x7e, 0x0a, 0x4b, 0x76, 0x5f, 0x78, 0x94, 0x11, 0x31, 0xaa,
-	0x2a, 0x0d, 0x65, 0x7b, 0x69, 0xbf, 0xfa, 0xc7, 0x2f, 0xbb, 0xab, 0xe9, 0xd6, 0x8f, 0x5c, 0x97,
-	0x21, 0xce, 0x0f, 0x04, 0x23, 0x21, 0x36, 0x27, 0x50, 0xf5, 0x0e, 0x2c, 0x32, 0x64, 0x73, 0x1a,
-	0x56, 0x6f, 0xc4, 0x45, 0x66, 0xba, 0x6a, 0x3f, 0xf8, 0xe1, 0xe2, 0x64, 0x67, 0x82, 0x3b, 0xbe,
-	0x38, 0xd9, 0x69, 0xc4, 0x4c, 0x87, 0x09, 0xdf, 0x62, 0x12, 0x7a, 0x03, 0x6a, 0xc5, 0x19, 0x13,
-	0xf1, 0x88, 0x86, 0x1c, 0xe9, 0xc7, 0x37, 0x60, 0xa5, 0xc3, 0xf1, 0xd3, 0x90, 0x08, 0x62, 0xfb,
-	0x8f, 0x8f, 0x70, 0x4c, 0x9c, 0x24, 0x2b, 0x41, 0xd9, 0xff, 0x13, 0xcf, 0xa0, 0xea, 0x5d, 0x00,
-	0xc7, 0xb3, 0x49, 0x68, 0x79, 0x36, 0xf7, 0x12, 0xf2, 0xcb, 0xe6, 0x52, 0x12, 0xf9, 0xca, 0xe6,
-	0x5e, 0x9c, 0x8e, 0xfa, 0x5d, 0x9f, 0x38, 0xd6, 0x11, 0x1a, 0x55, 0xe7, 0x64, 0x5a, 0x46, 0xbe,
-	0x46, 0x23, 0x75, 0x0b, 0x6e, 0x46, 0x88, 0x11, 0xea, 0x5a, 0x1c, 0x39, 0x34, 0x74, 0x79, 0x75,
-	0xbe, 0xa1, 0x6c, 0xcf, 0x9b, 0x2b, 0x32, 0x7a, 0x20, 0x83, 0xea, 0x36, 0x7c, 0x90, 0x3e, 0x17,
-	0xab, 0x1f, 0x92, 0x61, 0x0c, 0xae, 0x2e, 0x34, 0x94, 0xed, 0x39, 0xf3, 0x66, 0x1a, 0x7f, 0x1e,
-	0x92, 0xe1, 0x01, 0x72, 0xda, 0xad, 0x1f, 0x5f, 0xd6, 0x2b, 0xff, 0xbc, 0xac, 0x57, 0x92, 0xb9,
-	0x65, 0x34, 0xe3, 0xb9, 0xad, 0xe7, 0xe6, 0x36, 0x91, 0xae, 0xaf, 0xc3, 0x5a, 0x2e, 0x90, 0x4d,
-	0xe9, 0x37, 0x05, 0xde, 0xef, 0x70, 0xfc, 0x3c, 0x72, 0x6d, 0x81, 0x9e, 0xd9, 0xcc, 0x0e, 0xf8,
-	0x1b, 0x3f, 0xe0, 0x2f, 0x60, 0x31, 0x4a, 0x3a, 0x24, 0x33, 0x7a, 0xaf, 0xd5, 0x68, 0x16, 0x9e,
-	0xee, 0xe6, 0x21, 0xeb, 0xc9, 0x9d, 0xf6, 0xe7, 0x4f, 0xff, 0xaa, 0x57, 0xcc, 0xb4, 0xaa, 0xbd,
-	0x97, 0x13, 0x96, 0x3b, 0x10, 0x1b, 0x39, 0x61, 0xd3, 0x6c, 0xf5, 0x0d, 0x58, 0xff, 0x4f, 0x28,
-	0x13, 0xf7, 0x5a, 0x81, 0x6a, 0x87, 0xe3, 0x47, 0xae, 0x7b, 0xc8, 0x7a, 0x5f, 0xd2, 0x20, 0x20,
-	0x42, 0x20, 0xd4, 0x41, 0x41, 0x17, 0xb1, 0x37, 0x56, 0xd9, 0x82, 0x77, 0x6c, 0x99, 0x93, 0xe7,
-	0x78, 0x46, 0xd5, 0x18, 0xa8, 0xae, 0xc2, 0x82, 0x6f, 0x77, 0x91, 0x9f, 0x9c, 0x8e, 0x25, 0x53,
-	0x2e, 0xda, 0x9f, 0x97, 0xeb, 0xd5, 0x73, 0x7a, 0x0b, 0x05, 0xe8, 0x3a, 0x34, 0xca, 0x72, 0xd9,
-	0x04, 0x7e, 0x57, 0xe0, 0xc3, 0x0e, 0xc7, 0x26, 0x0a, 0xe8, 0x00, 0xbd, 0xdd, 0x21, 0xb4, 0x1f,
-	0x96, 0xcb, 0xdd, 0xca, 0xc9, 0x2d, 0x63, 0xab, 0x6f, 0xc1, 0xe6, 0x8c, 0x74, 0x26, 0xfa, 0x57,
-	0xe9, 0x5d, 0x26, 0xc2, 0x84, 0x0b, 0xc4, 0x0e, 0x59, 0xef, 0xa9, 0x8b, 0x42, 0x11, 0xf3, 0xde,
-	0x83, 0x77, 0x69, 0x84, 0xd8, 0x95, 0x1c, 0x20, 0x43, 0xaa, 0x06, 0xac, 0xba, 0xcc, 0x0e, 0x5d,
-	0xab, 0xeb, 0x73, 0x6b, 0xea, 0xae, 0x4b, 0x2b, 0xb8, 0x95, 0xe4, 0xf6, 0x7d, 0xfe, 0x6c, 0x7c,
-	0xe7, 0xdb, 0xed, 0x69, 0xa9, 0x59, 0x9f, 0xcb, 0xce, 0x56, 0x40, 0x31, 0x75, 0xb6, 0x82, 0x4c,
-	0xa6, 0xef, 0x54, 0x49, 0x6e, 0xf3, 0x81, 0xe3, 0x21, 0xb7, 0xef, 0xc7, 0x93, 0x30, 0x11, 0xf7,
-	0x6c, 0x96, 0x58, 0x33, 0x4f, 0xa3, 0x57, 0x70, 0xb8, 0x0c, 0xaa, 0x6e, 0xc2, 0x0a, 0x93, 0x2d,
-	0x2c, 0x14, 0x51, 0x47, 0x9a, 0xdc, 0xbc, 0xb9, 0x9c, 0x06, 0x9f, 0xc4, 0xb1, 0x29, 0xff, 0x9e,
-	0xcb, 0xf9, 0x77, 0x4e, 0xec, 0xa4, 0x69, 0xac, 0xb6, 0x9e, 0x53, 0x7b, 0x99, 0xb0, 0x5e, 0x87,
-	0xbb, 0x85, 0x89, 0xb1, 0xd6, 0xd6, 0xeb, 0x45, 0x98, 0xeb, 0x70, 0xac, 0xbe, 0x80, 0xdb, 0x45,
-	0xef, 0xa2, 0xdd, 0x12, 0x8b, 0x29, 0x7e, 0x37, 0x68, 0x9f, 0x5c, 0x0b, 0x3e, 0x26, 0xa1, 0x7e,
-	0x0b, 0x30, 0xf5, 0x1a, 0xf9, 0xa8, 0xbc, 0xc9, 0x04, 0xa5, 0x7d, 0x7c, 0x15, 0x54, 0xb6, 0x43,
-	0x0f, 0x96, 0x73, 0x16, 0x7c, 0xaf, 0xbc, 0x7a, 0x1a, 0xa7, 0x35, 0xaf, 0x86, 0xcb, 0xf6, 0xf9,
-	0x5e, 0x81, 0xb5, 0x62, 0x3b, 0x34, 0xca, 0x3b, 0x15, 0x16, 0x68, 0x9f, 0x5d, 0xb3, 0x20, 0xe3,
-	0x70, 0xac, 0x40, 0xb5, 0xd4, 0x90, 0x5a, 0xe5, 0x5d, 0xcb, 0x6a, 0xb4, 0xf6, 0xf5, 0x6b, 0x32,
-	0x32, 0x2f, 0xe0, 0x76, 0x91, 0x4f, 0xec, 0xce, 0x6a, 0x79, 0x09, 0x3e, 0xeb, 0x5c, 0xcd, 0xb8,
-	0xc8, 0xea, 0x10, 0xd4, 0x82, 0x4b, 0x3c, 0xe3, 0xe4, 0x5c, 0x46, 0x6b, 0x7b, 0xd7, 0x41, 0x8f,
-	0x77, 0xd6, 0x16, 0xbe, 0xbb, 0x38, 0xd9, 0x51, 0xf6, 0x1f, 0x9e, 0x9e, 0xd5, 0x94, 0x57, 0x67,
-	0x35, 0xe5, 0xef, 0xb3, 0x9a, 0xf2, 0xd3, 0x79, 0xad, 0xf2, 0xea, 0xbc, 0x56, 0xf9, 0xf3, 0xbc,
-	0x56, 0xf9, 0xe6, 0x1e, 0x26, 0xc2, 0xeb, 0x77, 0x9b, 0x0e, 0x0d, 0x0c, 0x17, 0x8b, 0xdc, 0x27,
-	0xa4, 0xbc, 0xd0, 0x62, 0x14, 0x21, 0xde, 0x5d, 0x4c, 0x3e, 0x17, 0x1f, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0x19, 0xdf, 0x54, 0xe9, 0x0a, 0x00, 0x00,
+	// 1492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x49, 0x1a, 0x4f, 0x7e, 0x34, 0xd9, 0xa6, 0x8d, 0xe3, 0x7e, 0x63, 0xfb, 0xbb,
+	0xa5, 0x95, 0x49, 0x1b, 0x5b, 0x71, 0x7f, 0x81, 0x25, 0x50, 0xeb, 0xb6, 0xd0, 0xa8, 0xb2, 0x54,
+	0x6d, 0xda, 0x20, 0x71, 0x59, 0xd6, 0xbb, 0xe3, 0xf5, 0x28, 0xde, 0x1d, 0x33, 0xb3, 0x36, 0x8e,
+	0x7a, 0x01, 0xc4, 0x01, 0xf5, 0xc4, 0x3f, 0x80, 0xd4, 0x23, 0xea, 0x01, 0xf5, 0xc0, 0x89, 0x33,
+	0x87, 0x22, 0x0e, 0x54, 0x9c, 0x38, 0x01, 0x6a, 0x0f, 0xe5, 0xc0, 0xdf, 0x80, 0xd0, 0xcc, 0xac,
+	0xc7, 0x5e, 0x7b, 0xd7, 0x75, 0x2a, 0x21, 0x2e, 0x6d, 0xf7, 0xf3, 0x3e, 0xf3, 0xe6, 0xbd, 0xcf,
+	0x9b, 0x79, 0x6f, 0x6a, 0x90, 0xb1, 0x91, 0x83, 0x7c, 0xb3, 0x79, 0x80, 0x7c, 0xab, 0x01, 0xbd,
+	0x62, 0x87, 0xd4, 0x8b, 0x9d, 0x9d, 0xa2, 0xdf, 0x2d, 0xb4, 0x08, 0xf6, 0xb1, 0x7a, 0x32, 0x6c,
+	0x2f, 0x74, 0x48, 0xbd, 0xd0, 0xd9, 0x49, 0xaf, 0x9a, 0x2e, 0xf2, 0x70, 0x91, 0xff, 0x29, 0x98,
+	0xe9, 0x8c, 0x85, 0xa9, 0x8b, 0x69, 0xb1, 0x66, 0x52, 0x58, 0xec, 0xec, 0xd4, 0xa0, 0x6f, 0xee,
+	0x14, 0x2d, 0x8c, 0xbc, 0xc0, 0xbe, 0x1e, 0xd8, 0x5d, 0xea, 0xb0, 0x1d, 0x5c, 0xea, 0x04, 0x86,
+	0x0d, 0x61, 0x30, 0xf8, 0x57, 0x51, 0x7c, 0x04, 0xa6, 0x33, 0xd1, 0xd1, 0x39, 0xd0, 0x83, 0x14,
+	0xf5, 0x48, 0xd9, 0x68, 0x12, 0x8b, 0x54, 0x10, 0xd6, 0x1c, 0xec, 0x60, 0xe1, 0x9d, 0xfd, 0x4b,
+	0xa0, 0xda, 0xd7, 0x0a, 0x38, 0x55, 0xa5, 0xce, 0x3e, 0xa9, 0xdf, 0x72, 0x21, 0x71, 0xa0, 0x67,
+	0x1d, 0xde, 0x44, 0xd4, 0xac, 0x35, 0xa1, 0x7a, 0x05, 0x24, 0xcd, 0xb6, 0xdf, 0xc0, 0x04, 0xf9,
+	0x87, 0x29, 0x25, 0xa7, 0xe4, 0x93, 0x95, 0xd4, 0x2f, 0xdf, 0x6d, 0xaf, 0x05, 0xb1, 0x5d, 0xb7,
+	0x6d, 0x02, 0x29, 0xdd, 0xf3, 0x09, 0xf2, 0x1c, 0xbd, 0x4f, 0x55, 0x4f, 0x81, 0x39, 0x02, 0x4d,
+	0x8a, 0xbd, 0xd4, 0x34, 0x5b, 0xa4, 0x07, 0x5f, 0xe5, 0x8b, 0x9f, 0xbf, 0x7c, 0xb2, 0xd5, 0xe7,
+	0x3d, 0x7c, 0xf9, 0x64, 0x2b, 0xc7, 0xa2, 0xec, 0xf2, 0x58, 0xa3, 0x83, 0xd0, 0x72, 0x20, 0x13,
+	0x6d, 0xd1, 0x21, 0x6d, 0x61, 0x8f, 0x42, 0xed, 0x87, 0x04, 0x58, 0xaa, 0x52, 0x67, 0xd7, 0x43,
+	0x3e, 0x32, 0x9b, 0x37, 0x0f, 0x1c, 0x16, 0x38, 0xe2, 0x5f, 0x3e, 0x26, 0xaf, 0x0e, 0x5c, 0x52,
+	0xd5, 0x4d, 0x00, 0xac, 0x86, 0x89, 0x3c, 0xa3, 0x61, 0xd2, 0x06, 0x0f, 0x7e, 0x51, 0x4f, 0x72,
+	0xe4, 0xb6, 0x49, 0x1b, 0xcc, 0xdc, 0x6a, 0xd7, 0x9a, 0xc8, 0x32, 0x0e, 0xe0, 0x61, 0x2a, 0x21,
+	0xcc, 0x02, 0xb9, 0x03, 0x0f, 0xd5, 0xb3, 0x60, 0xb9, 0x05, 0x09, 0xc2, 0xb6, 0x41, 0xa1, 0x85,
+	0x3d, 0x9b, 0xa6, 0x66, 0x72, 0x4a, 0x7e, 0x46, 0x5f, 0x12, 0xe8, 0x9e, 0x00, 0xd5, 0x3c, 0x58,
+	0x09, 0x0a, 0x67, 0xb4, 0x3d, 0xd4, 0x65, 0xe4, 0xd4, 0x6c, 0x4e, 0xc9, 0x27, 0xf4, 0xe5, 0x00,
+	0xbf, 0xef, 0xa1, 0xee, 0x1e, 0xb4, 0xd4, 0xf3, 0x60, 0xd5, 0xb4, 0x7c, 0xd4, 0x31, 0x7d, 0x84,
+	0x3d, 0xa3, 0x01, 0x91, 0xd3, 0xf0, 0x53, 0x73, 0x9c, 0xba, 0xd2, 0x37, 0xdc, 0xe6, 0xb8, 0xfa,
+	0x7f, 0xb0, 0xd8, 0x73, 0x4b, 0x21, 0xb4, 0x53, 0xc7, 0x78, 0x78, 0x0b, 0x01, 0xb6, 0x07, 0xa1,
+	0xad, 0x9e, 0x06, 0xc9, 0x1a, 0x34, 0x2d, 0xec, 0x19, 0xc8, 0x4e, 0xcd, 0xf3, 0xd2, 0xcc, 0x0b,
+	0x60, 0xd7, 0x56, 0xef, 0x80, 0x95, 0x1a, 0xc6, 0x3e, 0xf5, 0x89, 0xd9, 0x32, 0x04, 0x9a, 0x4a,
+	0xe6, 0x94, 0xfc, 0x42, 0x29, 0x57, 0x88, 0x3c, 0xfc, 0x85, 0x7d, 0x52, 0xaf, 0x70, 0x9e, 0x7e,
+	0x5c, 0xae, 0x14, 0x40, 0xb9, 0xf4, 0xe5, 0xa3, 0xec, 0xd4, 0x9f, 0x8f, 0xb2, 0x53, 0xbc, 0xe2,
+	0x52, 0x60, 0x56, 0xf1, 0xf5, 0x50, 0xc5, 0xfb, 0x45, 0xd3, 0xd6, 0xc1, 0xc9, 0x10, 0x20, 0xeb,
+	0xfb, 0x97, 0x02, 0x8e, 0x57, 0xa9, 0x73, 0xbf, 0x65, 0x9b, 0x3e, 0xbc, 0x6b, 0x12, 0xd3, 0xa5,
+	0xaf, 0x7d, 0x34, 0xdf, 0x05, 0x73, 0x2d, 0xee, 0x81, 0x57, 0x77, 0x6c, 0x6e, 0x62, 0xa7, 0xca,
+	0xcc, 0xd3, 0xdf, 0xb2, 0x53, 0x7a, 0xb0, 0x2a, 0xba, 0x24, 0x89, 0xe8, 0x92, 0x94, 0x2f, 0x85,
+	0x54, 0x08, 0x9d, 0xfb, 0x8d, 0x90, 0x0a, 0x83, 0xa9, 0x69, 0x1b, 0x60, 0x7d, 0x08, 0x92, 0x4a,
+	0x3c, 0x9e, 0x06, 0xa9, 0x2a, 0x75, 0xae, 0xdb, 0xf6, 0x3e, 0xa9, 0xdf, 0xc0, 0xae, 0x8b, 0x7c,
+	0x1f, 0xc2, 0x2a, 0x74, 0x6b, 0x90, 0xbc, 0xb6, 0x24, 0x25, 0x70, 0xcc, 0x14, 0x36, 0x71, 0x5d,
+	0xc7, 0xac, 0xea, 0x11, 0xd5, 0x35, 0x30, 0xdb, 0x34, 0x6b, 0xb0, 0xc9, 0x53, 0x4f, 0xea, 0xe2,
+	0x43, 0xad, 0x82, 0x59, 0x82, 0x9b, 0x90, 0x9d, 0xfb, 0x44, 0x7e, 0xa1, 0x74, 0x21, 0x5e, 0x5b,
+	0x19, 0xbb, 0x8e, 0x9b, 0xf0, 0x7d, 0x62, 0x7a, 0x7e, 0x25, 0xc9, 0x74, 0xfe, 0xe6, 0xe5, 0x93,
+	0x2d, 0x45, 0x17, 0x5e, 0xca, 0xef, 0xc4, 0xcb, 0xa7, 0x85, 0xe4, 0x8b, 0xd4, 0x43, 0xd3, 0x40,
+	0x2e, 0xce, 0x26, 0x05, 0xfd, 0x49, 0x01, 0xa7, 0xab, 0xd4, 0xd1, 0xa1, 0x8b, 0x3b, 0xf0, 0xbf,
+	0xd5, 0xb4, 0x7c, 0x2d, 0x3e, 0xdd, 0xb3, 0xa1, 0x74, 0xe3, 0xa2, 0xd5, 0xce, 0x82, 0x33, 0x63,
+	0xcc, 0x32, 0xe9, 0x2f, 0xa6, 0x79, 0xc7, 0xd7, 0xa1, 0x83, 0xa8, 0x0f, 0xc9, 0x3e, 0xa9, 0xef,
+	0xda, 0xd0, 0xf3, 0x59, 0xdc, 0x97, 0xc0, 0x3c, 0x6e, 0x41, 0x32, 0x51, 0xdf, 0x94, 0x4c, 0xb5,
+	0x08, 0xd6, 0x6c, 0x62, 0x7a, 0xb6, 0x51, 0x6b, 0x52, 0x63, 0xa0, 0x43, 0x8a, 0x06, 0xba, 0xca,
+	0x6d, 0x95, 0x26, 0xbd, 0x2b, 0x3b, 0x65, 0x16, 0x2c, 0xd0, 0x86, 0x49, 0xa0, 0x81, 0x3c, 0x1b,
+	0x76, 0xf9, 0x21, 0x5a, 0xd2, 0x01, 0x87, 0x76, 0x19, 0xa2, 0x9e, 0x03, 0xc7, 0x07, 0x3c, 0x12,
+	0x8c, 0xeb, 0xbc, 0x97, 0x2e, 0xea, 0x4b, 0xd2, 0x19, 0x03, 0xcb, 0xe5, 0x41, 0xcd, 0x64, 0x40,
+	0xa3, 0x83, 0x25, 0x22, 0xd7, 0x60, 0xb0, 0x44, 0x58, 0xa4, 0x50, 0x3f, 0x2b, 0xe0, 0xc4, 0x00,
+	0xe5, 0x96, 0xd5, 0x21, 0x75, 0x16, 0xfe, 0xeb, 0xa9, 0x94, 0x07, 0x2b, 0x90, 0x79, 0x18, 0x55,
+	0x68, 0x99, 0xe3, 0x21, 0x79, 0x02, 0x26, 0xcf, 0x5c, 0x0c, 0x1a, 0x20, 0x48, 0x3c, 0xed, 0xab,
+	0xb1, 0x69, 0x6f, 0x46, 0xa6, 0xdd, 0x8b, 0x5c, 0xdb, 0x0c, 0x8e, 0x7b, 0x18, 0x96, 0x09, 0x3f,
+	0x55, 0x78, 0x0f, 0xde, 0xb3, 0x1a, 0xd0, 0x6e, 0x37, 0xd9, 0x19, 0xd2, 0x21, 0xaf, 0x0a, 0xbb,
+	0x08, 0x34, 0x40, 0x27, 0x98, 0xa8, 0x92, 0xaa, 0x9e, 0x01, 0x4b, 0x44, 0xb8, 0x30, 0x60, 0x0b,
+	0x5b, 0x62, 0xa8, 0xce, 0xe8, 0x8b, 0x01, 0x78, 0x8b, 0x61, 0x03, 0xef, 0x85, 0x44, 0xe8, 0xbd,
+	0x10, 0xaa, 0x6e, 0xdf, 0x29, 0xcb, 0x33, 0x1b, 0xca, 0x73, 0x34, 0x60, 0x2d, 0x0b, 0x36, 0x23,
+	0x0d, 0x32, 0xd7, 0x6f, 0xa7, 0xc1, 0x1a, 0xd7, 0xe2, 0xe3, 0x36, 0xa4, 0xbe, 0x6e, 0x7a, 0x36,
+	0x76, 0x3d, 0xd6, 0xdb, 0xae, 0x80, 0x24, 0x11, 0xe0, 0x24, 0xa9, 0x4a, 0x2a, 0x9b, 0xae, 0x5e,
+	0xdb, 0x35, 0x3e, 0xc1, 0xc4, 0x16, 0xb7, 0x7e, 0x49, 0x9f, 0xf7, 0xda, 0xee, 0x07, 0xec, 0x9b,
+	0x19, 0xdb, 0x14, 0x12, 0x31, 0x9a, 0x45, 0x41, 0xe7, 0x19, 0xc0, 0xe7, 0x32, 0x02, 0xc7, 0x5c,
+	0xb3, 0x6b, 0xd4, 0x21, 0x0c, 0x3a, 0xe7, 0x46, 0x21, 0xd8, 0x8c, 0x3d, 0x22, 0x0b, 0xc1, 0x23,
+	0xb2, 0x70, 0x03, 0x23, 0xaf, 0x72, 0x99, 0xb5, 0xc9, 0xc7, 0xbf, 0x67, 0xf3, 0x0e, 0xf2, 0x1b,
+	0xed, 0x5a, 0xc1, 0xc2, 0x6e, 0xf0, 0x56, 0x0c, 0xfe, 0xda, 0xa6, 0xf6, 0x41, 0xd1, 0x3f, 0x6c,
+	0x41, 0xca, 0x17, 0x50, 0xd1, 0x52, 0xe7, 0x5c, 0xb3, 0xfb, 0x1e, 0x84, 0xe5, 0xb7, 0x43, 0x92,
+	0xca, 0xe0, 0x99, 0xa4, 0x99, 0xa1, 0xa3, 0x33, 0xa4, 0x8b, 0xf6, 0xa3, 0x02, 0xfe, 0x17, 0x65,
+	0xe8, 0x29, 0xca, 0x9e, 0x47, 0x81, 0x43, 0xf6, 0xbe, 0x50, 0x78, 0xa1, 0x7b, 0x5b, 0xec, 0xda,
+	0xec, 0x4e, 0xbb, 0xc8, 0x33, 0xc4, 0xbd, 0x26, 0xb8, 0xed, 0xd9, 0xc1, 0x61, 0x58, 0x72, 0x91,
+	0x77, 0x93, 0xa1, 0x3a, 0x03, 0xd5, 0x1a, 0x48, 0x30, 0x25, 0x12, 0xff, 0x92, 0x12, 0xcc, 0xb9,
+	0xf6, 0xb7, 0xb8, 0xd9, 0x7b, 0xed, 0x9a, 0x8b, 0xfc, 0x7b, 0xc8, 0x85, 0x4d, 0x6c, 0x1d, 0x40,
+	0x9b, 0x1f, 0x73, 0x8e, 0x4d, 0x54, 0x7b, 0x49, 0x65, 0x37, 0x76, 0x34, 0x2f, 0x60, 0xf7, 0x93,
+	0xba, 0x07, 0x80, 0x85, 0x5a, 0x0d, 0x48, 0x7c, 0xd8, 0x15, 0x0f, 0x86, 0x85, 0xd2, 0x9b, 0x31,
+	0xf3, 0xb1, 0x17, 0xcf, 0x0d, 0xb9, 0x60, 0x70, 0x38, 0x0e, 0xf8, 0x29, 0xbf, 0x15, 0xbe, 0x20,
+	0xbd, 0x70, 0x46, 0x1b, 0xc1, 0x70, 0xa2, 0xda, 0x36, 0x6f, 0x04, 0xc3, 0xb0, 0x2c, 0xe5, 0x32,
+	0x98, 0x96, 0x25, 0x9c, 0x46, 0x76, 0xe9, 0xfb, 0x24, 0x48, 0x54, 0xa9, 0xa3, 0x3e, 0x00, 0x27,
+	0xa2, 0xfe, 0xa3, 0xb0, 0x1d, 0x93, 0x49, 0xf4, 0xc3, 0x3d, 0x7d, 0xf9, 0x48, 0x74, 0x19, 0xd4,
+	0x47, 0x00, 0x0c, 0xbc, 0xf1, 0xdf, 0x88, 0x77, 0xd2, 0x67, 0xa5, 0x2f, 0x4c, 0xc2, 0x92, 0x3b,
+	0xd4, 0xc1, 0x62, 0xe8, 0x95, 0x79, 0x2e, 0x7e, 0xf5, 0x20, 0x2f, 0x5d, 0x98, 0x8c, 0x27, 0xf7,
+	0xf9, 0x4c, 0x01, 0x27, 0xa3, 0x1f, 0x71, 0xc5, 0x78, 0x4f, 0x91, 0x0b, 0xd2, 0x57, 0x8f, 0xb8,
+	0x40, 0xc6, 0xf0, 0x50, 0x01, 0xa9, 0xd8, 0x77, 0x4f, 0x29, 0xde, 0x6b, 0xdc, 0x9a, 0x74, 0xf9,
+	0xe8, 0x6b, 0x64, 0x30, 0x0f, 0xc0, 0x89, 0xa8, 0xe7, 0xc8, 0xf6, 0x38, 0x97, 0x23, 0xf4, 0x71,
+	0xe7, 0x6a, 0xcc, 0x98, 0x57, 0x09, 0x58, 0x19, 0x19, 0xf1, 0x5b, 0xaf, 0x76, 0xd5, 0xe3, 0xa6,
+	0x4b, 0x93, 0x73, 0xe5, 0x9e, 0x5d, 0xa0, 0x46, 0x4c, 0xd9, 0x31, 0xa7, 0x75, 0x94, 0x9d, 0xbe,
+	0x74, 0x14, 0xb6, 0xdc, 0xb9, 0x0d, 0x56, 0x47, 0x67, 0xde, 0xf9, 0x71, 0x29, 0x0c, 0x91, 0xd3,
+	0x17, 0x8f, 0x40, 0x1e, 0x14, 0x79, 0xa4, 0xdb, 0x8e, 0x11, 0x79, 0x98, 0x3b, 0x4e, 0xe4, 0xb8,
+	0x2e, 0x96, 0x9e, 0xfd, 0x94, 0x75, 0xcc, 0xca, 0xb5, 0xa7, 0xcf, 0x33, 0xca, 0xb3, 0xe7, 0x19,
+	0xe5, 0x8f, 0xe7, 0x19, 0xe5, 0xab, 0x17, 0x99, 0xa9, 0x67, 0x2f, 0x32, 0x53, 0xbf, 0xbe, 0xc8,
+	0x4c, 0x7d, 0x78, 0x6e, 0x60, 0x74, 0xd8, 0x8e, 0x1f, 0xfa, 0xe9, 0x44, 0xf4, 0x4e, 0x3e, 0x3e,
+	0x6a, 0x73, 0xfc, 0xa7, 0x92, 0x8b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xca, 0x67, 0x9a, 0x3e,
+	0x26, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -725,8 +1081,19 @@ type MsgClient interface {
 	RemoveVrfCommitteeMember(ctx context.Context, in *MsgRemoveVrfCommitteeMember, opts ...grpc.CallOption) (*MsgRemoveVrfCommitteeMemberResponse, error)
 	// RegisterVrfIdentity binds a validator to its drand identity/share.
 	RegisterVrfIdentity(ctx context.Context, in *MsgRegisterVrfIdentity, opts ...grpc.CallOption) (*MsgRegisterVrfIdentityResponse, error)
+	// RegisterEcvrfKey binds a validator to the ed25519 key of its ECVRF
+	// fallback proofs.
+	RegisterEcvrfKey(ctx context.Context, in *MsgRegisterEcvrfKey, opts ...grpc.CallOption) (*MsgRegisterEcvrfKeyResponse, error)
 	// ScheduleVrfReshare bumps VrfParams.reshare_epoch to signal resharing.
 	ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfReshare, opts ...grpc.CallOption) (*MsgScheduleVrfReshareResponse, error)
+	// RequestRandomness pays for randomness committed to a future drand round.
+	// The fee is escrowed in the vrf module account and paid to the validators
+	// whose vote extensions finalized the fulfilling beacon.
+	RequestRandomness(ctx context.Context, in *MsgRequestRandomness, opts ...grpc.CallOption) (*MsgRequestRandomnessResponse, error)
+	// SubmitTimelocked stores a ciphertext time-lock encrypted to a future drand
+	// round. PreBlock decrypts it with the beacon signature once that round is
+	// finalized.
+	SubmitTimelocked(ctx context.Context, in *MsgSubmitTimelocked, opts ...grpc.CallOption) (*MsgSubmitTimelockedResponse, error)
 }
 
 type msgClient struct {
@@ -791,6 +1158,15 @@ func (c *msgClient) RegisterVrfIdentity(ctx context.Context, in *MsgRegisterVrfI
 	return out, nil
 }
 
+func (c *msgClient) RegisterEcvrfKey(ctx context.Context, in *MsgRegisterEcvrfKey, opts ...grpc.CallOption) (*MsgRegisterEcvrfKeyResponse, error) {
+	out := new(MsgRegisterEcvrfKeyResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Msg/RegisterEcvrfKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfReshare, opts ...grpc.CallOption) (*MsgScheduleVrfReshareResponse, error) {
 	out := new(MsgScheduleVrfReshareResponse)
 	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Msg/ScheduleVrfReshare", in, out, opts...)
@@ -800,6 +1176,24 @@ func (c *msgClient) ScheduleVrfReshare(ctx context.Context, in *MsgScheduleVrfRe
 	return out, nil
 }
 
+func (c *msgClient) RequestRandomness(ctx context.Context, in *MsgRequestRandomness, opts ...grpc.CallOption) (*MsgRequestRandomnessResponse, error) {
+	out := new(MsgRequestRandomnessResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Msg/RequestRandomness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitTimelocked(ctx context.Context, in *MsgSubmitTimelocked, opts ...grpc.CallOption) (*MsgSubmitTimelockedResponse, error) {
+	out := new(MsgSubmitTimelockedResponse)
+	err := c.cc.Invoke(ctx, "/digitalkitchen.vrf.v1.Msg/SubmitTimelocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// VrfEmergencyDisable submits an emergency disable request. The message
@@ -821,8 +1215,19 @@ type MsgServer interface {
 	RemoveVrfCommitteeMember(context.Context, *MsgRemoveVrfCommitteeMember) (*MsgRemoveVrfCommitteeMemberResponse, error)
 	// RegisterVrfIdentity binds a validator to its drand identity/share.
 	RegisterVrfIdentity(context.Context, *MsgRegisterVrfIdentity) (*MsgRegisterVrfIdentityResponse, error)
+	// RegisterEcvrfKey binds a validator to the ed25519 key of its ECVRF
+	// fallback proofs.
+	RegisterEcvrfKey(context.Context, *MsgRegisterEcvrfKey) (*MsgRegisterEcvrfKeyResponse, error)
 	// ScheduleVrfReshare bumps VrfParams.reshare_epoch to signal resharing.
 	ScheduleVrfReshare(context.Context, *MsgScheduleVrfReshare) (*MsgScheduleVrfReshareResponse, error)
+	// RequestRandomness pays for randomness committed to a future drand round.
+	// The fee is escrowed in the vrf module account and paid to the validators
+	// whose vote extensions finalized the fulfilling beacon.
+	RequestRandomness(context.Context, *MsgRequestRandomness) (*MsgRequestRandomnessResponse, error)
+	// SubmitTimelocked stores a ciphertext time-lock encrypted to a future drand
+	// round. PreBlock decrypts it with the beacon signature once that round is
+	// finalized.
+	SubmitTimelocked(context.Context, *MsgSubmitTimelocked) (*MsgSubmitTimelockedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -847,9 +1252,18 @@ func (*UnimplementedMsgServer) RemoveVrfCommitteeMember(ctx context.Context, req
 func (*UnimplementedMsgServer) RegisterVrfIdentity(ctx context.Context, req *MsgRegisterVrfIdentity) (*MsgRegisterVrfIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterVrfIdentity not implemented")
 }
+func (*UnimplementedMsgServer) RegisterEcvrfKey(ctx context.Context, req *MsgRegisterEcvrfKey) (*MsgRegisterEcvrfKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEcvrfKey not implemented")
+}
 func (*UnimplementedMsgServer) ScheduleVrfReshare(ctx context.Context, req *MsgScheduleVrfReshare) (*MsgScheduleVrfReshareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleVrfReshare not implemented")
 }
+func (*UnimplementedMsgServer) RequestRandomness(ctx context.Context, req *MsgRequestRandomness) (*MsgRequestRandomnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRandomness not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTimelocked(ctx context.Context, req *MsgSubmitTimelocked) (*MsgSubmitTimelockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTimelocked not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -963,6 +1377,24 @@ func _Msg_RegisterVrfIdentity_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterEcvrfKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterEcvrfKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterEcvrfKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Msg/RegisterEcvrfKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterEcvrfKey(ctx, req.(*MsgRegisterEcvrfKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ScheduleVrfReshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgScheduleVrfReshare)
 	if err := dec(in); err != nil {
@@ -981,6 +1413,42 @@ func _Msg_ScheduleVrfReshare_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRandomness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRandomness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Msg/RequestRandomness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRandomness(ctx, req.(*MsgRequestRandomness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTimelocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTimelocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTimelocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/digitalkitchen.vrf.v1.Msg/SubmitTimelocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTimelocked(ctx, req.(*MsgSubmitTimelocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "digitalkitchen.vrf.v1.Msg",
@@ -1010,10 +1478,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterVrfIdentity",
 			Handler:    _Msg_RegisterVrfIdentity_Handler,
 		},
+		{
+			MethodName: "RegisterEcvrfKey",
+			Handler:    _Msg_RegisterEcvrfKey_Handler,
+		},
 		{
 			MethodName: "ScheduleVrfReshare",
 			Handler:    _Msg_ScheduleVrfReshare_Handler,
 		},
+		{
+			MethodName: "RequestRandomness",
+			Handler:    _Msg_RequestRandomness_Handler,
+		},
+		{
+			MethodName: "SubmitTimelocked",
+			Handler:    _Msg_SubmitTimelocked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "digitalkitchen/vrf/v1/tx.proto",
@@ -1099,6 +1579,37 @@ func (m *MsgInitialDkg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BootstrapBeacon != nil {
+		{
+			size, err := m.BootstrapBeacon.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.BeaconId) > 0 {
+		i -= len(m.BeaconId)
+		copy(dAtA[i:], m.BeaconId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BeaconId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.GenesisSeed) > 0 {
+		i -= len(m.GenesisSeed)
+		copy(dAtA[i:], m.GenesisSeed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisSeed)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.GenesisUnixSec != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GenesisUnixSec))
 		i--
@@ -1176,6 +1687,11 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1239,6 +1755,20 @@ func (m *MsgAddVrfCommitteeMember) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Label) > 0 {
 		i -= len(m.Label)
 		copy(dAtA[i:], m.Label)
@@ -1366,6 +1896,18 @@ func (m *MsgRegisterVrfIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DrandBlsProof) > 0 {
+		i -= len(m.DrandBlsProof)
+		copy(dAtA[i:], m.DrandBlsProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DrandBlsProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShareIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ShareIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DrandBlsPublicKey) > 0 {
 		i -= len(m.DrandBlsPublicKey)
 		copy(dAtA[i:], m.DrandBlsPublicKey)
@@ -1406,7 +1948,7 @@ func (m *MsgRegisterVrfIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgScheduleVrfReshare) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterEcvrfKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1416,39 +1958,41 @@ func (m *MsgScheduleVrfReshare) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgScheduleVrfReshare) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterEcvrfKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgScheduleVrfReshare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterEcvrfKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+	if len(m.EcvrfProof) > 0 {
+		i -= len(m.EcvrfProof)
+		copy(dAtA[i:], m.EcvrfProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EcvrfProof)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ReshareEpoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ReshareEpoch))
+	if len(m.EcvrfPublicKey) > 0 {
+		i -= len(m.EcvrfPublicKey)
+		copy(dAtA[i:], m.EcvrfPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EcvrfPublicKey)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Scheduler) > 0 {
-		i -= len(m.Scheduler)
-		copy(dAtA[i:], m.Scheduler)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Scheduler)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgScheduleVrfReshareResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterEcvrfKeyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1458,12 +2002,12 @@ func (m *MsgScheduleVrfReshareResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgScheduleVrfReshareResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterEcvrfKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgScheduleVrfReshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterEcvrfKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1471,7 +2015,248 @@ func (m *MsgScheduleVrfReshareResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgScheduleVrfReshare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleVrfReshare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleVrfReshare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReshareEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReshareEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Scheduler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleVrfReshareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleVrfReshareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleVrfReshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRandomness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRandomness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRandomness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserSeed) > 0 {
+		i -= len(m.UserSeed)
+		copy(dAtA[i:], m.UserSeed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UserSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NumWords != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumWords))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRandomnessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinDrandRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinDrandRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTimelocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTimelocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTimelocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Ciphertext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DrandRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTimelockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTimelockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTimelockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -1532,6 +2317,21 @@ func (m *MsgInitialDkg) Size() (n int) {
 	if m.GenesisUnixSec != 0 {
 		n += 1 + sovTx(uint64(m.GenesisUnixSec))
 	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	l = len(m.GenesisSeed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BeaconId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BootstrapBeacon != nil {
+		l = m.BootstrapBeacon.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1556,6 +2356,9 @@ func (m *MsgUpdateParams) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -1586,6 +2389,12 @@ func (m *MsgAddVrfCommitteeMember) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1638,6 +2447,13 @@ func (m *MsgRegisterVrfIdentity) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ShareIndex != 0 {
+		n += 1 + sovTx(uint64(m.ShareIndex))
+	}
+	l = len(m.DrandBlsProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1650,6 +2466,36 @@ func (m *MsgRegisterVrfIdentityResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterEcvrfKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EcvrfPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EcvrfProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterEcvrfKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgScheduleVrfReshare) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1674,18 +2520,802 @@ func (m *MsgScheduleVrfReshareResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestRandomness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NumWords != 0 {
+		n += 1 + sovTx(uint64(m.NumWords))
+	}
+	l = len(m.UserSeed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRequestRandomnessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.MinDrandRound != 0 {
+		n += 1 + sovTx(uint64(m.MinDrandRound))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitTimelocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DrandRound != 0 {
+		n += 1 + sovTx(uint64(m.DrandRound))
+	}
+	l = m.Ciphertext.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitTimelockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVrfEmergencyDisable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVrfEmergencyDisable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVrfEmergencyDisableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVrfEmergencyDisableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVrfEmergencyDisableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitialDkg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitialDkg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainHash == nil {
+				m.ChainHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
+			}
+			m.GenesisUnixSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisUnixSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisSeed = append(m.GenesisSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisSeed == nil {
+				m.GenesisSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeaconId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapBeacon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BootstrapBeacon == nil {
+				m.BootstrapBeacon = &VrfBeacon{}
+			}
+			if err := m.BootstrapBeacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitialDkgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitialDkgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitialDkgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1708,10 +3338,10 @@ func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVrfEmergencyDisable: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVrfCommitteeMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVrfEmergencyDisable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVrfCommitteeMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1748,7 +3378,7 @@ func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1776,7 +3406,73 @@ func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, VrfCommitteeRoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1799,7 +3495,7 @@ func (m *MsgVrfEmergencyDisable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVrfEmergencyDisableResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1822,10 +3518,10 @@ func (m *MsgVrfEmergencyDisableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVrfEmergencyDisableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddVrfCommitteeMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVrfEmergencyDisableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddVrfCommitteeMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1849,7 +3545,7 @@ func (m *MsgVrfEmergencyDisableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1872,15 +3568,15 @@ func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitialDkg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitialDkg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1908,47 +3604,13 @@ func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Initiator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainHash == nil {
-				m.ChainHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1958,64 +3620,24 @@ func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
-			}
-			m.PeriodSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PeriodSeconds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUnixSec", wireType)
-			}
-			m.GenesisUnixSec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GenesisUnixSec |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2037,7 +3659,7 @@ func (m *MsgInitialDkg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitialDkgResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,10 +3682,10 @@ func (m *MsgInitialDkgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitialDkgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitialDkgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2087,7 +3709,7 @@ func (m *MsgInitialDkgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2110,15 +3732,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterVrfIdentity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterVrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2146,13 +3768,66 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrandBlsPublicKey = append(m.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DrandBlsPublicKey == nil {
+				m.DrandBlsPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
+			}
+			m.ShareIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandBlsProof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2162,23 +3837,24 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DrandBlsProof = append(m.DrandBlsProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.DrandBlsProof == nil {
+				m.DrandBlsProof = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2202,7 +3878,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterVrfIdentityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2225,10 +3901,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterVrfIdentityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterVrfIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2252,7 +3928,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterEcvrfKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2275,15 +3951,15 @@ func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddVrfCommitteeMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterEcvrfKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddVrfCommitteeMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterEcvrfKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2311,13 +3987,13 @@ func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfPublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2327,29 +4003,31 @@ func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EcvrfPublicKey = append(m.EcvrfPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EcvrfPublicKey == nil {
+				m.EcvrfPublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcvrfProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2359,23 +4037,25 @@ func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.EcvrfProof = append(m.EcvrfProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.EcvrfProof == nil {
+				m.EcvrfProof = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2398,7 +4078,7 @@ func (m *MsgAddVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterEcvrfKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2421,10 +4101,10 @@ func (m *MsgAddVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddVrfCommitteeMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterEcvrfKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddVrfCommitteeMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterEcvrfKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2448,7 +4128,7 @@ func (m *MsgAddVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2471,15 +4151,15 @@ func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleVrfReshare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleVrfReshare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,11 +4187,30 @@ func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+			}
+			m.ReshareEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReshareEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,7 +4238,7 @@ func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2562,7 +4261,7 @@ func (m *MsgRemoveVrfCommitteeMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleVrfReshareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,10 +4284,10 @@ func (m *MsgRemoveVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleVrfReshareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveVrfCommitteeMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleVrfReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2612,7 +4311,7 @@ func (m *MsgRemoveVrfCommitteeMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestRandomness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2635,15 +4334,15 @@ func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterVrfIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestRandomness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterVrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestRandomness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2671,11 +4370,30 @@ func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Requester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWords", wireType)
+			}
+			m.NumWords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWords |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2702,9 +4420,43 @@ func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrandBlsPublicKey = append(m.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.DrandBlsPublicKey == nil {
-				m.DrandBlsPublicKey = []byte{}
+			m.UserSeed = append(m.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserSeed == nil {
+				m.UserSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2728,7 +4480,7 @@ func (m *MsgRegisterVrfIdentity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterVrfIdentityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestRandomnessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2751,12 +4503,84 @@ func (m *MsgRegisterVrfIdentityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterVrfIdentityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestRandomnessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterVrfIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDrandRound", wireType)
+			}
+			m.MinDrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2778,7 +4602,7 @@ func (m *MsgRegisterVrfIdentityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTimelocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2801,15 +4625,15 @@ func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleVrfReshare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTimelocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleVrfReshare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTimelocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2837,13 +4661,13 @@ func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReshareEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
 			}
-			m.ReshareEpoch = 0
+			m.DrandRound = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2853,16 +4677,16 @@ func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReshareEpoch |= uint64(b&0x7F) << shift
+				m.DrandRound |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2872,23 +4696,24 @@ func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.Ciphertext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2911,7 +4736,7 @@ func (m *MsgScheduleVrfReshare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleVrfReshareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTimelockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2934,12 +4759,31 @@ func (m *MsgScheduleVrfReshareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleVrfReshareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTimelockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleVrfReshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTimelockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1,11 +1,17 @@
 package types_test
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/drand/drand/v2/common"
+	"github.com/drand/drand/v2/crypto"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -29,6 +35,72 @@ func (s *TypesSuite) TestParamsValidate() {
 
 	bad = vrftypes.VrfParams{PeriodSeconds: 10, SafetyMarginSeconds: 5}
 	s.Require().Error(bad.Validate())
+
+	bad = vrftypes.DefaultParams()
+	bad.RoundTolerance = vrftypes.MaxRoundTolerance + 1
+	s.Require().Error(bad.Validate())
+
+	bad = vrftypes.DefaultParams()
+	bad.SchemeId = "bls-unchained-g1-rfc9380"
+	s.Require().Error(bad.Validate())
+
+	// Threshold mode requires an unchained scheme.
+	bad = vrftypes.DefaultParams()
+	bad.PartialThreshold = 2
+	s.Require().Error(bad.Validate())
+
+	good := vrftypes.DefaultParams()
+	good.SchemeId = "pedersen-bls-unchained"
+	good.PartialThreshold = 2
+	s.Require().NoError(good.Validate())
+	s.Require().True(good.ThresholdMode())
+}
+
+func (s *TypesSuite) TestParamsValidateDrandChain() {
+	// League of Entropy mainnet, the default pedersen-bls-chained beacon.
+	mainnet := vrftypes.DefaultParams()
+	mainnet.ChainHash, _ = hex.DecodeString("8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce")
+	mainnet.PublicKey, _ = hex.DecodeString("868f005eb8e6e4ca0a47c8a77ceaa5309a47978a7c71bc5cce96366b5d7a569937c529eeda66c7293784a9402801af31")
+	mainnet.GenesisSeed, _ = hex.DecodeString("176f93498eac9ca337150b46d21dd58673ea4e3581185f869672e59fa4cb390a")
+	mainnet.PeriodSeconds = 30
+	mainnet.GenesisUnixSec = 1595431050
+	s.Require().NoError(mainnet.ValidateDrandChain())
+
+	// Params without chain-info are valid until the initial DKG.
+	s.Require().NoError(vrftypes.DefaultParams().ValidateDrandChain())
+
+	params, err := vrftestutil.WithDrandChain(vrftypes.DefaultParams())
+	s.Require().NoError(err)
+	s.Require().NoError(params.ValidateDrandChain())
+
+	// The default beacon ID may be spelled out; any other ID changes the hash.
+	bad := params
+	bad.BeaconId = common.DefaultBeaconID
+	s.Require().NoError(bad.ValidateDrandChain())
+	bad.BeaconId = "quicknet"
+	s.Require().Error(bad.ValidateDrandChain())
+
+	bad = params
+	bad.GenesisUnixSec++
+	s.Require().Error(bad.ValidateDrandChain())
+
+	bad = params
+	bad.PublicKey = []byte("pk")
+	s.Require().Error(bad.ValidateDrandChain())
+
+	// A G2 point is not on the key group of the supported schemes.
+	bad = params
+	bad.PublicKey, err = crypto.NewPedersenBLSChained().SigGroup.Point().Base().MarshalBinary()
+	s.Require().NoError(err)
+	s.Require().Error(bad.ValidateDrandChain())
+
+	bad = params
+	bad.ChainHash = nil
+	s.Require().Error(bad.ValidateDrandChain())
+
+	bad = params
+	bad.PublicKey = nil
+	s.Require().Error(bad.ValidateDrandChain())
 }
 
 func (s *TypesSuite) TestGenesisValidate() {
@@ -71,14 +143,94 @@ func (s *TypesSuite) TestGenesisValidate() {
 	}}
 	s.Require().Error(gs.Validate())
 
-	gs = vrftypes.GenesisState{Params: vrftypes.DefaultParams()}
+	chainParams, err := vrftestutil.WithDrandChain(vrftypes.DefaultParams())
+	s.Require().NoError(err)
+
+	gs = vrftypes.GenesisState{Params: chainParams}
 	gs.Params.ChainHash = []byte{0x01}
+	s.Require().Error(gs.Validate())
+
+	gs = vrftypes.GenesisState{Params: chainParams}
 	gs.Identities = []vrftypes.VrfIdentity{{
 		ValidatorAddress:  valAddr,
 		DrandBlsPublicKey: []byte("pk"),
 		ChainHash:         []byte{0x02},
 	}}
 	s.Require().Error(gs.Validate())
+
+	gs.Identities[0].ChainHash = chainParams.ChainHash
+	s.Require().NoError(gs.Validate())
+
+	gs = vrftypes.GenesisState{Params: vrftypes.DefaultParams()}
+	gs.PendingParams = &vrftypes.VrfPendingParams{Params: vrftypes.DefaultParams(), ActivationHeight: 10}
+	s.Require().NoError(gs.Validate())
+
+	gs.PendingParams.ActivationHeight = 0
+	s.Require().Error(gs.Validate())
+
+	gs.PendingParams = &vrftypes.VrfPendingParams{ActivationHeight: 10}
+	s.Require().Error(gs.Validate())
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 12))
+	gs = vrftypes.GenesisState{
+		Params: vrftypes.DefaultParams(),
+		Requests: []vrftypes.RandomnessRequest{
+			{Id: 0, Requester: member, Fee: fee, MinDrandRound: 4, Status: vrftypes.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_FULFILLED},
+			{Id: 1, Requester: member, Fee: fee, MinDrandRound: 5, Status: vrftypes.RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING},
+		},
+		NextRequestId: 2,
+		RequestEscrow: fee,
+	}
+	s.Require().NoError(gs.Validate())
+
+	gs.NextRequestId = 1
+	s.Require().Error(gs.Validate())
+	gs.NextRequestId = 2
+
+	gs.RequestEscrow = fee.Add(fee...)
+	s.Require().Error(gs.Validate(), "escrow must match the pending fees")
+	gs.RequestEscrow = fee
+
+	gs.Requests[0].Id = 1
+	s.Require().Error(gs.Validate())
+	gs.Requests[0].Id = 0
+
+	gs.Requests[1].MinDrandRound = 0
+	s.Require().Error(gs.Validate())
+
+	gs = vrftypes.GenesisState{
+		Params: vrftypes.DefaultParams(),
+		Timelocked: []vrftypes.TimelockedMessage{
+			{Id: 0, Submitter: member, DrandRound: 5, Status: vrftypes.TimelockStatus_TIMELOCK_STATUS_PENDING},
+		},
+		NextTimelockId: 1,
+	}
+	s.Require().NoError(gs.Validate())
+
+	gs.NextTimelockId = 0
+	s.Require().Error(gs.Validate())
+	gs.NextTimelockId = 1
+
+	gs.Timelocked[0].Status = vrftypes.TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED
+	s.Require().Error(gs.Validate())
+
+	gs = vrftypes.GenesisState{
+		Params: vrftypes.DefaultParams(),
+		EmergencyTxs: []vrftypes.EmergencyTxRecord{
+			{TxHash: []byte("tx-a"), TimeoutHeight: 10},
+			{TxHash: []byte("tx-b"), TimeoutHeight: 10},
+		},
+	}
+	s.Require().NoError(gs.Validate())
+
+	gs.EmergencyTxs[1].TxHash = []byte("tx-a")
+	s.Require().Error(gs.Validate())
+
+	gs.EmergencyTxs[1].TxHash = nil
+	s.Require().Error(gs.Validate())
+
+	gs.EmergencyTxs[1] = vrftypes.EmergencyTxRecord{TxHash: []byte("tx-b")}
+	s.Require().Error(gs.Validate())
 }
 
 func (s *TypesSuite) TestMsgsValidate() {
@@ -97,6 +249,10 @@ func (s *TypesSuite) TestMsgsValidate() {
 		GenesisUnixSec: 1,
 	}
 	s.Require().NoError(initMsg.ValidateBasic())
+	initMsg.BootstrapBeacon = &vrftypes.VrfBeacon{DrandRound: 5}
+	s.Require().Error(initMsg.ValidateBasic())
+	initMsg.BootstrapBeacon.Signature = []byte("sig")
+	s.Require().NoError(initMsg.ValidateBasic())
 	initMsg.ChainHash = nil
 	s.Require().Error(initMsg.ValidateBasic())
 
@@ -104,11 +260,29 @@ func (s *TypesSuite) TestMsgsValidate() {
 	params.Enabled = true
 	updateMsg := &vrftypes.MsgUpdateParams{Authority: addrStr, Params: params}
 	s.Require().NoError(updateMsg.ValidateBasic())
+	updateMsg.ActivationHeight = -1
+	s.Require().Error(updateMsg.ValidateBasic())
+	updateMsg.ActivationHeight = 0
+	updateMsg.Params.PublicKey = []byte("pk")
+	updateMsg.Params.ChainHash = []byte{0x01}
+	s.Require().Error(updateMsg.ValidateBasic())
+	updateMsg.Params = params
 	updateMsg.Authority = "bad"
 	s.Require().Error(updateMsg.ValidateBasic())
 
 	addMsg := &vrftypes.MsgAddVrfCommitteeMember{Authority: addrStr, Address: addrStr, Label: "l"}
+	s.Require().Error(addMsg.ValidateBasic())
+	addMsg.Roles = []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY, ExpiryHeight: 10}}
 	s.Require().NoError(addMsg.ValidateBasic())
+	addMsg.Roles = append(addMsg.Roles, vrftypes.VrfCommitteeRoleGrant{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY})
+	s.Require().Error(addMsg.ValidateBasic())
+	addMsg.Roles = []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED}}
+	s.Require().Error(addMsg.ValidateBasic())
+	addMsg.Roles = []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP, ExpiryHeight: -1}}
+	s.Require().Error(addMsg.ValidateBasic())
+	addMsg.Roles = []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole(42)}}
+	s.Require().Error(addMsg.ValidateBasic())
+	addMsg.Roles = []vrftypes.VrfCommitteeRoleGrant{{Role: vrftypes.VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP}}
 	addMsg.Address = "bad"
 	s.Require().Error(addMsg.ValidateBasic())
 
@@ -117,15 +291,48 @@ func (s *TypesSuite) TestMsgsValidate() {
 	removeMsg.Authority = "bad"
 	s.Require().Error(removeMsg.ValidateBasic())
 
-	regMsg := &vrftypes.MsgRegisterVrfIdentity{Operator: addrStr, DrandBlsPublicKey: []byte("pk")}
+	regMsg := &vrftypes.MsgRegisterVrfIdentity{Operator: addrStr, DrandBlsPublicKey: []byte("pk"), DrandBlsProof: []byte("proof")}
 	s.Require().NoError(regMsg.ValidateBasic())
+	regMsg.DrandBlsProof = nil
+	s.Require().Error(regMsg.ValidateBasic())
 	regMsg.DrandBlsPublicKey = nil
 	s.Require().Error(regMsg.ValidateBasic())
 
+	ecvrfMsg := &vrftypes.MsgRegisterEcvrfKey{Operator: addrStr, EcvrfPublicKey: make([]byte, 32), EcvrfProof: make([]byte, vrftypes.ECVRFProofLength)}
+	s.Require().NoError(ecvrfMsg.ValidateBasic())
+	ecvrfMsg.EcvrfProof = nil
+	s.Require().Error(ecvrfMsg.ValidateBasic())
+	ecvrfMsg.EcvrfProof = make([]byte, vrftypes.ECVRFProofLength)
+	ecvrfMsg.EcvrfPublicKey = []byte("pk")
+	s.Require().Error(ecvrfMsg.ValidateBasic())
+
 	schedMsg := &vrftypes.MsgScheduleVrfReshare{Scheduler: addrStr, ReshareEpoch: 1}
 	s.Require().NoError(schedMsg.ValidateBasic())
 	schedMsg.ReshareEpoch = 0
 	s.Require().Error(schedMsg.ValidateBasic())
+
+	reqMsg := &vrftypes.MsgRequestRandomness{Requester: addrStr, NumWords: 1}
+	s.Require().NoError(reqMsg.ValidateBasic())
+	reqMsg.NumWords = vrftypes.MaxRequestWords + 1
+	s.Require().Error(reqMsg.ValidateBasic())
+
+	tlMsg := &vrftypes.MsgSubmitTimelocked{Submitter: addrStr, DrandRound: 1}
+	s.Require().Error(tlMsg.ValidateBasic())
+}
+
+func (s *TypesSuite) TestEmergencyDisableAuthorization() {
+	a := vrftypes.NewEmergencyDisableAuthorization()
+	s.Require().NoError(a.ValidateBasic())
+	s.Require().Equal("/digitalkitchen.vrf.v1.MsgVrfEmergencyDisable", a.MsgTypeURL())
+
+	resp, err := a.Accept(context.Background(), &vrftypes.MsgVrfEmergencyDisable{})
+	s.Require().NoError(err)
+	s.Require().True(resp.Accept)
+	s.Require().False(resp.Delete)
+	s.Require().Nil(resp.Updated)
+
+	_, err = a.Accept(context.Background(), &vrftypes.MsgInitialDkg{})
+	s.Require().Error(err)
 }
 
 func (s *TypesSuite) TestRoundAt() {
@@ -143,4 +350,107 @@ func (s *TypesSuite) TestRoundAt() {
 
 	params.PeriodSeconds = 10
 	s.Require().Equal(uint64(0), vrftypes.RoundAt(params, genesis.Add(-time.Second)))
+
+	s.Require().Equal(genesis, vrftypes.RoundTime(params, 1))
+	s.Require().Equal(genesis.Add(20*time.Second), vrftypes.RoundTime(params, 3))
+	s.Require().Equal(uint64(3), vrftypes.RoundAt(params, vrftypes.RoundTime(params, 3)))
+}
+
+func (s *TypesSuite) TestEligibleRounds() {
+	params := vrftypes.DefaultParams()
+
+	params.RoundTolerance = 0
+	s.Require().Equal([]uint64{10}, vrftypes.EligibleRounds(params, 10))
+
+	params.RoundTolerance = 2
+	s.Require().Equal([]uint64{10, 9, 8}, vrftypes.EligibleRounds(params, 10))
+	s.Require().Equal([]uint64{2, 1}, vrftypes.EligibleRounds(params, 2))
+	s.Require().Empty(vrftypes.EligibleRounds(params, 0))
+}
+
+func (s *TypesSuite) TestTimelockRoundTrip() {
+	scheme := crypto.NewPedersenBLSUnchained()
+	secret := scheme.KeyGroup.Scalar().SetInt64(7)
+	pubKey, err := scheme.KeyGroup.Point().Mul(secret, nil).MarshalBinary()
+	s.Require().NoError(err)
+
+	sign := func(round uint64) []byte {
+		sig, err := scheme.AuthScheme.Sign(secret, scheme.DigestBeacon(&common.Beacon{Round: round}))
+		s.Require().NoError(err)
+		return sig
+	}
+
+	ct, err := vrftypes.TimelockEncrypt(pubKey, 42, []byte("sealed bid"))
+	s.Require().NoError(err)
+	s.Require().NoError(ct.Validate())
+
+	plaintext, err := vrftypes.TimelockDecrypt(sign(42), 42, ct)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("sealed bid"), plaintext)
+
+	_, err = vrftypes.TimelockDecrypt(sign(43), 42, ct)
+	s.Require().Error(err)
+
+	ct.Payload[0] ^= 0xff
+	_, err = vrftypes.TimelockDecrypt(sign(42), 42, ct)
+	s.Require().Error(err)
+
+	_, err = vrftypes.TimelockEncrypt(pubKey, 42, make([]byte, vrftypes.MaxTimelockPayloadLength+1))
+	s.Require().Error(err)
+}
+
+func (s *TypesSuite) TestECVRF() {
+	// RFC 9381 Appendix B.3 test vector for ECVRF-EDWARDS25519-SHA512-TAI.
+	vectors := []struct {
+		sk, alpha, pi, beta string
+	}{
+		{
+			sk:    "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			alpha: "",
+			pi:    "8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805",
+			beta:  "90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae",
+		},
+	}
+
+	for _, v := range vectors {
+		seed, err := hex.DecodeString(v.sk)
+		s.Require().NoError(err)
+		alpha, err := hex.DecodeString(v.alpha)
+		s.Require().NoError(err)
+		sk := ed25519.NewKeyFromSeed(seed)
+		pk := sk.Public().(ed25519.PublicKey)
+
+		pi, err := vrftypes.ECVRFProve(sk, alpha)
+		s.Require().NoError(err)
+		s.Require().Equal(v.pi, hex.EncodeToString(pi))
+
+		beta, err := vrftypes.ECVRFVerify(pk, alpha, pi)
+		s.Require().NoError(err)
+		s.Require().Equal(v.beta, hex.EncodeToString(beta))
+
+		_, err = vrftypes.ECVRFVerify(pk, append(alpha, 0), pi)
+		s.Require().Error(err)
+
+		pi[len(pi)-1] ^= 0x01
+		_, err = vrftypes.ECVRFVerify(pk, alpha, pi)
+		s.Require().Error(err)
+	}
+
+	// Proofs over the fallback input verify only under the proving key.
+	pk, sk, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	otherPK, _, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+
+	alpha := vrftypes.ECVRFFallbackInput(10, []byte("randomness"))
+	pi, err := vrftypes.ECVRFProve(sk, alpha)
+	s.Require().NoError(err)
+	s.Require().Len(pi, vrftypes.ECVRFProofLength)
+
+	beta, err := vrftypes.ECVRFVerify(pk, alpha, pi)
+	s.Require().NoError(err)
+	s.Require().Len(beta, vrftypes.ECVRFOutputLength)
+
+	_, err = vrftypes.ECVRFVerify(otherPK, alpha, pi)
+	s.Require().Error(err)
 }
@@ -7,6 +7,9 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,6 +28,224 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VrfBeaconSource identifies the origin of a VrfBeacon.
+type VrfBeaconSource int32
+
+const (
+	// VRF_BEACON_SOURCE_DRAND marks a verified drand beacon. It is the zero
+	// value so that beacons stored before sources existed read as drand.
+	VrfBeaconSource_VRF_BEACON_SOURCE_DRAND VrfBeaconSource = 0
+	// VRF_BEACON_SOURCE_ECVRF marks the weaker fallback randomness combined from
+	// the validators' ECVRF proofs while no drand round reached quorum. Its
+	// drand_round and signatures are empty.
+	VrfBeaconSource_VRF_BEACON_SOURCE_ECVRF VrfBeaconSource = 1
+)
+
+var VrfBeaconSource_name = map[int32]string{
+	0: "VRF_BEACON_SOURCE_DRAND",
+	1: "VRF_BEACON_SOURCE_ECVRF",
+}
+
+var VrfBeaconSource_value = map[string]int32{
+	"VRF_BEACON_SOURCE_DRAND": 0,
+	"VRF_BEACON_SOURCE_ECVRF": 1,
+}
+
+func (x VrfBeaconSource) String() string {
+	return proto.EnumName(VrfBeaconSource_name, int32(x))
+}
+
+func (VrfBeaconSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{0}
+}
+
+// VrfCommitteeRole is a permission of a committee member. The module
+// authority holds every role.
+type VrfCommitteeRole int32
+
+const (
+	// VRF_COMMITTEE_ROLE_UNSPECIFIED is not a valid role.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED VrfCommitteeRole = 0
+	// VRF_COMMITTEE_ROLE_EMERGENCY allows MsgVrfEmergencyDisable.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_EMERGENCY VrfCommitteeRole = 1
+	// VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP allows MsgInitialDkg.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP VrfCommitteeRole = 2
+	// VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER allows MsgScheduleVrfReshare.
+	VrfCommitteeRole_VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER VrfCommitteeRole = 3
+)
+
+var VrfCommitteeRole_name = map[int32]string{
+	0: "VRF_COMMITTEE_ROLE_UNSPECIFIED",
+	1: "VRF_COMMITTEE_ROLE_EMERGENCY",
+	2: "VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP",
+	3: "VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER",
+}
+
+var VrfCommitteeRole_value = map[string]int32{
+	"VRF_COMMITTEE_ROLE_UNSPECIFIED":       0,
+	"VRF_COMMITTEE_ROLE_EMERGENCY":         1,
+	"VRF_COMMITTEE_ROLE_DKG_BOOTSTRAP":     2,
+	"VRF_COMMITTEE_ROLE_RESHARE_SCHEDULER": 3,
+}
+
+func (x VrfCommitteeRole) String() string {
+	return proto.EnumName(VrfCommitteeRole_name, int32(x))
+}
+
+func (VrfCommitteeRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{1}
+}
+
+// VrfParticipationReason classifies a validator's vote extension at a height.
+type VrfParticipationReason int32
+
+const (
+	// VRF_PARTICIPATION_REASON_UNSPECIFIED is the zero value and is never recorded.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED VrfParticipationReason = 0
+	// VRF_PARTICIPATION_REASON_CONTRIBUTED marks a valid beacon for the finalized round.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_CONTRIBUTED VrfParticipationReason = 1
+	// VRF_PARTICIPATION_REASON_ABSENT marks a validator that did not commit a vote.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_ABSENT VrfParticipationReason = 2
+	// VRF_PARTICIPATION_REASON_EMPTY marks a committed vote without an extension.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_EMPTY VrfParticipationReason = 3
+	// VRF_PARTICIPATION_REASON_DECODE_FAILED marks an extension that could not be
+	// decoded or has an unsupported version.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_DECODE_FAILED VrfParticipationReason = 4
+	// VRF_PARTICIPATION_REASON_WRONG_ROUND marks an extension without any beacon
+	// inside the round_tolerance window.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_WRONG_ROUND VrfParticipationReason = 5
+	// VRF_PARTICIPATION_REASON_HASH_MISMATCH marks an extension whose beacons
+	// failed the randomness == SHA256(signature) check.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_HASH_MISMATCH VrfParticipationReason = 6
+	// VRF_PARTICIPATION_REASON_BLS_FAILED marks an extension whose beacons failed
+	// BLS verification.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_BLS_FAILED VrfParticipationReason = 7
+	// VRF_PARTICIPATION_REASON_OTHER_ROUND marks an extension with valid beacons,
+	// none of which is for the finalized round.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_OTHER_ROUND VrfParticipationReason = 8
+	// VRF_PARTICIPATION_REASON_UNKNOWN_SHARE marks a partial signature from a
+	// validator without a registered identity or with a mismatching share index.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_UNKNOWN_SHARE VrfParticipationReason = 9
+	// VRF_PARTICIPATION_REASON_ECVRF_ONLY marks an extension carrying only an
+	// ECVRF fallback proof, sent when the validator could not reach drand.
+	VrfParticipationReason_VRF_PARTICIPATION_REASON_ECVRF_ONLY VrfParticipationReason = 10
+)
+
+var VrfParticipationReason_name = map[int32]string{
+	0:  "VRF_PARTICIPATION_REASON_UNSPECIFIED",
+	1:  "VRF_PARTICIPATION_REASON_CONTRIBUTED",
+	2:  "VRF_PARTICIPATION_REASON_ABSENT",
+	3:  "VRF_PARTICIPATION_REASON_EMPTY",
+	4:  "VRF_PARTICIPATION_REASON_DECODE_FAILED",
+	5:  "VRF_PARTICIPATION_REASON_WRONG_ROUND",
+	6:  "VRF_PARTICIPATION_REASON_HASH_MISMATCH",
+	7:  "VRF_PARTICIPATION_REASON_BLS_FAILED",
+	8:  "VRF_PARTICIPATION_REASON_OTHER_ROUND",
+	9:  "VRF_PARTICIPATION_REASON_UNKNOWN_SHARE",
+	10: "VRF_PARTICIPATION_REASON_ECVRF_ONLY",
+}
+
+var VrfParticipationReason_value = map[string]int32{
+	"VRF_PARTICIPATION_REASON_UNSPECIFIED":   0,
+	"VRF_PARTICIPATION_REASON_CONTRIBUTED":   1,
+	"VRF_PARTICIPATION_REASON_ABSENT":        2,
+	"VRF_PARTICIPATION_REASON_EMPTY":         3,
+	"VRF_PARTICIPATION_REASON_DECODE_FAILED": 4,
+	"VRF_PARTICIPATION_REASON_WRONG_ROUND":   5,
+	"VRF_PARTICIPATION_REASON_HASH_MISMATCH": 6,
+	"VRF_PARTICIPATION_REASON_BLS_FAILED":    7,
+	"VRF_PARTICIPATION_REASON_OTHER_ROUND":   8,
+	"VRF_PARTICIPATION_REASON_UNKNOWN_SHARE": 9,
+	"VRF_PARTICIPATION_REASON_ECVRF_ONLY":    10,
+}
+
+func (x VrfParticipationReason) String() string {
+	return proto.EnumName(VrfParticipationReason_name, int32(x))
+}
+
+func (VrfParticipationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{2}
+}
+
+// RandomnessRequestStatus is the lifecycle state of a paid randomness request.
+type RandomnessRequestStatus int32
+
+const (
+	// RANDOMNESS_REQUEST_STATUS_UNSPECIFIED is the zero value and is never stored.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_UNSPECIFIED RandomnessRequestStatus = 0
+	// RANDOMNESS_REQUEST_STATUS_PENDING waits for a beacon at or above min_drand_round.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_PENDING RandomnessRequestStatus = 1
+	// RANDOMNESS_REQUEST_STATUS_FULFILLED carries words derived from the
+	// fulfilling beacon; the fee was paid to its contributors.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_FULFILLED RandomnessRequestStatus = 2
+	// RANDOMNESS_REQUEST_STATUS_REFUNDED was refunded because VRF was disabled
+	// before fulfillment.
+	RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_REFUNDED RandomnessRequestStatus = 3
+)
+
+var RandomnessRequestStatus_name = map[int32]string{
+	0: "RANDOMNESS_REQUEST_STATUS_UNSPECIFIED",
+	1: "RANDOMNESS_REQUEST_STATUS_PENDING",
+	2: "RANDOMNESS_REQUEST_STATUS_FULFILLED",
+	3: "RANDOMNESS_REQUEST_STATUS_REFUNDED",
+}
+
+var RandomnessRequestStatus_value = map[string]int32{
+	"RANDOMNESS_REQUEST_STATUS_UNSPECIFIED": 0,
+	"RANDOMNESS_REQUEST_STATUS_PENDING":     1,
+	"RANDOMNESS_REQUEST_STATUS_FULFILLED":   2,
+	"RANDOMNESS_REQUEST_STATUS_REFUNDED":    3,
+}
+
+func (x RandomnessRequestStatus) String() string {
+	return proto.EnumName(RandomnessRequestStatus_name, int32(x))
+}
+
+func (RandomnessRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{3}
+}
+
+// TimelockStatus is the lifecycle state of a time-locked ciphertext.
+type TimelockStatus int32
+
+const (
+	// TIMELOCK_STATUS_UNSPECIFIED is the zero value and is never stored.
+	TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED TimelockStatus = 0
+	// TIMELOCK_STATUS_PENDING waits for drand_round to be finalized.
+	TimelockStatus_TIMELOCK_STATUS_PENDING TimelockStatus = 1
+	// TIMELOCK_STATUS_DECRYPTED carries the plaintext.
+	TimelockStatus_TIMELOCK_STATUS_DECRYPTED TimelockStatus = 2
+	// TIMELOCK_STATUS_FAILED could not be decrypted with the round signature.
+	TimelockStatus_TIMELOCK_STATUS_FAILED TimelockStatus = 3
+	// TIMELOCK_STATUS_SKIPPED was not decrypted because the chain finalized a
+	// later round without finalizing drand_round.
+	TimelockStatus_TIMELOCK_STATUS_SKIPPED TimelockStatus = 4
+)
+
+var TimelockStatus_name = map[int32]string{
+	0: "TIMELOCK_STATUS_UNSPECIFIED",
+	1: "TIMELOCK_STATUS_PENDING",
+	2: "TIMELOCK_STATUS_DECRYPTED",
+	3: "TIMELOCK_STATUS_FAILED",
+	4: "TIMELOCK_STATUS_SKIPPED",
+}
+
+var TimelockStatus_value = map[string]int32{
+	"TIMELOCK_STATUS_UNSPECIFIED": 0,
+	"TIMELOCK_STATUS_PENDING":     1,
+	"TIMELOCK_STATUS_DECRYPTED":   2,
+	"TIMELOCK_STATUS_FAILED":      3,
+	"TIMELOCK_STATUS_SKIPPED":     4,
+}
+
+func (x TimelockStatus) String() string {
+	return proto.EnumName(TimelockStatus_name, int32(x))
+}
+
+func (TimelockStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{4}
+}
+
 // VrfBeacon is the canonical drand beacon selected for a given block height.
 // It carries only the fields required for full on-chain verification.
 type VrfBeacon struct {
@@ -37,6 +258,9 @@ type VrfBeacon struct {
 	// previous_signature is the BLS signature of the previous round, required
 	// for chained-scheme verification.
 	PreviousSignature []byte `protobuf:"bytes,4,opt,name=previous_signature,json=previousSignature,proto3" json:"previous_signature,omitempty"`
+	// source identifies where the randomness came from. Consumers that need
+	// drand's guarantees must refuse any other source.
+	Source VrfBeaconSource `protobuf:"varint,5,opt,name=source,proto3,enum=digitalkitchen.vrf.v1.VrfBeaconSource" json:"source,omitempty"`
 }
 
 func (m *VrfBeacon) Reset()         { *m = VrfBeacon{} }
@@ -100,12 +324,23 @@ func (m *VrfBeacon) GetPreviousSignature() []byte {
 	return nil
 }
 
-// AllowlistEntry maps an address to a human-readable label for auditing.
+func (m *VrfBeacon) GetSource() VrfBeaconSource {
+	if m != nil {
+		return m.Source
+	}
+	return VrfBeaconSource_VRF_BEACON_SOURCE_DRAND
+}
+
+// AllowlistEntry maps an address to a human-readable label for auditing and
+// to the committee roles it holds.
 type AllowlistEntry struct {
 	// address is the allowlisted account address.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// label is free-form metadata for operator UX / auditing.
 	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// roles are the permissions granted to the member. A member without roles
+	// is only listed.
+	Roles []VrfCommitteeRoleGrant `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles"`
 }
 
 func (m *AllowlistEntry) Reset()         { *m = AllowlistEntry{} }
@@ -155,6 +390,69 @@ func (m *AllowlistEntry) GetLabel() string {
 	return ""
 }
 
+func (m *AllowlistEntry) GetRoles() []VrfCommitteeRoleGrant {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// VrfCommitteeRoleGrant grants a role to a committee member.
+type VrfCommitteeRoleGrant struct {
+	// role is the granted permission.
+	Role VrfCommitteeRole `protobuf:"varint,1,opt,name=role,proto3,enum=digitalkitchen.vrf.v1.VrfCommitteeRole" json:"role,omitempty"`
+	// expiry_height, when non-zero, is the first height at which the grant no
+	// longer applies.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *VrfCommitteeRoleGrant) Reset()         { *m = VrfCommitteeRoleGrant{} }
+func (m *VrfCommitteeRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*VrfCommitteeRoleGrant) ProtoMessage()    {}
+func (*VrfCommitteeRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{2}
+}
+func (m *VrfCommitteeRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfCommitteeRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfCommitteeRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VrfCommitteeRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfCommitteeRoleGrant.Merge(m, src)
+}
+func (m *VrfCommitteeRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfCommitteeRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfCommitteeRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfCommitteeRoleGrant proto.InternalMessageInfo
+
+func (m *VrfCommitteeRoleGrant) GetRole() VrfCommitteeRole {
+	if m != nil {
+		return m.Role
+	}
+	return VrfCommitteeRole_VRF_COMMITTEE_ROLE_UNSPECIFIED
+}
+
+func (m *VrfCommitteeRoleGrant) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // VrfIdentity binds a validator to a drand BLS identity / share for monitoring
 // and resharing coordination.
 type VrfIdentity struct {
@@ -169,13 +467,17 @@ type VrfIdentity struct {
 	// signal_reshare_epoch is the value of VrfParams.reshare_epoch when this
 	// identity was first registered.
 	SignalReshareEpoch uint64 `protobuf:"varint,5,opt,name=signal_reshare_epoch,json=signalReshareEpoch,proto3" json:"signal_reshare_epoch,omitempty"`
+	// share_index is the validator's index in the drand group. In threshold mode
+	// its partial signatures must carry this index and verify against
+	// drand_bls_public_key.
+	ShareIndex uint32 `protobuf:"varint,6,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
 }
 
 func (m *VrfIdentity) Reset()         { *m = VrfIdentity{} }
 func (m *VrfIdentity) String() string { return proto.CompactTextString(m) }
 func (*VrfIdentity) ProtoMessage()    {}
 func (*VrfIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e758a8cd98a93fdd, []int{2}
+	return fileDescriptor_e758a8cd98a93fdd, []int{3}
 }
 func (m *VrfIdentity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,370 +541,3186 @@ func (m *VrfIdentity) GetSignalReshareEpoch() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*VrfBeacon)(nil), "digitalkitchen.vrf.v1.VrfBeacon")
-	proto.RegisterType((*AllowlistEntry)(nil), "digitalkitchen.vrf.v1.AllowlistEntry")
-	proto.RegisterType((*VrfIdentity)(nil), "digitalkitchen.vrf.v1.VrfIdentity")
+func (m *VrfIdentity) GetShareIndex() uint32 {
+	if m != nil {
+		return m.ShareIndex
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("digitalkitchen/vrf/v1/vrf.proto", fileDescriptor_e758a8cd98a93fdd) }
+// VrfParticipation records how every validator in the injected commit
+// contributed to the beacon finalized at a height.
+type VrfParticipation struct {
+	// height is the block height at which the beacon was finalized.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// target_round is target_round(H) computed by PreBlock.
+	TargetRound uint64 `protobuf:"varint,2,opt,name=target_round,json=targetRound,proto3" json:"target_round,omitempty"`
+	// drand_round is the round of the finalized beacon.
+	DrandRound uint64 `protobuf:"varint,3,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// validators are the consensus addresses in commit order.
+	Validators [][]byte `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	// contributed is a bitmap over validators; bit i (LSB first) is set when
+	// validators[i] contributed a valid beacon for drand_round.
+	Contributed []byte `protobuf:"bytes,5,opt,name=contributed,proto3" json:"contributed,omitempty"`
+	// reasons holds one reason code per entry in validators.
+	Reasons []VrfParticipationReason `protobuf:"varint,6,rep,packed,name=reasons,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"reasons,omitempty"`
+}
 
-var fileDescriptor_e758a8cd98a93fdd = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xad, 0x03, 0xd5, 0xe3, 0x5f, 0xad, 0x20, 0x85, 0x89, 0xa5, 0x63, 0x87, 0x69,
-	0x97, 0x36, 0x0c, 0x6e, 0x9c, 0x58, 0xa5, 0x49, 0x20, 0x24, 0x84, 0x52, 0xd1, 0x03, 0x17, 0xe3,
-	0xc4, 0x6e, 0x62, 0xcd, 0xb5, 0x23, 0xdb, 0x09, 0xcd, 0x87, 0x40, 0xe2, 0x1b, 0xc0, 0x87, 0xd8,
-	0x87, 0xe0, 0x38, 0xed, 0xc4, 0x11, 0xb5, 0x17, 0x3e, 0x06, 0x8a, 0x9d, 0x68, 0x94, 0x4b, 0x92,
-	0xf7, 0xf9, 0x3d, 0xd1, 0xf3, 0xe6, 0x89, 0xc1, 0x88, 0xb0, 0x8c, 0x19, 0xcc, 0x2f, 0x99, 0x49,
-	0x73, 0x2a, 0xa2, 0x4a, 0x2d, 0xa2, 0xea, 0xac, 0xb9, 0x4d, 0x0a, 0x25, 0x8d, 0x84, 0x8f, 0xb7,
-	0x0d, 0x93, 0x86, 0x54, 0x67, 0x07, 0x4f, 0x52, 0xa9, 0x97, 0x52, 0x23, 0x6b, 0x8a, 0xdc, 0xe0,
-	0xde, 0x38, 0xf0, 0x33, 0x99, 0x49, 0xa7, 0x37, 0x4f, 0x4e, 0x3d, 0xfe, 0xee, 0x81, 0xc1, 0x5c,
-	0x2d, 0xa6, 0x14, 0xa7, 0x52, 0xc0, 0x11, 0xd8, 0x27, 0x0a, 0x0b, 0x82, 0x94, 0x2c, 0x05, 0x09,
-	0xbc, 0x23, 0xef, 0xb4, 0x1f, 0x03, 0x2b, 0xc5, 0x8d, 0x02, 0x43, 0x00, 0x9a, 0x41, 0x2e, 0x05,
-	0xd5, 0x3a, 0xd8, 0x39, 0xf2, 0x4e, 0xef, 0xc5, 0xff, 0x28, 0xf0, 0x29, 0x18, 0x68, 0x96, 0x09,
-	0x6c, 0x4a, 0x45, 0x83, 0x5d, 0x8b, 0x6f, 0x05, 0x38, 0x06, 0xb0, 0x50, 0xb4, 0x62, 0xb2, 0xd4,
-	0xe8, 0xd6, 0xd6, 0xb7, 0xb6, 0x61, 0x47, 0x66, 0x1d, 0x78, 0xd5, 0xff, 0xf3, 0x63, 0xe4, 0x1d,
-	0x7f, 0x06, 0x0f, 0xce, 0x39, 0x97, 0x5f, 0x38, 0xd3, 0xe6, 0x42, 0x18, 0x55, 0xc3, 0x17, 0xe0,
-	0x2e, 0x26, 0x44, 0x35, 0x1b, 0x34, 0x1b, 0x0e, 0xa6, 0xc1, 0xcd, 0xd5, 0xd8, 0x6f, 0x3f, 0xf6,
-	0xdc, 0x91, 0x99, 0x51, 0x4c, 0x64, 0x71, 0x67, 0x84, 0x3e, 0xd8, 0xe3, 0x38, 0xa1, 0xdc, 0xee,
-	0x3c, 0x88, 0xdd, 0xd0, 0x26, 0x7c, 0xdd, 0x01, 0xfb, 0x73, 0xb5, 0x78, 0x4b, 0xa8, 0x30, 0xcc,
-	0xd4, 0xf0, 0x3d, 0x18, 0x56, 0x98, 0x33, 0x82, 0x8d, 0x54, 0x68, 0x3b, 0xe9, 0xd9, 0xcd, 0xd5,
-	0xf8, 0xb0, 0x4d, 0x9a, 0x77, 0x9e, 0xed, 0xc8, 0x47, 0xd5, 0x7f, 0x3a, 0x8c, 0x80, 0xef, 0x5a,
-	0x4d, 0xb8, 0x46, 0x45, 0x99, 0x70, 0x96, 0xa2, 0x4b, 0x5a, 0xb7, 0xf5, 0x0d, 0x2d, 0x9b, 0x72,
-	0xfd, 0xc1, 0x92, 0x77, 0xb4, 0x86, 0x87, 0x00, 0xa4, 0x39, 0x66, 0x02, 0xe5, 0x58, 0xe7, 0x5d,
-	0x8d, 0x56, 0x79, 0x83, 0x75, 0x0e, 0x4f, 0xc0, 0x43, 0xdb, 0x1e, 0x47, 0xa5, 0x60, 0x2b, 0xa4,
-	0x69, 0x6a, 0x3b, 0xdc, 0x8d, 0xef, 0x3b, 0xf9, 0xa3, 0x60, 0xab, 0x19, 0x4d, 0xe1, 0x73, 0xe0,
-	0xb7, 0x3e, 0x45, 0x75, 0x8e, 0x15, 0x45, 0xb4, 0x90, 0x69, 0x1e, 0xec, 0xd9, 0xdf, 0x0a, 0x1d,
-	0x8b, 0x1d, 0xba, 0x68, 0x88, 0xeb, 0x63, 0xfa, 0xfa, 0xe7, 0x3a, 0xf4, 0xae, 0xd7, 0xa1, 0xf7,
-	0x7b, 0x1d, 0x7a, 0xdf, 0x36, 0x61, 0xef, 0x7a, 0x13, 0xf6, 0x7e, 0x6d, 0xc2, 0xde, 0xa7, 0x93,
-	0x8c, 0x99, 0xbc, 0x4c, 0x26, 0xa9, 0x5c, 0x46, 0x24, 0x33, 0x5b, 0xc7, 0x73, 0x65, 0xaf, 0xa6,
-	0x2e, 0xa8, 0x4e, 0xee, 0xd8, 0xc3, 0xf5, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x7c,
-	0xd1, 0x1e, 0xc7, 0x02, 0x00, 0x00,
+func (m *VrfParticipation) Reset()         { *m = VrfParticipation{} }
+func (m *VrfParticipation) String() string { return proto.CompactTextString(m) }
+func (*VrfParticipation) ProtoMessage()    {}
+func (*VrfParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{4}
+}
+func (m *VrfParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VrfParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfParticipation.Merge(m, src)
+}
+func (m *VrfParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfParticipation.DiscardUnknown(m)
 }
 
-func (this *VrfBeacon) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_VrfParticipation proto.InternalMessageInfo
+
+func (m *VrfParticipation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
+	return 0
+}
 
-	that1, ok := that.(*VrfBeacon)
-	if !ok {
-		that2, ok := that.(VrfBeacon)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *VrfParticipation) GetTargetRound() uint64 {
+	if m != nil {
+		return m.TargetRound
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *VrfParticipation) GetDrandRound() uint64 {
+	if m != nil {
+		return m.DrandRound
 	}
-	if this.DrandRound != that1.DrandRound {
-		return false
+	return 0
+}
+
+func (m *VrfParticipation) GetValidators() [][]byte {
+	if m != nil {
+		return m.Validators
 	}
-	if !bytes.Equal(this.Randomness, that1.Randomness) {
-		return false
+	return nil
+}
+
+func (m *VrfParticipation) GetContributed() []byte {
+	if m != nil {
+		return m.Contributed
 	}
-	if !bytes.Equal(this.Signature, that1.Signature) {
-		return false
+	return nil
+}
+
+func (m *VrfParticipation) GetReasons() []VrfParticipationReason {
+	if m != nil {
+		return m.Reasons
 	}
-	if !bytes.Equal(this.PreviousSignature, that1.PreviousSignature) {
-		return false
+	return nil
+}
+
+// VrfParticipationReasonCount counts how often a reason was recorded.
+type VrfParticipationReasonCount struct {
+	Reason VrfParticipationReason `protobuf:"varint,1,opt,name=reason,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"reason,omitempty"`
+	Count  uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *VrfParticipationReasonCount) Reset()         { *m = VrfParticipationReasonCount{} }
+func (m *VrfParticipationReasonCount) String() string { return proto.CompactTextString(m) }
+func (*VrfParticipationReasonCount) ProtoMessage()    {}
+func (*VrfParticipationReasonCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{5}
+}
+func (m *VrfParticipationReasonCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfParticipationReasonCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfParticipationReasonCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *AllowlistEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *VrfParticipationReasonCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfParticipationReasonCount.Merge(m, src)
+}
+func (m *VrfParticipationReasonCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfParticipationReasonCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfParticipationReasonCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfParticipationReasonCount proto.InternalMessageInfo
+
+func (m *VrfParticipationReasonCount) GetReason() VrfParticipationReason {
+	if m != nil {
+		return m.Reason
 	}
+	return VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED
+}
 
-	that1, ok := that.(*AllowlistEntry)
-	if !ok {
-		that2, ok := that.(AllowlistEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *VrfParticipationReasonCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// VrfParticipationSummary aggregates a validator's participation over the
+// retained participation records.
+type VrfParticipationSummary struct {
+	// consensus_address is the validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// heights is the number of retained records in which the validator appears.
+	Heights uint64 `protobuf:"varint,2,opt,name=heights,proto3" json:"heights,omitempty"`
+	// contributed is the number of heights at which the validator contributed.
+	Contributed uint64 `protobuf:"varint,3,opt,name=contributed,proto3" json:"contributed,omitempty"`
+	// reason_counts breaks heights down by reason, ordered by reason code.
+	ReasonCounts []VrfParticipationReasonCount `protobuf:"bytes,4,rep,name=reason_counts,json=reasonCounts,proto3" json:"reason_counts"`
+	// last_height is the most recent retained height including the validator.
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// last_reason is the reason recorded at last_height.
+	LastReason VrfParticipationReason `protobuf:"varint,6,opt,name=last_reason,json=lastReason,proto3,enum=digitalkitchen.vrf.v1.VrfParticipationReason" json:"last_reason,omitempty"`
+	// last_contributed_height is the most recent height at which the validator
+	// contributed, or zero if it did not contribute within the window.
+	LastContributedHeight int64 `protobuf:"varint,7,opt,name=last_contributed_height,json=lastContributedHeight,proto3" json:"last_contributed_height,omitempty"`
+}
+
+func (m *VrfParticipationSummary) Reset()         { *m = VrfParticipationSummary{} }
+func (m *VrfParticipationSummary) String() string { return proto.CompactTextString(m) }
+func (*VrfParticipationSummary) ProtoMessage()    {}
+func (*VrfParticipationSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{6}
+}
+func (m *VrfParticipationSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VrfParticipationSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VrfParticipationSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *VrfParticipationSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VrfParticipationSummary.Merge(m, src)
+}
+func (m *VrfParticipationSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *VrfParticipationSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_VrfParticipationSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VrfParticipationSummary proto.InternalMessageInfo
+
+func (m *VrfParticipationSummary) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
 	}
-	if this.Address != that1.Address {
-		return false
+	return ""
+}
+
+func (m *VrfParticipationSummary) GetHeights() uint64 {
+	if m != nil {
+		return m.Heights
 	}
-	if this.Label != that1.Label {
-		return false
+	return 0
+}
+
+func (m *VrfParticipationSummary) GetContributed() uint64 {
+	if m != nil {
+		return m.Contributed
 	}
-	return true
+	return 0
 }
-func (this *VrfIdentity) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *VrfParticipationSummary) GetReasonCounts() []VrfParticipationReasonCount {
+	if m != nil {
+		return m.ReasonCounts
 	}
+	return nil
+}
 
-	that1, ok := that.(*VrfIdentity)
-	if !ok {
-		that2, ok := that.(VrfIdentity)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if !bytes.Equal(this.DrandBlsPublicKey, that1.DrandBlsPublicKey) {
-		return false
-	}
-	if !bytes.Equal(this.ChainHash, that1.ChainHash) {
-		return false
-	}
-	if this.SignalUnixSec != that1.SignalUnixSec {
-		return false
+func (m *VrfParticipationSummary) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
 	}
-	if this.SignalReshareEpoch != that1.SignalReshareEpoch {
-		return false
+	return 0
+}
+
+func (m *VrfParticipationSummary) GetLastReason() VrfParticipationReason {
+	if m != nil {
+		return m.LastReason
 	}
-	return true
+	return VrfParticipationReason_VRF_PARTICIPATION_REASON_UNSPECIFIED
 }
-func (m *VrfBeacon) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *VrfParticipationSummary) GetLastContributedHeight() int64 {
+	if m != nil {
+		return m.LastContributedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VrfBeacon) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RandomnessRequest is a paid request for randomness committed to a drand
+// round that had not been published when the request was made.
+type RandomnessRequest struct {
+	// id is the unique request identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// requester is the account that paid for the request.
+	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	// num_words is the number of 32-byte words to derive.
+	NumWords uint32 `protobuf:"varint,3,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// user_seed is mixed into the word derivation.
+	UserSeed []byte `protobuf:"bytes,4,opt,name=user_seed,json=userSeed,proto3" json:"user_seed,omitempty"`
+	// fee is the amount escrowed in the vrf module account.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// min_drand_round is the first drand round allowed to fulfill the request.
+	MinDrandRound uint64 `protobuf:"varint,6,opt,name=min_drand_round,json=minDrandRound,proto3" json:"min_drand_round,omitempty"`
+	// request_height is the height at which the request was made.
+	RequestHeight int64 `protobuf:"varint,7,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	// status is the request lifecycle state.
+	Status RandomnessRequestStatus `protobuf:"varint,8,opt,name=status,proto3,enum=digitalkitchen.vrf.v1.RandomnessRequestStatus" json:"status,omitempty"`
+	// drand_round is the round of the fulfilling beacon.
+	DrandRound uint64 `protobuf:"varint,9,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// fulfilled_height is the height at which the request was fulfilled or
+	// refunded.
+	FulfilledHeight int64 `protobuf:"varint,10,opt,name=fulfilled_height,json=fulfilledHeight,proto3" json:"fulfilled_height,omitempty"`
+	// words are the derived random words once fulfilled.
+	Words [][]byte `protobuf:"bytes,11,rep,name=words,proto3" json:"words,omitempty"`
 }
 
-func (m *VrfBeacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PreviousSignature) > 0 {
-		i -= len(m.PreviousSignature)
-		copy(dAtA[i:], m.PreviousSignature)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.PreviousSignature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Randomness) > 0 {
-		i -= len(m.Randomness)
-		copy(dAtA[i:], m.Randomness)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.Randomness)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DrandRound != 0 {
-		i = encodeVarintVrf(dAtA, i, uint64(m.DrandRound))
-		i--
-		dAtA[i] = 0x8
+func (m *RandomnessRequest) Reset()         { *m = RandomnessRequest{} }
+func (m *RandomnessRequest) String() string { return proto.CompactTextString(m) }
+func (*RandomnessRequest) ProtoMessage()    {}
+func (*RandomnessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{7}
+}
+func (m *RandomnessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandomnessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandomnessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RandomnessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandomnessRequest.Merge(m, src)
+}
+func (m *RandomnessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandomnessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandomnessRequest.DiscardUnknown(m)
 }
 
-func (m *AllowlistEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RandomnessRequest proto.InternalMessageInfo
+
+func (m *RandomnessRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AllowlistEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RandomnessRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
 }
 
-func (m *AllowlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *RandomnessRequest) GetNumWords() uint32 {
+	if m != nil {
+		return m.NumWords
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *VrfIdentity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RandomnessRequest) GetUserSeed() []byte {
+	if m != nil {
+		return m.UserSeed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VrfIdentity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RandomnessRequest) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
 
-func (m *VrfIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignalReshareEpoch != 0 {
-		i = encodeVarintVrf(dAtA, i, uint64(m.SignalReshareEpoch))
-		i--
-		dAtA[i] = 0x28
+func (m *RandomnessRequest) GetMinDrandRound() uint64 {
+	if m != nil {
+		return m.MinDrandRound
 	}
-	if m.SignalUnixSec != 0 {
-		i = encodeVarintVrf(dAtA, i, uint64(m.SignalUnixSec))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *RandomnessRequest) GetRequestHeight() int64 {
+	if m != nil {
+		return m.RequestHeight
 	}
-	if len(m.ChainHash) > 0 {
-		i -= len(m.ChainHash)
-		copy(dAtA[i:], m.ChainHash)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.ChainHash)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *RandomnessRequest) GetStatus() RandomnessRequestStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.DrandBlsPublicKey) > 0 {
-		i -= len(m.DrandBlsPublicKey)
-		copy(dAtA[i:], m.DrandBlsPublicKey)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.DrandBlsPublicKey)))
-		i--
-		dAtA[i] = 0x12
+	return RandomnessRequestStatus_RANDOMNESS_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (m *RandomnessRequest) GetDrandRound() uint64 {
+	if m != nil {
+		return m.DrandRound
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintVrf(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *RandomnessRequest) GetFulfilledHeight() int64 {
+	if m != nil {
+		return m.FulfilledHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintVrf(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVrf(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RandomnessRequest) GetWords() [][]byte {
+	if m != nil {
+		return m.Words
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *VrfBeacon) Size() (n int) {
-	if m == nil {
-		return 0
+
+// TimelockCiphertext is a payload time-lock encrypted to a drand round, as in
+// tlock: a 32-byte key is encrypted with Boneh-Franklin IBE to the identity
+// sha256(be64(round)), and the payload is sealed with ChaCha20-Poly1305 under
+// that key.
+type TimelockCiphertext struct {
+	// u is the IBE point rP on the key group.
+	U []byte `protobuf:"bytes,1,opt,name=u,proto3" json:"u,omitempty"`
+	// v is sigma XOR H(e(rP, Q_id)).
+	V []byte `protobuf:"bytes,2,opt,name=v,proto3" json:"v,omitempty"`
+	// w is the symmetric key XOR H(sigma).
+	W []byte `protobuf:"bytes,3,opt,name=w,proto3" json:"w,omitempty"`
+	// payload is the ChaCha20-Poly1305 sealed plaintext.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *TimelockCiphertext) Reset()         { *m = TimelockCiphertext{} }
+func (m *TimelockCiphertext) String() string { return proto.CompactTextString(m) }
+func (*TimelockCiphertext) ProtoMessage()    {}
+func (*TimelockCiphertext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{8}
+}
+func (m *TimelockCiphertext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockCiphertext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockCiphertext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.DrandRound != 0 {
-		n += 1 + sovVrf(uint64(m.DrandRound))
+}
+func (m *TimelockCiphertext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockCiphertext.Merge(m, src)
+}
+func (m *TimelockCiphertext) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockCiphertext) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockCiphertext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockCiphertext proto.InternalMessageInfo
+
+func (m *TimelockCiphertext) GetU() []byte {
+	if m != nil {
+		return m.U
 	}
-	l = len(m.Randomness)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
+	return nil
+}
+
+func (m *TimelockCiphertext) GetV() []byte {
+	if m != nil {
+		return m.V
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
+	return nil
+}
+
+func (m *TimelockCiphertext) GetW() []byte {
+	if m != nil {
+		return m.W
 	}
-	l = len(m.PreviousSignature)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
+	return nil
+}
+
+func (m *TimelockCiphertext) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return n
+	return nil
+}
+
+// TimelockedMessage is a stored time-locked ciphertext and, once its round is
+// finalized, its plaintext.
+type TimelockedMessage struct {
+	// id is the unique ciphertext identifier.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// submitter is the account that submitted the ciphertext.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// drand_round is the round the ciphertext is encrypted to.
+	DrandRound uint64 `protobuf:"varint,3,opt,name=drand_round,json=drandRound,proto3" json:"drand_round,omitempty"`
+	// ciphertext is the submitted ciphertext.
+	Ciphertext TimelockCiphertext `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext"`
+	// submit_height is the height at which the ciphertext was submitted.
+	SubmitHeight int64 `protobuf:"varint,5,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// status is the ciphertext lifecycle state.
+	Status TimelockStatus `protobuf:"varint,6,opt,name=status,proto3,enum=digitalkitchen.vrf.v1.TimelockStatus" json:"status,omitempty"`
+	// plaintext is the decrypted payload once decrypted.
+	Plaintext []byte `protobuf:"bytes,7,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// decrypted_height is the height at which decryption was attempted.
+	DecryptedHeight int64 `protobuf:"varint,8,opt,name=decrypted_height,json=decryptedHeight,proto3" json:"decrypted_height,omitempty"`
+}
+
+func (m *TimelockedMessage) Reset()         { *m = TimelockedMessage{} }
+func (m *TimelockedMessage) String() string { return proto.CompactTextString(m) }
+func (*TimelockedMessage) ProtoMessage()    {}
+func (*TimelockedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e758a8cd98a93fdd, []int{9}
+}
+func (m *TimelockedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedMessage.Merge(m, src)
+}
+func (m *TimelockedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedMessage proto.InternalMessageInfo
+
+func (m *TimelockedMessage) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TimelockedMessage) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *TimelockedMessage) GetDrandRound() uint64 {
+	if m != nil {
+		return m.DrandRound
+	}
+	return 0
+}
+
+func (m *TimelockedMessage) GetCiphertext() TimelockCiphertext {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return TimelockCiphertext{}
+}
+
+func (m *TimelockedMessage) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *TimelockedMessage) GetStatus() TimelockStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TimelockStatus_TIMELOCK_STATUS_UNSPECIFIED
+}
+
+func (m *TimelockedMessage) GetPlaintext() []byte {
+	if m != nil {
+		return m.Plaintext
+	}
+	return nil
+}
+
+func (m *TimelockedMessage) GetDecryptedHeight() int64 {
+	if m != nil {
+		return m.DecryptedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("digitalkitchen.vrf.v1.VrfBeaconSource", VrfBeaconSource_name, VrfBeaconSource_value)
+	proto.RegisterEnum("digitalkitchen.vrf.v1.VrfCommitteeRole", VrfCommitteeRole_name, VrfCommitteeRole_value)
+	proto.RegisterEnum("digitalkitchen.vrf.v1.VrfParticipationReason", VrfParticipationReason_name, VrfParticipationReason_value)
+	proto.RegisterEnum("digitalkitchen.vrf.v1.RandomnessRequestStatus", RandomnessRequestStatus_name, RandomnessRequestStatus_value)
+	proto.RegisterEnum("digitalkitchen.vrf.v1.TimelockStatus", TimelockStatus_name, TimelockStatus_value)
+	proto.RegisterType((*VrfBeacon)(nil), "digitalkitchen.vrf.v1.VrfBeacon")
+	proto.RegisterType((*AllowlistEntry)(nil), "digitalkitchen.vrf.v1.AllowlistEntry")
+	proto.RegisterType((*VrfCommitteeRoleGrant)(nil), "digitalkitchen.vrf.v1.VrfCommitteeRoleGrant")
+	proto.RegisterType((*VrfIdentity)(nil), "digitalkitchen.vrf.v1.VrfIdentity")
+	proto.RegisterType((*VrfParticipation)(nil), "digitalkitchen.vrf.v1.VrfParticipation")
+	proto.RegisterType((*VrfParticipationReasonCount)(nil), "digitalkitchen.vrf.v1.VrfParticipationReasonCount")
+	proto.RegisterType((*VrfParticipationSummary)(nil), "digitalkitchen.vrf.v1.VrfParticipationSummary")
+	proto.RegisterType((*RandomnessRequest)(nil), "digitalkitchen.vrf.v1.RandomnessRequest")
+	proto.RegisterType((*TimelockCiphertext)(nil), "digitalkitchen.vrf.v1.TimelockCiphertext")
+	proto.RegisterType((*TimelockedMessage)(nil), "digitalkitchen.vrf.v1.TimelockedMessage")
+}
+
+func init() { proto.RegisterFile("digitalkitchen/vrf/v1/vrf.proto", fileDescriptor_e758a8cd98a93fdd) }
+
+var fileDescriptor_e758a8cd98a93fdd = []byte{
+	// 1693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0x5b, 0xb6, 0x9e, 0xff, 0x84, 0x1e, 0x38, 0xb1, 0x12, 0x27, 0xb2, 0x92, 0x6c,
+	0xbc, 0x8e, 0x51, 0x4b, 0x8d, 0x8b, 0xee, 0xa1, 0x45, 0x8b, 0x4a, 0x14, 0x6d, 0x09, 0xb6, 0x28,
+	0x75, 0x48, 0x39, 0x48, 0x81, 0x96, 0xa0, 0xc8, 0xb1, 0x44, 0x84, 0x22, 0x55, 0x0e, 0xa9, 0xd8,
+	0xa7, 0xf6, 0xd2, 0x7b, 0x3f, 0x42, 0x4f, 0xc5, 0x62, 0x0f, 0x45, 0x81, 0xee, 0xb1, 0x1f, 0x60,
+	0x8f, 0x8b, 0x3d, 0xf5, 0xd4, 0x14, 0xc9, 0xa1, 0xfd, 0x08, 0xbd, 0xb5, 0x98, 0xe1, 0x48, 0xb2,
+	0x14, 0xc9, 0xbb, 0xd9, 0x8b, 0xad, 0xf7, 0x7b, 0xbf, 0x99, 0xf7, 0xe6, 0xfd, 0x95, 0x60, 0xcf,
+	0x71, 0x3b, 0x6e, 0x64, 0x79, 0xaf, 0xdd, 0xc8, 0xee, 0x12, 0xbf, 0x38, 0x08, 0x2f, 0x8b, 0x83,
+	0x17, 0xec, 0x5f, 0xa1, 0x1f, 0x06, 0x51, 0x80, 0xee, 0x4e, 0x12, 0x0a, 0x4c, 0x33, 0x78, 0xf1,
+	0x60, 0xcb, 0xea, 0xb9, 0x7e, 0x50, 0xe4, 0x7f, 0x13, 0xe6, 0x83, 0x9c, 0x1d, 0xd0, 0x5e, 0x40,
+	0x8b, 0x6d, 0x8b, 0x92, 0xe2, 0xe0, 0x45, 0x9b, 0x44, 0xd6, 0x8b, 0xa2, 0x1d, 0xb8, 0xbe, 0xd0,
+	0xdf, 0x4f, 0xf4, 0x26, 0x97, 0x8a, 0x89, 0x20, 0x54, 0xdb, 0x9d, 0xa0, 0x13, 0x24, 0x38, 0xfb,
+	0x94, 0xa0, 0x4f, 0xde, 0x4a, 0x90, 0xb9, 0x08, 0x2f, 0xcb, 0xc4, 0xb2, 0x03, 0x1f, 0xed, 0xc1,
+	0x9a, 0x13, 0x5a, 0xbe, 0x63, 0x86, 0x41, 0xec, 0x3b, 0x59, 0x29, 0x2f, 0x1d, 0x2c, 0x61, 0xe0,
+	0x10, 0x66, 0x08, 0xca, 0x01, 0x30, 0x21, 0xe8, 0xf9, 0x84, 0xd2, 0xec, 0x62, 0x5e, 0x3a, 0x58,
+	0xc7, 0x37, 0x10, 0xf4, 0x10, 0x32, 0xd4, 0xed, 0xf8, 0x56, 0x14, 0x87, 0x24, 0x9b, 0xe2, 0xea,
+	0x31, 0x80, 0x8e, 0x00, 0xf5, 0x43, 0x32, 0x70, 0x83, 0x98, 0x9a, 0x63, 0xda, 0x12, 0xa7, 0x6d,
+	0x0d, 0x35, 0xfa, 0x88, 0xfe, 0x73, 0x48, 0xd3, 0x20, 0x0e, 0x6d, 0x92, 0x5d, 0xce, 0x4b, 0x07,
+	0x9b, 0xc7, 0xfb, 0x85, 0x99, 0x71, 0x2a, 0x8c, 0xfc, 0xd7, 0x39, 0x1b, 0x8b, 0x53, 0x3f, 0x59,
+	0xfa, 0xcf, 0x9f, 0xf6, 0xa4, 0x27, 0x5f, 0x48, 0xb0, 0x59, 0xf2, 0xbc, 0xe0, 0x8d, 0xe7, 0xd2,
+	0x48, 0xf5, 0xa3, 0xf0, 0x1a, 0x1d, 0xc3, 0x8a, 0xe5, 0x38, 0x21, 0x7b, 0x02, 0x7b, 0x62, 0xa6,
+	0x9c, 0xfd, 0xe6, 0xcb, 0xa3, 0x6d, 0x11, 0xad, 0x52, 0xa2, 0xd1, 0xa3, 0xd0, 0xf5, 0x3b, 0x78,
+	0x48, 0x44, 0xdb, 0xb0, 0xec, 0x59, 0x6d, 0xe2, 0xf1, 0x47, 0x67, 0x70, 0x22, 0xa0, 0x2a, 0x2c,
+	0x87, 0x81, 0x47, 0x68, 0x36, 0x95, 0x4f, 0x1d, 0xac, 0x1d, 0xff, 0x60, 0xbe, 0x87, 0x4a, 0xd0,
+	0xeb, 0xb9, 0x51, 0x44, 0x08, 0x0e, 0x3c, 0x72, 0x1a, 0x5a, 0x7e, 0x54, 0x5e, 0xfa, 0xea, 0x9f,
+	0x7b, 0x0b, 0x38, 0xb9, 0x40, 0x38, 0xfb, 0x3b, 0xb8, 0x3b, 0x93, 0x8b, 0x7e, 0x0a, 0x4b, 0x8c,
+	0xc7, 0xfd, 0xdd, 0x3c, 0xfe, 0xf4, 0x3b, 0xda, 0xc1, 0xfc, 0x10, 0x7a, 0x0a, 0x1b, 0xe4, 0xaa,
+	0xef, 0x86, 0xd7, 0x66, 0x97, 0xb8, 0x9d, 0x6e, 0xc4, 0xdf, 0x90, 0xc2, 0xeb, 0x09, 0x58, 0xe5,
+	0x98, 0x70, 0xe0, 0x2f, 0x8b, 0xb0, 0x76, 0x11, 0x5e, 0xd6, 0x1c, 0xe2, 0x47, 0x6e, 0x74, 0x8d,
+	0x34, 0xd8, 0x1a, 0x58, 0x9e, 0xeb, 0x58, 0x51, 0x10, 0x9a, 0x93, 0x41, 0x7b, 0xfc, 0xcd, 0x97,
+	0x47, 0x8f, 0x44, 0xd0, 0x2e, 0x86, 0x9c, 0xc9, 0xe8, 0xc9, 0x83, 0x29, 0x1c, 0x15, 0x61, 0x3b,
+	0xa9, 0xb0, 0xb6, 0x47, 0xcd, 0x7e, 0xdc, 0xf6, 0x5c, 0xdb, 0x7c, 0x4d, 0xae, 0x45, 0x29, 0x6d,
+	0x71, 0x5d, 0xd9, 0xa3, 0x4d, 0xae, 0x39, 0x23, 0xd7, 0xe8, 0x11, 0x80, 0xdd, 0xb5, 0x5c, 0xdf,
+	0xec, 0x5a, 0xb4, 0x3b, 0x2c, 0x29, 0x8e, 0x54, 0x2d, 0xda, 0x45, 0xfb, 0x70, 0x87, 0x57, 0x92,
+	0x67, 0xc6, 0xbe, 0x7b, 0x65, 0x52, 0x62, 0xf3, 0x7a, 0x4a, 0xe1, 0x8d, 0x04, 0x6e, 0xf9, 0xee,
+	0x95, 0x4e, 0x6c, 0xf4, 0x43, 0xd8, 0x16, 0xbc, 0x90, 0xd0, 0xae, 0x15, 0x12, 0x93, 0xf4, 0x03,
+	0xbb, 0xcb, 0x2b, 0x6b, 0x09, 0xa3, 0x44, 0x87, 0x13, 0x95, 0xca, 0x34, 0xac, 0x17, 0x12, 0xa2,
+	0xeb, 0x3b, 0xe4, 0x2a, 0x9b, 0xce, 0x4b, 0x07, 0x1b, 0x18, 0x38, 0x54, 0x63, 0x88, 0x08, 0xd8,
+	0xff, 0x24, 0x90, 0x2f, 0xc2, 0xcb, 0xa6, 0x15, 0x46, 0xae, 0xed, 0xf6, 0xad, 0xc8, 0x0d, 0x7c,
+	0x74, 0x0f, 0xd2, 0x22, 0xd2, 0x12, 0x77, 0x46, 0x48, 0xe8, 0x31, 0xac, 0x47, 0x56, 0xd8, 0x21,
+	0x91, 0x68, 0xb0, 0x45, 0x6e, 0x7d, 0x2d, 0xc1, 0x92, 0x0e, 0x9b, 0x6a, 0xc1, 0xd4, 0xac, 0x16,
+	0x1c, 0x45, 0x95, 0x66, 0x97, 0xf2, 0x29, 0xd6, 0x82, 0x63, 0x04, 0xe5, 0x61, 0xcd, 0x0e, 0xfc,
+	0x28, 0x74, 0xdb, 0x71, 0x44, 0x1c, 0xfe, 0xc0, 0x75, 0x7c, 0x13, 0x42, 0xa7, 0xb0, 0x12, 0x12,
+	0x8b, 0x06, 0x3e, 0xcd, 0xa6, 0xf3, 0xa9, 0x83, 0xcd, 0xe3, 0xa3, 0xf9, 0xe5, 0x34, 0xf1, 0x2e,
+	0xcc, 0x4f, 0xe1, 0xe1, 0x69, 0x11, 0x81, 0xdf, 0x4b, 0xb0, 0x3b, 0x9b, 0xa9, 0x04, 0xb1, 0x1f,
+	0x21, 0x15, 0xd2, 0xc9, 0x01, 0x51, 0xbc, 0x1f, 0x69, 0x4d, 0x1c, 0x66, 0x0d, 0x68, 0xb3, 0xfb,
+	0x44, 0xd0, 0x12, 0x41, 0xb8, 0xf0, 0xb7, 0x14, 0xec, 0x4c, 0x1f, 0xd7, 0xe3, 0x5e, 0xcf, 0x0a,
+	0x79, 0x05, 0xdb, 0x81, 0x4f, 0x89, 0x4f, 0x63, 0x7a, 0x4b, 0x05, 0x2b, 0x43, 0xce, 0x54, 0x05,
+	0xdb, 0x53, 0x38, 0xca, 0xc2, 0x4a, 0x92, 0x4d, 0x2a, 0x3c, 0x19, 0x8a, 0xd3, 0x91, 0x4f, 0x52,
+	0x37, 0x11, 0xf9, 0x5f, 0xc3, 0x46, 0xf2, 0x1a, 0x93, 0x7b, 0x9f, 0xa4, 0x6f, 0xed, 0xf8, 0xf8,
+	0xa3, 0x22, 0xc2, 0xa3, 0x2a, 0x86, 0xc7, 0x7a, 0x38, 0x86, 0x28, 0xab, 0x1d, 0xcf, 0xa2, 0xd1,
+	0xb0, 0xcb, 0x97, 0x79, 0xed, 0x01, 0x83, 0x92, 0x1e, 0x47, 0x9a, 0x20, 0x88, 0x7c, 0xa4, 0xbf,
+	0x4f, 0x3e, 0xf8, 0x7d, 0xc9, 0x67, 0xf4, 0x19, 0xec, 0xf0, 0xfb, 0x6e, 0xbc, 0x71, 0x68, 0x7c,
+	0x85, 0x1b, 0xbf, 0xcb, 0xd4, 0xca, 0x58, 0x3b, 0x31, 0x6b, 0xfe, 0x9b, 0x82, 0x2d, 0x3c, 0xda,
+	0x1d, 0x98, 0xfc, 0x36, 0x26, 0x34, 0x42, 0x9b, 0xb0, 0xe8, 0x0e, 0x57, 0xcf, 0xa2, 0xeb, 0xa0,
+	0xcf, 0x20, 0x13, 0x26, 0x2a, 0x12, 0x26, 0xc3, 0xf7, 0x96, 0x71, 0x3d, 0xa6, 0xa2, 0x5d, 0xc8,
+	0xf8, 0x71, 0xcf, 0x7c, 0x13, 0x84, 0x0e, 0xe5, 0xb9, 0xd8, 0xc0, 0xab, 0x7e, 0xdc, 0x7b, 0xc9,
+	0x64, 0xa6, 0x8c, 0x29, 0x09, 0x4d, 0x4a, 0x88, 0x23, 0x16, 0xd0, 0x2a, 0x03, 0x74, 0x42, 0x1c,
+	0xd4, 0x86, 0xd4, 0x25, 0x61, 0x4b, 0x87, 0xe5, 0xe6, 0x7e, 0x41, 0x18, 0x62, 0x2b, 0xb7, 0x20,
+	0x56, 0x6e, 0x41, 0x09, 0x5c, 0xbf, 0xfc, 0x63, 0x96, 0x82, 0x2f, 0xde, 0xee, 0x1d, 0x74, 0xdc,
+	0xa8, 0x1b, 0xb7, 0x0b, 0x76, 0xd0, 0x13, 0x2b, 0x57, 0xfc, 0x3b, 0xa2, 0xce, 0xeb, 0x62, 0x74,
+	0xdd, 0x27, 0x94, 0x1f, 0xa0, 0x9f, 0xff, 0xfb, 0xaf, 0x87, 0x12, 0x66, 0x97, 0xb3, 0xb9, 0xd5,
+	0x73, 0x7d, 0xf3, 0x66, 0xab, 0xa7, 0xf9, 0x93, 0x37, 0x7a, 0xae, 0x5f, 0x19, 0x77, 0xfb, 0x33,
+	0xd8, 0x14, 0x4f, 0x9a, 0x0c, 0xec, 0x86, 0x40, 0x45, 0x62, 0x4f, 0x20, 0x4d, 0x23, 0x2b, 0x8a,
+	0x69, 0x76, 0x95, 0xe7, 0xb4, 0x30, 0x27, 0xa7, 0x1f, 0x84, 0x5b, 0xe7, 0xa7, 0xb0, 0x38, 0x3d,
+	0x3d, 0x7d, 0x32, 0x1f, 0x4c, 0x9f, 0xe7, 0x20, 0x5f, 0xc6, 0xde, 0xa5, 0xeb, 0x79, 0xe3, 0x54,
+	0x03, 0xf7, 0xe8, 0xce, 0x08, 0x17, 0x3e, 0x6d, 0xc3, 0x72, 0x12, 0xfc, 0x35, 0x3e, 0xa3, 0x12,
+	0x41, 0xa4, 0xfe, 0x37, 0x80, 0x0c, 0xb7, 0x47, 0xbc, 0xc0, 0x7e, 0xad, 0xb8, 0xfd, 0x2e, 0x09,
+	0x23, 0x72, 0x15, 0xa1, 0x75, 0x90, 0x62, 0x9e, 0xf9, 0x75, 0x2c, 0xc5, 0x4c, 0x1a, 0x88, 0xbd,
+	0x20, 0x0d, 0x98, 0xf4, 0x46, 0x8c, 0x7f, 0xe9, 0x0d, 0x6b, 0xc2, 0xbe, 0x75, 0xed, 0x05, 0xd6,
+	0x30, 0x7b, 0x43, 0x51, 0xdc, 0xff, 0x87, 0x14, 0x6c, 0x0d, 0x0d, 0x10, 0xa7, 0x4e, 0x28, 0xb5,
+	0x3a, 0x64, 0x56, 0x69, 0xd1, 0xb8, 0xcd, 0xd7, 0xe5, 0x77, 0x28, 0xad, 0x11, 0xf5, 0xdb, 0x67,
+	0xb4, 0x01, 0x60, 0x8f, 0x9e, 0xc5, 0x3d, 0x5c, 0x3b, 0x7e, 0x3e, 0x27, 0x25, 0x1f, 0xc6, 0xa1,
+	0x9c, 0x61, 0x85, 0x95, 0x14, 0xcb, 0x8d, 0x7b, 0xd8, 0x1a, 0x4f, 0x7c, 0x98, 0x6c, 0xf0, 0xf5,
+	0x04, 0x14, 0x51, 0xff, 0xd9, 0xa8, 0x12, 0x92, 0xee, 0x7e, 0xf6, 0x2d, 0x66, 0xa7, 0x0a, 0xe0,
+	0x21, 0x64, 0xfa, 0x9e, 0xe5, 0xfa, 0xdc, 0xf1, 0x95, 0x64, 0xdb, 0x8e, 0x00, 0x96, 0x7d, 0x87,
+	0xd8, 0xe1, 0x75, 0xff, 0x46, 0xa3, 0xaf, 0x26, 0xd9, 0x1f, 0xe1, 0x37, 0x5b, 0xfc, 0xf0, 0x0c,
+	0xee, 0x4c, 0x7d, 0x3b, 0x43, 0xbb, 0xb0, 0x73, 0x81, 0x4f, 0xcc, 0xb2, 0x5a, 0x52, 0x1a, 0x9a,
+	0xa9, 0x37, 0x5a, 0x58, 0x51, 0xcd, 0x0a, 0x2e, 0x69, 0x15, 0x79, 0x61, 0xb6, 0x52, 0x55, 0x2e,
+	0xf0, 0x89, 0x2c, 0x1d, 0x7e, 0x9e, 0xac, 0xda, 0x89, 0x6f, 0x38, 0xe8, 0x09, 0xe4, 0xd8, 0x09,
+	0xa5, 0x51, 0xaf, 0xd7, 0x0c, 0x43, 0x55, 0x4d, 0xdc, 0x38, 0x57, 0xcd, 0x96, 0xa6, 0x37, 0x55,
+	0xa5, 0x76, 0x52, 0x53, 0xd9, 0xad, 0x79, 0x78, 0x38, 0x83, 0xa3, 0xd6, 0x55, 0x7c, 0xaa, 0x6a,
+	0xca, 0x2b, 0x59, 0x42, 0x9f, 0x40, 0x7e, 0x06, 0xa3, 0x72, 0x76, 0x6a, 0x96, 0x1b, 0x0d, 0x43,
+	0x37, 0x70, 0xa9, 0x29, 0x2f, 0xa2, 0x03, 0xf8, 0x64, 0x06, 0x0b, 0xab, 0x7a, 0xb5, 0x84, 0x55,
+	0x53, 0x57, 0xaa, 0x6a, 0xa5, 0x75, 0xae, 0x62, 0x39, 0x75, 0xf8, 0x36, 0x05, 0xf7, 0x66, 0xcf,
+	0xcf, 0xe1, 0x25, 0xcd, 0x12, 0x36, 0x6a, 0x4a, 0xad, 0x59, 0x32, 0x6a, 0x0d, 0xcd, 0xc4, 0x6a,
+	0x49, 0x6f, 0x68, 0x53, 0x6e, 0xdf, 0xc6, 0x54, 0x1a, 0x9a, 0x81, 0x6b, 0xe5, 0x96, 0xa1, 0x56,
+	0x64, 0x09, 0x3d, 0x85, 0xbd, 0xb9, 0xcc, 0x52, 0x59, 0x57, 0x35, 0x43, 0x5e, 0x1c, 0x46, 0x6a,
+	0x26, 0x49, 0xad, 0x37, 0x8d, 0x57, 0x72, 0x0a, 0x1d, 0xc2, 0xfe, 0x5c, 0x4e, 0x45, 0x55, 0x1a,
+	0x15, 0xd5, 0x3c, 0x29, 0xd5, 0xce, 0xd5, 0x8a, 0xbc, 0x74, 0xab, 0x7b, 0x2f, 0x71, 0x43, 0x3b,
+	0x35, 0x71, 0xa3, 0xa5, 0x55, 0xe4, 0xe5, 0x5b, 0x6f, 0xad, 0x96, 0xf4, 0xaa, 0x59, 0xaf, 0xe9,
+	0xf5, 0x92, 0xa1, 0x54, 0xe5, 0x34, 0xfa, 0x14, 0x9e, 0xce, 0xe5, 0x96, 0xcf, 0xf5, 0xa1, 0xf9,
+	0x95, 0x5b, 0xcd, 0x37, 0x8c, 0xaa, 0x8a, 0x85, 0xf9, 0xd5, 0x5b, 0xcd, 0xb7, 0xb4, 0x33, 0xad,
+	0xf1, 0x52, 0x33, 0x79, 0x0a, 0xe5, 0xcc, 0xad, 0xe6, 0x79, 0x1d, 0x9a, 0x0d, 0xed, 0xfc, 0x95,
+	0x0c, 0x87, 0x7f, 0x97, 0x60, 0x67, 0xce, 0x34, 0x45, 0xcf, 0xe1, 0x19, 0xab, 0xe7, 0x46, 0x5d,
+	0x53, 0x75, 0xdd, 0xc4, 0xea, 0x2f, 0x5b, 0xaa, 0x6e, 0x98, 0xba, 0x51, 0x32, 0x5a, 0xfa, 0x54,
+	0x8e, 0x9f, 0xc1, 0xe3, 0xf9, 0xd4, 0xa6, 0xaa, 0x55, 0x6a, 0xda, 0xa9, 0x2c, 0x31, 0xb7, 0xe6,
+	0xd3, 0x4e, 0x5a, 0xe7, 0x27, 0xb5, 0x73, 0x16, 0x95, 0x45, 0xb4, 0x0f, 0x4f, 0xe6, 0x13, 0xb1,
+	0x7a, 0xd2, 0xd2, 0x2a, 0x6a, 0x45, 0x4e, 0x1d, 0xfe, 0x59, 0x82, 0xcd, 0xc9, 0x11, 0x80, 0xf6,
+	0x60, 0xd7, 0xa8, 0xd5, 0xd5, 0xf3, 0x86, 0x72, 0x36, 0xdb, 0xd7, 0x5d, 0xd8, 0x99, 0x26, 0x8c,
+	0x3d, 0x7c, 0x04, 0xf7, 0xa7, 0x95, 0x15, 0x55, 0xc1, 0xaf, 0x9a, 0x06, 0xf7, 0xeb, 0x01, 0xdc,
+	0x9b, 0x56, 0x8b, 0x4c, 0xa6, 0x66, 0xdd, 0xab, 0x9f, 0xd5, 0x9a, 0x4d, 0x56, 0x65, 0xe5, 0x5f,
+	0x7c, 0xf5, 0x2e, 0x27, 0x7d, 0xfd, 0x2e, 0x27, 0xfd, 0xeb, 0x5d, 0x4e, 0xfa, 0xe3, 0xfb, 0xdc,
+	0xc2, 0xd7, 0xef, 0x73, 0x0b, 0xff, 0x78, 0x9f, 0x5b, 0xf8, 0xd5, 0xfe, 0x8d, 0xb5, 0xeb, 0x74,
+	0xa2, 0x89, 0x9f, 0xd7, 0x57, 0xfc, 0x2f, 0x5f, 0xbd, 0xed, 0x34, 0xff, 0xa5, 0xfb, 0xa3, 0xff,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0x98, 0x4f, 0x8a, 0xf0, 0x87, 0x0f, 0x00, 0x00,
+}
+
+func (this *VrfBeacon) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfBeacon)
+	if !ok {
+		that2, ok := that.(VrfBeacon)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DrandRound != that1.DrandRound {
+		return false
+	}
+	if !bytes.Equal(this.Randomness, that1.Randomness) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.PreviousSignature, that1.PreviousSignature) {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	return true
+}
+func (this *AllowlistEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllowlistEntry)
+	if !ok {
+		that2, ok := that.(AllowlistEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VrfCommitteeRoleGrant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfCommitteeRoleGrant)
+	if !ok {
+		that2, ok := that.(VrfCommitteeRoleGrant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	return true
+}
+func (this *VrfIdentity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfIdentity)
+	if !ok {
+		that2, ok := that.(VrfIdentity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !bytes.Equal(this.DrandBlsPublicKey, that1.DrandBlsPublicKey) {
+		return false
+	}
+	if !bytes.Equal(this.ChainHash, that1.ChainHash) {
+		return false
+	}
+	if this.SignalUnixSec != that1.SignalUnixSec {
+		return false
+	}
+	if this.SignalReshareEpoch != that1.SignalReshareEpoch {
+		return false
+	}
+	if this.ShareIndex != that1.ShareIndex {
+		return false
+	}
+	return true
+}
+func (this *VrfParticipation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfParticipation)
+	if !ok {
+		that2, ok := that.(VrfParticipation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.TargetRound != that1.TargetRound {
+		return false
+	}
+	if this.DrandRound != that1.DrandRound {
+		return false
+	}
+	if len(this.Validators) != len(that1.Validators) {
+		return false
+	}
+	for i := range this.Validators {
+		if !bytes.Equal(this.Validators[i], that1.Validators[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Contributed, that1.Contributed) {
+		return false
+	}
+	if len(this.Reasons) != len(that1.Reasons) {
+		return false
+	}
+	for i := range this.Reasons {
+		if this.Reasons[i] != that1.Reasons[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *VrfParticipationReasonCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfParticipationReasonCount)
+	if !ok {
+		that2, ok := that.(VrfParticipationReasonCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *VrfParticipationSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VrfParticipationSummary)
+	if !ok {
+		that2, ok := that.(VrfParticipationSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.Heights != that1.Heights {
+		return false
+	}
+	if this.Contributed != that1.Contributed {
+		return false
+	}
+	if len(this.ReasonCounts) != len(that1.ReasonCounts) {
+		return false
+	}
+	for i := range this.ReasonCounts {
+		if !this.ReasonCounts[i].Equal(&that1.ReasonCounts[i]) {
+			return false
+		}
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	if this.LastReason != that1.LastReason {
+		return false
+	}
+	if this.LastContributedHeight != that1.LastContributedHeight {
+		return false
+	}
+	return true
+}
+func (this *RandomnessRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RandomnessRequest)
+	if !ok {
+		that2, ok := that.(RandomnessRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Requester != that1.Requester {
+		return false
+	}
+	if this.NumWords != that1.NumWords {
+		return false
+	}
+	if !bytes.Equal(this.UserSeed, that1.UserSeed) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if this.MinDrandRound != that1.MinDrandRound {
+		return false
+	}
+	if this.RequestHeight != that1.RequestHeight {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.DrandRound != that1.DrandRound {
+		return false
+	}
+	if this.FulfilledHeight != that1.FulfilledHeight {
+		return false
+	}
+	if len(this.Words) != len(that1.Words) {
+		return false
+	}
+	for i := range this.Words {
+		if !bytes.Equal(this.Words[i], that1.Words[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TimelockCiphertext) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimelockCiphertext)
+	if !ok {
+		that2, ok := that.(TimelockCiphertext)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.U, that1.U) {
+		return false
+	}
+	if !bytes.Equal(this.V, that1.V) {
+		return false
+	}
+	if !bytes.Equal(this.W, that1.W) {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *TimelockedMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimelockedMessage)
+	if !ok {
+		that2, ok := that.(TimelockedMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Submitter != that1.Submitter {
+		return false
+	}
+	if this.DrandRound != that1.DrandRound {
+		return false
+	}
+	if !this.Ciphertext.Equal(&that1.Ciphertext) {
+		return false
+	}
+	if this.SubmitHeight != that1.SubmitHeight {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !bytes.Equal(this.Plaintext, that1.Plaintext) {
+		return false
+	}
+	if this.DecryptedHeight != that1.DecryptedHeight {
+		return false
+	}
+	return true
+}
+func (m *VrfBeacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfBeacon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfBeacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PreviousSignature) > 0 {
+		i -= len(m.PreviousSignature)
+		copy(dAtA[i:], m.PreviousSignature)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.PreviousSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Randomness) > 0 {
+		i -= len(m.Randomness)
+		copy(dAtA[i:], m.Randomness)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Randomness)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowlistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowlistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVrf(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfCommitteeRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfCommitteeRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfCommitteeRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Role != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareIndex != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.ShareIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SignalReshareEpoch != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.SignalReshareEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignalUnixSec != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.SignalUnixSec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainHash) > 0 {
+		i -= len(m.ChainHash)
+		copy(dAtA[i:], m.ChainHash)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.ChainHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DrandBlsPublicKey) > 0 {
+		i -= len(m.DrandBlsPublicKey)
+		copy(dAtA[i:], m.DrandBlsPublicKey)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.DrandBlsPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		dAtA2 := make([]byte, len(m.Reasons)*10)
+		var j1 int
+		for _, num := range m.Reasons {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintVrf(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contributed) > 0 {
+		i -= len(m.Contributed)
+		copy(dAtA[i:], m.Contributed)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Contributed)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintVrf(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.TargetRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfParticipationReasonCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfParticipationReasonCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfParticipationReasonCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VrfParticipationSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VrfParticipationSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VrfParticipationSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastContributedHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.LastContributedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastReason != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.LastReason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReasonCounts) > 0 {
+		for iNdEx := len(m.ReasonCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReasonCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVrf(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Contributed != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Contributed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Heights != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Heights))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandomnessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandomnessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandomnessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Words) > 0 {
+		for iNdEx := len(m.Words) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Words[iNdEx])
+			copy(dAtA[i:], m.Words[iNdEx])
+			i = encodeVarintVrf(dAtA, i, uint64(len(m.Words[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.FulfilledHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.FulfilledHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DrandRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RequestHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinDrandRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.MinDrandRound))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVrf(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UserSeed) > 0 {
+		i -= len(m.UserSeed)
+		copy(dAtA[i:], m.UserSeed)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.UserSeed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumWords != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.NumWords))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockCiphertext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockCiphertext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockCiphertext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.W) > 0 {
+		i -= len(m.W)
+		copy(dAtA[i:], m.W)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.W)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.U) > 0 {
+		i -= len(m.U)
+		copy(dAtA[i:], m.U)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.U)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecryptedHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.DecryptedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Plaintext) > 0 {
+		i -= len(m.Plaintext)
+		copy(dAtA[i:], m.Plaintext)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Plaintext)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Ciphertext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVrf(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DrandRound != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.DrandRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintVrf(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVrf(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVrf(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVrf(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VrfBeacon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrandRound != 0 {
+		n += 1 + sovVrf(uint64(m.DrandRound))
+	}
+	l = len(m.Randomness)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.PreviousSignature)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovVrf(uint64(m.Source))
+	}
+	return n
 }
 
 func (m *AllowlistEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovVrf(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VrfCommitteeRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovVrf(uint64(m.Role))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovVrf(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *VrfIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.DrandBlsPublicKey)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.ChainHash)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.SignalUnixSec != 0 {
+		n += 1 + sovVrf(uint64(m.SignalUnixSec))
+	}
+	if m.SignalReshareEpoch != 0 {
+		n += 1 + sovVrf(uint64(m.SignalReshareEpoch))
+	}
+	if m.ShareIndex != 0 {
+		n += 1 + sovVrf(uint64(m.ShareIndex))
+	}
+	return n
+}
+
+func (m *VrfParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovVrf(uint64(m.Height))
+	}
+	if m.TargetRound != 0 {
+		n += 1 + sovVrf(uint64(m.TargetRound))
+	}
+	if m.DrandRound != 0 {
+		n += 1 + sovVrf(uint64(m.DrandRound))
+	}
+	if len(m.Validators) > 0 {
+		for _, b := range m.Validators {
+			l = len(b)
+			n += 1 + l + sovVrf(uint64(l))
+		}
+	}
+	l = len(m.Contributed)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if len(m.Reasons) > 0 {
+		l = 0
+		for _, e := range m.Reasons {
+			l += sovVrf(uint64(e))
+		}
+		n += 1 + sovVrf(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *VrfParticipationReasonCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovVrf(uint64(m.Reason))
+	}
+	if m.Count != 0 {
+		n += 1 + sovVrf(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *VrfParticipationSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.Heights != 0 {
+		n += 1 + sovVrf(uint64(m.Heights))
+	}
+	if m.Contributed != 0 {
+		n += 1 + sovVrf(uint64(m.Contributed))
+	}
+	if len(m.ReasonCounts) > 0 {
+		for _, e := range m.ReasonCounts {
+			l = e.Size()
+			n += 1 + l + sovVrf(uint64(l))
+		}
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovVrf(uint64(m.LastHeight))
+	}
+	if m.LastReason != 0 {
+		n += 1 + sovVrf(uint64(m.LastReason))
+	}
+	if m.LastContributedHeight != 0 {
+		n += 1 + sovVrf(uint64(m.LastContributedHeight))
+	}
+	return n
+}
+
+func (m *RandomnessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVrf(uint64(m.Id))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.NumWords != 0 {
+		n += 1 + sovVrf(uint64(m.NumWords))
+	}
+	l = len(m.UserSeed)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovVrf(uint64(l))
+		}
+	}
+	if m.MinDrandRound != 0 {
+		n += 1 + sovVrf(uint64(m.MinDrandRound))
+	}
+	if m.RequestHeight != 0 {
+		n += 1 + sovVrf(uint64(m.RequestHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovVrf(uint64(m.Status))
+	}
+	if m.DrandRound != 0 {
+		n += 1 + sovVrf(uint64(m.DrandRound))
+	}
+	if m.FulfilledHeight != 0 {
+		n += 1 + sovVrf(uint64(m.FulfilledHeight))
+	}
+	if len(m.Words) > 0 {
+		for _, b := range m.Words {
+			l = len(b)
+			n += 1 + l + sovVrf(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimelockCiphertext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.U)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.W)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	return n
+}
+
+func (m *TimelockedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVrf(uint64(m.Id))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.DrandRound != 0 {
+		n += 1 + sovVrf(uint64(m.DrandRound))
+	}
+	l = m.Ciphertext.Size()
+	n += 1 + l + sovVrf(uint64(l))
+	if m.SubmitHeight != 0 {
+		n += 1 + sovVrf(uint64(m.SubmitHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovVrf(uint64(m.Status))
+	}
+	l = len(m.Plaintext)
+	if l > 0 {
+		n += 1 + l + sovVrf(uint64(l))
+	}
+	if m.DecryptedHeight != 0 {
+		n += 1 + sovVrf(uint64(m.DecryptedHeight))
+	}
+	return n
+}
+
+func sovVrf(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVrf(x uint64) (n int) {
+	return sovVrf(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfBeacon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randomness = append(m.Randomness[:0], dAtA[iNdEx:postIndex]...)
+			if m.Randomness == nil {
+				m.Randomness = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousSignature = append(m.PreviousSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousSignature == nil {
+				m.PreviousSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= VrfBeaconSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowlistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, VrfCommitteeRoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfCommitteeRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfCommitteeRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfCommitteeRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= VrfCommitteeRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrandBlsPublicKey = append(m.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DrandBlsPublicKey == nil {
+				m.DrandBlsPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainHash == nil {
+				m.ChainHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalUnixSec", wireType)
+			}
+			m.SignalUnixSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalUnixSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalReshareEpoch", wireType)
+			}
+			m.SignalReshareEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalReshareEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
+			}
+			m.ShareIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRound", wireType)
+			}
+			m.TargetRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, make([]byte, postIndex-iNdEx))
+			copy(m.Validators[len(m.Validators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributed = append(m.Contributed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Contributed == nil {
+				m.Contributed = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v VrfParticipationReason
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVrf
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VrfParticipationReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Reasons = append(m.Reasons, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVrf
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVrf
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVrf
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Reasons) == 0 {
+					m.Reasons = make([]VrfParticipationReason, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VrfParticipationReason
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVrf
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VrfParticipationReason(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Reasons = append(m.Reasons, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
+	return nil
+}
+func (m *VrfParticipationReasonCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfParticipationReasonCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfParticipationReasonCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= VrfParticipationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VrfParticipationSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVrf
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VrfParticipationSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VrfParticipationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+			m.Heights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
+			}
+			m.Contributed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Contributed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonCounts = append(m.ReasonCounts, VrfParticipationReasonCount{})
+			if err := m.ReasonCounts[len(m.ReasonCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReason", wireType)
+			}
+			m.LastReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReason |= VrfParticipationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastContributedHeight", wireType)
+			}
+			m.LastContributedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastContributedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVrf(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *VrfIdentity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
-	}
-	l = len(m.DrandBlsPublicKey)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
-	}
-	l = len(m.ChainHash)
-	if l > 0 {
-		n += 1 + l + sovVrf(uint64(l))
-	}
-	if m.SignalUnixSec != 0 {
-		n += 1 + sovVrf(uint64(m.SignalUnixSec))
-	}
-	if m.SignalReshareEpoch != 0 {
-		n += 1 + sovVrf(uint64(m.SignalReshareEpoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVrf(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVrf(x uint64) (n int) {
-	return sovVrf(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
+func (m *RandomnessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -625,17 +3743,193 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VrfBeacon: wiretype end group for non-group")
+			return fmt.Errorf("proto: RandomnessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VrfBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWords", wireType)
+			}
+			m.NumWords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWords |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserSeed = append(m.UserSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserSeed == nil {
+				m.UserSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDrandRound", wireType)
+			}
+			m.MinDrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.DrandRound = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -645,16 +3939,16 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DrandRound |= uint64(b&0x7F) << shift
+				m.Status |= RandomnessRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
 			}
-			var byteLen int
+			m.DrandRound = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -664,31 +3958,16 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DrandRound |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthVrf
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVrf
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Randomness = append(m.Randomness[:0], dAtA[iNdEx:postIndex]...)
-			if m.Randomness == nil {
-				m.Randomness = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfilledHeight", wireType)
 			}
-			var byteLen int
+			m.FulfilledHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -698,29 +3977,14 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.FulfilledHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthVrf
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVrf
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -747,10 +4011,8 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousSignature = append(m.PreviousSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.PreviousSignature == nil {
-				m.PreviousSignature = []byte{}
-			}
+			m.Words = append(m.Words, make([]byte, postIndex-iNdEx))
+			copy(m.Words[len(m.Words)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -773,7 +4035,7 @@ func (m *VrfBeacon) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
+func (m *TimelockCiphertext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -796,17 +4058,17 @@ func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowlistEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelockCiphertext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelockCiphertext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field U", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -816,29 +4078,31 @@ func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVrf
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVrf
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.U = append(m.U[:0], dAtA[iNdEx:postIndex]...)
+			if m.U == nil {
+				m.U = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -848,23 +4112,93 @@ func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthVrf
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVrf
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field W", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.W = append(m.W[:0], dAtA[iNdEx:postIndex]...)
+			if m.W == nil {
+				m.W = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -887,7 +4221,7 @@ func (m *AllowlistEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
+func (m *TimelockedMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -910,15 +4244,34 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VrfIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimelockedMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VrfIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimelockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -946,13 +4299,32 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrandRound", wireType)
+			}
+			m.DrandRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrandRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrandBlsPublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -962,31 +4334,30 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVrf
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVrf
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrandBlsPublicKey = append(m.DrandBlsPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.DrandBlsPublicKey == nil {
-				m.DrandBlsPublicKey = []byte{}
+			if err := m.Ciphertext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainHash", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
 			}
-			var byteLen int
+			m.SubmitHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -996,31 +4367,35 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SubmitHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthVrf
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthVrf
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.ChainHash = append(m.ChainHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainHash == nil {
-				m.ChainHash = []byte{}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVrf
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TimelockStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalUnixSec", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plaintext", wireType)
 			}
-			m.SignalUnixSec = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -1030,16 +4405,31 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignalUnixSec |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthVrf
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVrf
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plaintext = append(m.Plaintext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Plaintext == nil {
+				m.Plaintext = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalReshareEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecryptedHeight", wireType)
 			}
-			m.SignalReshareEpoch = 0
+			m.DecryptedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVrf
@@ -1049,7 +4439,7 @@ func (m *VrfIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignalReshareEpoch |= uint64(b&0x7F) << shift
+				m.DecryptedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}